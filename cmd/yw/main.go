@@ -5,11 +5,14 @@
 package main
 
 import (
+	"errors"
 	"flag"
+	"fmt"
 	"image"
 	"image/png"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/inseo-oh/yw"
 	"github.com/inseo-oh/yw/platform/linux"
@@ -20,8 +23,30 @@ var (
 	dumpDom    = flag.Bool("dumpdom", false, "Dump DOM tree")
 	dumpLayout = flag.Bool("dumplayout", false, "Dump layout tree")
 	dumpPaint  = flag.Bool("dumppaint", false, "Dump paint tree")
+
+	scrollOffsets = map[string]image.Point{}
+	fontDirs      = []string{}
 )
 
+func init() {
+	flag.Func("scroll", "Scroll the element with given ID, as id=x,y (can be repeated)", func(s string) error {
+		id, pos, ok := strings.Cut(s, "=")
+		if !ok {
+			return errors.New("expected id=x,y")
+		}
+		var x, y int
+		if _, err := fmt.Sscanf(pos, "%d,%d", &x, &y); err != nil {
+			return fmt.Errorf("invalid scroll position %q: %w", pos, err)
+		}
+		scrollOffsets[id] = image.Pt(x, y)
+		return nil
+	})
+	flag.Func("fontdir", "Search fonts in given directory, in addition to system font directories (can be repeated)", func(s string) error {
+		fontDirs = append(fontDirs, s)
+		return nil
+	})
+}
+
 func main() {
 	flag.Parse()
 	if *url == "" {
@@ -32,6 +57,9 @@ func main() {
 		DumpDom:    *dumpDom,
 		DumpLayout: *dumpLayout,
 		DumpPaint:  *dumpPaint,
+
+		ScrollOffsets: scrollOffsets,
+		FontDirs:      append(linux.SystemFontDirs(), fontDirs...),
 	}
 	viewportImg := image.NewRGBA(image.Rect(0, 0, 1280, 720))
 	fontProvider := linux.NewDefaultFontProvider()
@@ -0,0 +1,120 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+// Package align provides types and values for [CSS Box Alignment Module Level 3].
+//
+// [CSS Box Alignment Module Level 3]: https://www.w3.org/TR/css-align-3/
+package align
+
+import (
+	"fmt"
+
+	"github.com/inseo-oh/yw/css/values"
+	"github.com/inseo-oh/yw/util"
+)
+
+// Content represents value of [CSS justify-content] property.
+//
+// [CSS justify-content]: https://www.w3.org/TR/css-align-3/#propdef-justify-content
+type Content uint8
+
+const (
+	ContentNormal       Content = iota // normal
+	ContentStart                       // start
+	ContentEnd                         // end
+	ContentFlexStart                   // flex-start
+	ContentFlexEnd                     // flex-end
+	ContentCenter                      // center
+	ContentSpaceBetween                // space-between
+	ContentSpaceAround                 // space-around
+	ContentSpaceEvenly                 // space-evenly
+	ContentStretch                     // stretch
+)
+
+func (c Content) String() string {
+	switch c {
+	case ContentNormal:
+		return "normal"
+	case ContentStart:
+		return "start"
+	case ContentEnd:
+		return "end"
+	case ContentFlexStart:
+		return "flex-start"
+	case ContentFlexEnd:
+		return "flex-end"
+	case ContentCenter:
+		return "center"
+	case ContentSpaceBetween:
+		return "space-between"
+	case ContentSpaceAround:
+		return "space-around"
+	case ContentSpaceEvenly:
+		return "space-evenly"
+	case ContentStretch:
+		return "stretch"
+	}
+	return fmt.Sprintf("<bad Content %d>", c)
+}
+
+// Item represents value of [CSS align-items] and [CSS align-self] property.
+//
+// [CSS align-items]: https://www.w3.org/TR/css-align-3/#propdef-align-items
+// [CSS align-self]: https://www.w3.org/TR/css-align-3/#propdef-align-self
+type Item uint8
+
+const (
+	ItemAuto      Item = iota // auto (Only accepted by align-self)
+	ItemNormal                // normal
+	ItemStretch               // stretch
+	ItemStart                 // start
+	ItemEnd                   // end
+	ItemFlexStart             // flex-start
+	ItemFlexEnd               // flex-end
+	ItemCenter                // center
+	ItemBaseline              // baseline
+)
+
+func (i Item) String() string {
+	switch i {
+	case ItemAuto:
+		return "auto"
+	case ItemNormal:
+		return "normal"
+	case ItemStretch:
+		return "stretch"
+	case ItemStart:
+		return "start"
+	case ItemEnd:
+		return "end"
+	case ItemFlexStart:
+		return "flex-start"
+	case ItemFlexEnd:
+		return "flex-end"
+	case ItemCenter:
+		return "center"
+	case ItemBaseline:
+		return "baseline"
+	}
+	return fmt.Sprintf("<bad Item %d>", i)
+}
+
+// Gap represents value of [CSS row-gap and column-gap] properties.
+//
+// Zero value for Gap means "normal".
+//
+// [CSS row-gap and column-gap]: https://www.w3.org/TR/css-align-3/#column-row-gap
+type Gap struct {
+	Value values.LengthResolvable // nil means normal
+}
+
+// IsNormal reports whether it's normal gap.
+func (g Gap) IsNormal() bool { return util.IsNil(g.Value) }
+
+func (g Gap) String() string {
+	if g.IsNormal() {
+		return "normal"
+	}
+	return fmt.Sprintf("%v", g.Value)
+}
@@ -0,0 +1,72 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package backgrounds
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/inseo-oh/yw/css/csscolor"
+	"github.com/inseo-oh/yw/css/values"
+)
+
+// Radius represents value of border-*-radius properties, which are radii of
+// a quarter ellipse defining shape of the corner.
+//
+// Releavnt spec: https://www.w3.org/TR/css-backgrounds-3/#border-radius
+type Radius struct {
+	Horizontal values.LengthResolvable // Percentages refer to width of the border box
+	Vertical   values.LengthResolvable // Percentages refer to height of the border box
+}
+
+// ZeroRadius is the initial value of border-*-radius, which makes square corner.
+var ZeroRadius = Radius{Horizontal: values.LengthFromPx(0), Vertical: values.LengthFromPx(0)}
+
+// Returns Radius in CSS syntax.
+func (r Radius) String() string {
+	h, v := fmt.Sprint(r.Horizontal), fmt.Sprint(r.Vertical)
+	if h == v {
+		return h
+	}
+	return fmt.Sprintf("%s %s", h, v)
+}
+
+// Shadow represents a single shadow of box-shadow.
+//
+// Releavnt spec: https://www.w3.org/TR/css-backgrounds-3/#box-shadow
+type Shadow struct {
+	Color            csscolor.Color
+	OffsetX, OffsetY values.Length // Offset of the shadow. Positive values move it to right and bottom.
+	Blur             values.Length // Blur radius. Can't be negative.
+	Spread           values.Length // Spread distance. Negative values shrink the shadow.
+	Inset            bool          // Is it inner shadow?
+}
+
+// Returns Shadow in CSS syntax.
+func (s Shadow) String() string {
+	res := fmt.Sprintf("%v %v %v %v %v", s.Color, s.OffsetX, s.OffsetY, s.Blur, s.Spread)
+	if s.Inset {
+		res = "inset " + res
+	}
+	return res
+}
+
+// ShadowList represents value of box-shadow. Shadows are painted from the last
+// one, so the first one is on top. Empty list means "none".
+//
+// Releavnt spec: https://www.w3.org/TR/css-backgrounds-3/#box-shadow
+type ShadowList []Shadow
+
+// Returns ShadowList in CSS syntax.
+func (l ShadowList) String() string {
+	if len(l) == 0 {
+		return "none"
+	}
+	strs := []string{}
+	for _, s := range l {
+		strs = append(strs, s.String())
+	}
+	return strings.Join(strs, ", ")
+}
@@ -0,0 +1,152 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package backgrounds
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/inseo-oh/yw/css/images"
+	"github.com/inseo-oh/yw/css/values"
+)
+
+// ImageList represents value of [CSS background-image] property. Each item is
+// a background layer, and the first one is painted on top of others.
+//
+// [CSS background-image]: https://www.w3.org/TR/css-backgrounds-3/#background-image
+type ImageList []images.Image
+
+// RepeatStyle represents how background images are tiled in an axis.
+type RepeatStyle uint8
+
+const (
+	RepeatTile   RepeatStyle = iota // repeat
+	SpaceTile                       // space
+	RoundTile                       // round
+	NoRepeatTile                    // no-repeat
+)
+
+// Repeat represents CSS <repeat-style> type
+//
+// Releavnt spec: https://www.w3.org/TR/css-backgrounds-3/#typedef-repeat-style
+type Repeat struct {
+	X, Y RepeatStyle
+}
+
+// RepeatList represents value of [CSS background-repeat] property.
+//
+// [CSS background-repeat]: https://www.w3.org/TR/css-backgrounds-3/#background-repeat
+type RepeatList []Repeat
+
+// PositionList represents value of [CSS background-position] property.
+//
+// [CSS background-position]: https://www.w3.org/TR/css-backgrounds-3/#background-position
+type PositionList []values.Position
+
+// SizeType represents type of [Size].
+type SizeType uint8
+
+const (
+	ExplicitSize SizeType = iota // Size is given by Width and Height.
+	CoverSize                    // cover
+	ContainSize                  // contain
+)
+
+// Size represents CSS <bg-size> type
+//
+// Releavnt spec: https://www.w3.org/TR/css-backgrounds-3/#typedef-bg-size
+type Size struct {
+	Type          SizeType
+	Width, Height values.LengthResolvable // nil if it's auto
+}
+
+// SizeList represents value of [CSS background-size] property.
+//
+// [CSS background-size]: https://www.w3.org/TR/css-backgrounds-3/#background-size
+type SizeList []Size
+
+// Box represents CSS <visual-box> type
+//
+// Releavnt spec: https://www.w3.org/TR/css-box-4/#typedef-visual-box
+type Box uint8
+
+const (
+	BorderBox  Box = iota // border-box
+	PaddingBox            // padding-box
+	ContentBox            // content-box
+)
+
+// BoxList represents value of [CSS background-clip] and [CSS background-origin]
+// properties.
+//
+// [CSS background-clip]: https://www.w3.org/TR/css-backgrounds-3/#background-clip
+// [CSS background-origin]: https://www.w3.org/TR/css-backgrounds-3/#background-origin
+type BoxList []Box
+
+// InitialPosition is the initial value of background-position (0% 0%).
+var InitialPosition = values.Position{
+	X: values.PositionOffset{Offset: values.Percentage{Value: 0}},
+	Y: values.PositionOffset{Offset: values.Percentage{Value: 0}},
+}
+
+func (s RepeatStyle) String() string {
+	switch s {
+	case RepeatTile:
+		return "repeat"
+	case SpaceTile:
+		return "space"
+	case RoundTile:
+		return "round"
+	case NoRepeatTile:
+		return "no-repeat"
+	}
+	return fmt.Sprintf("<bad RepeatStyle %d>", s)
+}
+func (r Repeat) String() string { return fmt.Sprintf("%v %v", r.X, r.Y) }
+
+func (s Size) String() string {
+	switch s.Type {
+	case CoverSize:
+		return "cover"
+	case ContainSize:
+		return "contain"
+	}
+	str := func(l values.LengthResolvable) string {
+		if l == nil {
+			return "auto"
+		}
+		return l.String()
+	}
+	return fmt.Sprintf("%s %s", str(s.Width), str(s.Height))
+}
+
+func (b Box) String() string {
+	switch b {
+	case BorderBox:
+		return "border-box"
+	case PaddingBox:
+		return "padding-box"
+	case ContentBox:
+		return "content-box"
+	}
+	return fmt.Sprintf("<bad Box %d>", b)
+}
+
+func (l ImageList) String() string    { return layersString(l) }
+func (l RepeatList) String() string   { return layersString(l) }
+func (l PositionList) String() string { return layersString(l) }
+func (l SizeList) String() string     { return layersString(l) }
+func (l BoxList) String() string      { return layersString(l) }
+
+func layersString[T fmt.Stringer](layers []T) string {
+	sb := strings.Builder{}
+	for i, layer := range layers {
+		if i != 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(layer.String())
+	}
+	return sb.String()
+}
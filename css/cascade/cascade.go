@@ -8,17 +8,25 @@
 package cascade
 
 import (
+	"slices"
+
 	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/fonts"
+	"github.com/inseo-oh/yw/css/props"
 	"github.com/inseo-oh/yw/css/selector"
 	"github.com/inseo-oh/yw/dom"
 	"github.com/inseo-oh/yw/util"
 )
 
+// pseudoElements is list of pseudo-elements that we calculate styles for.
+var pseudoElements = []string{"before", "after", "marker"}
+
 // ApplyStyleRules collects all relevant style rules from stylesheets associated
 // with docOrSr, together with uaStylesheet, and calculates computed value of
 // each descendant element of the docOrSr.
 //
-// Resulting style is saved to each element's ComputedStyleSet.
+// Resulting style is saved to each element's ComputedStyleSet, and
+// PseudoElementStyleSets for pseudo-elements (e.g. ::before).
 func ApplyStyleRules(uaStylesheet *cssom.Stylesheet, docOrSr dom.Node) {
 	// https://www.w3.org/TR/css-cascade-4/#cascade-origin
 
@@ -34,23 +42,10 @@ func ApplyStyleRules(uaStylesheet *cssom.Stylesheet, docOrSr dom.Node) {
 	)
 
 	type declEntry struct {
-		rule cssom.StyleRule
-		decl cssom.Declaration
-	}
-	declGroups := [][]declEntry{
-		// Higher priority first, lower priority last
-
-		{}, // Transition declarations
-		{}, // Important user agent declarations
-		{}, // Important user declarations
-		{}, // Important author declarations
-		{}, // Animation declarations
-		{}, // Normal author declarations
-		{}, // Normal user declarations
-		{}, // Normal user agent declarations
-	}
-	addDecl := func(group *[]declEntry, rule cssom.StyleRule, decl cssom.Declaration) {
-		*group = append(*group, declEntry{rule, decl})
+		decl        cssom.Declaration
+		priority    int                  // One of priority~ values
+		specificity selector.Specificity // Specificity of the rule's selector
+		order       int                  // Order of appearance
 	}
 	elems := []dom.Element{}
 	for _, n := range dom.InclusiveDescendants(docOrSr) {
@@ -58,67 +53,111 @@ func ApplyStyleRules(uaStylesheet *cssom.Stylesheet, docOrSr dom.Node) {
 			elems = append(elems, elem)
 		}
 	}
-
-	// User agent declarations -------------------------------------------------
-	for _, rule := range uaStylesheet.StyleRules {
-		for _, decl := range rule.Declarations {
-			if decl.IsImportant {
-				addDecl(&declGroups[priorityImportantUserAgent], rule, decl)
-			} else {
-				addDecl(&declGroups[priorityNormalUserAgent], rule, decl)
-			}
-		}
-	}
-	// Author declarations -----------------------------------------------------
+	uaIndex := newRuleIndex(uaStylesheet.StyleRules)
+	authorRules := []cssom.StyleRule{}
 	for _, sheet := range cssom.DocumentOrShadowRootDataOf(docOrSr).Stylesheets {
-		for _, rule := range sheet.StyleRules {
+		authorRules = append(authorRules, sheet.StyleRules...)
+	}
+	authorIndex := newRuleIndex(authorRules)
+
+	// collectDecls returns declarations that apply to elem (or its pseudo-element
+	// named pseudoElem, if it's not empty), in the order they should be applied.
+	collectDecls := func(elem dom.Element, pseudoElem string) []cssom.Declaration {
+		entries := []declEntry{}
+		addRule := func(rule cssom.StyleRule, spec selector.Specificity, normalPriority, importantPriority int) {
 			for _, decl := range rule.Declarations {
+				priority := normalPriority
 				if decl.IsImportant {
-					addDecl(&declGroups[priorityImportantAuthor], rule, decl)
-				} else {
-					addDecl(&declGroups[priorityNormalAuthor], rule, decl)
+					priority = importantPriority
 				}
+				entries = append(entries, declEntry{decl, priority, spec, len(entries)})
 			}
 		}
-	}
-	// Presentional hints ------------------------------------------------------
-	for _, elem := range elems {
-		cbs := elem.Callbacks()
-		if cbs.PresentationalHints != nil {
+
+		// User agent declarations ---------------------------------------------
+		for _, m := range uaIndex.matchingRules(elem, pseudoElem) {
+			addRule(m.rule, m.specificity, priorityNormalUserAgent, priorityImportantUserAgent)
+		}
+		// Presentional hints --------------------------------------------------
+		// These are treated as author-level rules at the start of the author
+		// stylesheet, with specificity of zero.
+		// https://www.w3.org/TR/css-cascade-4/#preshint
+		if cbs := elem.Callbacks(); cbs.PresentationalHints != nil && pseudoElem == "" {
 			rules := cbs.PresentationalHints().([]cssom.StyleRule)
 			for _, rule := range rules {
-				for _, decl := range rule.Declarations {
-					if decl.IsImportant {
-						addDecl(&declGroups[priorityImportantAuthor], rule, decl)
-					} else {
-						addDecl(&declGroups[priorityNormalAuthor], rule, decl)
-					}
+				if spec, ok := selector.MatchingSpecificity(rule.SelectorList, elem); ok {
+					addRule(rule, spec, priorityNormalAuthor, priorityImportantAuthor)
 				}
 			}
 		}
-	}
+		// Author declarations -------------------------------------------------
+		for _, m := range authorIndex.matchingRules(elem, pseudoElem) {
+			addRule(m.rule, m.specificity, priorityNormalAuthor, priorityImportantAuthor)
+		}
 
-	// Apply specificity -------------------------------------------------------
-	// TODO
+		// Sort by origin and importance, specificity, and then order of appearance.
+		// Lower priority declaration comes first, so that higher priority
+		// declaration overwrites it when we apply.
+		// https://www.w3.org/TR/css-cascade-4/#cascade-sort
+		slices.SortFunc(entries, func(a, b declEntry) int {
+			if a.priority != b.priority {
+				return b.priority - a.priority
+			}
+			if cmp := a.specificity.Compare(b.specificity); cmp != 0 {
+				return cmp
+			}
+			return a.order - b.order
+		})
+		decls := make([]cssom.Declaration, 0, len(entries))
+		for _, entry := range entries {
+			decls = append(decls, entry.decl)
+		}
+		return decls
+	}
 
-	// Now we apply rules ------------------------------------------------------
-	for i := len(declGroups) - 1; 0 <= i; i-- {
-		declGroup := declGroups[i]
-		for _, declEntry := range declGroup {
-			rule := declEntry.rule
-			selectedElements := selector.MatchAgainstTree(rule.SelectorList, []dom.Node{docOrSr})
-			for _, node := range selectedElements {
-				elem := node.(dom.Element)
-				declEntry.decl.ApplyStyleRules(elem)
+	for _, elem := range elems {
+		// Now we apply rules --------------------------------------------------
+		for _, decl := range collectDecls(elem, "") {
+			decl.ApplyStyleRules(elem)
+		}
+		for _, pseudoElem := range pseudoElements {
+			for _, decl := range collectDecls(elem, pseudoElem) {
+				decl.ApplyStyleRulesToPseudoElement(elem, pseudoElem)
 			}
 		}
 	}
 	// Inherit missing values from parent --------------------------------------
 	for _, elem := range elems {
+		styleSet := &cssom.ElementDataOf(elem).ComputedStyleSet
+		var parentSrc props.ComputedStyleSetSource
 		if parent := elem.Parent(); !util.IsNil(parent) {
 			if parentElem, ok := parent.(dom.Element); ok {
-				cssom.ElementDataOf(elem).ComputedStyleSet.InheritPropertiesFromParent(cssom.ComputedStyleSetSourceOf(parentElem))
+				parentSrc = cssom.ComputedStyleSetSourceOf(parentElem)
 			}
 		}
+		resolveRelativeValues(styleSet, parentSrc)
+		if !util.IsNil(parentSrc) {
+			styleSet.InheritPropertiesFromParent(parentSrc)
+		}
+		// Pseudo-elements inherit from their originating element
+		for _, styleSet := range cssom.ElementDataOf(elem).PseudoElementStyleSets {
+			resolveRelativeValues(styleSet, cssom.ComputedStyleSetSourceOf(elem))
+			styleSet.InheritPropertiesFromParent(cssom.ComputedStyleSetSourceOf(elem))
+		}
+	}
+}
+
+// resolveRelativeValues resolves values in styleSet that are relative to the
+// parent's computed value, so that children inherit resolved values instead.
+// parentSrc is nil for the root element.
+func resolveRelativeValues(styleSet *props.ComputedStyleSet, parentSrc props.ComputedStyleSetSource) {
+	if styleSet.FontWeightValue != nil {
+		parentWeight := props.DescriptorsMap["font-weight"].Initial.(fonts.Weight)
+		if !util.IsNil(parentSrc) {
+			// Parent's weight was already resolved, so this just takes it.
+			parentWeight = parentSrc.ComputedStyleSet().FontWeight().ResolveWeight(parentWeight)
+		}
+		var weight fonts.WeightValue = (*styleSet.FontWeightValue).ResolveWeight(parentWeight)
+		styleSet.FontWeightValue = &weight
 	}
 }
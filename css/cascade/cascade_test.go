@@ -0,0 +1,338 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package cascade
+
+import (
+	"fmt"
+	"image/color"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/inseo-oh/yw/css/csscolor"
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/csssyntax"
+	"github.com/inseo-oh/yw/css/selector"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/html/htmlparser"
+)
+
+func TestCascadeOrder(t *testing.T) {
+	red := csscolor.FromStdColor(color.RGBA{255, 0, 0, 255})
+	blue := csscolor.FromStdColor(color.RGBA{0, 0, 255, 255})
+
+	cases := []struct {
+		name     string
+		uaCss    string
+		html     string
+		expected csscolor.Color
+	}{
+		{"author over user agent", "p { color: red; }",
+			"<style>p { color: blue; }</style><p id=t>", blue},
+		{"important user agent over important author", "p { color: red !important; }",
+			"<style>#t { color: blue !important; }</style><p id=t>", red},
+		{"important over specificity", "",
+			"<style>p { color: red !important; } #t { color: blue; }</style><p id=t>", red},
+		{"id over type", "",
+			"<style>#t { color: blue; } p { color: red; }</style><p id=t>", blue},
+		{"class over type", "",
+			"<style>p.a { color: blue; } p { color: red; }</style><p id=t class=a>", blue},
+		{"two classes over one", "",
+			"<style>.a.b { color: blue; } .b { color: red; }</style><p id=t class='a b'>", blue},
+		{"later wins on equal specificity", "",
+			"<style>.a { color: red; } .b { color: blue; }</style><p id=t class='a b'>", blue},
+		{"later wins on equal specificity (reversed)", "",
+			"<style>.b { color: blue; } .a { color: red; }</style><p id=t class='a b'>", red},
+		{"most specific selector in the list", "",
+			"<style>p, #t { color: blue; } p.a { color: red; }</style><p id=t class=a>", blue},
+		{"descendant combinator", "",
+			"<style>div p { color: blue; } p { color: red; }</style><div><span><p id=t></span></div>", blue},
+		{"child combinator", "",
+			"<style>div > p { color: red; } p { color: blue; }</style><div><span><p id=t></span></div>", blue},
+		{"next-sibling combinator", "",
+			"<style>h1 + p { color: blue; } p { color: red; }</style><h1></h1><p id=t>", blue},
+		{"subsequent-sibling combinator", "",
+			"<style>h1 ~ p { color: blue; } p { color: red; }</style><h1></h1><div></div><p id=t>", blue},
+		{"next-sibling combinator with element between", "",
+			"<style>h1 + p { color: red; } p { color: blue; }</style><h1></h1><div></div><p id=t>", blue},
+		{"presentational hint before author rules", "",
+			"<style>* { color: blue; }</style><body id=t text=red>", blue},
+		{"presentational hint over user agent", "body { color: blue; }",
+			"<body id=t text=red>", red},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name, func(t *testing.T) {
+			uaSheet, err := csssyntax.ParseStylesheet([]byte(cs.uaCss), nil, "<test UA stylesheet>")
+			if err != nil {
+				t.Fatalf("failed to parse UA stylesheet: %v", err)
+			}
+			par := htmlparser.NewParser(cs.html)
+			par.Document = dom.NewDocument()
+			doc := par.Run()
+			ApplyStyleRules(&uaSheet, doc)
+
+			target := elementById(doc, "t")
+			if target == nil {
+				t.Fatalf("target element not found")
+			}
+			got := cssom.ElementDataOf(target).ComputedStyleSet.Color()
+			if !got.Equals(cs.expected) {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+}
+
+func TestPseudoElementCascade(t *testing.T) {
+	red := csscolor.FromStdColor(color.RGBA{255, 0, 0, 255})
+	blue := csscolor.FromStdColor(color.RGBA{0, 0, 255, 255})
+
+	cases := []struct {
+		name            string
+		html            string
+		pseudoElem      string
+		expectedColor   csscolor.Color
+		expectedContent string
+	}{
+		{"styled separately from element",
+			"<style>p { color: red; } p::before { color: blue; content: 'x'; }</style><p id=t>", "before", blue, `"x"`},
+		{"inherits from originating element",
+			"<style>p { color: blue; } p::after { content: open-quote attr(title) close-quote; }</style><p id=t>", "after", blue, "open-quote attr(title) close-quote"},
+		{"legacy single colon syntax",
+			"<style>p:after { color: blue; content: counter(foo); }</style><p id=t>", "after", blue, "counter(foo, decimal)"},
+		{"specificity",
+			"<style>#t::before { color: blue; } p::before { color: red; content: none; }</style><p id=t>", "before", blue, "none"},
+		{"other pseudo-element",
+			"<style>p { color: red; } p::after { color: blue; } p::before { content: 'x'; }</style><p id=t>", "before", red, `"x"`},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name, func(t *testing.T) {
+			uaSheet, err := csssyntax.ParseStylesheet([]byte(""), nil, "<test UA stylesheet>")
+			if err != nil {
+				t.Fatalf("failed to parse UA stylesheet: %v", err)
+			}
+			par := htmlparser.NewParser(cs.html)
+			par.Document = dom.NewDocument()
+			doc := par.Run()
+			ApplyStyleRules(&uaSheet, doc)
+
+			target := elementById(doc, "t")
+			if target == nil {
+				t.Fatalf("target element not found")
+			}
+			styleSet, ok := cssom.ElementDataOf(target).PseudoElementStyleSets[cs.pseudoElem]
+			if !ok {
+				t.Fatalf("no style for ::%s", cs.pseudoElem)
+			}
+			if got := styleSet.Color(); !got.Equals(cs.expectedColor) {
+				t.Errorf("expected color %v, got %v", cs.expectedColor, got)
+			}
+			if got := styleSet.Content().String(); got != cs.expectedContent {
+				t.Errorf("expected content %s, got %s", cs.expectedContent, got)
+			}
+		})
+	}
+	t.Run("no matching rules", func(t *testing.T) {
+		par := htmlparser.NewParser("<style>p { color: red; } div::before { content: 'x'; }</style><p id=t>")
+		par.Document = dom.NewDocument()
+		doc := par.Run()
+		ApplyStyleRules(&cssom.Stylesheet{}, doc)
+		if _, ok := cssom.ElementDataOf(elementById(doc, "t")).PseudoElementStyleSets["before"]; ok {
+			t.Errorf("::before should not have any style")
+		}
+	})
+}
+
+// elementById returns the element with given id in doc, or nil if not found.
+func elementById(doc dom.Node, id string) dom.Element {
+	for _, n := range dom.InclusiveDescendants(doc) {
+		if elem, ok := n.(dom.Element); ok {
+			if v, ok := elem.AttrWithoutNamespace("id"); ok && v == id {
+				return elem
+			}
+		}
+	}
+	return nil
+}
+
+func TestSelectorMatching(t *testing.T) {
+	list := "<ul id=l><li id=a class=x><li id=b><li id=c class=x><li id=d><li id=e class=x></ul>"
+	cases := []struct {
+		selector string
+		html     string
+		expected []string // IDs of matched elements
+	}{
+		{":root", "<p id=a>", []string{"html"}},
+		{":empty", "<div id=a></div><div id=b>text</div><div id=c><!-- comment --></div><div id=d><span></span></div>", []string{"head", "a", "c", "span"}},
+		{"li:first-child", list, []string{"a"}},
+		{"li:last-child", list, []string{"e"}},
+		{"li:only-child", "<ul><li id=a></ul><ul><li id=b><li id=c></ul>", []string{"a"}},
+		{"li:nth-child(2)", list, []string{"b"}},
+		{"li:nth-child(odd)", list, []string{"a", "c", "e"}},
+		{"li:nth-child(2n)", list, []string{"b", "d"}},
+		{"li:nth-child(-n+2)", list, []string{"a", "b"}},
+		{"li:nth-child(n+4)", list, []string{"d", "e"}},
+		{"li:nth-last-child(2)", list, []string{"d"}},
+		{"li:nth-child(2 of .x)", list, []string{"c"}},
+		{"li:nth-last-child(1 of .x)", list, []string{"e"}},
+		{"span:first-of-type", "<div><em id=a></em><span id=b></span><span id=c></span></div>", []string{"b"}},
+		{"span:last-of-type", "<div><span id=a></span><span id=b></span><em id=c></em></div>", []string{"b"}},
+		{"span:only-of-type", "<div><em id=a></em><span id=b></span><em id=c></em></div>", []string{"b"}},
+		{"span:nth-of-type(2)", "<div><span id=a></span><em id=b></em><span id=c></span></div>", []string{"c"}},
+		{"span:nth-last-of-type(2)", "<div><span id=a></span><em id=b></em><span id=c></span></div>", []string{"a"}},
+		{":link", "<a id=a href=x></a><a id=b></a><area id=c href=x>", []string{"a", "c"}},
+		{"li:is(#a, .x)", list, []string{"a", "c", "e"}},
+		{"li:where(#b)", list, []string{"b"}},
+		{"li:not(.x)", list, []string{"b", "d"}},
+		{"li:not(:first-child, :last-child)", list, []string{"b", "c", "d"}},
+		{"div:has(span)", "<div id=a><p><span></span></p></div><div id=b><p></p></div>", []string{"a"}},
+		{"div:has(> span)", "<div id=a><span></span></div><div id=b><p><span></span></p></div>", []string{"a"}},
+		{"div:has(+ p)", "<div id=a></div><p></p><div id=b></div><span></span>", []string{"a"}},
+		{"div:has(~ p)", "<div id=a></div><span></span><p></p><div id=b></div>", []string{"a"}},
+		{"[data-x]", "<div id=a data-x></div><div id=b></div>", []string{"a"}},
+		{"[data-x=foo]", "<div id=a data-x=foo></div><div id=b data-x=Foo></div>", []string{"a"}},
+		{"[data-x=foo i]", "<div id=a data-x=foo></div><div id=b data-x=Foo></div>", []string{"a", "b"}},
+		{"[data-x~=foo]", "<div id=a data-x='bar foo'></div><div id=b data-x=foobar></div>", []string{"a"}},
+		{"[data-x|=en]", "<div id=a data-x=en></div><div id=b data-x=en-US></div><div id=c data-x=english></div>", []string{"a", "b"}},
+		{"[data-x^=foo]", "<div id=a data-x=foobar></div><div id=b data-x=barfoo></div>", []string{"a"}},
+		{"[data-x$=foo]", "<div id=a data-x=foobar></div><div id=b data-x=barfoo></div>", []string{"b"}},
+		{"[data-x*=oba]", "<div id=a data-x=foobar></div><div id=b data-x=barfoo></div>", []string{"a"}},
+	}
+	for _, cs := range cases {
+		t.Run(cs.selector, func(t *testing.T) {
+			sheet, err := csssyntax.ParseStylesheet([]byte(cs.selector+" {}"), nil, "<test stylesheet>")
+			if err != nil || len(sheet.StyleRules) != 1 {
+				t.Fatalf("failed to parse selector: %v", err)
+			}
+			par := htmlparser.NewParser(cs.html)
+			par.Document = dom.NewDocument()
+			doc := par.Run()
+
+			got := []string{}
+			for _, elem := range elementsOf(doc) {
+				if !selector.MatchAgainstElement(sheet.StyleRules[0].SelectorList, elem) {
+					continue
+				}
+				if id, ok := elem.AttrWithoutNamespace("id"); ok {
+					got = append(got, id)
+				} else {
+					got = append(got, elem.LocalName())
+				}
+			}
+			if !slices.Equal(got, cs.expected) {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+}
+
+// makeSyntheticDocument returns a document with a lot of elements, together
+// with stylesheet containing a lot of rules.
+func makeSyntheticDocument(elemCount, ruleCount int) (dom.Document, []cssom.StyleRule) {
+	tags := []string{"div", "span", "section", "em", "ul", "article", "b", "nav"}
+
+	htmlSb := strings.Builder{}
+	for i := range elemCount {
+		// Every 4th element holds next 3 elements as its children
+		tag := tags[i%len(tags)]
+		htmlSb.WriteString(fmt.Sprintf("<%s id=e%d class='c%d c%d'>", tag, i, i%50, i%7))
+		if i%4 != 0 {
+			htmlSb.WriteString(fmt.Sprintf("</%s>", tag))
+		}
+		if i%4 == 3 {
+			htmlSb.WriteString(fmt.Sprintf("</%s>", tags[(i-3)%len(tags)]))
+		}
+	}
+	cssSb := strings.Builder{}
+	for i := range ruleCount {
+		tag := tags[i%len(tags)]
+		switch i % 5 {
+		case 0:
+			cssSb.WriteString(fmt.Sprintf("#e%d { color: red; }\n", i*7))
+		case 1:
+			cssSb.WriteString(fmt.Sprintf(".c%d { color: red; }\n", i%60))
+		case 2:
+			cssSb.WriteString(fmt.Sprintf("%s.c%d { color: red; }\n", tag, i%7))
+		case 3:
+			cssSb.WriteString(fmt.Sprintf("div %s { color: red; }\n", tag))
+		case 4:
+			cssSb.WriteString(fmt.Sprintf("* > %s + .c%d { color: red; }\n", tag, i%50))
+		}
+	}
+	par := htmlparser.NewParser(htmlSb.String())
+	par.Document = dom.NewDocument()
+	doc := par.Run()
+	sheet, err := csssyntax.ParseStylesheet([]byte(cssSb.String()), nil, "<synthetic stylesheet>")
+	if err != nil {
+		panic(err)
+	}
+	return doc, sheet.StyleRules
+}
+
+func elementsOf(root dom.Node) []dom.Element {
+	elems := []dom.Element{}
+	for _, n := range dom.InclusiveDescendants(root) {
+		if elem, ok := n.(dom.Element); ok {
+			elems = append(elems, elem)
+		}
+	}
+	return elems
+}
+
+// naiveMatchingRules matches every rule against elem.
+func naiveMatchingRules(rules []cssom.StyleRule, elem dom.Element) []matchedRule {
+	res := []matchedRule{}
+	for _, rule := range rules {
+		if spec, ok := selector.MatchingSpecificity(rule.SelectorList, elem); ok {
+			res = append(res, matchedRule{rule, spec})
+		}
+	}
+	return res
+}
+
+func TestRuleIndex(t *testing.T) {
+	doc, rules := makeSyntheticDocument(400, 200)
+	idx := newRuleIndex(rules)
+	for _, elem := range elementsOf(doc) {
+		expected := naiveMatchingRules(rules, elem)
+		got := idx.matchingRules(elem, "")
+		if len(got) != len(expected) {
+			t.Fatalf("%v: expected %d rules, got %d", elem, len(expected), len(got))
+		}
+		for i := range got {
+			if !slices.EqualFunc(got[i].rule.SelectorList, expected[i].rule.SelectorList, selector.Selector.Equals) ||
+				got[i].specificity != expected[i].specificity {
+				t.Errorf("%v: rule #%d mismatch: expected %v %v, got %v %v", elem, i,
+					expected[i].rule.SelectorList, expected[i].specificity,
+					got[i].rule.SelectorList, got[i].specificity)
+			}
+		}
+	}
+}
+
+func BenchmarkRuleMatching(b *testing.B) {
+	doc, rules := makeSyntheticDocument(4000, 1000)
+	elems := elementsOf(doc)
+
+	// This is how rules were matched before the index: The whole tree is
+	// matched against each declaration of each rule.
+	b.Run("tree", func(b *testing.B) {
+		for b.Loop() {
+			for _, rule := range rules {
+				for range rule.Declarations {
+					selector.MatchAgainstTree(rule.SelectorList, []dom.Node{doc})
+				}
+			}
+		}
+	})
+	b.Run("indexed", func(b *testing.B) {
+		for b.Loop() {
+			idx := newRuleIndex(rules)
+			for _, elem := range elems {
+				idx.matchingRules(elem, "")
+			}
+		}
+	})
+}
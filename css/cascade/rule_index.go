@@ -0,0 +1,141 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package cascade
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/selector"
+	"github.com/inseo-oh/yw/dom"
+)
+
+// ruleIndex holds style rules, bucketed by the rightmost compound selector of
+// each selector, so that only rules that may possibly match an element are tested
+// against it.
+//
+// Each selector is placed in exactly one bucket, picked in following order:
+//   - ID, if the rightmost compound selector has an ID selector
+//   - Class, if the rightmost compound selector has a class selector
+//   - Tag, if the rightmost compound selector has a type selector
+//   - Universal, otherwise
+type ruleIndex struct {
+	rules     []cssom.StyleRule
+	byId      map[string][]indexedSelector
+	byClass   map[string][]indexedSelector
+	byTag     map[string][]indexedSelector
+	universal []indexedSelector
+}
+
+type indexedSelector struct {
+	ruleIdx    int // Index into ruleIndex's rules
+	sel        selector.Selector
+	pseudoElem string // Name of the pseudo-element sel selects, or an empty string if it selects elements
+}
+
+// matchedRule is a rule that matched against an element.
+type matchedRule struct {
+	rule        cssom.StyleRule
+	specificity selector.Specificity // Specificity of the most specific selector that matched
+}
+
+func newRuleIndex(rules []cssom.StyleRule) ruleIndex {
+	idx := ruleIndex{
+		rules:   rules,
+		byId:    map[string][]indexedSelector{},
+		byClass: map[string][]indexedSelector{},
+		byTag:   map[string][]indexedSelector{},
+	}
+	for ruleIdx, rule := range rules {
+		for _, sel := range rule.SelectorList {
+			idx.add(indexedSelector{ruleIdx, sel, selector.PseudoElementOf(sel)})
+		}
+	}
+	return idx
+}
+
+func (idx *ruleIndex) add(entry indexedSelector) {
+	var compound selector.CompoundSelector
+	switch sel := entry.sel.(type) {
+	case selector.ComplexSelector:
+		compound = sel.Rightmost()
+	case selector.CompoundSelector:
+		compound = sel
+	default:
+		idx.universal = append(idx.universal, entry)
+		return
+	}
+	for _, ss := range compound.SubclassSelector {
+		if s, ok := ss.(selector.IdSelector); ok {
+			idx.byId[s.Id] = append(idx.byId[s.Id], entry)
+			return
+		}
+	}
+	for _, ss := range compound.SubclassSelector {
+		if s, ok := ss.(selector.ClassSelector); ok {
+			idx.byClass[s.Class] = append(idx.byClass[s.Class], entry)
+			return
+		}
+	}
+	if s, ok := compound.TypeSelector.(selector.TypeSelector); ok {
+		idx.byTag[s.TypeName.Ident] = append(idx.byTag[s.TypeName.Ident], entry)
+		return
+	}
+	idx.universal = append(idx.universal, entry)
+}
+
+// candidates returns selectors that may match against elem.
+func (idx *ruleIndex) candidates(elem dom.Element) []indexedSelector {
+	res := []indexedSelector{}
+	if id, ok := elem.AttrWithoutNamespace("id"); ok {
+		res = append(res, idx.byId[id]...)
+	}
+	if classes, ok := elem.AttrWithoutNamespace("class"); ok {
+		seenClasses := map[string]bool{}
+		for _, class := range strings.Fields(classes) {
+			if seenClasses[class] {
+				continue
+			}
+			seenClasses[class] = true
+			res = append(res, idx.byClass[class]...)
+		}
+	}
+	res = append(res, idx.byTag[elem.LocalName()]...)
+	res = append(res, idx.universal...)
+	return res
+}
+
+// matchingRules returns rules that match against elem, in order of appearance.
+//
+// If pseudoElem is not empty, rules are matched against the pseudo-element with
+// that name (e.g. "before"), originating from elem.
+func (idx *ruleIndex) matchingRules(elem dom.Element, pseudoElem string) []matchedRule {
+	specs := map[int]selector.Specificity{}
+	for _, c := range idx.candidates(elem) {
+		if c.pseudoElem != pseudoElem {
+			continue
+		}
+		if pseudoElem == "" && !c.sel.MatchAgainst(elem) {
+			continue
+		} else if pseudoElem != "" && !selector.MatchAgainstPseudoElement(c.sel, elem, pseudoElem) {
+			continue
+		}
+		spec := c.sel.Specificity()
+		if oldSpec, ok := specs[c.ruleIdx]; !ok || 0 < spec.Compare(oldSpec) {
+			specs[c.ruleIdx] = spec
+		}
+	}
+	ruleIdxs := make([]int, 0, len(specs))
+	for ruleIdx := range specs {
+		ruleIdxs = append(ruleIdxs, ruleIdx)
+	}
+	slices.Sort(ruleIdxs)
+	res := make([]matchedRule, 0, len(ruleIdxs))
+	for _, ruleIdx := range ruleIdxs {
+		res = append(res, matchedRule{idx.rules[ruleIdx], specs[ruleIdx]})
+	}
+	return res
+}
@@ -0,0 +1,89 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+// Package content provides types and values for [CSS Generated Content Module Level 3].
+//
+// [CSS Generated Content Module Level 3]: https://www.w3.org/TR/css-content-3
+package content
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Content represents value of [CSS content] property.
+//
+// [CSS content]: https://www.w3.org/TR/css-content-3/#content-property
+type Content struct {
+	Type  Type   // Content type
+	Items []Item // Only valid if the Type is List
+}
+
+// Type of [Content]
+type Type uint8
+
+const (
+	Normal Type = iota // content: normal
+	None               // content: none
+	List               // content: <content-list>
+)
+
+func (c Content) String() string {
+	switch c.Type {
+	case Normal:
+		return "normal"
+	case None:
+		return "none"
+	case List:
+		strs := []string{}
+		for _, item := range c.Items {
+			strs = append(strs, item.String())
+		}
+		return strings.Join(strs, " ")
+	}
+	return fmt.Sprintf("<bad Content type %d>", c.Type)
+}
+
+// Item represents single entry in [CSS content-list].
+//
+// [CSS content-list]: https://www.w3.org/TR/css-content-3/#typedef-content-content-list
+type Item struct {
+	Type         ItemType // Item type
+	Value        string   // String for StringItem, attribute name for AttrItem, and counter name for CounterItem
+	CounterStyle string   // Counter style name for CounterItem (e.g. "decimal")
+}
+
+// ItemType represents type of [Item].
+type ItemType uint8
+
+const (
+	StringItem   ItemType = iota // "string"
+	AttrItem                     // attr(name)
+	CounterItem                  // counter(name, style)
+	OpenQuote                    // open-quote
+	CloseQuote                   // close-quote
+	NoOpenQuote                  // no-open-quote
+	NoCloseQuote                 // no-close-quote
+)
+
+func (i Item) String() string {
+	switch i.Type {
+	case StringItem:
+		return strconv.Quote(i.Value)
+	case AttrItem:
+		return fmt.Sprintf("attr(%s)", i.Value)
+	case CounterItem:
+		return fmt.Sprintf("counter(%s, %s)", i.Value, i.CounterStyle)
+	case OpenQuote:
+		return "open-quote"
+	case CloseQuote:
+		return "close-quote"
+	case NoOpenQuote:
+		return "no-open-quote"
+	case NoCloseQuote:
+		return "no-close-quote"
+	}
+	return fmt.Sprintf("<bad Item type %d>", i.Type)
+}
@@ -191,6 +191,15 @@ const (
 	// TODO: System color
 )
 
+// AlphaValue represents [CSS <alpha-value>], clamped to the range [0, 1].
+// It is also used as value of [CSS opacity] property.
+//
+// [CSS <alpha-value>]: https://www.w3.org/TR/css-color-4/#typedef-alpha-value
+// [CSS opacity]: https://www.w3.org/TR/css-color-4/#transparency
+type AlphaValue float64
+
+func (a AlphaValue) String() string { return fmt.Sprintf("%g", float64(a)) }
+
 // Various predefined CSS color values
 var (
 	CanvasText  = FromStdColor(color.RGBA{0, 0, 0, 255}) // CanvasText
@@ -22,10 +22,23 @@ type Declaration struct {
 //
 // Resulting style is saved to elem's ComputedStyleSet.
 func (d Declaration) ApplyStyleRules(elem dom.Element) {
+	d.applyTo(&ElementDataOf(elem).ComputedStyleSet)
+}
+
+// ApplyStyleRulesToPseudoElement is like ApplyStyleRules, but calculates
+// computed style of the pseudo-element named pseudoElem (e.g. "before"),
+// originating from elem.
+//
+// Resulting style is saved to elem's PseudoElementStyleSets.
+func (d Declaration) ApplyStyleRulesToPseudoElement(elem dom.Element, pseudoElem string) {
+	d.applyTo(ElementDataOf(elem).PseudoElementStyleSet(pseudoElem))
+}
+
+func (d Declaration) applyTo(dest *props.ComputedStyleSet) {
 	desc := props.DescriptorsMap[d.Name]
 	if desc.ApplyFunc == nil {
 		log.Printf("TODO: CSS Property %s is recognized but not supported yet. (Missing applyFunc() function)", d.Name)
 		return
 	}
-	desc.ApplyFunc(&ElementDataOf(elem).ComputedStyleSet, d.Value)
+	desc.ApplyFunc(dest, d.Value)
 }
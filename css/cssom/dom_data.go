@@ -29,6 +29,23 @@ func DocumentOrShadowRootDataOf(node dom.Node) *DocumentOrShadowRootData {
 // ElementData holds CSS-specific data for DOM Element nodes.
 type ElementData struct {
 	ComputedStyleSet props.ComputedStyleSet
+
+	// Computed styles of pseudo-elements originating from the element, keyed
+	// by name of the pseudo-element (e.g. "before").
+	// Only pseudo-elements that have matching style rules are present.
+	PseudoElementStyleSets map[string]*props.ComputedStyleSet
+}
+
+// PseudoElementStyleSet returns ComputedStyleSet of the pseudo-element named name.
+// New ComputedStyleSet is created if it doesn't exist yet.
+func (data *ElementData) PseudoElementStyleSet(name string) *props.ComputedStyleSet {
+	if data.PseudoElementStyleSets == nil {
+		data.PseudoElementStyleSets = map[string]*props.ComputedStyleSet{}
+	}
+	if _, ok := data.PseudoElementStyleSets[name]; !ok {
+		data.PseudoElementStyleSets[name] = &props.ComputedStyleSet{}
+	}
+	return data.PseudoElementStyleSets[name]
 }
 
 // ElementDataOf returns DocumentOrShadowRootData for given node.
@@ -59,6 +76,40 @@ func (src ComputedStyleSetSource) ParentSource() props.ComputedStyleSetSource {
 	return nil
 }
 func (src ComputedStyleSetSource) CurrentColor() color.Color {
+	return currentColorOf(src)
+}
+
+// ComputedStyleSetSourceOf creates new ComputedStyleSetSource for given elem.
+func ComputedStyleSetSourceOf(elem dom.Element) ComputedStyleSetSource {
+	return ComputedStyleSetSource{elem}
+}
+
+// PseudoElementStyleSetSource is like [ComputedStyleSetSource], but for
+// the pseudo-element originating from an DOM Element.
+//
+// Parent of the pseudo-element is its originating element.
+type PseudoElementStyleSetSource struct {
+	elem dom.Element
+	name string
+}
+
+func (src PseudoElementStyleSetSource) ComputedStyleSet() *props.ComputedStyleSet {
+	return ElementDataOf(src.elem).PseudoElementStyleSet(src.name)
+}
+func (src PseudoElementStyleSetSource) ParentSource() props.ComputedStyleSetSource {
+	return ComputedStyleSetSourceOf(src.elem)
+}
+func (src PseudoElementStyleSetSource) CurrentColor() color.Color {
+	return currentColorOf(src)
+}
+
+// PseudoElementStyleSetSourceOf creates new PseudoElementStyleSetSource for
+// the pseudo-element named name (e.g. "before"), originating from elem.
+func PseudoElementStyleSetSourceOf(elem dom.Element, name string) PseudoElementStyleSetSource {
+	return PseudoElementStyleSetSource{elem, name}
+}
+
+func currentColorOf(src props.ComputedStyleSetSource) color.Color {
 	colorVal := src.ComputedStyleSet().Color()
 	if colorVal.Type == csscolor.CurrentColor {
 		parentSrc := src.ParentSource()
@@ -69,8 +120,3 @@ func (src ComputedStyleSetSource) CurrentColor() color.Color {
 	}
 	return colorVal.ToStdColor(nil)
 }
-
-// ComputedStyleSetSourceOf creates new ComputedStyleSetSource for given elem.
-func ComputedStyleSetSourceOf(elem dom.Element) ComputedStyleSetSource {
-	return ComputedStyleSetSource{elem}
-}
@@ -8,6 +8,9 @@ import (
 	"fmt"
 
 	"github.com/inseo-oh/yw/css/backgrounds"
+	"github.com/inseo-oh/yw/css/csscolor"
+	"github.com/inseo-oh/yw/css/images"
+	"github.com/inseo-oh/yw/css/props"
 	"github.com/inseo-oh/yw/css/values"
 )
 
@@ -53,3 +56,354 @@ func (ts *tokenStream) parseLineWidth() (res values.Length, err error) {
 	}
 	return res, fmt.Errorf("%s: expected line-width", ts.errorHeader())
 }
+
+// https://www.w3.org/TR/css-backgrounds-3/#typedef-bg-image
+func (ts *tokenStream) parseBgImage() (res images.Image, err error) {
+	if err := ts.consumeIdentTokenWith("none"); err == nil {
+		return images.NoImage{}, nil
+	}
+	return ts.parseImage()
+}
+
+// https://www.w3.org/TR/css-backgrounds-3/#typedef-bg-size
+func (ts *tokenStream) parseBgSize() (res backgrounds.Size, err error) {
+	if err := ts.consumeIdentTokenWith("cover"); err == nil {
+		return backgrounds.Size{Type: backgrounds.CoverSize}, nil
+	}
+	if err := ts.consumeIdentTokenWith("contain"); err == nil {
+		return backgrounds.Size{Type: backgrounds.ContainSize}, nil
+	}
+	// [ <length-percentage [0,∞]> | auto ]{1,2}
+	parseItem := func(ts *tokenStream) (values.LengthResolvable, error) {
+		if err := ts.consumeIdentTokenWith("auto"); err == nil {
+			return nil, nil
+		}
+		l, err := ts.parseLengthOrPercentage(true)
+		if err == nil && isNegativeLength(l) {
+			return nil, fmt.Errorf("%s: negative background size", ts.errorHeader())
+		}
+		return l, err
+	}
+	res = backgrounds.Size{Type: backgrounds.ExplicitSize}
+	if res.Width, err = parseItem(ts); err != nil {
+		return res, fmt.Errorf("%s: expected bg-size", ts.errorHeader())
+	}
+	oldCursor := ts.cursor
+	ts.skipWhitespaces()
+	if res.Height, err = parseItem(ts); err != nil {
+		ts.cursor = oldCursor
+	}
+	return res, nil
+}
+
+// https://www.w3.org/TR/css-backgrounds-3/#typedef-repeat-style
+func (ts *tokenStream) parseRepeatStyle() (res backgrounds.Repeat, err error) {
+	if err := ts.consumeIdentTokenWith("repeat-x"); err == nil {
+		return backgrounds.Repeat{X: backgrounds.RepeatTile, Y: backgrounds.NoRepeatTile}, nil
+	}
+	if err := ts.consumeIdentTokenWith("repeat-y"); err == nil {
+		return backgrounds.Repeat{X: backgrounds.NoRepeatTile, Y: backgrounds.RepeatTile}, nil
+	}
+	parseItem := func(ts *tokenStream) (backgrounds.RepeatStyle, error) {
+		if err := ts.consumeIdentTokenWith("repeat"); err == nil {
+			return backgrounds.RepeatTile, nil
+		} else if err := ts.consumeIdentTokenWith("space"); err == nil {
+			return backgrounds.SpaceTile, nil
+		} else if err := ts.consumeIdentTokenWith("round"); err == nil {
+			return backgrounds.RoundTile, nil
+		} else if err := ts.consumeIdentTokenWith("no-repeat"); err == nil {
+			return backgrounds.NoRepeatTile, nil
+		}
+		return 0, fmt.Errorf("%s: expected repeat-style", ts.errorHeader())
+	}
+	if res.X, err = parseItem(ts); err != nil {
+		return res, err
+	}
+	oldCursor := ts.cursor
+	ts.skipWhitespaces()
+	if res.Y, err = parseItem(ts); err != nil {
+		ts.cursor = oldCursor
+		res.Y = res.X
+	}
+	return res, nil
+}
+
+// https://www.w3.org/TR/css-box-4/#typedef-visual-box
+func (ts *tokenStream) parseVisualBox() (res backgrounds.Box, err error) {
+	if err := ts.consumeIdentTokenWith("border-box"); err == nil {
+		return backgrounds.BorderBox, nil
+	} else if err := ts.consumeIdentTokenWith("padding-box"); err == nil {
+		return backgrounds.PaddingBox, nil
+	} else if err := ts.consumeIdentTokenWith("content-box"); err == nil {
+		return backgrounds.ContentBox, nil
+	}
+	return res, fmt.Errorf("%s: expected visual-box", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-backgrounds-3/#background-image
+func (ts *tokenStream) parseBackgroundImage() (res backgrounds.ImageList, err error) {
+	return parseCommaSeparatedRepeation(ts, 0, "background-image", (*tokenStream).parseBgImage)
+}
+
+// https://www.w3.org/TR/css-backgrounds-3/#background-position
+func (ts *tokenStream) parseBackgroundPosition() (res backgrounds.PositionList, err error) {
+	return parseCommaSeparatedRepeation(ts, 0, "background-position", (*tokenStream).parsePositionValue)
+}
+
+// https://www.w3.org/TR/css-backgrounds-3/#background-size
+func (ts *tokenStream) parseBackgroundSize() (res backgrounds.SizeList, err error) {
+	return parseCommaSeparatedRepeation(ts, 0, "background-size", (*tokenStream).parseBgSize)
+}
+
+// https://www.w3.org/TR/css-backgrounds-3/#background-repeat
+func (ts *tokenStream) parseBackgroundRepeat() (res backgrounds.RepeatList, err error) {
+	return parseCommaSeparatedRepeation(ts, 0, "background-repeat", (*tokenStream).parseRepeatStyle)
+}
+
+// Parses background-clip and background-origin.
+//
+// https://www.w3.org/TR/css-backgrounds-3/#background-clip
+// https://www.w3.org/TR/css-backgrounds-3/#background-origin
+func (ts *tokenStream) parseBackgroundBox() (res backgrounds.BoxList, err error) {
+	return parseCommaSeparatedRepeation(ts, 0, "visual-box", (*tokenStream).parseVisualBox)
+}
+
+// bgLayer is a layer in background shorthand.
+type bgLayer struct {
+	image    images.Image
+	position values.Position
+	size     backgrounds.Size
+	repeat   backgrounds.Repeat
+	origin   backgrounds.Box
+	clip     backgrounds.Box
+	color    *csscolor.Color // Only allowed for the final layer
+}
+
+// https://www.w3.org/TR/css-backgrounds-3/#typedef-bg-layer
+// https://www.w3.org/TR/css-backgrounds-3/#typedef-final-bg-layer
+func (ts *tokenStream) parseBgLayer() (res bgLayer, err error) {
+	res = bgLayer{
+		image:    images.NoImage{},
+		position: backgrounds.InitialPosition,
+		size:     backgrounds.Size{Type: backgrounds.ExplicitSize},
+		repeat:   backgrounds.Repeat{X: backgrounds.RepeatTile, Y: backgrounds.RepeatTile},
+		origin:   backgrounds.PaddingBox,
+		clip:     backgrounds.BorderBox,
+	}
+	gotImage, gotPosition, gotRepeat := false, false, false
+	boxes := []backgrounds.Box{}
+	gotAny := false
+	for {
+		ts.skipWhitespaces()
+		if !gotImage {
+			if v, err := ts.parseBgImage(); err == nil {
+				res.image, gotImage, gotAny = v, true, true
+				continue
+			}
+		}
+		if !gotPosition {
+			if v, err := ts.parsePositionValue(); err == nil {
+				res.position, gotPosition, gotAny = v, true, true
+				// <bg-size> can only follow <bg-position>, separated by '/'.
+				oldCursor := ts.cursor
+				ts.skipWhitespaces()
+				if err := ts.consumeDelimTokenWith('/'); err == nil {
+					ts.skipWhitespaces()
+					if res.size, err = ts.parseBgSize(); err != nil {
+						return res, err
+					}
+				} else {
+					ts.cursor = oldCursor
+				}
+				continue
+			}
+		}
+		if !gotRepeat {
+			if v, err := ts.parseRepeatStyle(); err == nil {
+				res.repeat, gotRepeat, gotAny = v, true, true
+				continue
+			}
+		}
+		if len(boxes) < 2 {
+			if v, err := ts.parseVisualBox(); err == nil {
+				boxes, gotAny = append(boxes, v), true
+				continue
+			}
+		}
+		if res.color == nil {
+			if v, err := ts.parseColor(); err == nil {
+				res.color, gotAny = &v, true
+				continue
+			}
+		}
+		break
+	}
+	if !gotAny {
+		return res, fmt.Errorf("%s: expected background layer", ts.errorHeader())
+	}
+	// If only one box is given, it sets both background-origin and
+	// background-clip.
+	switch len(boxes) {
+	case 1:
+		res.origin, res.clip = boxes[0], boxes[0]
+	case 2:
+		res.origin, res.clip = boxes[0], boxes[1]
+	}
+	return res, nil
+}
+
+// https://www.w3.org/TR/css-backgrounds-3/#background
+func (ts *tokenStream) parseBackgroundShorthand() (res props.BackgroundShorthand, err error) {
+	layers, err := parseCommaSeparatedRepeation(ts, 0, "background", (*tokenStream).parseBgLayer)
+	if err != nil {
+		return res, err
+	}
+	res.BackgroundColor = csscolor.Transparent
+	for i, layer := range layers {
+		if layer.color != nil {
+			if i != len(layers)-1 {
+				return res, fmt.Errorf("%s: background color is only allowed in the final layer", ts.errorHeader())
+			}
+			res.BackgroundColor = *layer.color
+		}
+		res.BackgroundImage = append(res.BackgroundImage, layer.image)
+		res.BackgroundPosition = append(res.BackgroundPosition, layer.position)
+		res.BackgroundSize = append(res.BackgroundSize, layer.size)
+		res.BackgroundRepeat = append(res.BackgroundRepeat, layer.repeat)
+		res.BackgroundOrigin = append(res.BackgroundOrigin, layer.origin)
+		res.BackgroundClip = append(res.BackgroundClip, layer.clip)
+	}
+	return res, nil
+}
+
+// parseNonNegativeLengthOrPercentage parses <length-percentage [0,∞]>.
+func (ts *tokenStream) parseNonNegativeLengthOrPercentage() (res values.LengthResolvable, err error) {
+	oldCursor := ts.cursor
+	res, err = ts.parseLengthOrPercentage(true)
+	if err != nil {
+		return nil, err
+	}
+	if isNegativeLength(res) {
+		ts.cursor = oldCursor
+		return nil, fmt.Errorf("%s: expected non-negative length or percentage", ts.errorHeader())
+	}
+	return res, nil
+}
+
+// https://www.w3.org/TR/css-backgrounds-3/#border-radius
+func (ts *tokenStream) parseBorderRadius() (res backgrounds.Radius, err error) {
+	if res.Horizontal, err = ts.parseNonNegativeLengthOrPercentage(); err != nil {
+		return res, err
+	}
+	oldCursor := ts.cursor
+	ts.skipWhitespaces()
+	if res.Vertical, err = ts.parseNonNegativeLengthOrPercentage(); err != nil {
+		ts.cursor = oldCursor
+		res.Vertical = res.Horizontal
+	}
+	return res, nil
+}
+
+// https://www.w3.org/TR/css-backgrounds-3/#border-radius
+func (ts *tokenStream) parseBorderRadiusShorthand() (res props.BorderRadiusShorthand, err error) {
+	// Values are expanded the same way as margin and padding, starting from
+	// top-left corner.
+	parseCorners := func() (res [4]values.LengthResolvable, err error) {
+		items, err := parseRepeation(ts, 4, "border-radius", (*tokenStream).parseNonNegativeLengthOrPercentage)
+		if err != nil {
+			return res, err
+		}
+		switch len(items) {
+		case 1:
+			res = [4]values.LengthResolvable{items[0], items[0], items[0], items[0]}
+		case 2:
+			res = [4]values.LengthResolvable{items[0], items[1], items[0], items[1]}
+		case 3:
+			res = [4]values.LengthResolvable{items[0], items[1], items[2], items[1]}
+		case 4:
+			res = [4]values.LengthResolvable{items[0], items[1], items[2], items[3]}
+		}
+		return res, nil
+	}
+	horizontal, err := parseCorners()
+	if err != nil {
+		return res, err
+	}
+	vertical := horizontal
+	ts.skipWhitespaces()
+	if err := ts.consumeDelimTokenWith('/'); err == nil {
+		ts.skipWhitespaces()
+		if vertical, err = parseCorners(); err != nil {
+			return res, err
+		}
+	}
+	res.TopLeft = backgrounds.Radius{Horizontal: horizontal[0], Vertical: vertical[0]}
+	res.TopRight = backgrounds.Radius{Horizontal: horizontal[1], Vertical: vertical[1]}
+	res.BottomRight = backgrounds.Radius{Horizontal: horizontal[2], Vertical: vertical[2]}
+	res.BottomLeft = backgrounds.Radius{Horizontal: horizontal[3], Vertical: vertical[3]}
+	return res, nil
+}
+
+// https://www.w3.org/TR/css-backgrounds-3/#typedef-shadow
+func (ts *tokenStream) parseShadow() (res backgrounds.Shadow, err error) {
+	res.Color = csscolor.Color{Type: csscolor.CurrentColor}
+	gotColor, gotLengths, gotInset := false, false, false
+	for {
+		oldCursor := ts.cursor
+		ts.skipWhitespaces()
+		if !gotColor {
+			if v, err := ts.parseColor(); err == nil {
+				res.Color, gotColor = v, true
+				continue
+			}
+		}
+		if !gotInset {
+			if err := ts.consumeIdentTokenWith("inset"); err == nil {
+				gotInset, res.Inset = true, true
+				continue
+			}
+		}
+		if !gotLengths {
+			// <length>{2} <length [0,∞]>? <length>?
+			lengths, err := parseRepeation(ts, 4, "shadow", func(ts *tokenStream) (*values.Length, error) {
+				l, err := ts.parseLength(true)
+				if err != nil {
+					return nil, err
+				}
+				return &l, nil
+			})
+			if err == nil {
+				if len(lengths) < 2 {
+					return res, fmt.Errorf("%s: shadow needs both horizontal and vertical offsets", ts.errorHeader())
+				}
+				res.OffsetX, res.OffsetY = *lengths[0], *lengths[1]
+				res.Blur, res.Spread = values.LengthFromPx(0), values.LengthFromPx(0)
+				if 3 <= len(lengths) {
+					if lengths[2].Value < 0 {
+						return res, fmt.Errorf("%s: negative blur radius", ts.errorHeader())
+					}
+					res.Blur = *lengths[2]
+				}
+				if 4 <= len(lengths) {
+					res.Spread = *lengths[3]
+				}
+				gotLengths = true
+				continue
+			}
+		}
+		ts.cursor = oldCursor
+		break
+	}
+	if !gotLengths {
+		return res, fmt.Errorf("%s: expected shadow", ts.errorHeader())
+	}
+	return res, nil
+}
+
+// https://www.w3.org/TR/css-backgrounds-3/#box-shadow
+func (ts *tokenStream) parseBoxShadow() (res backgrounds.ShadowList, err error) {
+	if err := ts.consumeIdentTokenWith("none"); err == nil {
+		return nil, nil
+	}
+	return parseCommaSeparatedRepeation(ts, 0, "box-shadow", (*tokenStream).parseShadow)
+}
@@ -0,0 +1,157 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"image/color"
+	"reflect"
+	"testing"
+
+	"github.com/inseo-oh/yw/css/backgrounds"
+	"github.com/inseo-oh/yw/css/csscolor"
+	"github.com/inseo-oh/yw/css/images"
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/css/values"
+)
+
+func TestCssBackgroundProperties(t *testing.T) {
+	red := csscolor.FromStdColor(color.RGBA{255, 0, 0, 255})
+	blue := csscolor.FromStdColor(color.RGBA{0, 0, 255, 255})
+	px := values.LengthFromPx
+	pct := func(v float64) values.Percentage { return values.Percentage{Value: v} }
+	pos := func(x, y values.LengthResolvable) values.Position {
+		return values.Position{X: values.PositionOffset{Offset: x}, Y: values.PositionOffset{Offset: y}}
+	}
+	stops := []images.ColorStop{{Color: red}, {Color: blue}}
+	cases := []struct {
+		name     string
+		css      string
+		expected props.PropertyValue
+	}{
+		{"background-image", "none", backgrounds.ImageList{images.NoImage{}}},
+		{"background-image", "url(a.png)", backgrounds.ImageList{images.URLImage{URL: "a.png"}}},
+		{"background-image", `url("a b.png"), none`, backgrounds.ImageList{images.URLImage{URL: "a b.png"}, images.NoImage{}}},
+		{"background-image", "linear-gradient(red, blue)", backgrounds.ImageList{images.LinearGradient{Angle: 180, Stops: stops}}},
+		{"background-image", "linear-gradient(0.25turn, red 10px, blue 20% 50%)", backgrounds.ImageList{images.LinearGradient{
+			Angle: 90,
+			Stops: []images.ColorStop{{Color: red, Position: px(10)}, {Color: blue, Position: pct(20)}, {Color: blue, Position: pct(50)}},
+		}}},
+		{"background-image", "linear-gradient(to left, red, blue)", backgrounds.ImageList{images.LinearGradient{Angle: 270, Stops: stops}}},
+		{"background-image", "repeating-linear-gradient(to bottom right, red, blue)", backgrounds.ImageList{images.LinearGradient{Angle: 180, Corner: images.BottomRight, Stops: stops, Repeating: true}}},
+		{"background-image", "radial-gradient(red, blue)", backgrounds.ImageList{images.RadialGradient{Position: values.CenterPosition, Stops: stops}}},
+		{"background-image", "radial-gradient(circle closest-side at left, red, blue)", backgrounds.ImageList{images.RadialGradient{
+			Shape: images.Circle, Extent: images.ClosestSide, Position: pos(pct(0), pct(50)), Stops: stops,
+		}}},
+		{"background-image", "radial-gradient(10px 50%, red, blue)", backgrounds.ImageList{images.RadialGradient{
+			Shape: images.Ellipse, Size: [2]values.LengthResolvable{px(10), pct(50)}, Position: values.CenterPosition, Stops: stops,
+		}}},
+		{"background-image", "conic-gradient(from 90deg at 10px 20px, red, blue 180deg)", backgrounds.ImageList{images.ConicGradient{
+			FromAngle: 90, Position: pos(px(10), px(20)), Stops: []images.ColorStop{{Color: red}, {Color: blue, Position: pct(50)}},
+		}}},
+		{"background-position", "center", backgrounds.PositionList{values.CenterPosition}},
+		{"background-position", "top", backgrounds.PositionList{pos(pct(50), pct(0))}},
+		{"background-position", "10px 20%", backgrounds.PositionList{pos(px(10), pct(20))}},
+		{"background-position", "bottom left", backgrounds.PositionList{pos(pct(0), pct(100))}},
+		{"background-position", "right 10px top", backgrounds.PositionList{{
+			X: values.PositionOffset{FromEnd: true, Offset: px(10)},
+			Y: values.PositionOffset{Offset: pct(0)},
+		}}},
+		{"background-position", "bottom 10px right 20px, 0 0", backgrounds.PositionList{{
+			X: values.PositionOffset{FromEnd: true, Offset: px(20)},
+			Y: values.PositionOffset{FromEnd: true, Offset: px(10)},
+		}, pos(px(0), px(0))}},
+		{"background-size", "cover", backgrounds.SizeList{{Type: backgrounds.CoverSize}}},
+		{"background-size", "10px", backgrounds.SizeList{{Width: px(10)}}},
+		{"background-size", "auto 50%, contain", backgrounds.SizeList{{Height: pct(50)}, {Type: backgrounds.ContainSize}}},
+		{"background-repeat", "repeat-x", backgrounds.RepeatList{{X: backgrounds.RepeatTile, Y: backgrounds.NoRepeatTile}}},
+		{"background-repeat", "space round", backgrounds.RepeatList{{X: backgrounds.SpaceTile, Y: backgrounds.RoundTile}}},
+		{"background-repeat", "no-repeat", backgrounds.RepeatList{{X: backgrounds.NoRepeatTile, Y: backgrounds.NoRepeatTile}}},
+		{"background-origin", "content-box", backgrounds.BoxList{backgrounds.ContentBox}},
+		{"background-clip", "padding-box, border-box", backgrounds.BoxList{backgrounds.PaddingBox, backgrounds.BorderBox}},
+		{"background", "red", props.BackgroundShorthand{
+			BackgroundColor:    red,
+			BackgroundImage:    backgrounds.ImageList{images.NoImage{}},
+			BackgroundPosition: backgrounds.PositionList{backgrounds.InitialPosition},
+			BackgroundSize:     backgrounds.SizeList{{}},
+			BackgroundRepeat:   backgrounds.RepeatList{{}},
+			BackgroundOrigin:   backgrounds.BoxList{backgrounds.PaddingBox},
+			BackgroundClip:     backgrounds.BoxList{backgrounds.BorderBox},
+		}},
+		{"background", "url(a.png) center / 10px no-repeat content-box, blue padding-box border-box", props.BackgroundShorthand{
+			BackgroundColor:    blue,
+			BackgroundImage:    backgrounds.ImageList{images.URLImage{URL: "a.png"}, images.NoImage{}},
+			BackgroundPosition: backgrounds.PositionList{values.CenterPosition, backgrounds.InitialPosition},
+			BackgroundSize:     backgrounds.SizeList{{Width: px(10)}, {}},
+			BackgroundRepeat:   backgrounds.RepeatList{{X: backgrounds.NoRepeatTile, Y: backgrounds.NoRepeatTile}, {}},
+			BackgroundOrigin:   backgrounds.BoxList{backgrounds.ContentBox, backgrounds.PaddingBox},
+			BackgroundClip:     backgrounds.BoxList{backgrounds.ContentBox, backgrounds.BorderBox},
+		}},
+		{"border-top-left-radius", "10px", backgrounds.Radius{Horizontal: px(10), Vertical: px(10)}},
+		{"border-top-left-radius", "10px 20%", backgrounds.Radius{Horizontal: px(10), Vertical: pct(20)}},
+		{"border-radius", "10px", props.BorderRadiusShorthand{
+			TopLeft:     backgrounds.Radius{Horizontal: px(10), Vertical: px(10)},
+			TopRight:    backgrounds.Radius{Horizontal: px(10), Vertical: px(10)},
+			BottomRight: backgrounds.Radius{Horizontal: px(10), Vertical: px(10)},
+			BottomLeft:  backgrounds.Radius{Horizontal: px(10), Vertical: px(10)},
+		}},
+		{"border-radius", "1px 2px 3px / 4px 5px", props.BorderRadiusShorthand{
+			TopLeft:     backgrounds.Radius{Horizontal: px(1), Vertical: px(4)},
+			TopRight:    backgrounds.Radius{Horizontal: px(2), Vertical: px(5)},
+			BottomRight: backgrounds.Radius{Horizontal: px(3), Vertical: px(4)},
+			BottomLeft:  backgrounds.Radius{Horizontal: px(2), Vertical: px(5)},
+		}},
+		{"box-shadow", "none", backgrounds.ShadowList(nil)},
+		{"box-shadow", "1px 2px", backgrounds.ShadowList{{
+			Color: csscolor.Color{Type: csscolor.CurrentColor}, OffsetX: px(1), OffsetY: px(2), Blur: px(0), Spread: px(0),
+		}}},
+		{"box-shadow", "red 1px 2px 3px, inset 4px 5px 6px -7px blue", backgrounds.ShadowList{
+			{Color: red, OffsetX: px(1), OffsetY: px(2), Blur: px(3), Spread: px(0)},
+			{Color: blue, OffsetX: px(4), OffsetY: px(5), Blur: px(6), Spread: px(-7), Inset: true},
+		}},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			got, err := parse(&ts, parseFuncMap[cs.name])
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, cs.expected) {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+	invalidCases := []struct{ name, css string }{
+		{"background-image", "linear-gradient(red)"},
+		{"background-image", "linear-gradient(to up, red, blue)"},
+		{"background-image", "radial-gradient(circle 10px 20px, red, blue)"},
+		{"background-image", "radial-gradient(circle 10%, red, blue)"},
+		{"background-image", "conic-gradient(red 10px, blue)"},
+		{"background-position", "middle"},
+		{"background-size", "-10px"},
+		{"background-repeat", "repeat-z"},
+		{"background-clip", "margin-box"},
+		{"background", "red, url(a.png)"},
+		{"border-top-left-radius", "-10px"},
+		{"border-radius", "10px /"},
+		{"box-shadow", "1px"},
+		{"box-shadow", "1px 2px -3px"},
+		{"box-shadow", "inset inset 1px 2px"},
+	}
+	for _, cs := range invalidCases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			if got, err := parse(&ts, parseFuncMap[cs.name]); err == nil {
+				t.Errorf("expected error, got %v", got)
+			}
+		})
+	}
+}
@@ -79,15 +79,11 @@ func (ts *tokenStream) parseColor() (res csscolor.Color, err error) {
 		ts := tokenStream{tokens: fn.value, tokenizerHelper: ts.tokenizerHelper}
 
 		parseAlpha := func() (res uint8, err error) {
-			if num := ts.parseNumber(); num != nil {
-				res = uint8(num.Clamp(css.NumFromInt(0), css.NumFromInt(1)).ToFloat() * 255)
-			} else if num, err := ts.parsePercentage(); err == nil {
-				aPer := css.Clamp(num.Value, 0, 100)
-				res = uint8((aPer / 100) * 255)
-			} else {
-				return res, fmt.Errorf("%s: expected number or percentage", ts.errorHeader())
+			a, err := ts.parseAlphaValue()
+			if err != nil {
+				return res, err
 			}
-			return res, nil
+			return uint8(a * 255), nil
 		}
 
 		// https://www.w3.org/TR/css-color-4/#funcdef-rgb
@@ -259,9 +255,8 @@ func (ts *tokenStream) parseColor() (res csscolor.Color, err error) {
 		if ok {
 			return csscolor.FromStdColor(col), nil
 		}
-	} else {
-		ts.cursor = oldCursor
 	}
+	ts.cursor = oldCursor
 	// Try transparent ---------------------------------------------------------
 	if err := ts.consumeIdentTokenWith("transparent"); err == nil {
 		c := csscolor.Transparent
@@ -276,3 +271,13 @@ func (ts *tokenStream) parseColor() (res csscolor.Color, err error) {
 	// TODO: Try system colors
 	return res, fmt.Errorf("%s expected color", ts.errorHeader())
 }
+
+// https://www.w3.org/TR/css-color-4/#typedef-alpha-value
+func (ts *tokenStream) parseAlphaValue() (res csscolor.AlphaValue, err error) {
+	if num := ts.parseNumber(); num != nil {
+		return csscolor.AlphaValue(num.Clamp(css.NumFromInt(0), css.NumFromInt(1)).ToFloat()), nil
+	} else if num, err := ts.parsePercentage(); err == nil {
+		return csscolor.AlphaValue(css.Clamp(num.Value, 0, 100) / 100), nil
+	}
+	return res, fmt.Errorf("%s: expected number or percentage", ts.errorHeader())
+}
@@ -0,0 +1,59 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/inseo-oh/yw/css/csscolor"
+	"github.com/inseo-oh/yw/css/props"
+)
+
+func TestCssColorProperties(t *testing.T) {
+	cases := []struct {
+		name     string
+		css      string
+		expected props.PropertyValue
+	}{
+		{"opacity", "0.5", csscolor.AlphaValue(0.5)},
+		{"opacity", "1", csscolor.AlphaValue(1)},
+		{"opacity", "25%", csscolor.AlphaValue(0.25)},
+		// Values outside of [0, 1] are clamped.
+		{"opacity", "2", csscolor.AlphaValue(1)},
+		{"opacity", "-1", csscolor.AlphaValue(0)},
+		{"opacity", "150%", csscolor.AlphaValue(1)},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			got, err := parse(&ts, parseFuncMap[cs.name])
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, cs.expected) {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+	invalidCases := []struct{ name, css string }{
+		{"opacity", "auto"},
+		{"opacity", "10px"},
+	}
+	for _, cs := range invalidCases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			if got, err := parse(&ts, parseFuncMap[cs.name]); err == nil {
+				t.Errorf("expected error, got %v", got)
+			}
+		})
+	}
+}
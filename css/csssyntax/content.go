@@ -0,0 +1,107 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"fmt"
+
+	"github.com/inseo-oh/yw/css/content"
+)
+
+// https://www.w3.org/TR/css-content-3/#content-property
+func (ts *tokenStream) parseContent() (res content.Content, err error) {
+	if err := ts.consumeIdentTokenWith("normal"); err == nil {
+		return content.Content{Type: content.Normal}, nil
+	} else if err := ts.consumeIdentTokenWith("none"); err == nil {
+		return content.Content{Type: content.None}, nil
+	}
+	items, err := parseRepeation(ts, 0, "content", func(ts *tokenStream) (*content.Item, error) {
+		item, err := ts.parseContentItem()
+		if err != nil {
+			return nil, err
+		}
+		return &item, nil
+	})
+	if err != nil {
+		return res, err
+	}
+	res = content.Content{Type: content.List}
+	for _, item := range items {
+		res.Items = append(res.Items, *item)
+	}
+	// Alternative text ("content: url(foo.png) / "Foo"") ---------------------
+	oldCursor := ts.cursor
+	ts.skipWhitespaces()
+	if err := ts.consumeDelimTokenWith('/'); err == nil {
+		ts.skipWhitespaces()
+		// TODO: Use the alternative text once we have accessibility tree
+		if _, err := ts.consumeTokenWith(tokenTypeString); err != nil {
+			return res, fmt.Errorf("%s: expected alternative text after /", ts.errorHeader())
+		}
+	} else {
+		ts.cursor = oldCursor
+	}
+	return res, nil
+}
+
+// https://www.w3.org/TR/css-content-3/#typedef-content-content-list
+func (ts *tokenStream) parseContentItem() (res content.Item, err error) {
+	if tk, err := ts.consumeTokenWith(tokenTypeString); err == nil {
+		return content.Item{Type: content.StringItem, Value: tk.(stringToken).value}, nil
+	} else if err := ts.consumeIdentTokenWith("open-quote"); err == nil {
+		return content.Item{Type: content.OpenQuote}, nil
+	} else if err := ts.consumeIdentTokenWith("close-quote"); err == nil {
+		return content.Item{Type: content.CloseQuote}, nil
+	} else if err := ts.consumeIdentTokenWith("no-open-quote"); err == nil {
+		return content.Item{Type: content.NoOpenQuote}, nil
+	} else if err := ts.consumeIdentTokenWith("no-close-quote"); err == nil {
+		return content.Item{Type: content.NoCloseQuote}, nil
+	}
+	oldCursor := ts.cursor
+	if fn, err := ts.consumeAstFuncWith("attr"); err == nil {
+		// https://www.w3.org/TR/CSS2/generate.html#value-def-attr
+		innerTs := tokenStream{tokens: fn.value, tokenizerHelper: ts.tokenizerHelper}
+		innerTs.skipWhitespaces()
+		nameTk, err := innerTs.consumeTokenWith(tokenTypeIdent)
+		if err != nil {
+			ts.cursor = oldCursor
+			return res, fmt.Errorf("%s: expected attribute name", innerTs.errorHeader())
+		}
+		innerTs.skipWhitespaces()
+		if !innerTs.isEnd() {
+			ts.cursor = oldCursor
+			return res, fmt.Errorf("%s: extra junk at the end of attr()", innerTs.errorHeader())
+		}
+		return content.Item{Type: content.AttrItem, Value: nameTk.(identToken).value}, nil
+	}
+	if fn, err := ts.consumeAstFuncWith("counter"); err == nil {
+		// https://www.w3.org/TR/css-lists-3/#funcdef-counter
+		innerTs := tokenStream{tokens: fn.value, tokenizerHelper: ts.tokenizerHelper}
+		innerTs.skipWhitespaces()
+		nameTk, err := innerTs.consumeTokenWith(tokenTypeIdent)
+		if err != nil {
+			ts.cursor = oldCursor
+			return res, fmt.Errorf("%s: expected counter name", innerTs.errorHeader())
+		}
+		counterStyle := "decimal"
+		innerTs.skipWhitespaces()
+		if _, err := innerTs.consumeTokenWith(tokenTypeComma); err == nil {
+			innerTs.skipWhitespaces()
+			styleTk, err := innerTs.consumeTokenWith(tokenTypeIdent)
+			if err != nil {
+				ts.cursor = oldCursor
+				return res, fmt.Errorf("%s: expected counter style", innerTs.errorHeader())
+			}
+			counterStyle = styleTk.(identToken).value
+			innerTs.skipWhitespaces()
+		}
+		if !innerTs.isEnd() {
+			ts.cursor = oldCursor
+			return res, fmt.Errorf("%s: extra junk at the end of counter()", innerTs.errorHeader())
+		}
+		return content.Item{Type: content.CounterItem, Value: nameTk.(identToken).value, CounterStyle: counterStyle}, nil
+	}
+	return res, fmt.Errorf("%s: expected content item", ts.errorHeader())
+}
@@ -0,0 +1,73 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/inseo-oh/yw/css/content"
+)
+
+func TestCssContent(t *testing.T) {
+	cases := []struct {
+		css      string
+		expected content.Content
+	}{
+		{"normal", content.Content{Type: content.Normal}},
+		{"none", content.Content{Type: content.None}},
+		{"'foo'", content.Content{Type: content.List, Items: []content.Item{
+			{Type: content.StringItem, Value: "foo"},
+		}}},
+		{"\"a\"  attr(href)\"b\"", content.Content{Type: content.List, Items: []content.Item{
+			{Type: content.StringItem, Value: "a"},
+			{Type: content.AttrItem, Value: "href"},
+			{Type: content.StringItem, Value: "b"},
+		}}},
+		{"counter(item) counter( item , upper-roman )", content.Content{Type: content.List, Items: []content.Item{
+			{Type: content.CounterItem, Value: "item", CounterStyle: "decimal"},
+			{Type: content.CounterItem, Value: "item", CounterStyle: "upper-roman"},
+		}}},
+		{"open-quote close-quote no-open-quote no-close-quote", content.Content{Type: content.List, Items: []content.Item{
+			{Type: content.OpenQuote},
+			{Type: content.CloseQuote},
+			{Type: content.NoOpenQuote},
+			{Type: content.NoCloseQuote},
+		}}},
+		{"'icon' / 'Alt text'", content.Content{Type: content.List, Items: []content.Item{
+			{Type: content.StringItem, Value: "icon"},
+		}}},
+	}
+	for _, cs := range cases {
+		t.Run(cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			got, err := parse(&ts, func(ts *tokenStream) (content.Content, error) {
+				return ts.parseContent()
+			})
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, cs.expected) {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+	for _, css := range []string{"attr()", "counter()", "counter(a,)", "foo"} {
+		t.Run(css, func(t *testing.T) {
+			ts, err := tokenize([]byte(css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			if got, err := parse(&ts, func(ts *tokenStream) (content.Content, error) {
+				return ts.parseContent()
+			}); err == nil {
+				t.Errorf("expected an error, got %v", got)
+			}
+		})
+	}
+}
@@ -361,6 +361,7 @@ func tokenize(bytes []byte, sourceName string) (res tokenStream, err error) {
 		// Rest of the job is handled by the standard library.
 
 		// Sign ----------------------------------------------------------------
+		tkh.ConsumeCharIfMatchesOneOf("+-")
 
 		// Integer part --------------------------------------------------------
 		for !tkh.IsEof() {
@@ -388,7 +389,7 @@ func tokenize(bytes []byte, sourceName string) (res tokenStream, err error) {
 				}
 			}
 			if !haveIntegerPart && digitCount == 0 {
-				tkh.Cursor = oldCursor
+				tkh.Cursor = startCursor
 				return nil
 			}
 			res.Type = css.NumTypeFloat
@@ -508,7 +509,10 @@ func tokenize(bytes []byte, sourceName string) (res tokenStream, err error) {
 			} else {
 				resultChr = *temp
 			}
-			if isIdentStartCodepoint(resultChr) ||
+			// '-' can also start an identifier, if it's followed by another identifier character(e.g. -n, --foo).
+			startsWithHyphen := sb.Len() == 0 && resultChr == '-' &&
+				(isIdentCodepoint(tkh.PeekChar()) || tkh.PeekChar() == '\\')
+			if isIdentStartCodepoint(resultChr) || startsWithHyphen ||
 				((sb.Len() != 0 || !mustStartWithIdentStart) && isIdentCodepoint(resultChr)) {
 				sb.WriteRune(resultChr)
 			} else {
@@ -941,6 +945,9 @@ type tokenStream struct {
 }
 
 func (ts *tokenStream) errorHeader() string {
+	if len(ts.tokens) == 0 {
+		return ts.tokenizerHelper.ErrorHeader(0)
+	}
 	cursor := min(ts.cursor, len(ts.tokens)-1)
 	return ts.tokenizerHelper.ErrorHeader(ts.tokens[cursor].tokenCursorFrom())
 }
@@ -1409,12 +1416,17 @@ func parseCommaSeparatedListOfComponentValues(ts tokenStream) [][]token {
 	}
 	return valueLists
 }
+
+// Unlike parseCommaSeparatedListOfComponentValues, commas are part of the
+// result, and parsing continues until the end of the input.
+//
+// https://www.w3.org/TR/2021/CRD-css-syntax-3-20211224/#parse-list-of-component-values
 func parseListOfComponentValues(ts *tokenStream) []token {
 	tempList := []token{}
 
 	for {
 		value, err := ts.consumeComponentValue()
-		if err != nil || value.tokenType() == tokenTypeComma {
+		if err != nil {
 			break
 		}
 		tempList = append(tempList, value)
@@ -1554,9 +1566,10 @@ func parseCommaSeparatedRepeation[T any](ts *tokenStream, maxRepeats int, descri
 		if maxRepeats != 0 && maxRepeats <= len(res) {
 			break
 		}
+		afterItemCursor := ts.cursor
 		ts.skipWhitespaces()
 		if _, err := ts.consumeTokenWith(tokenTypeComma); err != nil {
-			ts.cursor = oldCursor
+			ts.cursor = afterItemCursor
 			break
 		}
 		ts.skipWhitespaces()
@@ -0,0 +1,48 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseListOfComponentValues(t *testing.T) {
+	cases := []struct {
+		css      string
+		expected []tokenType
+	}{
+		{"a b", []tokenType{tokenTypeIdent, tokenTypeWhitespace, tokenTypeIdent}},
+		// Commas don't end the list.
+		{"a, b", []tokenType{tokenTypeIdent, tokenTypeComma, tokenTypeWhitespace, tokenTypeIdent}},
+		{"f(a, b), c", []tokenType{tokenTypeAstFunc, tokenTypeComma, tokenTypeWhitespace, tokenTypeIdent}},
+		{"", []tokenType{}},
+	}
+	for _, cs := range cases {
+		t.Run(cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			got := []tokenType{}
+			for _, tk := range parseListOfComponentValues(&ts) {
+				got = append(got, tk.tokenType())
+			}
+			if !slices.Equal(got, cs.expected) {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseSelectorList(t *testing.T) {
+	sels, err := parseSelector("a, b.c, #d", "<test>")
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if len(sels) != 3 {
+		t.Errorf("expected 3 selectors, got %v", sels)
+	}
+}
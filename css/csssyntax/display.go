@@ -54,12 +54,16 @@ func (ts *tokenStream) parseDisplay() (res display.Display, err error) {
 		return display.Display{Mode: display.OuterInnerMode, OuterMode: display.Inline, InnerMode: display.Grid}, nil
 	}
 	// Try <display-outside> <display-inside> ------------------------------
-	gotOuterMode, gotInnerMode := false, false
+	// This also handles display-listitem, which is <display-outside> and
+	// <display-inside> with list-item keyword.
+	// https://www.w3.org/TR/css-display-3/#typedef-display-listitem
+	gotOuterMode, gotInnerMode, gotListItem := false, false, false
 	var outerMode display.OuterMode
 	var innerMode display.InnerMode
-	for !gotOuterMode || !gotInnerMode {
+	for !gotOuterMode || !gotInnerMode || !gotListItem {
 		gotSomething := false
 		var err error
+		ts.skipWhitespaces()
 		if !gotOuterMode {
 			outerMode, err = ts.parseDisplayOutside()
 			if err == nil {
@@ -74,25 +78,33 @@ func (ts *tokenStream) parseDisplay() (res display.Display, err error) {
 				gotInnerMode = true
 			}
 		}
+		ts.skipWhitespaces()
+		if !gotListItem {
+			if err := ts.consumeIdentTokenWith("list-item"); err == nil {
+				gotSomething = true
+				gotListItem = true
+			}
+		}
 		if !gotSomething {
 			break
 		}
 	}
-	if gotOuterMode || gotInnerMode {
+	if gotListItem && gotInnerMode && innerMode != display.Flow && innerMode != display.FlowRoot {
+		return res, fmt.Errorf("%s: list-item can only be used with flow or flow-root", ts.errorHeader())
+	}
+	if gotOuterMode || gotInnerMode || gotListItem {
 		if !gotInnerMode {
 			innerMode = display.Flow
-		} else if !gotOuterMode {
+		}
+		if !gotOuterMode {
 			if innerMode == display.Ruby {
 				outerMode = display.Inline
 			} else {
 				outerMode = display.Block
 			}
 		}
-		return display.Display{Mode: display.OuterInnerMode, OuterMode: outerMode, InnerMode: innerMode}, nil
+		return display.Display{Mode: display.OuterInnerMode, OuterMode: outerMode, InnerMode: innerMode, IsListItem: gotListItem}, nil
 	}
-	// Try display-listitem ------------------------------------------------
-	// https://www.w3.org/TR/css-display-3/#typedef-display-listitem
-	// TODO
 
 	// Try display-internal ------------------------------------------------
 	// https://www.w3.org/TR/css-display-3/#typedef-display-internal
@@ -0,0 +1,141 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"fmt"
+
+	"github.com/inseo-oh/yw/css/align"
+	"github.com/inseo-oh/yw/css/flexbox"
+	"github.com/inseo-oh/yw/css/sizing"
+)
+
+// https://www.w3.org/TR/css-flexbox-1/#flex-direction-property
+func (ts *tokenStream) parseFlexDirection() (res flexbox.Direction, err error) {
+	if err := ts.consumeIdentTokenWith("row"); err == nil {
+		return flexbox.Row, nil
+	} else if err := ts.consumeIdentTokenWith("row-reverse"); err == nil {
+		return flexbox.RowReverse, nil
+	} else if err := ts.consumeIdentTokenWith("column"); err == nil {
+		return flexbox.Column, nil
+	} else if err := ts.consumeIdentTokenWith("column-reverse"); err == nil {
+		return flexbox.ColumnReverse, nil
+	}
+	return res, fmt.Errorf("%s: invalid flex-direction value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-flexbox-1/#flex-wrap-property
+func (ts *tokenStream) parseFlexWrap() (res flexbox.Wrap, err error) {
+	if err := ts.consumeIdentTokenWith("nowrap"); err == nil {
+		return flexbox.NoWrap, nil
+	} else if err := ts.consumeIdentTokenWith("wrap"); err == nil {
+		return flexbox.WrapLines, nil
+	} else if err := ts.consumeIdentTokenWith("wrap-reverse"); err == nil {
+		return flexbox.WrapReverse, nil
+	}
+	return res, fmt.Errorf("%s: invalid flex-wrap value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-flexbox-1/#flex-grow-property
+// https://www.w3.org/TR/css-flexbox-1/#flex-shrink-property
+func (ts *tokenStream) parseFlexFactor() (res flexbox.Factor, err error) {
+	n := ts.parseNumber()
+	if n == nil {
+		return res, fmt.Errorf("%s: expected number", ts.errorHeader())
+	}
+	if n.ToFloat() < 0 {
+		return res, fmt.Errorf("%s: negative values are not accepted by flex factors", ts.errorHeader())
+	}
+	return flexbox.Factor(n.ToFloat()), nil
+}
+
+// https://www.w3.org/TR/css-flexbox-1/#flex-basis-property
+func (ts *tokenStream) parseFlexBasis() (res sizing.Size, err error) {
+	// Content size is the max-content size.
+	// https://www.w3.org/TR/css-flexbox-1/#valdef-flex-basis-content
+	if err := ts.consumeIdentTokenWith("content"); err == nil {
+		return sizing.Size{Type: sizing.MaxContent}, nil
+	}
+	return ts.parseSizeOrAuto()
+}
+
+// https://www.w3.org/TR/css-align-3/#propdef-justify-content
+func (ts *tokenStream) parseJustifyContent() (res align.Content, err error) {
+	if err := ts.consumeIdentTokenWith("normal"); err == nil {
+		return align.ContentNormal, nil
+	} else if err := ts.consumeIdentTokenWith("start"); err == nil {
+		return align.ContentStart, nil
+	} else if err := ts.consumeIdentTokenWith("end"); err == nil {
+		return align.ContentEnd, nil
+	} else if err := ts.consumeIdentTokenWith("flex-start"); err == nil {
+		return align.ContentFlexStart, nil
+	} else if err := ts.consumeIdentTokenWith("flex-end"); err == nil {
+		return align.ContentFlexEnd, nil
+	} else if err := ts.consumeIdentTokenWith("center"); err == nil {
+		return align.ContentCenter, nil
+	} else if err := ts.consumeIdentTokenWith("space-between"); err == nil {
+		return align.ContentSpaceBetween, nil
+	} else if err := ts.consumeIdentTokenWith("space-around"); err == nil {
+		return align.ContentSpaceAround, nil
+	} else if err := ts.consumeIdentTokenWith("space-evenly"); err == nil {
+		return align.ContentSpaceEvenly, nil
+	} else if err := ts.consumeIdentTokenWith("stretch"); err == nil {
+		return align.ContentStretch, nil
+	}
+	return res, fmt.Errorf("%s: invalid justify-content value", ts.errorHeader())
+}
+
+// parseAlignItemImpl parses <self-position>, baseline, normal, stretch, and
+// auto if acceptAuto is set.
+func (ts *tokenStream) parseAlignItemImpl(acceptAuto bool) (res align.Item, err error) {
+	if acceptAuto {
+		if err := ts.consumeIdentTokenWith("auto"); err == nil {
+			return align.ItemAuto, nil
+		}
+	}
+	if err := ts.consumeIdentTokenWith("normal"); err == nil {
+		return align.ItemNormal, nil
+	} else if err := ts.consumeIdentTokenWith("stretch"); err == nil {
+		return align.ItemStretch, nil
+	} else if err := ts.consumeIdentTokenWith("start"); err == nil {
+		return align.ItemStart, nil
+	} else if err := ts.consumeIdentTokenWith("self-start"); err == nil {
+		return align.ItemStart, nil
+	} else if err := ts.consumeIdentTokenWith("end"); err == nil {
+		return align.ItemEnd, nil
+	} else if err := ts.consumeIdentTokenWith("self-end"); err == nil {
+		return align.ItemEnd, nil
+	} else if err := ts.consumeIdentTokenWith("flex-start"); err == nil {
+		return align.ItemFlexStart, nil
+	} else if err := ts.consumeIdentTokenWith("flex-end"); err == nil {
+		return align.ItemFlexEnd, nil
+	} else if err := ts.consumeIdentTokenWith("center"); err == nil {
+		return align.ItemCenter, nil
+	} else if err := ts.consumeIdentTokenWith("baseline"); err == nil {
+		return align.ItemBaseline, nil
+	}
+	return res, fmt.Errorf("%s: invalid alignment value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-align-3/#propdef-align-items
+func (ts *tokenStream) parseAlignItems() (res align.Item, err error) {
+	return ts.parseAlignItemImpl(false)
+}
+
+// https://www.w3.org/TR/css-align-3/#propdef-align-self
+func (ts *tokenStream) parseAlignSelf() (res align.Item, err error) {
+	return ts.parseAlignItemImpl(true)
+}
+
+// https://www.w3.org/TR/css-align-3/#column-row-gap
+func (ts *tokenStream) parseGap() (res align.Gap, err error) {
+	if err := ts.consumeIdentTokenWith("normal"); err == nil {
+		return align.Gap{Value: nil}, nil
+	}
+	if v, err := ts.parseLengthOrPercentage(true); err == nil {
+		return align.Gap{Value: v}, nil
+	}
+	return res, fmt.Errorf("%s: expected gap", ts.errorHeader())
+}
@@ -0,0 +1,67 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/inseo-oh/yw/css/align"
+	"github.com/inseo-oh/yw/css/flexbox"
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/css/values"
+)
+
+func TestCssFlexProperties(t *testing.T) {
+	cases := []struct {
+		name     string
+		css      string
+		expected props.PropertyValue
+	}{
+		{"flex-flow", "column wrap", props.FlexFlowShorthand{FlexDirection: flexbox.Column, FlexWrap: flexbox.WrapLines}},
+		{"flex-flow", "wrap-reverse", props.FlexFlowShorthand{FlexDirection: flexbox.Row, FlexWrap: flexbox.WrapReverse}},
+		{"flex-grow", "2.5", flexbox.Factor(2.5)},
+		{"justify-content", "space-between", align.ContentSpaceBetween},
+		{"align-self", "auto", align.ItemAuto},
+		{"gap", "10px", props.GapShorthand{
+			RowGap:    align.Gap{Value: values.LengthFromPx(10)},
+			ColumnGap: align.Gap{Value: values.LengthFromPx(10)},
+		}},
+		{"gap", "normal 5%", props.GapShorthand{
+			RowGap:    align.Gap{},
+			ColumnGap: align.Gap{Value: values.Percentage{Value: 5}},
+		}},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			got, err := parse(&ts, parseFuncMap[cs.name])
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, cs.expected) {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+	invalidCases := []struct{ name, css string }{
+		{"flex-grow", "-1"},
+		{"align-items", "auto"},
+	}
+	for _, cs := range invalidCases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			if got, err := parse(&ts, parseFuncMap[cs.name]); err == nil {
+				t.Errorf("expected error, got %v", got)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package csssyntax
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/inseo-oh/yw/css"
 	"github.com/inseo-oh/yw/css/fonts"
@@ -25,10 +26,12 @@ func (ts *tokenStream) parseFamilyName() (res string, err error) {
 	if err != nil {
 		return res, err
 	}
+	// Identifiers are joined with single space.
+	names := []string{}
 	for _, tk := range identTks {
-		res += tk.(identToken).value
+		names = append(names, tk.(identToken).value)
 	}
-	return res, nil
+	return strings.Join(names, " "), nil
 }
 
 // https://www.w3.org/TR/css-fonts-3/#generic-family-value
@@ -73,20 +76,26 @@ func (ts *tokenStream) parseFontFamily() (res fonts.FamilyList, err error) {
 }
 
 // https://www.w3.org/TR/css-fonts-3/#propdef-font-weight
-func (ts *tokenStream) parseFontWeight() (res fonts.Weight, err error) {
+func (ts *tokenStream) parseFontWeight() (res fonts.WeightValue, err error) {
 	if err := ts.consumeIdentTokenWith("normal"); err == nil {
 		return fonts.NormalWeight, nil
 	}
 	if err := ts.consumeIdentTokenWith("bold"); err == nil {
 		return fonts.Bold, nil
 	}
+	if err := ts.consumeIdentTokenWith("bolder"); err == nil {
+		return fonts.Bolder, nil
+	}
+	if err := ts.consumeIdentTokenWith("lighter"); err == nil {
+		return fonts.Lighter, nil
+	}
 	if n := ts.parseNumber(); n != nil {
 		if n.Type == css.NumTypeFloat {
-			return 0, fmt.Errorf("%s: floating point isn't accepted by font-weight", ts.errorHeader())
+			return nil, fmt.Errorf("%s: floating point isn't accepted by font-weight", ts.errorHeader())
 		}
 		intVal := n.ToInt()
 		if intVal < 0 || 1000 < intVal {
-			return 0, fmt.Errorf("%s: font-weight value is out of range", ts.errorHeader())
+			return nil, fmt.Errorf("%s: font-weight value is out of range", ts.errorHeader())
 		}
 		return fonts.Weight(n.ToInt()), nil
 	}
@@ -0,0 +1,71 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/inseo-oh/yw/css/fonts"
+	"github.com/inseo-oh/yw/css/props"
+)
+
+func TestCssFontsProperties(t *testing.T) {
+	cases := []struct {
+		name     string
+		css      string
+		expected props.PropertyValue
+	}{
+		{"font-family", "serif", fonts.FamilyList{Families: []fonts.Family{{Type: fonts.Serif}}}},
+		{"font-family", `"Noto Sans KR", monospace`, fonts.FamilyList{Families: []fonts.Family{
+			{Type: fonts.NonGeneric, Name: "Noto Sans KR"}, {Type: fonts.Monospace},
+		}}},
+		{"font-family", "DejaVu Sans, sans-serif", fonts.FamilyList{Families: []fonts.Family{
+			{Type: fonts.NonGeneric, Name: "DejaVu Sans"}, {Type: fonts.SansSerif},
+		}}},
+		{"font-weight", "normal", fonts.NormalWeight},
+		{"font-weight", "bold", fonts.Bold},
+		{"font-weight", "bolder", fonts.Bolder},
+		{"font-weight", "lighter", fonts.Lighter},
+		{"font-weight", "300", fonts.Weight(300)},
+		{"font-style", "italic", fonts.Italic},
+		{"font-style", "oblique", fonts.Oblique},
+		{"font-stretch", "condensed", fonts.Condensed},
+		{"font-stretch", "ultra-expanded", fonts.UltraExpanded},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			got, err := parse(&ts, parseFuncMap[cs.name])
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, cs.expected) {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+	invalidCases := []struct{ name, css string }{
+		{"font-weight", "heavy"},
+		{"font-weight", "1001"},
+		{"font-weight", "400.5"},
+		{"font-style", "slanted"},
+		{"font-stretch", "wide"},
+	}
+	for _, cs := range invalidCases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			if got, err := parse(&ts, parseFuncMap[cs.name]); err == nil {
+				t.Errorf("expected error, got %v", got)
+			}
+		})
+	}
+}
@@ -25,6 +25,13 @@ var requiredImports = []string{
 	"github.com/inseo-oh/yw/css/values",
 	"github.com/inseo-oh/yw/css/fonts",
 	"github.com/inseo-oh/yw/css/textdecor",
+	"github.com/inseo-oh/yw/css/position",
+	"github.com/inseo-oh/yw/css/flexbox",
+	"github.com/inseo-oh/yw/css/align",
+	"github.com/inseo-oh/yw/css/grid",
+	"github.com/inseo-oh/yw/css/lists",
+	"github.com/inseo-oh/yw/css/text",
+	"github.com/inseo-oh/yw/css/overflow",
 }
 
 var (
@@ -50,7 +57,14 @@ func main() {
 	// Define shorthand struct and parser functions ----------------------------
 	for _, prop := range propsdef.Props {
 		sbInner := strings.Builder{}
+		var keywords []propsdef.ShorthandKeyword
+		if sh, ok := prop.(propsdef.ShorthandKeywordsProp); ok {
+			keywords = sh.Keywords
+			prop = sh.ShorthandAnyProp
+		}
 		switch sh := prop.(type) {
+		case propsdef.ShorthandLayersProp, propsdef.ShorthandCornersProp:
+			// Parser for these are written by hand.
 		case propsdef.ShorthandSidesProp:
 			sbInner.WriteString( /*      */ "\n")
 			sbInner.WriteString(fmt.Sprintf("func (ts *tokenStream) %s() (res %s, err error) {\n", sh.ParseMethodName(), sh.TypeName(true)))
@@ -90,9 +104,57 @@ func main() {
 			sbInner.WriteString( /*      */ "\t}\n")
 			sbInner.WriteString( /*      */ "\treturn res, nil\n")
 			sbInner.WriteString( /*      */ "}\n")
+		case propsdef.ShorthandPairProp:
+			sbInner.WriteString( /*      */ "\n")
+			sbInner.WriteString(fmt.Sprintf("func (ts *tokenStream) %s() (res %s, err error) {\n", sh.ParseMethodName(), sh.TypeName(true)))
+			if sh.SlashSeparated {
+				sbInner.WriteString(fmt.Sprintf("\tres = %s\n", sh.PropInitialValue(true)))
+				sbInner.WriteString(fmt.Sprintf("\tres.%s, err = ts.%s()\n", propsdef.GoIdentNameOfProp(sh.PropFirst), sh.PropFirst.PropType(false).ParseMethodName))
+				sbInner.WriteString( /*      */ "\tif err != nil {\n")
+				sbInner.WriteString( /*      */ "\t\treturn res, err\n")
+				sbInner.WriteString( /*      */ "\t}\n")
+				sbInner.WriteString( /*      */ "\tts.skipWhitespaces()\n")
+				sbInner.WriteString( /*      */ "\tif err := ts.consumeDelimTokenWith('/'); err == nil {\n")
+				sbInner.WriteString( /*      */ "\t\tts.skipWhitespaces()\n")
+				sbInner.WriteString(fmt.Sprintf("\t\tres.%s, err = ts.%s()\n", propsdef.GoIdentNameOfProp(sh.PropSecond), sh.PropSecond.PropType(false).ParseMethodName))
+				sbInner.WriteString( /*      */ "\t\tif err != nil {\n")
+				sbInner.WriteString( /*      */ "\t\t\treturn res, err\n")
+				sbInner.WriteString( /*      */ "\t\t}\n")
+				sbInner.WriteString( /*      */ "\t}\n")
+				sbInner.WriteString( /*      */ "\treturn res, nil\n")
+				sbInner.WriteString( /*      */ "}\n")
+				break
+			}
+			sbInner.WriteString(fmt.Sprintf("\titems, err := parseRepeation(ts, 2, %s, func(ts *tokenStream) (*%s, error) {\n", strconv.Quote(prop.PropName()), sh.PropSecond.PropType(false).TypeName))
+			sbInner.WriteString(fmt.Sprintf("\t\tres, err := ts.%s()\n", sh.PropFirst.PropType(false).ParseMethodName))
+			sbInner.WriteString( /*      */ "\t\tif err != nil {\n")
+			sbInner.WriteString( /*      */ "\t\t\treturn nil, err\n")
+			sbInner.WriteString( /*      */ "\t\t}\n")
+			sbInner.WriteString( /*      */ "\t\treturn &res, nil\n")
+			sbInner.WriteString( /*      */ "\t})\n")
+			sbInner.WriteString( /*      */ "\tif err != nil {\n")
+			sbInner.WriteString( /*      */ "\t\treturn res, err\n")
+			sbInner.WriteString( /*      */ "\t}\n")
+			sbInner.WriteString(fmt.Sprintf("\tres = %s{}\n", sh.TypeName(true)))
+			sbInner.WriteString(fmt.Sprintf("\tres.%s = *items[0]\n", propsdef.GoIdentNameOfProp(sh.PropFirst)))
+			sbInner.WriteString(fmt.Sprintf("\tres.%s = *items[len(items)-1]\n", propsdef.GoIdentNameOfProp(sh.PropSecond)))
+			sbInner.WriteString( /*      */ "\treturn res, nil\n")
+			sbInner.WriteString( /*      */ "}\n")
 		case propsdef.ShorthandAnyProp:
 			sbInner.WriteString( /*      */ "\n")
 			sbInner.WriteString(fmt.Sprintf("func (ts *tokenStream) %s() (res %s, err error) {\n", sh.ParseMethodName(), sh.TypeName(true)))
+			for _, kw := range keywords {
+				sbInner.WriteString(fmt.Sprintf("\tif err := ts.consumeIdentTokenWith(%s); err == nil {\n", strconv.Quote(kw.Keyword)))
+				sbInner.WriteString(fmt.Sprintf("\t\treturn %s{", sh.TypeName(true)))
+				for i, prop := range sh.Props {
+					if i != 0 {
+						sbInner.WriteString(", ")
+					}
+					sbInner.WriteString(fmt.Sprintf("%s: %s", propsdef.GoIdentNameOfProp(prop), kw.Values[i]))
+				}
+				sbInner.WriteString( /*      */ "}, nil\n")
+				sbInner.WriteString( /*      */ "\t}\n")
+			}
 			sbInner.WriteString(fmt.Sprintf("\tres = %s\n", sh.PropInitialValue(true)))
 			for _, prop := range sh.Props {
 				sbInner.WriteString(fmt.Sprintf("\tgot%s := false\n", propsdef.GoIdentNameOfProp(prop)))
@@ -0,0 +1,232 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"fmt"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/grid"
+)
+
+// https://www.w3.org/TR/css-grid-1/#typedef-track-breadth
+func (ts *tokenStream) parseTrackBreadth() (res grid.Breadth, err error) {
+	if err := ts.consumeIdentTokenWith("auto"); err == nil {
+		return grid.Breadth{Type: grid.BreadthAuto}, nil
+	} else if err := ts.consumeIdentTokenWith("min-content"); err == nil {
+		return grid.Breadth{Type: grid.BreadthMinContent}, nil
+	} else if err := ts.consumeIdentTokenWith("max-content"); err == nil {
+		return grid.Breadth{Type: grid.BreadthMaxContent}, nil
+	}
+	// https://www.w3.org/TR/css-grid-1/#typedef-flex
+	oldCursor := ts.cursor
+	if tk, err := ts.consumeTokenWith(tokenTypeDimension); err == nil {
+		if dim := tk.(dimensionToken); dim.unit == "fr" {
+			if dim.value.ToFloat() < 0 {
+				ts.cursor = oldCursor
+				return res, fmt.Errorf("%s: negative values are not accepted by <flex>", ts.errorHeader())
+			}
+			return grid.Breadth{Type: grid.BreadthFlex, Flex: dim.value.ToFloat()}, nil
+		}
+		ts.cursor = oldCursor
+	}
+	if v, err := ts.parseLengthOrPercentage(true); err == nil {
+		return grid.Breadth{Type: grid.BreadthLength, Length: v}, nil
+	}
+	ts.cursor = oldCursor
+	return res, fmt.Errorf("%s: expected track breadth", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-grid-1/#typedef-track-size
+func (ts *tokenStream) parseTrackSize() (res grid.TrackSize, err error) {
+	oldCursor := ts.cursor
+	if fn, err := ts.consumeAstFuncWith("minmax"); err == nil {
+		innerTs := tokenStream{tokens: fn.value, tokenizerHelper: ts.tokenizerHelper}
+		innerTs.skipWhitespaces()
+		minBreadth, err := innerTs.parseTrackBreadth()
+		if err != nil {
+			ts.cursor = oldCursor
+			return res, err
+		}
+		if minBreadth.Type == grid.BreadthFlex {
+			// Minimum can't be flexible.
+			ts.cursor = oldCursor
+			return res, fmt.Errorf("%s: <flex> is not accepted as minimum of minmax()", innerTs.errorHeader())
+		}
+		innerTs.skipWhitespaces()
+		if _, err := innerTs.consumeTokenWith(tokenTypeComma); err != nil {
+			ts.cursor = oldCursor
+			return res, err
+		}
+		innerTs.skipWhitespaces()
+		maxBreadth, err := innerTs.parseTrackBreadth()
+		if err != nil {
+			ts.cursor = oldCursor
+			return res, err
+		}
+		innerTs.skipWhitespaces()
+		if !innerTs.isEnd() {
+			ts.cursor = oldCursor
+			return res, fmt.Errorf("%s: extra junk at the end of minmax()", innerTs.errorHeader())
+		}
+		return grid.TrackSize{Min: minBreadth, Max: maxBreadth}, nil
+	}
+	breadth, err := ts.parseTrackBreadth()
+	if err != nil {
+		return res, err
+	}
+	return grid.TrackSizeOf(breadth), nil
+}
+
+// https://www.w3.org/TR/css-grid-1/#track-sizing
+func (ts *tokenStream) parseTrackList() (res grid.TrackList, err error) {
+	if err := ts.consumeIdentTokenWith("none"); err == nil {
+		return grid.TrackList{}, nil
+	}
+	for {
+		ts.skipWhitespaces()
+		if ts.isEnd() {
+			break
+		}
+		oldCursor := ts.cursor
+		if fn, err := ts.consumeAstFuncWith("repeat"); err == nil {
+			// https://www.w3.org/TR/css-grid-1/#repeat-notation
+			innerTs := tokenStream{tokens: fn.value, tokenizerHelper: ts.tokenizerHelper}
+			innerTs.skipWhitespaces()
+			var autoRepeat *grid.AutoRepeat
+			count := 0
+			if err := innerTs.consumeIdentTokenWith("auto-fill"); err == nil {
+				autoRepeat = &grid.AutoRepeat{IsAutoFit: false}
+			} else if err := innerTs.consumeIdentTokenWith("auto-fit"); err == nil {
+				autoRepeat = &grid.AutoRepeat{IsAutoFit: true}
+			} else if n := innerTs.parseNumber(); n != nil && n.Type == css.NumTypeInt && 0 < n.ToInt() {
+				count = int(n.ToInt())
+			} else {
+				ts.cursor = oldCursor
+				return res, fmt.Errorf("%s: expected repeat count", innerTs.errorHeader())
+			}
+			innerTs.skipWhitespaces()
+			if _, err := innerTs.consumeTokenWith(tokenTypeComma); err != nil {
+				ts.cursor = oldCursor
+				return res, err
+			}
+			tracks := []grid.TrackSize{}
+			for {
+				innerTs.skipWhitespaces()
+				if innerTs.isEnd() {
+					break
+				}
+				track, err := innerTs.parseTrackSize()
+				if err != nil {
+					ts.cursor = oldCursor
+					return res, err
+				}
+				tracks = append(tracks, track)
+			}
+			if len(tracks) == 0 {
+				ts.cursor = oldCursor
+				return res, fmt.Errorf("%s: expected track size", innerTs.errorHeader())
+			}
+			if autoRepeat != nil {
+				// Only one auto repetition is allowed, and it can only have fixed
+				// sizes (i.e. either minimum or maximum is a fixed size).
+				// https://www.w3.org/TR/css-grid-1/#typedef-auto-track-list
+				// https://www.w3.org/TR/css-grid-1/#typedef-fixed-size
+				if res.AutoRepeat != nil {
+					ts.cursor = oldCursor
+					return res, fmt.Errorf("%s: multiple auto repetitions are not allowed", innerTs.errorHeader())
+				}
+				for _, t := range tracks {
+					if t.Min.Type != grid.BreadthLength && t.Max.Type != grid.BreadthLength {
+						ts.cursor = oldCursor
+						return res, fmt.Errorf("%s: auto repetition only accepts fixed sizes", innerTs.errorHeader())
+					}
+				}
+				autoRepeat.Tracks = tracks
+				autoRepeat.Index = len(res.Tracks)
+				res.AutoRepeat = autoRepeat
+				continue
+			}
+			for range count {
+				res.Tracks = append(res.Tracks, tracks...)
+			}
+			continue
+		}
+		track, err := ts.parseTrackSize()
+		if err != nil {
+			if len(res.Tracks) == 0 && res.AutoRepeat == nil {
+				return res, err
+			}
+			break
+		}
+		res.Tracks = append(res.Tracks, track)
+	}
+	if res.IsNone() {
+		return res, fmt.Errorf("%s: expected track list", ts.errorHeader())
+	}
+	return res, nil
+}
+
+// https://www.w3.org/TR/css-grid-1/#grid-auto-flow-property
+func (ts *tokenStream) parseGridAutoFlow() (res grid.AutoFlow, err error) {
+	gotDirection, gotDense := false, false
+	for {
+		ts.skipWhitespaces()
+		if !gotDirection {
+			if err := ts.consumeIdentTokenWith("row"); err == nil {
+				gotDirection = true
+				continue
+			} else if err := ts.consumeIdentTokenWith("column"); err == nil {
+				res.IsColumn = true
+				gotDirection = true
+				continue
+			}
+		}
+		if !gotDense {
+			if err := ts.consumeIdentTokenWith("dense"); err == nil {
+				res.IsDense = true
+				gotDense = true
+				continue
+			}
+		}
+		break
+	}
+	if !gotDirection && !gotDense {
+		return res, fmt.Errorf("%s: invalid grid-auto-flow value", ts.errorHeader())
+	}
+	return res, nil
+}
+
+// https://www.w3.org/TR/css-grid-1/#typedef-grid-row-start-grid-line
+//
+// TODO: Support named lines
+func (ts *tokenStream) parseGridLine() (res grid.Line, err error) {
+	if err := ts.consumeIdentTokenWith("auto"); err == nil {
+		return grid.Line{Type: grid.LineAuto}, nil
+	}
+	oldCursor := ts.cursor
+	isSpan := false
+	if err := ts.consumeIdentTokenWith("span"); err == nil {
+		isSpan = true
+		ts.skipWhitespaces()
+	}
+	n := ts.parseNumber()
+	if n == nil || n.Type != css.NumTypeInt {
+		ts.cursor = oldCursor
+		return res, fmt.Errorf("%s: expected integer", ts.errorHeader())
+	}
+	if isSpan {
+		if n.ToInt() <= 0 {
+			ts.cursor = oldCursor
+			return res, fmt.Errorf("%s: span must be positive", ts.errorHeader())
+		}
+		return grid.Line{Type: grid.LineSpan, Value: int(n.ToInt())}, nil
+	}
+	if n.ToInt() == 0 {
+		ts.cursor = oldCursor
+		return res, fmt.Errorf("%s: line number can't be zero", ts.errorHeader())
+	}
+	return grid.Line{Type: grid.LineNumber, Value: int(n.ToInt())}, nil
+}
@@ -0,0 +1,86 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/inseo-oh/yw/css/grid"
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/css/values"
+)
+
+func TestCssGridProperties(t *testing.T) {
+	px := func(v float64) grid.TrackSize {
+		return grid.TrackSizeOf(grid.Breadth{Type: grid.BreadthLength, Length: values.LengthFromPx(v)})
+	}
+	fr := func(v float64) grid.TrackSize {
+		return grid.TrackSizeOf(grid.Breadth{Type: grid.BreadthFlex, Flex: v})
+	}
+	cases := []struct {
+		name     string
+		css      string
+		expected props.PropertyValue
+	}{
+		{"grid-template-columns", "none", grid.TrackList{}},
+		{"grid-template-columns", "100px 1fr 2fr", grid.TrackList{Tracks: []grid.TrackSize{px(100), fr(1), fr(2)}}},
+		{"grid-template-columns", "repeat(2, 10px 1fr)", grid.TrackList{Tracks: []grid.TrackSize{px(10), fr(1), px(10), fr(1)}}},
+		{"grid-template-rows", "minmax(auto, 1fr) max-content", grid.TrackList{Tracks: []grid.TrackSize{
+			fr(1),
+			{Min: grid.Breadth{Type: grid.BreadthMaxContent}, Max: grid.Breadth{Type: grid.BreadthMaxContent}},
+		}}},
+		{"grid-template-columns", "10px repeat(auto-fill, 100px)", grid.TrackList{
+			Tracks:     []grid.TrackSize{px(10)},
+			AutoRepeat: &grid.AutoRepeat{IsAutoFit: false, Tracks: []grid.TrackSize{px(100)}, Index: 1},
+		}},
+		{"grid-template-columns", "repeat(auto-fit, minmax(200px, 1fr))", grid.TrackList{
+			AutoRepeat: &grid.AutoRepeat{IsAutoFit: true, Tracks: []grid.TrackSize{
+				{Min: grid.Breadth{Type: grid.BreadthLength, Length: values.LengthFromPx(200)}, Max: grid.Breadth{Type: grid.BreadthFlex, Flex: 1}},
+			}},
+		}},
+		{"grid-auto-flow", "dense column", grid.AutoFlow{IsColumn: true, IsDense: true}},
+		{"grid-row", "2", props.GridRowShorthand{
+			GridRowStart: grid.Line{Type: grid.LineNumber, Value: 2},
+			GridRowEnd:   grid.Line{},
+		}},
+		{"grid-column", "-1 / span 3", props.GridColumnShorthand{
+			GridColumnStart: grid.Line{Type: grid.LineNumber, Value: -1},
+			GridColumnEnd:   grid.Line{Type: grid.LineSpan, Value: 3},
+		}},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			got, err := parse(&ts, parseFuncMap[cs.name])
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, cs.expected) {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+	invalidCases := []struct{ name, css string }{
+		{"grid-template-columns", "minmax(1fr, 100px)"},
+		{"grid-template-columns", "repeat(auto-fill, 1fr)"},
+		{"grid-row-start", "0"},
+		{"grid-row-start", "span 0"},
+	}
+	for _, cs := range invalidCases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			if got, err := parse(&ts, parseFuncMap[cs.name]); err == nil {
+				t.Errorf("expected error, got %v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"fmt"
+
+	"github.com/inseo-oh/yw/css/images"
+	"github.com/inseo-oh/yw/css/values"
+)
+
+// https://www.w3.org/TR/css-images-3/#typedef-image
+func (ts *tokenStream) parseImage() (res images.Image, err error) {
+	// Unquoted url() is a single token, while quoted one is a function.
+	if tk, err := ts.consumeTokenWith(tokenTypeUrl); err == nil {
+		return images.URLImage{URL: tk.(urlToken).value}, nil
+	}
+	if fn, err := ts.consumeAstFuncWith("url"); err == nil {
+		ts := tokenStream{tokens: fn.value, tokenizerHelper: ts.tokenizerHelper}
+		ts.skipWhitespaces()
+		tk, err := ts.consumeTokenWith(tokenTypeString)
+		if err != nil {
+			return res, err
+		}
+		ts.skipWhitespaces()
+		if !ts.isEnd() {
+			return res, fmt.Errorf("%s: extra junk in url()", ts.errorHeader())
+		}
+		return images.URLImage{URL: tk.(stringToken).value}, nil
+	}
+	for _, repeating := range []bool{false, true} {
+		prefix := ""
+		if repeating {
+			prefix = "repeating-"
+		}
+		if fn, err := ts.consumeAstFuncWith(prefix + "linear-gradient"); err == nil {
+			ts := tokenStream{tokens: fn.value, tokenizerHelper: ts.tokenizerHelper}
+			return ts.parseLinearGradient(repeating)
+		}
+		if fn, err := ts.consumeAstFuncWith(prefix + "radial-gradient"); err == nil {
+			ts := tokenStream{tokens: fn.value, tokenizerHelper: ts.tokenizerHelper}
+			return ts.parseRadialGradient(repeating)
+		}
+		if fn, err := ts.consumeAstFuncWith(prefix + "conic-gradient"); err == nil {
+			ts := tokenStream{tokens: fn.value, tokenizerHelper: ts.tokenizerHelper}
+			return ts.parseConicGradient(repeating)
+		}
+	}
+	return res, fmt.Errorf("%s: expected image", ts.errorHeader())
+}
+
+// Parses arguments of linear-gradient().
+//
+// https://www.w3.org/TR/css-images-3/#linear-gradient-syntax
+func (ts *tokenStream) parseLinearGradient(repeating bool) (res images.LinearGradient, err error) {
+	res = images.LinearGradient{Angle: 180, Repeating: repeating}
+	ts.skipWhitespaces()
+	hasPrelude := true
+	if angle, err := ts.parseAngle(true); err == nil {
+		res.Angle = angle
+	} else if err := ts.consumeIdentTokenWith("to"); err == nil {
+		// to [ left | right ] || [ top | bottom ]
+		var x, y string
+		for range 2 {
+			ts.skipWhitespaces()
+			if x == "" {
+				if err := ts.consumeIdentTokenWith("left"); err == nil {
+					x = "left"
+					continue
+				} else if err := ts.consumeIdentTokenWith("right"); err == nil {
+					x = "right"
+					continue
+				}
+			}
+			if y == "" {
+				if err := ts.consumeIdentTokenWith("top"); err == nil {
+					y = "top"
+					continue
+				} else if err := ts.consumeIdentTokenWith("bottom"); err == nil {
+					y = "bottom"
+					continue
+				}
+			}
+		}
+		switch {
+		case x == "" && y == "":
+			return res, fmt.Errorf("%s: expected side or corner", ts.errorHeader())
+		case y == "":
+			res.Angle = map[string]float64{"left": 270, "right": 90}[x]
+		case x == "":
+			res.Angle = map[string]float64{"top": 0, "bottom": 180}[y]
+		default:
+			res.Corner = map[string]images.Corner{
+				"lefttop": images.TopLeft, "righttop": images.TopRight,
+				"leftbottom": images.BottomLeft, "rightbottom": images.BottomRight,
+			}[x+y]
+		}
+	} else {
+		hasPrelude = false
+	}
+	res.Stops, err = ts.parseColorStopList(hasPrelude, func(ts *tokenStream) (values.LengthResolvable, error) {
+		return ts.parseLengthOrPercentage(true)
+	})
+	return res, err
+}
+
+// Parses arguments of radial-gradient().
+//
+// https://www.w3.org/TR/css-images-3/#radial-gradient-syntax
+func (ts *tokenStream) parseRadialGradient(repeating bool) (res images.RadialGradient, err error) {
+	res = images.RadialGradient{Position: values.CenterPosition, Repeating: repeating}
+	ts.skipWhitespaces()
+	gotShape, gotExtent := false, false
+	sizes := []values.LengthResolvable{}
+	for range 2 {
+		ts.skipWhitespaces()
+		if !gotShape {
+			if err := ts.consumeIdentTokenWith("circle"); err == nil {
+				res.Shape, gotShape = images.Circle, true
+				continue
+			} else if err := ts.consumeIdentTokenWith("ellipse"); err == nil {
+				res.Shape, gotShape = images.Ellipse, true
+				continue
+			}
+		}
+		if !gotExtent && len(sizes) == 0 {
+			extents := []struct {
+				name   string
+				extent images.RadialExtent
+			}{
+				{"closest-side", images.ClosestSide},
+				{"closest-corner", images.ClosestCorner},
+				{"farthest-side", images.FarthestSide},
+				{"farthest-corner", images.FarthestCorner},
+			}
+			for _, ext := range extents {
+				if err := ts.consumeIdentTokenWith(ext.name); err == nil {
+					res.Extent, gotExtent = ext.extent, true
+					break
+				}
+			}
+			if gotExtent {
+				continue
+			}
+			sizes, _ = parseRepeation(ts, 2, "radial-size", func(ts *tokenStream) (values.LengthResolvable, error) {
+				l, err := ts.parseLengthOrPercentage(true)
+				if err == nil && isNegativeLength(l) {
+					return nil, fmt.Errorf("%s: negative radial-size", ts.errorHeader())
+				}
+				return l, err
+			})
+			if len(sizes) != 0 {
+				continue
+			}
+		}
+	}
+	switch len(sizes) {
+	case 1:
+		if gotShape && res.Shape != images.Circle {
+			return res, fmt.Errorf("%s: ellipse needs two radii", ts.errorHeader())
+		}
+		if _, ok := sizes[0].(values.Percentage); ok {
+			return res, fmt.Errorf("%s: circle radius can't be percentage", ts.errorHeader())
+		}
+		res.Shape = images.Circle
+		res.Size = [2]values.LengthResolvable{sizes[0], sizes[0]}
+	case 2:
+		if gotShape && res.Shape != images.Ellipse {
+			return res, fmt.Errorf("%s: circle needs single radius", ts.errorHeader())
+		}
+		res.Shape = images.Ellipse
+		res.Size = [2]values.LengthResolvable{sizes[0], sizes[1]}
+	}
+	ts.skipWhitespaces()
+	gotPosition := false
+	if err := ts.consumeIdentTokenWith("at"); err == nil {
+		ts.skipWhitespaces()
+		if res.Position, err = ts.parsePositionValue(); err != nil {
+			return res, err
+		}
+		gotPosition = true
+	}
+	hasPrelude := gotShape || gotExtent || len(sizes) != 0 || gotPosition
+	res.Stops, err = ts.parseColorStopList(hasPrelude, func(ts *tokenStream) (values.LengthResolvable, error) {
+		return ts.parseLengthOrPercentage(true)
+	})
+	return res, err
+}
+
+// Parses arguments of conic-gradient().
+//
+// https://www.w3.org/TR/css-images-4/#conic-gradient-syntax
+func (ts *tokenStream) parseConicGradient(repeating bool) (res images.ConicGradient, err error) {
+	res = images.ConicGradient{Position: values.CenterPosition, Repeating: repeating}
+	ts.skipWhitespaces()
+	hasPrelude := false
+	if err := ts.consumeIdentTokenWith("from"); err == nil {
+		ts.skipWhitespaces()
+		if res.FromAngle, err = ts.parseAngle(true); err != nil {
+			return res, err
+		}
+		hasPrelude = true
+	}
+	ts.skipWhitespaces()
+	if err := ts.consumeIdentTokenWith("at"); err == nil {
+		ts.skipWhitespaces()
+		if res.Position, err = ts.parsePositionValue(); err != nil {
+			return res, err
+		}
+		hasPrelude = true
+	}
+	// <angle-percentage>s are stored as percentages.
+	res.Stops, err = ts.parseColorStopList(hasPrelude, func(ts *tokenStream) (values.LengthResolvable, error) {
+		if angle, err := ts.parseAngle(true); err == nil {
+			return values.Percentage{Value: angle / 360 * 100}, nil
+		}
+		return ts.parsePercentage()
+	})
+	return res, err
+}
+
+// Parses color stop list at the end of gradient function arguments, which is
+// preceded by a comma if hasPrelude is set. posParser is used to parse
+// positions of color stops.
+//
+// https://www.w3.org/TR/css-images-3/#typedef-color-stop-list
+func (ts *tokenStream) parseColorStopList(hasPrelude bool, posParser func(ts *tokenStream) (values.LengthResolvable, error)) (res []images.ColorStop, err error) {
+	ts.skipWhitespaces()
+	if hasPrelude {
+		if _, err := ts.consumeTokenWith(tokenTypeComma); err != nil {
+			return res, err
+		}
+		ts.skipWhitespaces()
+	}
+	// TODO: Support color hints
+	stops, err := parseCommaSeparatedRepeation(ts, 0, "color stop", func(ts *tokenStream) ([]images.ColorStop, error) {
+		color, err := ts.parseColor()
+		if err != nil {
+			return nil, err
+		}
+		res := []images.ColorStop{{Color: color}}
+		// Each stop can have up to two positions, which are the same as two
+		// stops with the same color.
+		for i := range 2 {
+			oldCursor := ts.cursor
+			ts.skipWhitespaces()
+			pos, err := posParser(ts)
+			if err != nil {
+				ts.cursor = oldCursor
+				break
+			}
+			if i == 0 {
+				res[0].Position = pos
+			} else {
+				res = append(res, images.ColorStop{Color: color, Position: pos})
+			}
+		}
+		return res, nil
+	})
+	if err != nil {
+		return res, err
+	}
+	if len(stops) < 2 {
+		return res, fmt.Errorf("%s: gradients need at least two color stops", ts.errorHeader())
+	}
+	ts.skipWhitespaces()
+	if !ts.isEnd() {
+		return res, fmt.Errorf("%s: extra junk after color stops", ts.errorHeader())
+	}
+	for _, s := range stops {
+		res = append(res, s...)
+	}
+	return res, nil
+}
+
+// isNegativeLength reports whether l is negative length or percentage.
+func isNegativeLength(l values.LengthResolvable) bool {
+	switch l := l.(type) {
+	case values.Length:
+		return l.Value < 0
+	case values.Percentage:
+		return l.Value < 0
+	}
+	return false
+}
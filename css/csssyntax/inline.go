@@ -0,0 +1,68 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"fmt"
+
+	"github.com/inseo-oh/yw/css/inline"
+	"github.com/inseo-oh/yw/css/values"
+)
+
+// https://www.w3.org/TR/css-inline-3/#line-height-property
+func (ts *tokenStream) parseLineHeight() (res inline.LineHeight, err error) {
+	if err := ts.consumeIdentTokenWith("normal"); err == nil {
+		return inline.LineHeight{Type: inline.NormalLineHeight}, nil
+	}
+	// Numbers must be tried first, so that 0 is parsed as <number 0>.
+	if num := ts.parseNumber(); num != nil {
+		if num.ToFloat() < 0 {
+			return res, fmt.Errorf("%s: negative values are not accepted by line-height", ts.errorHeader())
+		}
+		return inline.LineHeight{Type: inline.NumberLineHeight, Number: *num}, nil
+	}
+	if v, err := ts.parseLengthOrPercentage(false); err == nil {
+		if isNegativeLengthOrPercentage(v) {
+			return res, fmt.Errorf("%s: negative values are not accepted by line-height", ts.errorHeader())
+		}
+		return inline.LineHeight{Type: inline.LengthLineHeight, Length: v}, nil
+	}
+	return res, fmt.Errorf("%s: invalid line-height value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/CSS2/visudet.html#propdef-vertical-align
+func (ts *tokenStream) parseVerticalAlign() (res inline.VerticalAlign, err error) {
+	if err := ts.consumeIdentTokenWith("baseline"); err == nil {
+		return inline.VerticalAlign{Type: inline.Baseline}, nil
+	} else if err := ts.consumeIdentTokenWith("sub"); err == nil {
+		return inline.VerticalAlign{Type: inline.Sub}, nil
+	} else if err := ts.consumeIdentTokenWith("super"); err == nil {
+		return inline.VerticalAlign{Type: inline.Super}, nil
+	} else if err := ts.consumeIdentTokenWith("text-top"); err == nil {
+		return inline.VerticalAlign{Type: inline.TextTop}, nil
+	} else if err := ts.consumeIdentTokenWith("text-bottom"); err == nil {
+		return inline.VerticalAlign{Type: inline.TextBottom}, nil
+	} else if err := ts.consumeIdentTokenWith("middle"); err == nil {
+		return inline.VerticalAlign{Type: inline.Middle}, nil
+	} else if err := ts.consumeIdentTokenWith("top"); err == nil {
+		return inline.VerticalAlign{Type: inline.Top}, nil
+	} else if err := ts.consumeIdentTokenWith("bottom"); err == nil {
+		return inline.VerticalAlign{Type: inline.Bottom}, nil
+	} else if v, err := ts.parseLengthOrPercentage(true); err == nil {
+		return inline.VerticalAlign{Type: inline.LengthVerticalAlign, Length: v}, nil
+	}
+	return res, fmt.Errorf("%s: invalid vertical-align value", ts.errorHeader())
+}
+
+// isNegativeLengthOrPercentage reports whether v is a negative length or percentage.
+func isNegativeLengthOrPercentage(v values.LengthResolvable) bool {
+	switch v := v.(type) {
+	case values.Length:
+		return v.Value < 0
+	case values.Percentage:
+		return v.Value < 0
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/inline"
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/css/values"
+)
+
+func TestCssInlineProperties(t *testing.T) {
+	cases := []struct {
+		name     string
+		css      string
+		expected props.PropertyValue
+	}{
+		{"line-height", "normal", inline.LineHeight{Type: inline.NormalLineHeight}},
+		{"line-height", "1.5", inline.LineHeight{Type: inline.NumberLineHeight, Number: css.NumFromFloat(1.5)}},
+		{"line-height", "0", inline.LineHeight{Type: inline.NumberLineHeight, Number: css.NumFromInt(0)}},
+		{"line-height", "20px", inline.LineHeight{Type: inline.LengthLineHeight, Length: values.LengthFromPx(20)}},
+		{"line-height", "150%", inline.LineHeight{Type: inline.LengthLineHeight, Length: values.Percentage{Value: 150}}},
+		{"vertical-align", "baseline", inline.VerticalAlign{Type: inline.Baseline}},
+		{"vertical-align", "sub", inline.VerticalAlign{Type: inline.Sub}},
+		{"vertical-align", "text-top", inline.VerticalAlign{Type: inline.TextTop}},
+		{"vertical-align", "middle", inline.VerticalAlign{Type: inline.Middle}},
+		{"vertical-align", "bottom", inline.VerticalAlign{Type: inline.Bottom}},
+		{"vertical-align", "-3px", inline.VerticalAlign{Type: inline.LengthVerticalAlign, Length: values.LengthFromPx(-3)}},
+		{"vertical-align", "50%", inline.VerticalAlign{Type: inline.LengthVerticalAlign, Length: values.Percentage{Value: 50}}},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			got, err := parse(&ts, parseFuncMap[cs.name])
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, cs.expected) {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+	invalidCases := []struct{ name, css string }{
+		{"line-height", "-1"},
+		{"line-height", "-10px"},
+		{"line-height", "auto"},
+		{"vertical-align", "center"},
+	}
+	for _, cs := range invalidCases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			if got, err := parse(&ts, parseFuncMap[cs.name]); err == nil {
+				t.Errorf("expected error, got %v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/lists"
+	"github.com/inseo-oh/yw/util"
+)
+
+// https://www.w3.org/TR/css-lists-3/#text-markers
+func (ts *tokenStream) parseListStyleType() (res lists.StyleType, err error) {
+	if err := ts.consumeIdentTokenWith("none"); err == nil {
+		return lists.StyleType{Type: lists.NoStyleType}, nil
+	}
+	if tk, err := ts.consumeTokenWith(tokenTypeString); err == nil {
+		return lists.StyleType{Type: lists.StringStyleType, Value: tk.(stringToken).value}, nil
+	}
+	// https://www.w3.org/TR/css-counter-styles-3/#typedef-counter-style-name
+	if tk, err := ts.consumeTokenWith(tokenTypeIdent); err == nil {
+		return lists.StyleType{Type: lists.CounterStyleType, Value: util.ToAsciiLowercase(tk.(identToken).value)}, nil
+	}
+	return res, fmt.Errorf("%s: invalid list-style-type value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-lists-3/#list-style-position-property
+func (ts *tokenStream) parseListStylePosition() (res lists.Position, err error) {
+	if err := ts.consumeIdentTokenWith("outside"); err == nil {
+		return lists.Outside, nil
+	} else if err := ts.consumeIdentTokenWith("inside"); err == nil {
+		return lists.Inside, nil
+	}
+	return res, fmt.Errorf("%s: invalid list-style-position value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-lists-3/#image-markers
+func (ts *tokenStream) parseListStyleImage() (res lists.Image, err error) {
+	if err := ts.consumeIdentTokenWith("none"); err == nil {
+		return lists.NoImage, nil
+	}
+	// TODO: Support <image> values
+	return res, fmt.Errorf("%s: invalid list-style-image value", ts.errorHeader())
+}
+
+// parseCounterName parses <counter-name>, which is a <custom-ident> except none.
+//
+// https://www.w3.org/TR/css-lists-3/#typedef-counter-name
+func (ts *tokenStream) parseCounterName() (string, error) {
+	oldCursor := ts.cursor
+	tk, err := ts.consumeTokenWith(tokenTypeIdent)
+	if err != nil {
+		return "", fmt.Errorf("%s: expected counter name", ts.errorHeader())
+	}
+	name := tk.(identToken).value
+	// https://www.w3.org/TR/css-values-4/#custom-idents
+	if slices.Contains([]string{"none", "initial", "inherit", "unset", "default"}, util.ToAsciiLowercase(name)) {
+		ts.cursor = oldCursor
+		return "", fmt.Errorf("%s: %s can't be used as counter name", ts.errorHeader(), name)
+	}
+	return name, nil
+}
+
+// parseCounters parses value of counter-reset, counter-increment, or
+// counter-set property, where defaultValue is used for counters without
+// values. reversed(<counter-name>) is only accepted if allowReversed is set.
+func (ts *tokenStream) parseCounters(propName string, defaultValue int, allowReversed bool) (res lists.Counters, err error) {
+	if err := ts.consumeIdentTokenWith("none"); err == nil {
+		return lists.Counters{}, nil
+	}
+	items, err := parseRepeation(ts, 0, propName, func(ts *tokenStream) (*lists.Counter, error) {
+		res := lists.Counter{Value: defaultValue}
+		oldCursor := ts.cursor
+		if fn, err := ts.consumeAstFuncWith("reversed"); err == nil && allowReversed {
+			// https://www.w3.org/TR/css-lists-3/#valdef-counter-reset-reversed-counter-name
+			innerTs := tokenStream{tokens: fn.value, tokenizerHelper: ts.tokenizerHelper}
+			innerTs.skipWhitespaces()
+			name, err := innerTs.parseCounterName()
+			innerTs.skipWhitespaces()
+			if err != nil || !innerTs.isEnd() {
+				ts.cursor = oldCursor
+				return nil, fmt.Errorf("%s: invalid reversed() value", innerTs.errorHeader())
+			}
+			res.Name, res.IsReversed = name, true
+		} else {
+			ts.cursor = oldCursor
+			name, err := ts.parseCounterName()
+			if err != nil {
+				return nil, err
+			}
+			res.Name = name
+		}
+		oldCursor = ts.cursor
+		ts.skipWhitespaces()
+		if n := ts.parseNumber(); n != nil && n.Type == css.NumTypeInt {
+			res.Value, res.HasValue = int(n.ToInt()), true
+		} else {
+			ts.cursor = oldCursor
+		}
+		return &res, nil
+	})
+	if err != nil {
+		return res, err
+	}
+	for _, item := range items {
+		res = append(res, *item)
+	}
+	return res, nil
+}
+
+// https://www.w3.org/TR/css-lists-3/#counter-reset
+func (ts *tokenStream) parseCounterReset() (res lists.Counters, err error) {
+	return ts.parseCounters("counter-reset", 0, true)
+}
+
+// https://www.w3.org/TR/css-lists-3/#increment-set
+func (ts *tokenStream) parseCounterIncrement() (res lists.Counters, err error) {
+	return ts.parseCounters("counter-increment", 1, false)
+}
+
+// https://www.w3.org/TR/css-lists-3/#increment-set
+func (ts *tokenStream) parseCounterSet() (res lists.Counters, err error) {
+	return ts.parseCounters("counter-set", 0, false)
+}
@@ -0,0 +1,74 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/inseo-oh/yw/css/display"
+	"github.com/inseo-oh/yw/css/lists"
+	"github.com/inseo-oh/yw/css/props"
+)
+
+func TestCssListProperties(t *testing.T) {
+	disc := lists.StyleType{Type: lists.CounterStyleType, Value: "disc"}
+	cases := []struct {
+		name     string
+		css      string
+		expected props.PropertyValue
+	}{
+		{"display", "list-item", display.Display{Mode: display.OuterInnerMode, OuterMode: display.Block, InnerMode: display.Flow, IsListItem: true}},
+		{"display", "inline list-item", display.Display{Mode: display.OuterInnerMode, OuterMode: display.Inline, InnerMode: display.Flow, IsListItem: true}},
+		{"display", "list-item flow-root", display.Display{Mode: display.OuterInnerMode, OuterMode: display.Block, InnerMode: display.FlowRoot, IsListItem: true}},
+		{"list-style-type", "none", lists.StyleType{Type: lists.NoStyleType}},
+		{"list-style-type", "Upper-Roman", lists.StyleType{Type: lists.CounterStyleType, Value: "upper-roman"}},
+		{"list-style-type", `"-"`, lists.StyleType{Type: lists.StringStyleType, Value: "-"}},
+		{"list-style-position", "inside", lists.Inside},
+		{"list-style-image", "none", lists.NoImage},
+		{"list-style", "square inside", props.ListStyleShorthand{ListStylePosition: lists.Inside, ListStyleType: lists.StyleType{Type: lists.CounterStyleType, Value: "square"}, ListStyleImage: lists.NoImage}},
+		{"list-style", "none", props.ListStyleShorthand{ListStylePosition: lists.Outside, ListStyleType: lists.StyleType{Type: lists.NoStyleType}, ListStyleImage: lists.NoImage}},
+		{"list-style", "outside", props.ListStyleShorthand{ListStylePosition: lists.Outside, ListStyleType: disc, ListStyleImage: lists.NoImage}},
+		{"counter-reset", "none", lists.Counters{}},
+		{"counter-reset", "foo bar 3", lists.Counters{{Name: "foo", Value: 0}, {Name: "bar", Value: 3, HasValue: true}}},
+		{"counter-reset", "reversed(list-item)", lists.Counters{{Name: "list-item", IsReversed: true}}},
+		{"counter-reset", "reversed(list-item) -2", lists.Counters{{Name: "list-item", Value: -2, HasValue: true, IsReversed: true}}},
+		{"counter-increment", "list-item", lists.Counters{{Name: "list-item", Value: 1}}},
+		{"counter-increment", "list-item 0 foo -1", lists.Counters{{Name: "list-item", Value: 0, HasValue: true}, {Name: "foo", Value: -1, HasValue: true}}},
+		{"counter-set", "foo", lists.Counters{{Name: "foo", Value: 0}}},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			got, err := parse(&ts, parseFuncMap[cs.name])
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, cs.expected) {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+	invalidCases := []struct{ name, css string }{
+		{"display", "list-item flex"},
+		{"list-style-position", "none"},
+		{"counter-increment", "reversed(foo)"},
+		{"counter-set", "initial"},
+	}
+	for _, cs := range invalidCases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			if got, err := parse(&ts, parseFuncMap[cs.name]); err == nil {
+				t.Errorf("expected error, got %v", got)
+			}
+		})
+	}
+}
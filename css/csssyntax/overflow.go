@@ -0,0 +1,37 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"fmt"
+
+	"github.com/inseo-oh/yw/css/overflow"
+)
+
+// https://www.w3.org/TR/css-overflow-3/#overflow-control
+func (ts *tokenStream) parseOverflow() (res overflow.Overflow, err error) {
+	if err := ts.consumeIdentTokenWith("visible"); err == nil {
+		return overflow.Visible, nil
+	} else if err := ts.consumeIdentTokenWith("hidden"); err == nil {
+		return overflow.Hidden, nil
+	} else if err := ts.consumeIdentTokenWith("clip"); err == nil {
+		return overflow.Clip, nil
+	} else if err := ts.consumeIdentTokenWith("scroll"); err == nil {
+		return overflow.Scroll, nil
+	} else if err := ts.consumeIdentTokenWith("auto"); err == nil {
+		return overflow.Auto, nil
+	}
+	return res, fmt.Errorf("%s: invalid overflow value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-overflow-3/#text-overflow
+func (ts *tokenStream) parseTextOverflow() (res overflow.TextOverflow, err error) {
+	if err := ts.consumeIdentTokenWith("clip"); err == nil {
+		return overflow.ClipText, nil
+	} else if err := ts.consumeIdentTokenWith("ellipsis"); err == nil {
+		return overflow.EllipsisText, nil
+	}
+	return res, fmt.Errorf("%s: invalid text-overflow value", ts.errorHeader())
+}
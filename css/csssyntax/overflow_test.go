@@ -0,0 +1,61 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/inseo-oh/yw/css/overflow"
+	"github.com/inseo-oh/yw/css/props"
+)
+
+func TestCssOverflowProperties(t *testing.T) {
+	cases := []struct {
+		name     string
+		css      string
+		expected props.PropertyValue
+	}{
+		{"overflow-x", "visible", overflow.Visible},
+		{"overflow-x", "clip", overflow.Clip},
+		{"overflow-y", "scroll", overflow.Scroll},
+		{"overflow-y", "auto", overflow.Auto},
+		{"overflow", "hidden", props.OverflowShorthand{OverflowX: overflow.Hidden, OverflowY: overflow.Hidden}},
+		{"overflow", "clip auto", props.OverflowShorthand{OverflowX: overflow.Clip, OverflowY: overflow.Auto}},
+		{"text-overflow", "clip", overflow.ClipText},
+		{"text-overflow", "ellipsis", overflow.EllipsisText},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			got, err := parse(&ts, parseFuncMap[cs.name])
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, cs.expected) {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+	invalidCases := []struct{ name, css string }{
+		{"overflow-x", "none"},
+		{"overflow", "overlay"},
+		{"text-overflow", "hidden"},
+	}
+	for _, cs := range invalidCases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			if got, err := parse(&ts, parseFuncMap[cs.name]); err == nil {
+				t.Errorf("expected error, got %v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"fmt"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/position"
+)
+
+// https://www.w3.org/TR/css-position-3/#position-property
+func (ts *tokenStream) parsePosition() (res position.Position, err error) {
+	if err := ts.consumeIdentTokenWith("static"); err == nil {
+		return position.Static, nil
+	} else if err := ts.consumeIdentTokenWith("relative"); err == nil {
+		return position.Relative, nil
+	} else if err := ts.consumeIdentTokenWith("sticky"); err == nil {
+		return position.Sticky, nil
+	} else if err := ts.consumeIdentTokenWith("absolute"); err == nil {
+		return position.Absolute, nil
+	} else if err := ts.consumeIdentTokenWith("fixed"); err == nil {
+		return position.Fixed, nil
+	}
+	return res, fmt.Errorf("%s: invalid position value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-position-3/#insets
+func (ts *tokenStream) parseInset() (res position.Inset, err error) {
+	if v, err := ts.parseLengthOrPercentage(true); err == nil {
+		return position.Inset{Value: v}, nil
+	}
+	if err := ts.consumeIdentTokenWith("auto"); err == nil {
+		return position.Inset{Value: nil}, nil
+	}
+	return res, fmt.Errorf("%s: expected inset", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/CSS2/visuren.html#z-index
+func (ts *tokenStream) parseZIndex() (res position.ZIndex, err error) {
+	if err := ts.consumeIdentTokenWith("auto"); err == nil {
+		return position.ZIndex{IsAuto: true}, nil
+	}
+	if n := ts.parseNumber(); n != nil {
+		if n.Type == css.NumTypeFloat {
+			return res, fmt.Errorf("%s: floating point isn't accepted by z-index", ts.errorHeader())
+		}
+		return position.ZIndex{Value: int(n.ToInt())}, nil
+	}
+	return res, fmt.Errorf("%s: invalid z-index value", ts.errorHeader())
+}
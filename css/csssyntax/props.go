@@ -5,11 +5,18 @@ package csssyntax
 
 import (
 	"fmt"
+	"github.com/inseo-oh/yw/css/align"
 	"github.com/inseo-oh/yw/css/backgrounds"
 	"github.com/inseo-oh/yw/css/box"
 	"github.com/inseo-oh/yw/css/csscolor"
+	"github.com/inseo-oh/yw/css/flexbox"
 	"github.com/inseo-oh/yw/css/fonts"
+	"github.com/inseo-oh/yw/css/grid"
+	"github.com/inseo-oh/yw/css/lists"
+	"github.com/inseo-oh/yw/css/overflow"
+	"github.com/inseo-oh/yw/css/position"
 	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/css/text"
 	"github.com/inseo-oh/yw/css/textdecor"
 	"github.com/inseo-oh/yw/css/values"
 )
@@ -495,6 +502,53 @@ func (ts *tokenStream) parseFontShorthand() (res props.FontShorthand, err error)
 	return res, nil
 }
 
+func (ts *tokenStream) parseWhiteSpaceShorthand() (res props.WhiteSpaceShorthand, err error) {
+	if err := ts.consumeIdentTokenWith("normal"); err == nil {
+		return props.WhiteSpaceShorthand{WhiteSpaceCollapse: text.CollapseWhiteSpace, TextWrapMode: text.Wrap}, nil
+	}
+	if err := ts.consumeIdentTokenWith("pre"); err == nil {
+		return props.WhiteSpaceShorthand{WhiteSpaceCollapse: text.PreserveWhiteSpace, TextWrapMode: text.NoWrap}, nil
+	}
+	if err := ts.consumeIdentTokenWith("pre-wrap"); err == nil {
+		return props.WhiteSpaceShorthand{WhiteSpaceCollapse: text.PreserveWhiteSpace, TextWrapMode: text.Wrap}, nil
+	}
+	if err := ts.consumeIdentTokenWith("pre-line"); err == nil {
+		return props.WhiteSpaceShorthand{WhiteSpaceCollapse: text.PreserveBreaks, TextWrapMode: text.Wrap}, nil
+	}
+	res = props.WhiteSpaceShorthand{WhiteSpaceCollapse: text.CollapseWhiteSpace, TextWrapMode: text.Wrap}
+	gotWhiteSpaceCollapse := false
+	gotTextWrapMode := false
+	gotAny := false
+	for {
+		valid := false
+		if !gotWhiteSpaceCollapse {
+			ts.skipWhitespaces()
+			if v, err := ts.parseWhiteSpaceCollapse(); err == nil {
+				res.WhiteSpaceCollapse = v
+				gotWhiteSpaceCollapse = true
+				valid = true
+			}
+		}
+		if !gotTextWrapMode {
+			ts.skipWhitespaces()
+			if v, err := ts.parseTextWrapMode(); err == nil {
+				res.TextWrapMode = v
+				gotTextWrapMode = true
+				valid = true
+			}
+		}
+		ts.skipWhitespaces()
+		if !valid {
+			break
+		}
+		gotAny = true
+	}
+	if !gotAny {
+		return res, fmt.Errorf("%s: expected white-space value", ts.errorHeader())
+	}
+	return res, nil
+}
+
 func (ts *tokenStream) parseTextDecorationShorthand() (res props.TextDecorationShorthand, err error) {
 	res = props.TextDecorationShorthand{TextDecorationLine: textdecor.NoLine, TextDecorationStyle: textdecor.Solid, TextDecorationColor: csscolor.Color{Type: csscolor.CurrentColor}}
 	gotTextDecorationLine := false
@@ -539,10 +593,198 @@ func (ts *tokenStream) parseTextDecorationShorthand() (res props.TextDecorationS
 	return res, nil
 }
 
+func (ts *tokenStream) parseOverflowShorthand() (res props.OverflowShorthand, err error) {
+	items, err := parseRepeation(ts, 2, "overflow", func(ts *tokenStream) (*overflow.Overflow, error) {
+		res, err := ts.parseOverflow()
+		if err != nil {
+			return nil, err
+		}
+		return &res, nil
+	})
+	if err != nil {
+		return res, err
+	}
+	res = props.OverflowShorthand{}
+	res.OverflowX = *items[0]
+	res.OverflowY = *items[len(items)-1]
+	return res, nil
+}
+
+func (ts *tokenStream) parseInsetShorthand() (res props.InsetShorthand, err error) {
+	items, err := parseRepeation(ts, 4, "inset", func(ts *tokenStream) (*position.Inset, error) {
+		var res position.Inset
+		res, err := ts.parseInset()
+		if err != nil {
+			return nil, err
+		}
+		return &res, nil
+	})
+	if err != nil {
+		return res, err
+	}
+	res = props.InsetShorthand{}
+	switch len(items) {
+	case 1:
+		res.Top = *items[0]
+		res.Right = *items[0]
+		res.Bottom = *items[0]
+		res.Left = *items[0]
+	case 2:
+		res.Top = *items[0]
+		res.Right = *items[1]
+		res.Bottom = *items[0]
+		res.Left = *items[1]
+	case 3:
+		res.Top = *items[0]
+		res.Right = *items[1]
+		res.Bottom = *items[2]
+		res.Left = *items[1]
+	case 4:
+		res.Top = *items[0]
+		res.Right = *items[1]
+		res.Bottom = *items[2]
+		res.Left = *items[3]
+	}
+	return res, nil
+}
+
+func (ts *tokenStream) parseFlexFlowShorthand() (res props.FlexFlowShorthand, err error) {
+	res = props.FlexFlowShorthand{FlexDirection: flexbox.Row, FlexWrap: flexbox.NoWrap}
+	gotFlexDirection := false
+	gotFlexWrap := false
+	gotAny := false
+	for {
+		valid := false
+		if !gotFlexDirection {
+			ts.skipWhitespaces()
+			if v, err := ts.parseFlexDirection(); err == nil {
+				res.FlexDirection = v
+				gotFlexDirection = true
+				valid = true
+			}
+		}
+		if !gotFlexWrap {
+			ts.skipWhitespaces()
+			if v, err := ts.parseFlexWrap(); err == nil {
+				res.FlexWrap = v
+				gotFlexWrap = true
+				valid = true
+			}
+		}
+		ts.skipWhitespaces()
+		if !valid {
+			break
+		}
+		gotAny = true
+	}
+	if !gotAny {
+		return res, fmt.Errorf("%s: expected flex-flow value", ts.errorHeader())
+	}
+	return res, nil
+}
+
+func (ts *tokenStream) parseGapShorthand() (res props.GapShorthand, err error) {
+	items, err := parseRepeation(ts, 2, "gap", func(ts *tokenStream) (*align.Gap, error) {
+		res, err := ts.parseGap()
+		if err != nil {
+			return nil, err
+		}
+		return &res, nil
+	})
+	if err != nil {
+		return res, err
+	}
+	res = props.GapShorthand{}
+	res.RowGap = *items[0]
+	res.ColumnGap = *items[len(items)-1]
+	return res, nil
+}
+
+func (ts *tokenStream) parseGridRowShorthand() (res props.GridRowShorthand, err error) {
+	res = props.GridRowShorthand{GridRowStart: grid.Line{}, GridRowEnd: grid.Line{}}
+	res.GridRowStart, err = ts.parseGridLine()
+	if err != nil {
+		return res, err
+	}
+	ts.skipWhitespaces()
+	if err := ts.consumeDelimTokenWith('/'); err == nil {
+		ts.skipWhitespaces()
+		res.GridRowEnd, err = ts.parseGridLine()
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func (ts *tokenStream) parseGridColumnShorthand() (res props.GridColumnShorthand, err error) {
+	res = props.GridColumnShorthand{GridColumnStart: grid.Line{}, GridColumnEnd: grid.Line{}}
+	res.GridColumnStart, err = ts.parseGridLine()
+	if err != nil {
+		return res, err
+	}
+	ts.skipWhitespaces()
+	if err := ts.consumeDelimTokenWith('/'); err == nil {
+		ts.skipWhitespaces()
+		res.GridColumnEnd, err = ts.parseGridLine()
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func (ts *tokenStream) parseListStyleShorthand() (res props.ListStyleShorthand, err error) {
+	res = props.ListStyleShorthand{ListStylePosition: lists.Outside, ListStyleType: lists.StyleType{Type: lists.CounterStyleType, Value: "disc"}, ListStyleImage: lists.NoImage}
+	gotListStylePosition := false
+	gotListStyleType := false
+	gotListStyleImage := false
+	gotAny := false
+	for {
+		valid := false
+		if !gotListStylePosition {
+			ts.skipWhitespaces()
+			if v, err := ts.parseListStylePosition(); err == nil {
+				res.ListStylePosition = v
+				gotListStylePosition = true
+				valid = true
+			}
+		}
+		if !gotListStyleType {
+			ts.skipWhitespaces()
+			if v, err := ts.parseListStyleType(); err == nil {
+				res.ListStyleType = v
+				gotListStyleType = true
+				valid = true
+			}
+		}
+		if !gotListStyleImage {
+			ts.skipWhitespaces()
+			if v, err := ts.parseListStyleImage(); err == nil {
+				res.ListStyleImage = v
+				gotListStyleImage = true
+				valid = true
+			}
+		}
+		ts.skipWhitespaces()
+		if !valid {
+			break
+		}
+		gotAny = true
+	}
+	if !gotAny {
+		return res, fmt.Errorf("%s: expected list-style value", ts.errorHeader())
+	}
+	return res, nil
+}
+
 var parseFuncMap = map[string]func(ts *tokenStream) (res props.PropertyValue, err error){
 	"color": func(ts *tokenStream) (props.PropertyValue, error) {
 		return ts.parseColor()
 	},
+	"opacity": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseAlphaValue()
+	},
 	"width": func(ts *tokenStream) (props.PropertyValue, error) {
 		return ts.parseSizeOrAuto()
 	},
@@ -561,6 +803,9 @@ var parseFuncMap = map[string]func(ts *tokenStream) (res props.PropertyValue, er
 	"max-height": func(ts *tokenStream) (props.PropertyValue, error) {
 		return ts.parseSizeOrNone()
 	},
+	"box-sizing": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBoxSizing()
+	},
 	"display": func(ts *tokenStream) (props.PropertyValue, error) {
 		return ts.parseDisplay()
 	},
@@ -570,6 +815,27 @@ var parseFuncMap = map[string]func(ts *tokenStream) (res props.PropertyValue, er
 	"background-color": func(ts *tokenStream) (props.PropertyValue, error) {
 		return ts.parseColor()
 	},
+	"background-image": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBackgroundImage()
+	},
+	"background-position": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBackgroundPosition()
+	},
+	"background-size": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBackgroundSize()
+	},
+	"background-repeat": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBackgroundRepeat()
+	},
+	"background-origin": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBackgroundBox()
+	},
+	"background-clip": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBackgroundBox()
+	},
+	"background": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBackgroundShorthand()
+	},
 	"border-top-color": func(ts *tokenStream) (props.PropertyValue, error) {
 		return ts.parseColor()
 	},
@@ -630,6 +896,24 @@ var parseFuncMap = map[string]func(ts *tokenStream) (res props.PropertyValue, er
 	"border": func(ts *tokenStream) (props.PropertyValue, error) {
 		return ts.parseBorderShorthand()
 	},
+	"border-top-left-radius": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBorderRadius()
+	},
+	"border-top-right-radius": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBorderRadius()
+	},
+	"border-bottom-right-radius": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBorderRadius()
+	},
+	"border-bottom-left-radius": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBorderRadius()
+	},
+	"border-radius": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBorderRadiusShorthand()
+	},
+	"box-shadow": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBoxShadow()
+	},
 	"margin-top": func(ts *tokenStream) (props.PropertyValue, error) {
 		return ts.parseMargin()
 	},
@@ -681,6 +965,51 @@ var parseFuncMap = map[string]func(ts *tokenStream) (res props.PropertyValue, er
 	"text-transform": func(ts *tokenStream) (props.PropertyValue, error) {
 		return ts.parseTextTransform()
 	},
+	"word-break": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseWordBreak()
+	},
+	"overflow-wrap": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseOverflowWrap()
+	},
+	"line-break": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseLineBreak()
+	},
+	"text-align": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseTextAlign()
+	},
+	"text-indent": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseTextIndent()
+	},
+	"tab-size": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseTabSize()
+	},
+	"white-space-collapse": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseWhiteSpaceCollapse()
+	},
+	"text-wrap-mode": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseTextWrapMode()
+	},
+	"white-space": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseWhiteSpaceShorthand()
+	},
+	"line-height": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseLineHeight()
+	},
+	"vertical-align": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseVerticalAlign()
+	},
+	"direction": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseDirection()
+	},
+	"unicode-bidi": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseUnicodeBidi()
+	},
+	"writing-mode": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseWritingMode()
+	},
+	"text-orientation": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseTextOrientation()
+	},
 	"text-decoration-line": func(ts *tokenStream) (props.PropertyValue, error) {
 		return ts.parseTextDecorationLine()
 	},
@@ -699,4 +1028,142 @@ var parseFuncMap = map[string]func(ts *tokenStream) (res props.PropertyValue, er
 	"float": func(ts *tokenStream) (props.PropertyValue, error) {
 		return ts.parseFloat()
 	},
+	"z-index": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseZIndex()
+	},
+	"overflow-x": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseOverflow()
+	},
+	"overflow-y": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseOverflow()
+	},
+	"overflow": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseOverflowShorthand()
+	},
+	"text-overflow": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseTextOverflow()
+	},
+	"position": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parsePosition()
+	},
+	"top": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseInset()
+	},
+	"right": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseInset()
+	},
+	"bottom": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseInset()
+	},
+	"left": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseInset()
+	},
+	"inset": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseInsetShorthand()
+	},
+	"flex-direction": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseFlexDirection()
+	},
+	"flex-wrap": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseFlexWrap()
+	},
+	"flex-flow": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseFlexFlowShorthand()
+	},
+	"flex-grow": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseFlexFactor()
+	},
+	"flex-shrink": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseFlexFactor()
+	},
+	"flex-basis": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseFlexBasis()
+	},
+	"justify-content": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseJustifyContent()
+	},
+	"align-items": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseAlignItems()
+	},
+	"align-self": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseAlignSelf()
+	},
+	"row-gap": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseGap()
+	},
+	"column-gap": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseGap()
+	},
+	"gap": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseGapShorthand()
+	},
+	"grid-template-columns": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseTrackList()
+	},
+	"grid-template-rows": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseTrackList()
+	},
+	"grid-auto-columns": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseTrackSize()
+	},
+	"grid-auto-rows": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseTrackSize()
+	},
+	"grid-auto-flow": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseGridAutoFlow()
+	},
+	"grid-row-start": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseGridLine()
+	},
+	"grid-row-end": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseGridLine()
+	},
+	"grid-column-start": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseGridLine()
+	},
+	"grid-column-end": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseGridLine()
+	},
+	"grid-row": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseGridRowShorthand()
+	},
+	"grid-column": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseGridColumnShorthand()
+	},
+	"table-layout": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseTableLayout()
+	},
+	"border-collapse": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBorderCollapse()
+	},
+	"border-spacing": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseBorderSpacing()
+	},
+	"caption-side": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseCaptionSide()
+	},
+	"list-style-image": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseListStyleImage()
+	},
+	"list-style-type": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseListStyleType()
+	},
+	"list-style-position": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseListStylePosition()
+	},
+	"list-style": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseListStyleShorthand()
+	},
+	"counter-reset": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseCounterReset()
+	},
+	"counter-increment": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseCounterIncrement()
+	},
+	"counter-set": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseCounterSet()
+	},
+	"content": func(ts *tokenStream) (props.PropertyValue, error) {
+		return ts.parseContent()
+	},
 }
@@ -6,8 +6,12 @@ package csssyntax
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/inseo-oh/yw/css"
 	"github.com/inseo-oh/yw/css/selector"
+	"github.com/inseo-oh/yw/util"
 )
 
 // Returns nil if not found
@@ -171,34 +175,292 @@ func (ts *tokenStream) parsePseudoClassSelector() (res selector.PseudoClassSelec
 		return res, err
 	} else if identTk, err := ts.consumeTokenWith(tokenTypeIdent); err == nil {
 		// :<name> ------------------------------------------------------------
-		name := identTk.(identToken).value
+		name := util.ToAsciiLowercase(identTk.(identToken).value)
 		return selector.PseudoClassSelector{Name: name, Args: nil}, nil
 	}
 	if funcNode, err := ts.consumeTokenWith(tokenTypeAstFunc); err == nil {
 		// :<func(value)> ------------------------------------------------------
-		name := funcNode.(astFuncToken).name
+		name := util.ToAsciiLowercase(funcNode.(astFuncToken).name)
 		subStream := tokenStream{tokens: funcNode.(astFuncToken).value, tokenizerHelper: ts.tokenizerHelper}
-		args := subStream.consumeAnyValue()
-		if args == nil {
+		args, err := subStream.parsePseudoClassArgs(name)
+		if err != nil {
 			ts.cursor = oldCursor
-			return res, fmt.Errorf("%s: expected value after '('", ts.errorHeader())
+			return res, err
+		}
+		return selector.PseudoClassSelector{Name: name, Args: args}, nil
+	} else {
+		ts.cursor = oldCursor
+		return res, err
+	}
+}
+
+// parsePseudoClassArgs parses arguments of functional pseudo-class with given name.
+// See [selector.PseudoClassSelector] for resulting values.
+func (ts *tokenStream) parsePseudoClassArgs(name string) (res []any, err error) {
+	ts.skipWhitespaces()
+	switch name {
+	case "nth-child", "nth-last-child":
+		// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#the-nth-child-pseudo
+		anb, err := ts.parseAnPlusB()
+		if err != nil {
+			return nil, err
+		}
+		res = []any{anb}
+		ts.skipWhitespaces()
+		if err := ts.consumeIdentTokenWith("of"); err == nil {
+			ts.skipWhitespaces()
+			list, err := ts.parseRealSelectorList()
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, list)
+		}
+	case "nth-of-type", "nth-last-of-type":
+		// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#the-nth-of-type-pseudo
+		anb, err := ts.parseAnPlusB()
+		if err != nil {
+			return nil, err
+		}
+		res = []any{anb}
+	case "is", "where":
+		// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#matches
+		res = []any{ts.parseForgivingSelectorList()}
+	case "not":
+		// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#negation
+		list, err := ts.parseRealSelectorList()
+		if err != nil {
+			return nil, err
+		}
+		res = []any{list}
+	case "has":
+		// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#relational
+		list, err := ts.parseRelativeSelectorList()
+		if err != nil {
+			return nil, err
 		}
-		if !subStream.isEnd() {
-			return res, fmt.Errorf("%s: unexpected junk after arguments", ts.errorHeader())
+		res = []any{list}
+	default:
+		args := ts.consumeAnyValue()
+		if args == nil {
+			return nil, fmt.Errorf("%s: expected value after '('", ts.errorHeader())
 		}
-		argsNew := []any{}
 		for _, arg := range args {
-			argsNew = append(argsNew, arg)
+			res = append(res, arg)
 		}
-		return selector.PseudoClassSelector{Name: name, Args: argsNew}, nil
+	}
+	ts.skipWhitespaces()
+	if !ts.isEnd() {
+		return nil, fmt.Errorf("%s: unexpected junk after arguments", ts.errorHeader())
+	}
+	return res, nil
+}
+
+// https://www.w3.org/TR/css-syntax-3/#anb-microsyntax
+//
+// NOTE: Number tokens don't remember whether sign was present, so we accept
+// both signed and signless integers where only one of them is allowed.
+func (ts *tokenStream) parseAnPlusB() (res selector.AnPlusB, err error) {
+	oldCursor := ts.cursor
+	defer func() {
+		if err != nil {
+			ts.cursor = oldCursor
+		}
+	}()
+	expectedAnPlusB := func() error {
+		return fmt.Errorf("%s: expected An+B", ts.errorHeader())
+	}
+	consumeInteger := func() (int, error) {
+		tk, err := ts.consumeTokenWith(tokenTypeNumber)
+		if err != nil {
+			return 0, err
+		}
+		if num := tk.(numberToken).value; num.Type == css.NumTypeInt {
+			return int(num.ToInt()), nil
+		}
+		return 0, expectedAnPlusB()
+	}
+
+	// odd, even, <integer> ----------------------------------------------------
+	if err := ts.consumeIdentTokenWith("odd"); err == nil {
+		return selector.AnPlusB{A: 2, B: 1}, nil
+	} else if err := ts.consumeIdentTokenWith("even"); err == nil {
+		return selector.AnPlusB{A: 2, B: 0}, nil
+	} else if b, err := consumeInteger(); err == nil {
+		return selector.AnPlusB{A: 0, B: b}, nil
+	}
+
+	// <A>n+B ------------------------------------------------------------------
+	// A part may come from either <n-dimension>(e.g. 2n), or an ident(e.g. -n, n).
+	// Whatever comes after 'n' goes into afterN (e.g. "-1" for 2n-1).
+	a := 0
+	afterN := ""
+	if tk, err := ts.consumeTokenWith(tokenTypeDimension); err == nil {
+		dim := tk.(dimensionToken)
+		unit := util.ToAsciiLowercase(dim.unit)
+		if dim.value.Type != css.NumTypeInt || !strings.HasPrefix(unit, "n") {
+			return res, expectedAnPlusB()
+		}
+		a = int(dim.value.ToInt())
+		afterN = unit[1:]
 	} else {
+		hasPlus := ts.consumeDelimTokenWith('+') == nil
+		tk, err := ts.consumeTokenWith(tokenTypeIdent)
+		if err != nil {
+			return res, expectedAnPlusB()
+		}
+		ident := util.ToAsciiLowercase(tk.(identToken).value)
+		a = 1
+		if !hasPlus && strings.HasPrefix(ident, "-") {
+			a = -1
+			ident = ident[1:]
+		}
+		if !strings.HasPrefix(ident, "n") {
+			return res, expectedAnPlusB()
+		}
+		afterN = ident[1:]
+	}
+
+	// A<n+B> ------------------------------------------------------------------
+	switch {
+	case afterN == "":
+		// An, An+B, An +B, An + B, An - B
+		cursorAfterN := ts.cursor
+		ts.skipWhitespaces()
+		if b, err := consumeInteger(); err == nil {
+			return selector.AnPlusB{A: a, B: b}, nil
+		}
+		sign := 1
+		if err := ts.consumeDelimTokenWith('+'); err == nil {
+			sign = 1
+		} else if err := ts.consumeDelimTokenWith('-'); err == nil {
+			sign = -1
+		} else {
+			ts.cursor = cursorAfterN
+			return selector.AnPlusB{A: a, B: 0}, nil
+		}
+		ts.skipWhitespaces()
+		b, err := consumeInteger()
+		if err != nil || b < 0 {
+			return res, expectedAnPlusB()
+		}
+		return selector.AnPlusB{A: a, B: sign * b}, nil
+	case afterN == "-":
+		// An- B
+		ts.skipWhitespaces()
+		b, err := consumeInteger()
+		if err != nil || b < 0 {
+			return res, expectedAnPlusB()
+		}
+		return selector.AnPlusB{A: a, B: -b}, nil
+	case strings.HasPrefix(afterN, "-"):
+		// An-B
+		digits := afterN[1:]
+		for _, c := range digits {
+			if c < '0' || '9' < c {
+				return res, expectedAnPlusB()
+			}
+		}
+		b, err := strconv.Atoi(digits)
+		if err != nil {
+			return res, expectedAnPlusB()
+		}
+		return selector.AnPlusB{A: a, B: -b}, nil
+	}
+	return res, expectedAnPlusB()
+}
+
+// splitTokensByComma splits tokens into lists separated by comma tokens.
+func splitTokensByComma(tokens []token) [][]token {
+	res := [][]token{{}}
+	for _, tk := range tokens {
+		if tk.tokenType() == tokenTypeComma {
+			res = append(res, []token{})
+			continue
+		}
+		res[len(res)-1] = append(res[len(res)-1], tk)
+	}
+	return res
+}
+
+// parseSelectorListItems parses each comma-separated item of remaining tokens
+// using the parser. If forgiving is true, invalid items are ignored instead of
+// causing the whole list to fail.
+//
+// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#forgiving-selector
+func parseSelectorListItems[T any](ts *tokenStream, forgiving bool, parser func(ts *tokenStream) (T, error)) ([]T, error) {
+	res := []T{}
+	for _, itemTokens := range splitTokensByComma(ts.tokens[ts.cursor:]) {
+		itemStream := tokenStream{tokens: itemTokens, tokenizerHelper: ts.tokenizerHelper}
+		itemStream.skipWhitespaces()
+		item, err := parser(&itemStream)
+		if err == nil {
+			itemStream.skipWhitespaces()
+			if !itemStream.isEnd() {
+				err = fmt.Errorf("%s: unexpected junk after selector", itemStream.errorHeader())
+			}
+		}
+		if err != nil {
+			if forgiving {
+				continue
+			}
+			return nil, err
+		}
+		res = append(res, item)
+	}
+	ts.cursor = len(ts.tokens)
+	return res, nil
+}
+
+// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#typedef-complex-real-selector-list
+func (ts *tokenStream) parseRealSelectorList() (res []selector.Selector, err error) {
+	return parseSelectorListItems(ts, false, func(ts *tokenStream) (selector.Selector, error) {
+		return ts.parseComplexSelector()
+	})
+}
+
+// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#typedef-forgiving-selector-list
+func (ts *tokenStream) parseForgivingSelectorList() []selector.Selector {
+	res, _ := parseSelectorListItems(ts, true, func(ts *tokenStream) (selector.Selector, error) {
+		return ts.parseComplexSelector()
+	})
+	return res
+}
+
+// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#typedef-relative-selector-list
+func (ts *tokenStream) parseRelativeSelectorList() (res []selector.RelativeSelector, err error) {
+	return parseSelectorListItems(ts, false, func(ts *tokenStream) (selector.RelativeSelector, error) {
+		return ts.parseRelativeSelector()
+	})
+}
+
+// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#typedef-relative-selector
+func (ts *tokenStream) parseRelativeSelector() (res selector.RelativeSelector, err error) {
+	oldCursor := ts.cursor
+	comb := selector.ChildCombinator
+	if err := ts.consumeDelimTokenWith('>'); err == nil {
+		comb = selector.DirectChildCombinator
+	} else if err := ts.consumeDelimTokenWith('+'); err == nil {
+		comb = selector.PlusCombinator
+	} else if err := ts.consumeDelimTokenWith('~'); err == nil {
+		comb = selector.TildeCombinator
+	} else if err := ts.consumeDelimTokenWith('|'); err == nil {
+		if err := ts.consumeDelimTokenWith('|'); err != nil {
+			ts.cursor = oldCursor
+			return res, err
+		}
+		comb = selector.TwoBarsCombinator
+	}
+	ts.skipWhitespaces()
+	sel, err := ts.parseComplexSelector()
+	if err != nil {
 		ts.cursor = oldCursor
 		return res, err
 	}
+	return selector.RelativeSelector{Combinator: comb, Selector: sel}, nil
 }
 
 // https://www.w3.org/TR/2022/WD-selectors-4-20221111/#typedef-pseudo-element-selector
-func (ts *tokenStream) parsePseudoElementSelector() (res selector.PseudoClassSelector, err error) {
+func (ts *tokenStream) parsePseudoElementSelector() (res selector.PseudoElementSelector, err error) {
 	oldCursor := ts.cursor
 	if _, err := ts.consumeTokenWith(tokenTypeColon); err != nil {
 		ts.cursor = oldCursor
@@ -206,9 +468,30 @@ func (ts *tokenStream) parsePseudoElementSelector() (res selector.PseudoClassSel
 	}
 	temp, err := ts.parsePseudoClassSelector()
 	if err != nil {
-		return res, err
+		// Legacy pseudo-elements can also be written with a single colon (e.g. :before).
+		// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#pseudo-element-syntax
+		ts.cursor = oldCursor
+		temp, err = ts.parsePseudoClassSelector()
+		if err != nil {
+			ts.cursor = oldCursor
+			return res, err
+		}
+		if !isLegacyPseudoElementName(temp.Name) {
+			ts.cursor = oldCursor
+			return res, fmt.Errorf("%s: expected a pseudo-element selector", ts.errorHeader())
+		}
 	}
-	return temp, nil
+	return selector.PseudoElementSelector{Name: temp.Name, Args: temp.Args}, nil
+}
+
+// isLegacyPseudoElementName reports whether name is one of pseudo-elements
+// introduced in CSS Level 1 and 2, which also accept the single colon syntax.
+func isLegacyPseudoElementName(name string) bool {
+	switch name {
+	case "before", "after", "first-line", "first-letter":
+		return true
+	}
+	return false
 }
 
 // https://www.w3.org/TR/2022/WD-selectors-4-20221111/#typedef-subclass-selector
@@ -227,8 +510,13 @@ func (ts *tokenStream) parseSubclassSelector() (res selector.Selector, err error
 		return sel, nil
 	}
 
+	oldCursor := ts.cursor
 	if sel, err := ts.parsePseudoClassSelector(); err == nil {
-		return sel, nil
+		if !isLegacyPseudoElementName(sel.Name) {
+			return sel, nil
+		}
+		// This is a pseudo-element using the legacy syntax (e.g. :before).
+		ts.cursor = oldCursor
 	}
 
 	return nil, fmt.Errorf("%s: expected a subclass selector", ts.errorHeader())
@@ -305,6 +593,9 @@ func (ts *tokenStream) parseComplexSelector() (res selector.ComplexSelector, err
 	}
 	rest := []selector.ComplexSelectorRest{}
 	for {
+		restCursor := ts.cursor
+		ts.skipWhitespaces()
+		hasWhitespace := restCursor != ts.cursor
 		comb := selector.ChildCombinator
 		if err := ts.consumeDelimTokenWith('>'); err == nil {
 			comb = selector.DirectChildCombinator
@@ -316,14 +607,17 @@ func (ts *tokenStream) parseComplexSelector() (res selector.ComplexSelector, err
 			if err := ts.consumeDelimTokenWith('|'); err == nil {
 				comb = selector.TwoBarsCombinator
 			} else {
-				ts.cursor -= 2
+				ts.cursor = restCursor
+				break
 			}
-		} else if _, err := ts.consumeTokenWith(tokenTypeWhitespace); err != nil {
-			ts.skipWhitespaces()
-			comb = selector.ChildCombinator
+		} else if !hasWhitespace {
+			// Compound selectors without combinator or whitespace between them
+			break
 		}
+		ts.skipWhitespaces()
 		anotherUnit, err := ts.parseCompoundSelector()
 		if err != nil {
+			ts.cursor = restCursor
 			break
 		}
 		rest = append(rest, selector.ComplexSelectorRest{Combinator: comb, Selector: anotherUnit})
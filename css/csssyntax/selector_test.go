@@ -7,7 +7,6 @@ package csssyntax
 import (
 	"testing"
 
-	"github.com/inseo-oh/yw/css"
 	"github.com/inseo-oh/yw/css/selector"
 )
 
@@ -75,9 +74,50 @@ func TestCssPseudoClassSelector(t *testing.T) {
 		expected selector.PseudoClassSelector
 	}{
 		{":link", selector.PseudoClassSelector{Name: "link"}},
-		{":nth-child(1)", selector.PseudoClassSelector{Name: "nth-child", Args: []any{
-			numberToken{tokenCommon{10, 11}, css.NumFromInt(1)},
+		{":LINK", selector.PseudoClassSelector{Name: "link"}},
+		{":nth-child(1)", selector.PseudoClassSelector{Name: "nth-child", Args: []any{selector.AnPlusB{A: 0, B: 1}}}},
+		{":nth-child(-3)", selector.PseudoClassSelector{Name: "nth-child", Args: []any{selector.AnPlusB{A: 0, B: -3}}}},
+		{":nth-child(odd)", selector.PseudoClassSelector{Name: "nth-child", Args: []any{selector.AnPlusB{A: 2, B: 1}}}},
+		{":nth-child(even)", selector.PseudoClassSelector{Name: "nth-child", Args: []any{selector.AnPlusB{A: 2, B: 0}}}},
+		{":nth-child(n)", selector.PseudoClassSelector{Name: "nth-child", Args: []any{selector.AnPlusB{A: 1, B: 0}}}},
+		{":nth-child(+n)", selector.PseudoClassSelector{Name: "nth-child", Args: []any{selector.AnPlusB{A: 1, B: 0}}}},
+		{":nth-child(-n+3)", selector.PseudoClassSelector{Name: "nth-child", Args: []any{selector.AnPlusB{A: -1, B: 3}}}},
+		{":nth-child(2n)", selector.PseudoClassSelector{Name: "nth-child", Args: []any{selector.AnPlusB{A: 2, B: 0}}}},
+		{":nth-child(2n+1)", selector.PseudoClassSelector{Name: "nth-child", Args: []any{selector.AnPlusB{A: 2, B: 1}}}},
+		{":nth-child(2n-1)", selector.PseudoClassSelector{Name: "nth-child", Args: []any{selector.AnPlusB{A: 2, B: -1}}}},
+		{":nth-child( 3n + 2 )", selector.PseudoClassSelector{Name: "nth-child", Args: []any{selector.AnPlusB{A: 3, B: 2}}}},
+		{":nth-child(3n - 2)", selector.PseudoClassSelector{Name: "nth-child", Args: []any{selector.AnPlusB{A: 3, B: -2}}}},
+		{":nth-child(3n- 2)", selector.PseudoClassSelector{Name: "nth-child", Args: []any{selector.AnPlusB{A: 3, B: -2}}}},
+		{":nth-child(-n-2)", selector.PseudoClassSelector{Name: "nth-child", Args: []any{selector.AnPlusB{A: -1, B: -2}}}},
+		{":nth-child(2n+1 of .foo)", selector.PseudoClassSelector{Name: "nth-child", Args: []any{
+			selector.AnPlusB{A: 2, B: 1},
+			[]selector.Selector{selector.ComplexSelector{Base: selector.CompoundSelector{SubclassSelector: []selector.Selector{selector.ClassSelector{Class: "foo"}}}}},
 		}}},
+		{":nth-last-of-type(2)", selector.PseudoClassSelector{Name: "nth-last-of-type", Args: []any{selector.AnPlusB{A: 0, B: 2}}}},
+		{":is(.a, #b)", selector.PseudoClassSelector{Name: "is", Args: []any{[]selector.Selector{
+			selector.ComplexSelector{Base: selector.CompoundSelector{SubclassSelector: []selector.Selector{selector.ClassSelector{Class: "a"}}}},
+			selector.ComplexSelector{Base: selector.CompoundSelector{SubclassSelector: []selector.Selector{selector.IdSelector{Id: "b"}}}},
+		}}}},
+		{":where(.a, !!!, #b)", selector.PseudoClassSelector{Name: "where", Args: []any{[]selector.Selector{
+			selector.ComplexSelector{Base: selector.CompoundSelector{SubclassSelector: []selector.Selector{selector.ClassSelector{Class: "a"}}}},
+			selector.ComplexSelector{Base: selector.CompoundSelector{SubclassSelector: []selector.Selector{selector.IdSelector{Id: "b"}}}},
+		}}}},
+		{":not(div p)", selector.PseudoClassSelector{Name: "not", Args: []any{[]selector.Selector{
+			selector.ComplexSelector{
+				Base: selector.CompoundSelector{TypeSelector: selector.TypeSelector{TypeName: selector.WqName{Ident: "div"}}},
+				Rest: []selector.ComplexSelectorRest{
+					{Combinator: selector.ChildCombinator, Selector: selector.CompoundSelector{TypeSelector: selector.TypeSelector{TypeName: selector.WqName{Ident: "p"}}}},
+				},
+			},
+		}}}},
+		{":has(> img, + p)", selector.PseudoClassSelector{Name: "has", Args: []any{[]selector.RelativeSelector{
+			{Combinator: selector.DirectChildCombinator, Selector: selector.ComplexSelector{
+				Base: selector.CompoundSelector{TypeSelector: selector.TypeSelector{TypeName: selector.WqName{Ident: "img"}}},
+			}},
+			{Combinator: selector.PlusCombinator, Selector: selector.ComplexSelector{
+				Base: selector.CompoundSelector{TypeSelector: selector.TypeSelector{TypeName: selector.WqName{Ident: "p"}}},
+			}},
+		}}}},
 	}
 	for _, cs := range cases {
 		selectorTestHelper(t, cs.css, cs.expected, func(ts *tokenStream) (selector.Selector, error) {
@@ -88,12 +128,15 @@ func TestCssPseudoClassSelector(t *testing.T) {
 func TestCssPseudoElementSelector(t *testing.T) {
 	cases := []struct {
 		css      string
-		expected selector.PseudoClassSelector
+		expected selector.PseudoElementSelector
 	}{
-		{"::before", selector.PseudoClassSelector{Name: "before"}},
-		{"::after", selector.PseudoClassSelector{Name: "after"}},
-		{"::first-line", selector.PseudoClassSelector{Name: "first-line"}},
-		{"::first-letter", selector.PseudoClassSelector{Name: "first-letter"}},
+		{"::before", selector.PseudoElementSelector{Name: "before"}},
+		{"::after", selector.PseudoElementSelector{Name: "after"}},
+		{"::first-line", selector.PseudoElementSelector{Name: "first-line"}},
+		{"::first-letter", selector.PseudoElementSelector{Name: "first-letter"}},
+		{"::BEFORE", selector.PseudoElementSelector{Name: "before"}},
+		{":before", selector.PseudoElementSelector{Name: "before"}},
+		{":after", selector.PseudoElementSelector{Name: "after"}},
 	}
 	for _, cs := range cases {
 		selectorTestHelper(t, cs.css, cs.expected, func(ts *tokenStream) (selector.Selector, error) {
@@ -142,7 +185,17 @@ func TestCssCompoundSelector(t *testing.T) {
 
 			PseudoItems: []selector.CompundSelectorPseudoItem{
 				{
-					ElementSelector: selector.PseudoClassSelector{Name: "before"},
+					ElementSelector: selector.PseudoElementSelector{Name: "before"},
+					ClassSelector:   []selector.PseudoClassSelector{},
+				},
+			},
+		}},
+		{"type.class:after", selector.CompoundSelector{
+			TypeSelector:     selector.TypeSelector{TypeName: selector.WqName{Ident: "type"}},
+			SubclassSelector: []selector.Selector{selector.ClassSelector{Class: "class"}},
+			PseudoItems: []selector.CompundSelectorPseudoItem{
+				{
+					ElementSelector: selector.PseudoElementSelector{Name: "after"},
 					ClassSelector:   []selector.PseudoClassSelector{},
 				},
 			},
@@ -180,3 +233,49 @@ func TestCssComplexSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestCssSelectorSpecificity(t *testing.T) {
+	cases := []struct {
+		css      string
+		expected selector.Specificity
+	}{
+		{"*", selector.Specificity{A: 0, B: 0, C: 0}},
+		{"li", selector.Specificity{A: 0, B: 0, C: 1}},
+		{"ul li", selector.Specificity{A: 0, B: 0, C: 2}},
+		{"ul>li+li", selector.Specificity{A: 0, B: 0, C: 3}},
+		{".foo", selector.Specificity{A: 0, B: 1, C: 0}},
+		{"[attr=value]", selector.Specificity{A: 0, B: 1, C: 0}},
+		{":link", selector.Specificity{A: 0, B: 1, C: 0}},
+		{"#id", selector.Specificity{A: 1, B: 0, C: 0}},
+		{"*.foo", selector.Specificity{A: 0, B: 1, C: 0}},
+		{"li.red.level", selector.Specificity{A: 0, B: 2, C: 1}},
+		{"ul ol+li.red", selector.Specificity{A: 0, B: 1, C: 3}},
+		{"h1+*[rel=up]", selector.Specificity{A: 0, B: 1, C: 1}},
+		{"#x34y", selector.Specificity{A: 1, B: 0, C: 0}},
+		{"div#id.class[attr]:hover", selector.Specificity{A: 1, B: 3, C: 1}},
+		{"p::before", selector.Specificity{A: 0, B: 0, C: 2}},
+		{"p.a:before", selector.Specificity{A: 0, B: 1, C: 2}},
+		{"#a #b .c d", selector.Specificity{A: 2, B: 1, C: 1}},
+		{":is(em, #foo)", selector.Specificity{A: 1, B: 0, C: 0}},
+		{":where(em, #foo)", selector.Specificity{A: 0, B: 0, C: 0}},
+		{".qux:where(em, #foo#bar#baz)", selector.Specificity{A: 0, B: 1, C: 0}},
+		{":not(em, strong#foo)", selector.Specificity{A: 1, B: 0, C: 1}},
+		{":has(> img, .a)", selector.Specificity{A: 0, B: 1, C: 0}},
+		{":nth-child(even of li.important)", selector.Specificity{A: 0, B: 2, C: 1}},
+		{":nth-of-type(2n)", selector.Specificity{A: 0, B: 1, C: 0}},
+	}
+	for _, cs := range cases {
+		t.Run(cs.css, func(t *testing.T) {
+			sels, err := parseSelector(cs.css, "<test>")
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if len(sels) != 1 {
+				t.Fatalf("expected 1 selector, got %d", len(sels))
+			}
+			if got := sels[0].Specificity(); got != cs.expected {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+}
@@ -19,7 +19,7 @@ func (ts *tokenStream) parseSizeValueImpl(acceptAuto, acceptNone bool) (res sizi
 	}
 	if acceptNone {
 		if err := ts.consumeIdentTokenWith("none"); err == nil {
-			return sizing.Size{Type: sizing.Auto}, nil
+			return sizing.Size{Type: sizing.NoneSize}, nil
 		}
 	}
 	if err := ts.consumeIdentTokenWith("min-content"); err == nil {
@@ -50,3 +50,13 @@ func (ts *tokenStream) parseSizeOrAuto() (res sizing.Size, err error) {
 func (ts *tokenStream) parseSizeOrNone() (res sizing.Size, err error) {
 	return ts.parseSizeValueImpl(false, true)
 }
+
+// https://www.w3.org/TR/2021/WD-css-sizing-3-20211217/#box-sizing
+func (ts *tokenStream) parseBoxSizing() (res sizing.BoxSizing, err error) {
+	if err := ts.consumeIdentTokenWith("content-box"); err == nil {
+		return sizing.ContentBox, nil
+	} else if err := ts.consumeIdentTokenWith("border-box"); err == nil {
+		return sizing.BorderBox, nil
+	}
+	return res, fmt.Errorf("%s: invalid box-sizing value", ts.errorHeader())
+}
@@ -0,0 +1,60 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"fmt"
+
+	"github.com/inseo-oh/yw/css/tables"
+)
+
+// https://www.w3.org/TR/css-tables-3/#table-layout-property
+func (ts *tokenStream) parseTableLayout() (res tables.Layout, err error) {
+	if err := ts.consumeIdentTokenWith("auto"); err == nil {
+		return tables.AutoLayout, nil
+	} else if err := ts.consumeIdentTokenWith("fixed"); err == nil {
+		return tables.FixedLayout, nil
+	}
+	return res, fmt.Errorf("%s: invalid table-layout value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-tables-3/#border-collapse-property
+func (ts *tokenStream) parseBorderCollapse() (res tables.BorderCollapse, err error) {
+	if err := ts.consumeIdentTokenWith("separate"); err == nil {
+		return tables.Separate, nil
+	} else if err := ts.consumeIdentTokenWith("collapse"); err == nil {
+		return tables.Collapse, nil
+	}
+	return res, fmt.Errorf("%s: invalid border-collapse value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-tables-3/#border-spacing-property
+func (ts *tokenStream) parseBorderSpacing() (res tables.BorderSpacing, err error) {
+	oldCursor := ts.cursor
+	horizontal, err := ts.parseLength(true)
+	if err != nil {
+		return res, err
+	}
+	vertical := horizontal
+	ts.skipWhitespaces()
+	if v, err := ts.parseLength(true); err == nil {
+		vertical = v
+	}
+	if horizontal.Value < 0 || vertical.Value < 0 {
+		ts.cursor = oldCursor
+		return res, fmt.Errorf("%s: negative values are not accepted by border-spacing", ts.errorHeader())
+	}
+	return tables.BorderSpacing{Horizontal: horizontal, Vertical: vertical}, nil
+}
+
+// https://www.w3.org/TR/css-tables-3/#caption-side-property
+func (ts *tokenStream) parseCaptionSide() (res tables.CaptionSide, err error) {
+	if err := ts.consumeIdentTokenWith("top"); err == nil {
+		return tables.CaptionTop, nil
+	} else if err := ts.consumeIdentTokenWith("bottom"); err == nil {
+		return tables.CaptionBottom, nil
+	}
+	return res, fmt.Errorf("%s: invalid caption-side value", ts.errorHeader())
+}
@@ -0,0 +1,59 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/css/tables"
+	"github.com/inseo-oh/yw/css/values"
+)
+
+func TestCssTableProperties(t *testing.T) {
+	cases := []struct {
+		name     string
+		css      string
+		expected props.PropertyValue
+	}{
+		{"table-layout", "fixed", tables.FixedLayout},
+		{"border-collapse", "collapse", tables.Collapse},
+		{"border-spacing", "2px", tables.BorderSpacing{Horizontal: values.LengthFromPx(2), Vertical: values.LengthFromPx(2)}},
+		{"border-spacing", "0 1em", tables.BorderSpacing{Horizontal: values.LengthFromPx(0), Vertical: values.Length{Value: 1, Unit: values.Em}}},
+		{"caption-side", "bottom", tables.CaptionBottom},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			got, err := parse(&ts, parseFuncMap[cs.name])
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, cs.expected) {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+	invalidCases := []struct{ name, css string }{
+		{"border-spacing", "-1px"},
+		{"border-spacing", "10%"},
+		{"table-layout", "none"},
+	}
+	for _, cs := range invalidCases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			if got, err := parse(&ts, parseFuncMap[cs.name]); err == nil {
+				t.Errorf("expected error, got %v", got)
+			}
+		})
+	}
+}
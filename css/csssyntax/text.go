@@ -65,3 +65,144 @@ func (ts *tokenStream) parseTextTransform() (res text.Transform, err error) {
 	}
 	return res, nil
 }
+
+// https://www.w3.org/TR/css-text-3/#word-break-property
+func (ts *tokenStream) parseWordBreak() (res text.WordBreak, err error) {
+	if err := ts.consumeIdentTokenWith("normal"); err == nil {
+		return text.NormalWordBreak, nil
+	} else if err := ts.consumeIdentTokenWith("keep-all"); err == nil {
+		return text.KeepAll, nil
+	} else if err := ts.consumeIdentTokenWith("break-all"); err == nil {
+		return text.BreakAll, nil
+	} else if err := ts.consumeIdentTokenWith("break-word"); err == nil {
+		return text.BreakWord, nil
+	}
+	return res, fmt.Errorf("%s: invalid word-break value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-text-3/#overflow-wrap-property
+func (ts *tokenStream) parseOverflowWrap() (res text.OverflowWrap, err error) {
+	if err := ts.consumeIdentTokenWith("normal"); err == nil {
+		return text.NormalOverflowWrap, nil
+	} else if err := ts.consumeIdentTokenWith("anywhere"); err == nil {
+		return text.AnywhereOverflowWrap, nil
+	} else if err := ts.consumeIdentTokenWith("break-word"); err == nil {
+		return text.BreakWordOverflowWrap, nil
+	}
+	return res, fmt.Errorf("%s: invalid overflow-wrap value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-text-3/#line-break-property
+func (ts *tokenStream) parseLineBreak() (res text.LineBreak, err error) {
+	if err := ts.consumeIdentTokenWith("auto"); err == nil {
+		return text.AutoLineBreak, nil
+	} else if err := ts.consumeIdentTokenWith("loose"); err == nil {
+		return text.LooseLineBreak, nil
+	} else if err := ts.consumeIdentTokenWith("normal"); err == nil {
+		return text.NormalLineBreak, nil
+	} else if err := ts.consumeIdentTokenWith("strict"); err == nil {
+		return text.StrictLineBreak, nil
+	} else if err := ts.consumeIdentTokenWith("anywhere"); err == nil {
+		return text.AnywhereLineBreak, nil
+	}
+	return res, fmt.Errorf("%s: invalid line-break value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-text-3/#text-align-property
+func (ts *tokenStream) parseTextAlign() (res text.Align, err error) {
+	if err := ts.consumeIdentTokenWith("start"); err == nil {
+		return text.StartAlign, nil
+	} else if err := ts.consumeIdentTokenWith("end"); err == nil {
+		return text.EndAlign, nil
+	} else if err := ts.consumeIdentTokenWith("left"); err == nil {
+		return text.LeftAlign, nil
+	} else if err := ts.consumeIdentTokenWith("right"); err == nil {
+		return text.RightAlign, nil
+	} else if err := ts.consumeIdentTokenWith("center"); err == nil {
+		return text.CenterAlign, nil
+	} else if err := ts.consumeIdentTokenWith("justify"); err == nil {
+		return text.JustifyAlign, nil
+	} else if err := ts.consumeIdentTokenWith("match-parent"); err == nil {
+		return text.MatchParent, nil
+	} else if err := ts.consumeIdentTokenWith("justify-all"); err == nil {
+		return text.JustifyAll, nil
+	}
+	return res, fmt.Errorf("%s: invalid text-align value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-text-3/#text-indent-property
+func (ts *tokenStream) parseTextIndent() (res text.Indent, err error) {
+	gotValue := false
+	for {
+		ts.skipWhitespaces()
+		if !gotValue {
+			if v, err := ts.parseLengthOrPercentage(true); err == nil {
+				res.Value = v
+				gotValue = true
+				continue
+			}
+		}
+		if !res.Hanging {
+			if err := ts.consumeIdentTokenWith("hanging"); err == nil {
+				res.Hanging = true
+				continue
+			}
+		}
+		if !res.EachLine {
+			if err := ts.consumeIdentTokenWith("each-line"); err == nil {
+				res.EachLine = true
+				continue
+			}
+		}
+		break
+	}
+	if !gotValue {
+		return res, fmt.Errorf("%s: invalid text-indent value", ts.errorHeader())
+	}
+	return res, nil
+}
+
+// https://www.w3.org/TR/css-text-4/#white-space-collapsing
+func (ts *tokenStream) parseWhiteSpaceCollapse() (res text.WhiteSpaceCollapse, err error) {
+	if err := ts.consumeIdentTokenWith("collapse"); err == nil {
+		return text.CollapseWhiteSpace, nil
+	} else if err := ts.consumeIdentTokenWith("discard"); err == nil {
+		return text.DiscardWhiteSpace, nil
+	} else if err := ts.consumeIdentTokenWith("preserve"); err == nil {
+		return text.PreserveWhiteSpace, nil
+	} else if err := ts.consumeIdentTokenWith("preserve-breaks"); err == nil {
+		return text.PreserveBreaks, nil
+	} else if err := ts.consumeIdentTokenWith("preserve-spaces"); err == nil {
+		return text.PreserveSpaces, nil
+	} else if err := ts.consumeIdentTokenWith("break-spaces"); err == nil {
+		return text.BreakSpaces, nil
+	}
+	return res, fmt.Errorf("%s: invalid white-space-collapse value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-text-4/#text-wrap-mode
+func (ts *tokenStream) parseTextWrapMode() (res text.WrapMode, err error) {
+	if err := ts.consumeIdentTokenWith("wrap"); err == nil {
+		return text.Wrap, nil
+	} else if err := ts.consumeIdentTokenWith("nowrap"); err == nil {
+		return text.NoWrap, nil
+	}
+	return res, fmt.Errorf("%s: invalid text-wrap-mode value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-text-3/#tab-size-property
+func (ts *tokenStream) parseTabSize() (res text.TabSize, err error) {
+	if num := ts.parseNumber(); num != nil {
+		if num.ToFloat() < 0 {
+			return res, fmt.Errorf("%s: negative values are not accepted by tab-size", ts.errorHeader())
+		}
+		return text.TabSize{Type: text.NumberTabSize, Number: *num}, nil
+	}
+	if l, err := ts.parseLength(false); err == nil {
+		if l.Value < 0 {
+			return res, fmt.Errorf("%s: negative values are not accepted by tab-size", ts.errorHeader())
+		}
+		return text.TabSize{Type: text.LengthTabSize, Length: l}, nil
+	}
+	return res, fmt.Errorf("%s: invalid tab-size value", ts.errorHeader())
+}
@@ -0,0 +1,94 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/css/text"
+	"github.com/inseo-oh/yw/css/values"
+)
+
+func TestCssTextProperties(t *testing.T) {
+	cases := []struct {
+		name     string
+		css      string
+		expected props.PropertyValue
+	}{
+		{"word-break", "normal", text.NormalWordBreak},
+		{"word-break", "keep-all", text.KeepAll},
+		{"word-break", "break-all", text.BreakAll},
+		{"word-break", "break-word", text.BreakWord},
+		{"overflow-wrap", "normal", text.NormalOverflowWrap},
+		{"overflow-wrap", "anywhere", text.AnywhereOverflowWrap},
+		{"overflow-wrap", "break-word", text.BreakWordOverflowWrap},
+		{"line-break", "auto", text.AutoLineBreak},
+		{"line-break", "loose", text.LooseLineBreak},
+		{"line-break", "normal", text.NormalLineBreak},
+		{"line-break", "strict", text.StrictLineBreak},
+		{"line-break", "anywhere", text.AnywhereLineBreak},
+		{"text-align", "start", text.StartAlign},
+		{"text-align", "right", text.RightAlign},
+		{"text-align", "justify", text.JustifyAlign},
+		{"text-align", "match-parent", text.MatchParent},
+		{"text-align", "justify-all", text.JustifyAll},
+		{"text-indent", "20px", text.Indent{Value: values.LengthFromPx(20)}},
+		{"text-indent", "-5%", text.Indent{Value: values.Percentage{Value: -5}}},
+		{"text-indent", "hanging 1em", text.Indent{Value: values.Length{Value: 1, Unit: values.Em}, Hanging: true}},
+		{"text-indent", "0 each-line hanging", text.Indent{Value: values.LengthFromPx(0), Hanging: true, EachLine: true}},
+		{"white-space-collapse", "preserve-breaks", text.PreserveBreaks},
+		{"white-space-collapse", "break-spaces", text.BreakSpaces},
+		{"text-wrap-mode", "nowrap", text.NoWrap},
+		{"white-space", "normal", props.WhiteSpaceShorthand{WhiteSpaceCollapse: text.CollapseWhiteSpace, TextWrapMode: text.Wrap}},
+		{"white-space", "pre", props.WhiteSpaceShorthand{WhiteSpaceCollapse: text.PreserveWhiteSpace, TextWrapMode: text.NoWrap}},
+		{"white-space", "pre-wrap", props.WhiteSpaceShorthand{WhiteSpaceCollapse: text.PreserveWhiteSpace, TextWrapMode: text.Wrap}},
+		{"white-space", "pre-line", props.WhiteSpaceShorthand{WhiteSpaceCollapse: text.PreserveBreaks, TextWrapMode: text.Wrap}},
+		{"white-space", "nowrap", props.WhiteSpaceShorthand{WhiteSpaceCollapse: text.CollapseWhiteSpace, TextWrapMode: text.NoWrap}},
+		{"white-space", "break-spaces wrap", props.WhiteSpaceShorthand{WhiteSpaceCollapse: text.BreakSpaces, TextWrapMode: text.Wrap}},
+		{"tab-size", "4", text.TabSize{Type: text.NumberTabSize, Number: css.NumFromInt(4)}},
+		{"tab-size", "2em", text.TabSize{Type: text.LengthTabSize, Length: values.Length{Value: 2, Unit: values.Em}}},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			got, err := parse(&ts, parseFuncMap[cs.name])
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, cs.expected) {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+	invalidCases := []struct{ name, css string }{
+		{"word-break", "auto"},
+		{"overflow-wrap", "break-all"},
+		{"line-break", "keep-all"},
+		{"text-align", "middle"},
+		{"text-indent", "hanging"},
+		{"text-indent", "auto"},
+		{"white-space-collapse", "pre"},
+		{"text-wrap-mode", "normal"},
+		{"tab-size", "-1"},
+		{"tab-size", "10%"},
+	}
+	for _, cs := range invalidCases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			if got, err := parse(&ts, parseFuncMap[cs.name]); err == nil {
+				t.Errorf("expected error, got %v", got)
+			}
+		})
+	}
+}
@@ -6,9 +6,11 @@ package csssyntax
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/inseo-oh/yw/css"
 	"github.com/inseo-oh/yw/css/values"
+	"github.com/inseo-oh/yw/util"
 )
 
 // Returns nil if not found
@@ -96,3 +98,157 @@ func (ts *tokenStream) parseLengthOrPercentage(allowZeroShorthand bool) (res val
 	}
 	return nil, fmt.Errorf("%s: expected length or percentage", ts.errorHeader())
 }
+
+// Returns angle in degrees.
+//
+// allowZeroShorthand allows unitless 0, which some properties(such as
+// linear-gradient()) accept for legacy reasons.
+//
+// https://www.w3.org/TR/css-values-4/#angles
+func (ts *tokenStream) parseAngle(allowZeroShorthand bool) (res float64, err error) {
+	oldCursor := ts.cursor
+	dimTk, err := ts.consumeTokenWith(tokenTypeDimension)
+	if err != nil {
+		if allowZeroShorthand {
+			numTk, err := ts.consumeTokenWith(tokenTypeNumber)
+			if err == nil && numTk.(numberToken).value.Equals(css.NumFromInt(0)) {
+				return 0, nil
+			}
+			ts.cursor = oldCursor
+		}
+		return res, fmt.Errorf("%s: expected angle", ts.errorHeader())
+	}
+	dim := dimTk.(dimensionToken)
+	switch util.ToAsciiLowercase(dim.unit) {
+	case "deg":
+		return dim.value.ToFloat(), nil
+	case "grad":
+		return dim.value.ToFloat() * 360 / 400, nil
+	case "rad":
+		return dim.value.ToFloat() * 180 / math.Pi, nil
+	case "turn":
+		return dim.value.ToFloat() * 360, nil
+	}
+	ts.cursor = oldCursor
+	return res, fmt.Errorf("%s: expected angle", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-values-4/#typedef-position
+func (ts *tokenStream) parsePositionValue() (res values.Position, err error) {
+	oldCursor := ts.cursor
+	items := []positionItem{}
+	cursors := []int{} // Cursor after each item
+	for len(items) < 4 {
+		itemCursor := ts.cursor
+		if len(items) != 0 {
+			ts.skipWhitespaces()
+		}
+		if l, err := ts.parseLengthOrPercentage(true); err == nil {
+			items = append(items, positionItem{length: l})
+		} else if tk, err := ts.consumeTokenWith(tokenTypeIdent); err == nil && positionKeywordAxis(tk.(identToken).value) != 0 {
+			items = append(items, positionItem{keyword: tk.(identToken).value})
+		} else {
+			ts.cursor = itemCursor
+			break
+		}
+		cursors = append(cursors, ts.cursor)
+	}
+	// Shorter ones may still be valid, in which case rest of items belong
+	// to whatever comes next.
+	for n := len(items); 0 < n; n-- {
+		if res, ok := positionFromItems(items[:n]); ok {
+			ts.cursor = cursors[n-1]
+			return res, nil
+		}
+	}
+	ts.cursor = oldCursor
+	return res, fmt.Errorf("%s: expected position", ts.errorHeader())
+}
+
+// positionItem is either a keyword or <length-percentage> in <position>.
+type positionItem struct {
+	keyword string                  // Empty if it's a length
+	length  values.LengthResolvable // Length if it's not a keyword
+}
+
+// positionKeywordAxis returns 'x' for horizontal keywords, 'y' for vertical
+// keywords, 'c' for center, and 0 if it's not a <position> keyword.
+func positionKeywordAxis(kw string) byte {
+	switch kw {
+	case "left", "right":
+		return 'x'
+	case "top", "bottom":
+		return 'y'
+	case "center":
+		return 'c'
+	}
+	return 0
+}
+
+// positionFromItems interprets items as <position>, and returns false if it's
+// not valid.
+func positionFromItems(items []positionItem) (res values.Position, ok bool) {
+	// Offset of the edge given by keyword, or the length
+	offsetOf := func(item positionItem) values.PositionOffset {
+		switch item.keyword {
+		case "":
+			return values.PositionOffset{Offset: item.length}
+		case "left", "top":
+			return values.PositionOffset{Offset: values.Percentage{Value: 0}}
+		case "right", "bottom":
+			return values.PositionOffset{Offset: values.Percentage{Value: 100}}
+		}
+		return values.PositionOffset{Offset: values.Percentage{Value: 50}}
+	}
+	switch len(items) {
+	case 1:
+		if positionKeywordAxis(items[0].keyword) == 'y' {
+			return values.Position{X: values.CenterPosition.X, Y: offsetOf(items[0])}, true
+		}
+		return values.Position{X: offsetOf(items[0]), Y: values.CenterPosition.Y}, true
+	case 2:
+		a, b := items[0], items[1]
+		aAxis, bAxis := positionKeywordAxis(a.keyword), positionKeywordAxis(b.keyword)
+		if a.keyword != "" && b.keyword != "" {
+			// Two keywords can be in any order.
+			if aAxis == bAxis && aAxis != 'c' {
+				return res, false
+			}
+			if aAxis == 'y' || bAxis == 'x' {
+				a, b = b, a
+			}
+		} else if aAxis == 'y' || bAxis == 'x' {
+			return res, false
+		}
+		return values.Position{X: offsetOf(a), Y: offsetOf(b)}, true
+	}
+	// 3 or 4 values: [ center | [ left | right ] <length-percentage>? ] && [ center | [ top | bottom ] <length-percentage>? ]
+	type group struct {
+		axis   byte
+		offset values.PositionOffset
+	}
+	groups := []group{}
+	for i := 0; i < len(items); i++ {
+		item := items[i]
+		axis := positionKeywordAxis(item.keyword)
+		if axis == 0 {
+			return res, false
+		}
+		g := group{axis: axis, offset: offsetOf(item)}
+		if i+1 < len(items) && items[i+1].keyword == "" {
+			if axis == 'c' {
+				return res, false
+			}
+			i++
+			g.offset = values.PositionOffset{Offset: items[i].length, FromEnd: item.keyword == "right" || item.keyword == "bottom"}
+		}
+		groups = append(groups, g)
+	}
+	if len(groups) != 2 || (groups[0].axis == groups[1].axis && groups[0].axis != 'c') {
+		return res, false
+	}
+	if groups[0].axis == 'y' || groups[1].axis == 'x' {
+		groups[0], groups[1] = groups[1], groups[0]
+	}
+	return values.Position{X: groups[0].offset, Y: groups[1].offset}, true
+}
@@ -0,0 +1,63 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"fmt"
+
+	"github.com/inseo-oh/yw/css/writingmodes"
+)
+
+// https://www.w3.org/TR/css-writing-modes-3/#direction
+func (ts *tokenStream) parseDirection() (res writingmodes.Direction, err error) {
+	if err := ts.consumeIdentTokenWith("ltr"); err == nil {
+		return writingmodes.Ltr, nil
+	} else if err := ts.consumeIdentTokenWith("rtl"); err == nil {
+		return writingmodes.Rtl, nil
+	}
+	return res, fmt.Errorf("%s: invalid direction value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-writing-modes-3/#unicode-bidi
+func (ts *tokenStream) parseUnicodeBidi() (res writingmodes.UnicodeBidi, err error) {
+	if err := ts.consumeIdentTokenWith("normal"); err == nil {
+		return writingmodes.NormalUnicodeBidi, nil
+	} else if err := ts.consumeIdentTokenWith("embed"); err == nil {
+		return writingmodes.Embed, nil
+	} else if err := ts.consumeIdentTokenWith("isolate"); err == nil {
+		return writingmodes.Isolate, nil
+	} else if err := ts.consumeIdentTokenWith("bidi-override"); err == nil {
+		return writingmodes.BidiOverride, nil
+	} else if err := ts.consumeIdentTokenWith("isolate-override"); err == nil {
+		return writingmodes.IsolateOverride, nil
+	} else if err := ts.consumeIdentTokenWith("plaintext"); err == nil {
+		return writingmodes.Plaintext, nil
+	}
+	return res, fmt.Errorf("%s: invalid unicode-bidi value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-writing-modes-3/#block-flow
+func (ts *tokenStream) parseWritingMode() (res writingmodes.WritingMode, err error) {
+	if err := ts.consumeIdentTokenWith("horizontal-tb"); err == nil {
+		return writingmodes.HorizontalTb, nil
+	} else if err := ts.consumeIdentTokenWith("vertical-rl"); err == nil {
+		return writingmodes.VerticalRl, nil
+	} else if err := ts.consumeIdentTokenWith("vertical-lr"); err == nil {
+		return writingmodes.VerticalLr, nil
+	}
+	return res, fmt.Errorf("%s: invalid writing-mode value", ts.errorHeader())
+}
+
+// https://www.w3.org/TR/css-writing-modes-3/#text-orientation
+func (ts *tokenStream) parseTextOrientation() (res writingmodes.TextOrientation, err error) {
+	if err := ts.consumeIdentTokenWith("mixed"); err == nil {
+		return writingmodes.Mixed, nil
+	} else if err := ts.consumeIdentTokenWith("upright"); err == nil {
+		return writingmodes.Upright, nil
+	} else if err := ts.consumeIdentTokenWith("sideways"); err == nil {
+		return writingmodes.Sideways, nil
+	}
+	return res, fmt.Errorf("%s: invalid text-orientation value", ts.errorHeader())
+}
@@ -0,0 +1,68 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package csssyntax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/css/writingmodes"
+)
+
+func TestCssWritingModesProperties(t *testing.T) {
+	cases := []struct {
+		name     string
+		css      string
+		expected props.PropertyValue
+	}{
+		{"direction", "ltr", writingmodes.Ltr},
+		{"direction", "rtl", writingmodes.Rtl},
+		{"unicode-bidi", "normal", writingmodes.NormalUnicodeBidi},
+		{"unicode-bidi", "embed", writingmodes.Embed},
+		{"unicode-bidi", "isolate", writingmodes.Isolate},
+		{"unicode-bidi", "bidi-override", writingmodes.BidiOverride},
+		{"unicode-bidi", "isolate-override", writingmodes.IsolateOverride},
+		{"unicode-bidi", "plaintext", writingmodes.Plaintext},
+		{"writing-mode", "horizontal-tb", writingmodes.HorizontalTb},
+		{"writing-mode", "vertical-rl", writingmodes.VerticalRl},
+		{"writing-mode", "vertical-lr", writingmodes.VerticalLr},
+		{"text-orientation", "mixed", writingmodes.Mixed},
+		{"text-orientation", "upright", writingmodes.Upright},
+		{"text-orientation", "sideways", writingmodes.Sideways},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			got, err := parse(&ts, parseFuncMap[cs.name])
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, cs.expected) {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+	invalidCases := []struct{ name, css string }{
+		{"direction", "auto"},
+		{"unicode-bidi", "override"},
+		{"writing-mode", "vertical"},
+		{"text-orientation", "sideways-right"},
+	}
+	for _, cs := range invalidCases {
+		t.Run(cs.name+": "+cs.css, func(t *testing.T) {
+			ts, err := tokenize([]byte(cs.css), "<test>")
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+			if got, err := parse(&ts, parseFuncMap[cs.name]); err == nil {
+				t.Errorf("expected error, got %v", got)
+			}
+		})
+	}
+}
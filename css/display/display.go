@@ -75,11 +75,16 @@ type Display struct {
 
 	OuterMode OuterMode // Outer display mode when Mode is OuterInnerMode
 	InnerMode InnerMode // Inner display mode when Mode is OuterInnerMode
+
+	IsListItem bool // Whether it generates ::marker box (display: list-item) when Mode is OuterInnerMode
 }
 
 func (d Display) String() string {
 	switch d.Mode {
 	case OuterInnerMode:
+		if d.IsListItem {
+			return fmt.Sprintf("%v %v list-item", d.OuterMode, d.InnerMode)
+		}
 		return fmt.Sprintf("%v %v", d.OuterMode, d.InnerMode)
 	case TableRowGroup:
 		return "table-row-group"
@@ -110,7 +115,7 @@ func (d Display) String() string {
 	case DisplayNone:
 		return "none"
 	}
-	return fmt.Sprintf("bad Display %d", d)
+	return fmt.Sprintf("<bad Display mode %d>", d.Mode)
 }
 
 // Mode represents display mode
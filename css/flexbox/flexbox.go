@@ -0,0 +1,75 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+// Package flexbox provides types and values for [CSS Flexible Box Layout Module Level 1].
+//
+// [CSS Flexible Box Layout Module Level 1]: https://www.w3.org/TR/css-flexbox-1/
+package flexbox
+
+import (
+	"fmt"
+)
+
+// Direction represents value of [CSS flex-direction] property.
+//
+// [CSS flex-direction]: https://www.w3.org/TR/css-flexbox-1/#flex-direction-property
+type Direction uint8
+
+const (
+	Row           Direction = iota // flex-direction: row
+	RowReverse                     // flex-direction: row-reverse
+	Column                         // flex-direction: column
+	ColumnReverse                  // flex-direction: column-reverse
+)
+
+// IsRow reports whether the main axis is the inline axis.
+func (d Direction) IsRow() bool { return d == Row || d == RowReverse }
+
+// IsReverse reports whether main-start and main-end directions are swapped.
+func (d Direction) IsReverse() bool { return d == RowReverse || d == ColumnReverse }
+
+func (d Direction) String() string {
+	switch d {
+	case Row:
+		return "row"
+	case RowReverse:
+		return "row-reverse"
+	case Column:
+		return "column"
+	case ColumnReverse:
+		return "column-reverse"
+	}
+	return fmt.Sprintf("<bad Direction %d>", d)
+}
+
+// Wrap represents value of [CSS flex-wrap] property.
+//
+// [CSS flex-wrap]: https://www.w3.org/TR/css-flexbox-1/#flex-wrap-property
+type Wrap uint8
+
+const (
+	NoWrap      Wrap = iota // flex-wrap: nowrap
+	WrapLines               // flex-wrap: wrap
+	WrapReverse             // flex-wrap: wrap-reverse
+)
+
+func (w Wrap) String() string {
+	switch w {
+	case NoWrap:
+		return "nowrap"
+	case WrapLines:
+		return "wrap"
+	case WrapReverse:
+		return "wrap-reverse"
+	}
+	return fmt.Sprintf("<bad Wrap %d>", w)
+}
+
+// Factor represents value of [CSS flex-grow] and [CSS flex-shrink] properties.
+//
+// [CSS flex-grow]: https://www.w3.org/TR/css-flexbox-1/#flex-grow-property
+// [CSS flex-shrink]: https://www.w3.org/TR/css-flexbox-1/#flex-shrink-property
+type Factor float64
+
+func (f Factor) String() string { return fmt.Sprintf("%g", float64(f)) }
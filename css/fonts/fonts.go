@@ -75,9 +75,18 @@ func (f FamilyList) String() string {
 	return sb.String()
 }
 
-// Weight represents value of [CSS font-weight] property.
+// WeightValue represents value of [CSS font-weight] property, which is either
+// [Weight] or [RelativeWeight].
 //
 // [CSS font-weight]: https://www.w3.org/TR/css-fonts-3/#propdef-font-weight
+type WeightValue interface {
+	// ResolveWeight returns absolute weight, where parent is the parent's
+	// weight.
+	ResolveWeight(parent Weight) Weight
+	String() string
+}
+
+// Weight represents absolute font weight, from 1 to 1000.
 type Weight uint16
 
 // Some predefined font-weight values
@@ -90,6 +99,57 @@ func (w Weight) String() string {
 	return fmt.Sprintf("%d", w)
 }
 
+func (w Weight) ResolveWeight(parent Weight) Weight {
+	return w
+}
+
+// RelativeWeight represents font weight relative to the parent's weight.
+//
+// Spec: https://www.w3.org/TR/css-fonts-4/#relative-weights
+type RelativeWeight uint8
+
+const (
+	Bolder  RelativeWeight = iota // font-weight: bolder
+	Lighter                       // font-weight: lighter
+)
+
+func (w RelativeWeight) String() string {
+	switch w {
+	case Bolder:
+		return "bolder"
+	case Lighter:
+		return "lighter"
+	}
+	return fmt.Sprintf("<bad RelativeWeight %d>", w)
+}
+
+func (w RelativeWeight) ResolveWeight(parent Weight) Weight {
+	switch w {
+	case Bolder:
+		switch {
+		case parent < 350:
+			return 400
+		case parent < 550:
+			return 700
+		case parent < 900:
+			return 900
+		}
+		return parent
+	case Lighter:
+		switch {
+		case parent < 100:
+			return parent
+		case parent < 550:
+			return 100
+		case parent < 750:
+			return 400
+		}
+		return 700
+	}
+	log.Panicf("<bad RelativeWeight %d>", w)
+	return 0
+}
+
 // Stretch represents value of [CSS font-stretch] property.
 //
 // [CSS font-stretch]: https://www.w3.org/TR/css-fonts-3/#propdef-font-stretch
@@ -0,0 +1,199 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+// Package grid provides types and values for [CSS Grid Layout Module Level 1].
+//
+// [CSS Grid Layout Module Level 1]: https://www.w3.org/TR/css-grid-1/
+package grid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/inseo-oh/yw/css/values"
+)
+
+// BreadthType represents type of [Breadth].
+type BreadthType uint8
+
+const (
+	BreadthAuto       BreadthType = iota // auto
+	BreadthLength                        // <length-percentage>
+	BreadthFlex                          // <flex> (e.g. 1fr)
+	BreadthMinContent                    // min-content
+	BreadthMaxContent                    // max-content
+)
+
+// Breadth represents sizing function of a track.
+//
+// Zero value for Breadth means "auto".
+//
+// https://www.w3.org/TR/css-grid-1/#typedef-track-breadth
+type Breadth struct {
+	Type   BreadthType
+	Length values.LengthResolvable // Only valid for BreadthLength
+	Flex   float64                 // Only valid for BreadthFlex
+}
+
+func (b Breadth) String() string {
+	switch b.Type {
+	case BreadthAuto:
+		return "auto"
+	case BreadthLength:
+		return fmt.Sprintf("%v", b.Length)
+	case BreadthFlex:
+		return fmt.Sprintf("%gfr", b.Flex)
+	case BreadthMinContent:
+		return "min-content"
+	case BreadthMaxContent:
+		return "max-content"
+	}
+	return fmt.Sprintf("<bad Breadth %d>", b.Type)
+}
+
+// TrackSize represents size of a track, with minimum and maximum sizing
+// functions. Single <track-breadth> has the same minimum and maximum, except
+// for flexible sizes, which have auto minimum.
+//
+// Zero value for TrackSize means "auto".
+//
+// https://www.w3.org/TR/css-grid-1/#typedef-track-size
+type TrackSize struct {
+	Min, Max Breadth
+}
+
+// TrackSizeOf returns TrackSize for a single <track-breadth>.
+func TrackSizeOf(b Breadth) TrackSize {
+	if b.Type == BreadthFlex {
+		// https://www.w3.org/TR/css-grid-1/#valdef-grid-template-columns-flex
+		return TrackSize{Min: Breadth{Type: BreadthAuto}, Max: b}
+	}
+	return TrackSize{Min: b, Max: b}
+}
+
+func (t TrackSize) String() string {
+	single := TrackSizeOf(t.Max)
+	if single.Min.String() == t.Min.String() && single.Max.String() == t.Max.String() {
+		return t.Max.String()
+	}
+	return fmt.Sprintf("minmax(%v, %v)", t.Min, t.Max)
+}
+
+// AutoRepeat represents repeat() with auto-fill or auto-fit.
+//
+// https://www.w3.org/TR/css-grid-1/#auto-repeat
+type AutoRepeat struct {
+	IsAutoFit bool        // auto-fit if set, auto-fill otherwise
+	Tracks    []TrackSize // Tracks to repeat
+	Index     int         // Index of TrackList.Tracks where repeated tracks are inserted
+}
+
+func (r AutoRepeat) String() string {
+	kind := "auto-fill"
+	if r.IsAutoFit {
+		kind = "auto-fit"
+	}
+	tracks := []string{}
+	for _, t := range r.Tracks {
+		tracks = append(tracks, t.String())
+	}
+	return fmt.Sprintf("repeat(%s, %s)", kind, strings.Join(tracks, " "))
+}
+
+// TrackList represents value of [CSS grid-template-columns and grid-template-rows] properties.
+// repeat() with integer count is expanded when it's parsed.
+//
+// Zero value for TrackList means "none".
+//
+// [CSS grid-template-columns and grid-template-rows]: https://www.w3.org/TR/css-grid-1/#track-sizing
+type TrackList struct {
+	Tracks     []TrackSize
+	AutoRepeat *AutoRepeat // nil if there's no repeat() with auto-fill or auto-fit
+}
+
+// IsNone reports whether it's none.
+func (l TrackList) IsNone() bool { return len(l.Tracks) == 0 && l.AutoRepeat == nil }
+
+// Expand returns list of tracks, with auto repeated tracks repeated count times.
+func (l TrackList) Expand(count int) []TrackSize {
+	if l.AutoRepeat == nil {
+		return l.Tracks
+	}
+	res := []TrackSize{}
+	res = append(res, l.Tracks[:l.AutoRepeat.Index]...)
+	for range count {
+		res = append(res, l.AutoRepeat.Tracks...)
+	}
+	res = append(res, l.Tracks[l.AutoRepeat.Index:]...)
+	return res
+}
+
+func (l TrackList) String() string {
+	if l.IsNone() {
+		return "none"
+	}
+	items := []string{}
+	for i, t := range l.Tracks {
+		if l.AutoRepeat != nil && l.AutoRepeat.Index == i {
+			items = append(items, l.AutoRepeat.String())
+		}
+		items = append(items, t.String())
+	}
+	if l.AutoRepeat != nil && l.AutoRepeat.Index == len(l.Tracks) {
+		items = append(items, l.AutoRepeat.String())
+	}
+	return strings.Join(items, " ")
+}
+
+// AutoFlow represents value of [CSS grid-auto-flow] property.
+//
+// Zero value for AutoFlow means "row".
+//
+// [CSS grid-auto-flow]: https://www.w3.org/TR/css-grid-1/#grid-auto-flow-property
+type AutoFlow struct {
+	IsColumn bool // Items are placed by filling each column
+	IsDense  bool // Use dense packing algorithm
+}
+
+func (f AutoFlow) String() string {
+	res := "row"
+	if f.IsColumn {
+		res = "column"
+	}
+	if f.IsDense {
+		res += " dense"
+	}
+	return res
+}
+
+// LineType represents type of [Line].
+type LineType uint8
+
+const (
+	LineAuto   LineType = iota // auto
+	LineNumber                 // <integer>
+	LineSpan                   // span <integer>
+)
+
+// Line represents value of [CSS grid-row-start, grid-row-end, grid-column-start and grid-column-end] properties.
+//
+// Zero value for Line means "auto".
+//
+// [CSS grid-row-start, grid-row-end, grid-column-start and grid-column-end]: https://www.w3.org/TR/css-grid-1/#line-placement
+type Line struct {
+	Type  LineType
+	Value int // Line number (negative values count from the end), or span count
+}
+
+func (l Line) String() string {
+	switch l.Type {
+	case LineAuto:
+		return "auto"
+	case LineNumber:
+		return fmt.Sprintf("%d", l.Value)
+	case LineSpan:
+		return fmt.Sprintf("span %d", l.Value)
+	}
+	return fmt.Sprintf("<bad Line %d>", l.Type)
+}
@@ -0,0 +1,192 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+// Package images provide types and values for CSS Images Module Level 3
+//
+// Releavnt spec: https://www.w3.org/TR/css-images-3/
+package images
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/inseo-oh/yw/css/csscolor"
+	"github.com/inseo-oh/yw/css/values"
+)
+
+// Image represents CSS <image> type, or none where it's also accepted.
+//
+// Releavnt spec: https://www.w3.org/TR/css-images-3/#typedef-image
+type Image interface {
+	String() string
+	isImage()
+}
+
+// NoImage represents none, where no image is given.
+type NoImage struct{}
+
+// URLImage is an image fetched from URL given by url().
+//
+// Releavnt spec: https://www.w3.org/TR/css-values-4/#urls
+type URLImage struct {
+	URL string // The URL, which is not resolved yet.
+}
+
+// ColorStop represents <linear-color-stop> and <angular-color-stop>.
+// Color stops with two positions are stored as two color stops.
+//
+// TODO: Support color hints(<linear-color-hint>)
+//
+// Releavnt spec: https://www.w3.org/TR/css-images-3/#color-stop-syntax
+type ColorStop struct {
+	Color    csscolor.Color
+	Position values.LengthResolvable // nil if it's omitted
+}
+
+// Corner represents corner given by "to <side-or-corner>" of linear-gradient().
+type Corner uint8
+
+const (
+	NoCorner    Corner = iota // Direction is given by an angle.
+	TopLeft                   // to top left
+	TopRight                  // to top right
+	BottomLeft                // to bottom left
+	BottomRight               // to bottom right
+)
+
+// LinearGradient represents linear-gradient() and repeating-linear-gradient().
+//
+// Releavnt spec: https://www.w3.org/TR/css-images-3/#linear-gradients
+type LinearGradient struct {
+	Angle     float64 // Direction in degrees, clockwise from "to top". Unused if Corner is set.
+	Corner    Corner  // Corner the gradient points to.
+	Stops     []ColorStop
+	Repeating bool
+}
+
+// RadialShape represents <radial-shape>.
+type RadialShape uint8
+
+const (
+	Ellipse RadialShape = iota // ellipse
+	Circle                     // circle
+)
+
+// RadialExtent represents <radial-extent>.
+type RadialExtent uint8
+
+const (
+	FarthestCorner RadialExtent = iota // farthest-corner
+	ClosestSide                        // closest-side
+	ClosestCorner                      // closest-corner
+	FarthestSide                       // farthest-side
+)
+
+// RadialGradient represents radial-gradient() and repeating-radial-gradient().
+//
+// Releavnt spec: https://www.w3.org/TR/css-images-3/#radial-gradients
+type RadialGradient struct {
+	Shape RadialShape
+	// Radii of the ending shape in horizontal and vertical axis. If these are
+	// nil, Extent determines the size instead. Circles use the first one only.
+	Size      [2]values.LengthResolvable
+	Extent    RadialExtent
+	Position  values.Position // Center of the gradient
+	Stops     []ColorStop
+	Repeating bool
+}
+
+// ConicGradient represents conic-gradient() and repeating-conic-gradient().
+// Angles of color stops are stored as percentages of full turn(e.g. 90deg is
+// stored as 25%).
+//
+// Releavnt spec: https://www.w3.org/TR/css-images-4/#conic-gradients
+type ConicGradient struct {
+	FromAngle float64         // Starting angle in degrees, clockwise from top.
+	Position  values.Position // Center of the gradient
+	Stops     []ColorStop
+	Repeating bool
+}
+
+func (NoImage) isImage()        {}
+func (URLImage) isImage()       {}
+func (LinearGradient) isImage() {}
+func (RadialGradient) isImage() {}
+func (ConicGradient) isImage()  {}
+
+func (NoImage) String() string    { return "none" }
+func (i URLImage) String() string { return fmt.Sprintf("url(%q)", i.URL) }
+
+func (c Corner) String() string {
+	switch c {
+	case TopLeft:
+		return "to top left"
+	case TopRight:
+		return "to top right"
+	case BottomLeft:
+		return "to bottom left"
+	case BottomRight:
+		return "to bottom right"
+	}
+	return fmt.Sprintf("<bad Corner %d>", c)
+}
+func (s RadialShape) String() string {
+	switch s {
+	case Ellipse:
+		return "ellipse"
+	case Circle:
+		return "circle"
+	}
+	return fmt.Sprintf("<bad RadialShape %d>", s)
+}
+func (e RadialExtent) String() string {
+	switch e {
+	case FarthestCorner:
+		return "farthest-corner"
+	case ClosestSide:
+		return "closest-side"
+	case ClosestCorner:
+		return "closest-corner"
+	case FarthestSide:
+		return "farthest-side"
+	}
+	return fmt.Sprintf("<bad RadialExtent %d>", e)
+}
+
+func (g LinearGradient) String() string {
+	dir := fmt.Sprintf("%gdeg", g.Angle)
+	if g.Corner != NoCorner {
+		dir = g.Corner.String()
+	}
+	return gradientString("linear-gradient", g.Repeating, dir, g.Stops)
+}
+func (g RadialGradient) String() string {
+	size := g.Extent.String()
+	if g.Size[0] != nil {
+		size = g.Size[0].String()
+		if g.Shape == Ellipse {
+			size += " " + g.Size[1].String()
+		}
+	}
+	return gradientString("radial-gradient", g.Repeating, fmt.Sprintf("%v %s at %v", g.Shape, size, g.Position), g.Stops)
+}
+func (g ConicGradient) String() string {
+	return gradientString("conic-gradient", g.Repeating, fmt.Sprintf("from %gdeg at %v", g.FromAngle, g.Position), g.Stops)
+}
+
+func gradientString(name string, repeating bool, prelude string, stops []ColorStop) string {
+	sb := strings.Builder{}
+	if repeating {
+		sb.WriteString("repeating-")
+	}
+	sb.WriteString(name + "(" + prelude)
+	for _, stop := range stops {
+		sb.WriteString(fmt.Sprintf(", %v", stop.Color))
+		if stop.Position != nil {
+			sb.WriteString(fmt.Sprintf(" %v", stop.Position))
+		}
+	}
+	sb.WriteString(")")
+	return sb.String()
+}
@@ -0,0 +1,92 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+// Package inline provides types and values for [CSS Inline Layout Module Level 3].
+//
+// [CSS Inline Layout Module Level 3]: https://www.w3.org/TR/css-inline-3/
+package inline
+
+import (
+	"fmt"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/values"
+)
+
+// LineHeight represents value of [CSS line-height] property.
+//
+// [CSS line-height]: https://www.w3.org/TR/css-inline-3/#line-height-property
+type LineHeight struct {
+	Type   LineHeightType          // Type of line height value
+	Number css.Num                 // Multiplier of the font size when Type is NumberLineHeight
+	Length values.LengthResolvable // Line height when Type is LengthLineHeight
+}
+
+// Type of [LineHeight] value.
+type LineHeightType uint8
+
+const (
+	NormalLineHeight LineHeightType = iota // normal
+	NumberLineHeight                       // <number>
+	LengthLineHeight                       // <length-percentage>
+)
+
+func (l LineHeight) String() string {
+	switch l.Type {
+	case NormalLineHeight:
+		return "normal"
+	case NumberLineHeight:
+		return l.Number.String()
+	case LengthLineHeight:
+		return l.Length.String()
+	}
+	return fmt.Sprintf("<bad LineHeight type %d>", l.Type)
+}
+
+// VerticalAlign represents value of [CSS vertical-align] property.
+//
+// [CSS vertical-align]: https://www.w3.org/TR/CSS2/visudet.html#propdef-vertical-align
+type VerticalAlign struct {
+	Type   VerticalAlignType       // Type of alignment
+	Length values.LengthResolvable // Amount of baseline shift when Type is LengthVerticalAlign
+}
+
+// Type of [VerticalAlign] value.
+type VerticalAlignType uint8
+
+const (
+	Baseline            VerticalAlignType = iota // baseline
+	Sub                                          // sub
+	Super                                        // super
+	TextTop                                      // text-top
+	TextBottom                                   // text-bottom
+	Middle                                       // middle
+	Top                                          // top
+	Bottom                                       // bottom
+	LengthVerticalAlign                          // <length-percentage>
+)
+
+func (v VerticalAlign) String() string {
+	switch v.Type {
+	case Baseline:
+		return "baseline"
+	case Sub:
+		return "sub"
+	case Super:
+		return "super"
+	case TextTop:
+		return "text-top"
+	case TextBottom:
+		return "text-bottom"
+	case Middle:
+		return "middle"
+	case Top:
+		return "top"
+	case Bottom:
+		return "bottom"
+	case LengthVerticalAlign:
+		return v.Length.String()
+	}
+	return fmt.Sprintf("<bad VerticalAlign type %d>", v.Type)
+}
@@ -5,33 +5,113 @@ package propsdef
 // ==============================================================================
 var (
 	typeColor                  = CssType{"csscolor.Color", "parseColor"}
+	typeAlphaValue             = CssType{"csscolor.AlphaValue", "parseAlphaValue"}
 	typeSizeOrAuto             = CssType{"sizing.Size", "parseSizeOrAuto"}
 	typeSizeOrNone             = CssType{"sizing.Size", "parseSizeOrNone"}
+	typeBoxSizing              = CssType{"sizing.BoxSizing", "parseBoxSizing"}
 	typeDisplay                = CssType{"display.Display", "parseDisplay"}
 	typeVisibility             = CssType{"display.Visibility", "parseVisibility"}
 	typeLineStyle              = CssType{"backgrounds.LineStyle", "parseLineStyle"}
+	typeBackgroundImage        = CssType{"backgrounds.ImageList", "parseBackgroundImage"}
+	typeBackgroundPosition     = CssType{"backgrounds.PositionList", "parseBackgroundPosition"}
+	typeBackgroundSize         = CssType{"backgrounds.SizeList", "parseBackgroundSize"}
+	typeBackgroundRepeat       = CssType{"backgrounds.RepeatList", "parseBackgroundRepeat"}
+	typeBackgroundBox          = CssType{"backgrounds.BoxList", "parseBackgroundBox"}
+	typeBorderRadius           = CssType{"backgrounds.Radius", "parseBorderRadius"}
+	typeBoxShadow              = CssType{"backgrounds.ShadowList", "parseBoxShadow"}
 	typeLineWidth              = CssType{"values.Length", "parseLineWidth"}
 	typeMargin                 = CssType{"box.Margin", "parseMargin"}
 	typePadding                = CssType{"values.LengthResolvable", "parsePadding"}
 	typeFontFamilyList         = CssType{"fonts.FamilyList", "parseFontFamily"}
-	typeFontWeight             = CssType{"fonts.Weight", "parseFontWeight"}
+	typeFontWeight             = CssType{"fonts.WeightValue", "parseFontWeight"}
 	typeFontStretch            = CssType{"fonts.Stretch", "parseFontStretch"}
 	typeFontStyle              = CssType{"fonts.Style", "parseFontStyle"}
 	typeFontSize               = CssType{"fonts.Size", "parseFontSize"}
 	typeTextTransform          = CssType{"text.Transform", "parseTextTransform"}
+	typeWordBreak              = CssType{"text.WordBreak", "parseWordBreak"}
+	typeOverflowWrap           = CssType{"text.OverflowWrap", "parseOverflowWrap"}
+	typeLineBreak              = CssType{"text.LineBreak", "parseLineBreak"}
+	typeTextAlign              = CssType{"text.Align", "parseTextAlign"}
+	typeTextIndent             = CssType{"text.Indent", "parseTextIndent"}
+	typeWhiteSpaceCollapse     = CssType{"text.WhiteSpaceCollapse", "parseWhiteSpaceCollapse"}
+	typeTextWrapMode           = CssType{"text.WrapMode", "parseTextWrapMode"}
+	typeTabSize                = CssType{"text.TabSize", "parseTabSize"}
+	typeLineHeight             = CssType{"inline.LineHeight", "parseLineHeight"}
+	typeVerticalAlign          = CssType{"inline.VerticalAlign", "parseVerticalAlign"}
 	typeTextDecorationLine     = CssType{"textdecor.LineFlags", "parseTextDecorationLine"}
 	typeTextDecorationStyle    = CssType{"textdecor.Style", "parseTextDecorationStyle"}
 	typeTextDecorationPosition = CssType{"textdecor.PositionFlags", "parseTextDecorationPosition"}
 	typeFloat                  = CssType{"float.Float", "parseFloat"}
+	typeOverflow               = CssType{"overflow.Overflow", "parseOverflow"}
+	typeTextOverflow           = CssType{"overflow.TextOverflow", "parseTextOverflow"}
+	typeContent                = CssType{"content.Content", "parseContent"}
+	typePosition               = CssType{"position.Position", "parsePosition"}
+	typeInset                  = CssType{"position.Inset", "parseInset"}
+	typeZIndex                 = CssType{"position.ZIndex", "parseZIndex"}
+	typeFlexDirection          = CssType{"flexbox.Direction", "parseFlexDirection"}
+	typeFlexWrap               = CssType{"flexbox.Wrap", "parseFlexWrap"}
+	typeFlexFactor             = CssType{"flexbox.Factor", "parseFlexFactor"}
+	typeFlexBasis              = CssType{"sizing.Size", "parseFlexBasis"}
+	typeJustifyContent         = CssType{"align.Content", "parseJustifyContent"}
+	typeAlignItems             = CssType{"align.Item", "parseAlignItems"}
+	typeAlignSelf              = CssType{"align.Item", "parseAlignSelf"}
+	typeGap                    = CssType{"align.Gap", "parseGap"}
+	typeTrackList              = CssType{"grid.TrackList", "parseTrackList"}
+	typeTrackSize              = CssType{"grid.TrackSize", "parseTrackSize"}
+	typeGridAutoFlow           = CssType{"grid.AutoFlow", "parseGridAutoFlow"}
+	typeGridLine               = CssType{"grid.Line", "parseGridLine"}
+	typeBorderCollapse         = CssType{"tables.BorderCollapse", "parseBorderCollapse"}
+	typeBorderSpacing          = CssType{"tables.BorderSpacing", "parseBorderSpacing"}
+	typeTableLayout            = CssType{"tables.Layout", "parseTableLayout"}
+	typeCaptionSide            = CssType{"tables.CaptionSide", "parseCaptionSide"}
+	typeListStyleType          = CssType{"lists.StyleType", "parseListStyleType"}
+	typeListStylePosition      = CssType{"lists.Position", "parseListStylePosition"}
+	typeListStyleImage         = CssType{"lists.Image", "parseListStyleImage"}
+	typeCounterReset           = CssType{"lists.Counters", "parseCounterReset"}
+	typeCounterIncrement       = CssType{"lists.Counters", "parseCounterIncrement"}
+	typeCounterSet             = CssType{"lists.Counters", "parseCounterSet"}
+	typeDirection              = CssType{"writingmodes.Direction", "parseDirection"}
+	typeUnicodeBidi            = CssType{"writingmodes.UnicodeBidi", "parseUnicodeBidi"}
+	typeWritingMode            = CssType{"writingmodes.WritingMode", "parseWritingMode"}
+	typeTextOrientation        = CssType{"writingmodes.TextOrientation", "parseTextOrientation"}
 )
 
 // ==============================================================================
 // List of CSS properties
 // ==============================================================================
 var (
+	//==========================================================================
+	// https://www.w3.org/TR/css-text-4/
+	//==========================================================================
+	// https://www.w3.org/TR/css-text-4/#white-space-collapsing
+	propWhiteSpaceCollapse = SimpleProp{"white-space-collapse", typeWhiteSpaceCollapse, "text.CollapseWhiteSpace", true}
+	// https://www.w3.org/TR/css-text-4/#text-wrap-mode
+	propTextWrapMode = SimpleProp{"text-wrap-mode", typeTextWrapMode, "text.Wrap", true}
+
+	//==========================================================================
+	// https://www.w3.org/TR/css-overflow-3/
+	//==========================================================================
+	// https://www.w3.org/TR/css-overflow-3/#overflow-control
+	propOverflowX = SimpleProp{"overflow-x", typeOverflow, "overflow.Visible", false}
+	propOverflowY = SimpleProp{"overflow-y", typeOverflow, "overflow.Visible", false}
+
 	//==========================================================================
 	// https://www.w3.org/TR/css-backgrounds-3/
 	//==========================================================================
+	// https://www.w3.org/TR/css-backgrounds-3/#background-color
+	propBackgroundColor = SimpleProp{"background-color", typeColor, "csscolor.Transparent", false}
+	// https://www.w3.org/TR/css-backgrounds-3/#background-image
+	propBackgroundImage = SimpleProp{"background-image", typeBackgroundImage, "backgrounds.ImageList{images.NoImage{}}", false}
+	// https://www.w3.org/TR/css-backgrounds-3/#background-position
+	propBackgroundPosition = SimpleProp{"background-position", typeBackgroundPosition, "backgrounds.PositionList{backgrounds.InitialPosition}", false}
+	// https://www.w3.org/TR/css-backgrounds-3/#background-size
+	propBackgroundSize = SimpleProp{"background-size", typeBackgroundSize, "backgrounds.SizeList{{Type: backgrounds.ExplicitSize}}", false}
+	// https://www.w3.org/TR/css-backgrounds-3/#background-repeat
+	propBackgroundRepeat = SimpleProp{"background-repeat", typeBackgroundRepeat, "backgrounds.RepeatList{{X: backgrounds.RepeatTile, Y: backgrounds.RepeatTile}}", false}
+	// https://www.w3.org/TR/css-backgrounds-3/#background-origin
+	propBackgroundOrigin = SimpleProp{"background-origin", typeBackgroundBox, "backgrounds.BoxList{backgrounds.PaddingBox}", false}
+	// https://www.w3.org/TR/css-backgrounds-3/#background-clip
+	propBackgroundClip = SimpleProp{"background-clip", typeBackgroundBox, "backgrounds.BoxList{backgrounds.BorderBox}", false}
 	// https://www.w3.org/TR/css-backgrounds-3/#border-color
 	propBorderTopColor    = SimpleProp{"border-top-color", typeColor, "csscolor.Color{Type: csscolor.CurrentColor}", false}
 	propBorderRightColor  = SimpleProp{"border-right-color", typeColor, "csscolor.Color{Type: csscolor.CurrentColor}", false}
@@ -51,6 +131,11 @@ var (
 	propBorderColor = ShorthandSidesProp{"border-color", propBorderTopColor, propBorderRightColor, propBorderBottomColor, propBorderLeftColor, false}
 	propBorderStyle = ShorthandSidesProp{"border-style", propBorderTopStyle, propBorderRightStyle, propBorderBottomStyle, propBorderLeftStyle, false}
 	propBorderWidth = ShorthandSidesProp{"border-width", propBorderTopWidth, propBorderRightWidth, propBorderBottomWidth, propBorderLeftWidth, false}
+	// https://www.w3.org/TR/css-backgrounds-3/#border-radius
+	propBorderTopLeftRadius     = SimpleProp{"border-top-left-radius", typeBorderRadius, "backgrounds.ZeroRadius", false}
+	propBorderTopRightRadius    = SimpleProp{"border-top-right-radius", typeBorderRadius, "backgrounds.ZeroRadius", false}
+	propBorderBottomRightRadius = SimpleProp{"border-bottom-right-radius", typeBorderRadius, "backgrounds.ZeroRadius", false}
+	propBorderBottomLeftRadius  = SimpleProp{"border-bottom-left-radius", typeBorderRadius, "backgrounds.ZeroRadius", false}
 	//==========================================================================
 	// https://www.w3.org/TR/css-box-3/
 	//==========================================================================
@@ -86,6 +171,44 @@ var (
 	propTextDecorationStyle = SimpleProp{"text-decoration-style", typeTextDecorationStyle, "textdecor.Solid", false}
 	// https://www.w3.org/TR/css-text-decor-3/#text-decoration-color-property
 	propTextDecorationColor = SimpleProp{"text-decoration-color", typeColor, "csscolor.Color{Type: csscolor.CurrentColor}", false}
+	//==========================================================================
+	// https://www.w3.org/TR/css-position-3/
+	//==========================================================================
+	// https://www.w3.org/TR/css-position-3/#insets
+	propTop    = SimpleProp{"top", typeInset, "position.Inset{}", false}
+	propRight  = SimpleProp{"right", typeInset, "position.Inset{}", false}
+	propBottom = SimpleProp{"bottom", typeInset, "position.Inset{}", false}
+	propLeft   = SimpleProp{"left", typeInset, "position.Inset{}", false}
+	//==========================================================================
+	// https://www.w3.org/TR/css-flexbox-1/
+	//==========================================================================
+	// https://www.w3.org/TR/css-flexbox-1/#flex-direction-property
+	propFlexDirection = SimpleProp{"flex-direction", typeFlexDirection, "flexbox.Row", false}
+	// https://www.w3.org/TR/css-flexbox-1/#flex-wrap-property
+	propFlexWrap = SimpleProp{"flex-wrap", typeFlexWrap, "flexbox.NoWrap", false}
+	//==========================================================================
+	// https://www.w3.org/TR/css-align-3/
+	//==========================================================================
+	// https://www.w3.org/TR/css-align-3/#column-row-gap
+	propRowGap    = SimpleProp{"row-gap", typeGap, "align.Gap{}", false}
+	propColumnGap = SimpleProp{"column-gap", typeGap, "align.Gap{}", false}
+	//==========================================================================
+	// https://www.w3.org/TR/css-grid-1/
+	//==========================================================================
+	// https://www.w3.org/TR/css-grid-1/#line-placement
+	propGridRowStart    = SimpleProp{"grid-row-start", typeGridLine, "grid.Line{}", false}
+	propGridRowEnd      = SimpleProp{"grid-row-end", typeGridLine, "grid.Line{}", false}
+	propGridColumnStart = SimpleProp{"grid-column-start", typeGridLine, "grid.Line{}", false}
+	propGridColumnEnd   = SimpleProp{"grid-column-end", typeGridLine, "grid.Line{}", false}
+	//==========================================================================
+	// https://www.w3.org/TR/css-lists-3/
+	//==========================================================================
+	// https://www.w3.org/TR/css-lists-3/#image-markers
+	propListStyleImage = SimpleProp{"list-style-image", typeListStyleImage, "lists.NoImage", true}
+	// https://www.w3.org/TR/css-lists-3/#text-markers
+	propListStyleType = SimpleProp{"list-style-type", typeListStyleType, "lists.StyleType{Type: lists.CounterStyleType, Value: \"disc\"}", true}
+	// https://www.w3.org/TR/css-lists-3/#list-style-position-property
+	propListStylePosition = SimpleProp{"list-style-position", typeListStylePosition, "lists.Outside", true}
 )
 var Props = []CssProp{
 	//==========================================================================
@@ -93,6 +216,8 @@ var Props = []CssProp{
 	//==========================================================================
 	// https://www.w3.org/TR/css-color-4/#the-color-property
 	SimpleProp{"color", typeColor, "csscolor.CanvasText", true},
+	// https://www.w3.org/TR/css-color-4/#transparency
+	SimpleProp{"opacity", typeAlphaValue, "csscolor.AlphaValue(1)", false},
 	//==========================================================================
 	// https://www.w3.org/TR/2021/WD-css-sizing-3-20211217/
 	//==========================================================================
@@ -105,6 +230,8 @@ var Props = []CssProp{
 	// https://www.w3.org/TR/2021/WD-css-sizing-3-20211217/#max-size-properties
 	SimpleProp{"max-width", typeSizeOrNone, "sizing.Size{Type: sizing.NoneSize}", false},
 	SimpleProp{"max-height", typeSizeOrNone, "sizing.Size{Type: sizing.NoneSize}", false},
+	// https://www.w3.org/TR/2021/WD-css-sizing-3-20211217/#box-sizing
+	SimpleProp{"box-sizing", typeBoxSizing, "sizing.ContentBox", false},
 	//==========================================================================
 	// https://www.w3.org/TR/css-display-3/
 	//==========================================================================
@@ -116,7 +243,24 @@ var Props = []CssProp{
 	// https://www.w3.org/TR/css-backgrounds-3/
 	//==========================================================================
 	// https://www.w3.org/TR/css-backgrounds-3/#background-color
-	SimpleProp{"background-color", typeColor, "csscolor.Transparent", false},
+	propBackgroundColor,
+	// https://www.w3.org/TR/css-backgrounds-3/#background-image
+	propBackgroundImage,
+	// https://www.w3.org/TR/css-backgrounds-3/#background-position
+	propBackgroundPosition,
+	// https://www.w3.org/TR/css-backgrounds-3/#background-size
+	propBackgroundSize,
+	// https://www.w3.org/TR/css-backgrounds-3/#background-repeat
+	propBackgroundRepeat,
+	// https://www.w3.org/TR/css-backgrounds-3/#background-origin
+	propBackgroundOrigin,
+	// https://www.w3.org/TR/css-backgrounds-3/#background-clip
+	propBackgroundClip,
+	// https://www.w3.org/TR/css-backgrounds-3/#background
+	ShorthandLayersProp{ShorthandAnyProp{"background", []CssProp{
+		propBackgroundColor, propBackgroundImage, propBackgroundPosition, propBackgroundSize,
+		propBackgroundRepeat, propBackgroundOrigin, propBackgroundClip,
+	}, false}},
 	// https://www.w3.org/TR/css-backgrounds-3/#border-color
 	propBorderTopColor, propBorderRightColor, propBorderBottomColor, propBorderLeftColor, propBorderColor,
 	// https://www.w3.org/TR/css-backgrounds-3/#border-style
@@ -129,6 +273,11 @@ var Props = []CssProp{
 	ShorthandAnyProp{"border-bottom", []CssProp{propBorderBottomWidth, propBorderBottomStyle, propBorderBottomColor}, false},
 	ShorthandAnyProp{"border-left", []CssProp{propBorderLeftWidth, propBorderLeftStyle, propBorderLeftColor}, false},
 	ShorthandAnyProp{"border", []CssProp{propBorderWidth, propBorderStyle, propBorderColor}, false},
+	// https://www.w3.org/TR/css-backgrounds-3/#border-radius
+	propBorderTopLeftRadius, propBorderTopRightRadius, propBorderBottomRightRadius, propBorderBottomLeftRadius,
+	ShorthandCornersProp{"border-radius", propBorderTopLeftRadius, propBorderTopRightRadius, propBorderBottomRightRadius, propBorderBottomLeftRadius, false},
+	// https://www.w3.org/TR/css-backgrounds-3/#box-shadow
+	SimpleProp{"box-shadow", typeBoxShadow, "backgrounds.ShadowList(nil)", false},
 	//==========================================================================
 	// https://www.w3.org/TR/css-box-3/
 	//==========================================================================
@@ -158,6 +307,50 @@ var Props = []CssProp{
 	//==========================================================================
 	// https://www.w3.org/TR/css-text-3/#text-transform-property
 	SimpleProp{"text-transform", typeTextTransform, "text.Transform{Type: text.NoTransform}", true},
+	// https://www.w3.org/TR/css-text-3/#word-break-property
+	SimpleProp{"word-break", typeWordBreak, "text.NormalWordBreak", true},
+	// https://www.w3.org/TR/css-text-3/#overflow-wrap-property
+	SimpleProp{"overflow-wrap", typeOverflowWrap, "text.NormalOverflowWrap", true},
+	// https://www.w3.org/TR/css-text-3/#line-break-property
+	SimpleProp{"line-break", typeLineBreak, "text.AutoLineBreak", true},
+	// https://www.w3.org/TR/css-text-3/#text-align-property
+	SimpleProp{"text-align", typeTextAlign, "text.StartAlign", true},
+	// https://www.w3.org/TR/css-text-3/#text-indent-property
+	SimpleProp{"text-indent", typeTextIndent, "text.Indent{Value: values.LengthFromPx(0)}", true},
+	// https://www.w3.org/TR/css-text-3/#tab-size-property
+	SimpleProp{"tab-size", typeTabSize, "text.TabSize{Type: text.NumberTabSize, Number: css.NumFromInt(8)}", true},
+	//==========================================================================
+	// https://www.w3.org/TR/css-text-4/
+	//==========================================================================
+	propWhiteSpaceCollapse, propTextWrapMode,
+	// https://www.w3.org/TR/css-text-4/#white-space-property
+	ShorthandKeywordsProp{
+		ShorthandAnyProp{"white-space", []CssProp{propWhiteSpaceCollapse, propTextWrapMode}, true},
+		[]ShorthandKeyword{
+			{"normal", []string{"text.CollapseWhiteSpace", "text.Wrap"}},
+			{"pre", []string{"text.PreserveWhiteSpace", "text.NoWrap"}},
+			{"pre-wrap", []string{"text.PreserveWhiteSpace", "text.Wrap"}},
+			{"pre-line", []string{"text.PreserveBreaks", "text.Wrap"}},
+		},
+	},
+	//==========================================================================
+	// https://www.w3.org/TR/css-inline-3/
+	//==========================================================================
+	// https://www.w3.org/TR/css-inline-3/#line-height-property
+	SimpleProp{"line-height", typeLineHeight, "inline.LineHeight{Type: inline.NormalLineHeight}", true},
+	// https://www.w3.org/TR/CSS2/visudet.html#propdef-vertical-align
+	SimpleProp{"vertical-align", typeVerticalAlign, "inline.VerticalAlign{Type: inline.Baseline}", false},
+	//==========================================================================
+	// https://www.w3.org/TR/css-writing-modes-3/
+	//==========================================================================
+	// https://www.w3.org/TR/css-writing-modes-3/#direction
+	SimpleProp{"direction", typeDirection, "writingmodes.Ltr", true},
+	// https://www.w3.org/TR/css-writing-modes-3/#unicode-bidi
+	SimpleProp{"unicode-bidi", typeUnicodeBidi, "writingmodes.NormalUnicodeBidi", false},
+	// https://www.w3.org/TR/css-writing-modes-3/#block-flow
+	SimpleProp{"writing-mode", typeWritingMode, "writingmodes.HorizontalTb", true},
+	// https://www.w3.org/TR/css-writing-modes-3/#text-orientation
+	SimpleProp{"text-orientation", typeTextOrientation, "writingmodes.Mixed", true},
 	//==========================================================================
 	// https://www.w3.org/TR/css-text-decor-3/
 	//==========================================================================
@@ -171,4 +364,99 @@ var Props = []CssProp{
 	//==========================================================================
 	// https://www.w3.org/TR/CSS2/visuren.html#propdef-float
 	SimpleProp{"float", typeFloat, "float.None", false},
+	// https://www.w3.org/TR/CSS2/visuren.html#z-index
+	SimpleProp{"z-index", typeZIndex, "position.ZIndex{IsAuto: true}", false},
+	//==========================================================================
+	// https://www.w3.org/TR/css-overflow-3/
+	//==========================================================================
+	propOverflowX, propOverflowY,
+	// https://www.w3.org/TR/css-overflow-3/#overflow-properties
+	ShorthandPairProp{"overflow", propOverflowX, propOverflowY, false, false},
+	// https://www.w3.org/TR/css-overflow-3/#text-overflow
+	SimpleProp{"text-overflow", typeTextOverflow, "overflow.ClipText", false},
+	//==========================================================================
+	// https://www.w3.org/TR/css-position-3/
+	//==========================================================================
+	// https://www.w3.org/TR/css-position-3/#position-property
+	SimpleProp{"position", typePosition, "position.Static", false},
+	// https://www.w3.org/TR/css-position-3/#insets
+	propTop, propRight, propBottom, propLeft,
+	ShorthandSidesProp{"inset", propTop, propRight, propBottom, propLeft, false},
+	//==========================================================================
+	// https://www.w3.org/TR/css-flexbox-1/
+	//==========================================================================
+	// https://www.w3.org/TR/css-flexbox-1/#flex-direction-property
+	propFlexDirection,
+	// https://www.w3.org/TR/css-flexbox-1/#flex-wrap-property
+	propFlexWrap,
+	// https://www.w3.org/TR/css-flexbox-1/#flex-flow-property
+	ShorthandAnyProp{"flex-flow", []CssProp{propFlexDirection, propFlexWrap}, false},
+	// https://www.w3.org/TR/css-flexbox-1/#flex-grow-property
+	SimpleProp{"flex-grow", typeFlexFactor, "flexbox.Factor(0)", false},
+	// https://www.w3.org/TR/css-flexbox-1/#flex-shrink-property
+	SimpleProp{"flex-shrink", typeFlexFactor, "flexbox.Factor(1)", false},
+	// https://www.w3.org/TR/css-flexbox-1/#flex-basis-property
+	SimpleProp{"flex-basis", typeFlexBasis, "sizing.Size{Type: sizing.Auto}", false},
+	//==========================================================================
+	// https://www.w3.org/TR/css-align-3/
+	//==========================================================================
+	// https://www.w3.org/TR/css-align-3/#propdef-justify-content
+	SimpleProp{"justify-content", typeJustifyContent, "align.ContentNormal", false},
+	// https://www.w3.org/TR/css-align-3/#propdef-align-items
+	SimpleProp{"align-items", typeAlignItems, "align.ItemNormal", false},
+	// https://www.w3.org/TR/css-align-3/#propdef-align-self
+	SimpleProp{"align-self", typeAlignSelf, "align.ItemAuto", false},
+	// https://www.w3.org/TR/css-align-3/#column-row-gap
+	propRowGap, propColumnGap,
+	// https://www.w3.org/TR/css-align-3/#gap-shorthand
+	ShorthandPairProp{"gap", propRowGap, propColumnGap, false, false},
+	//==========================================================================
+	// https://www.w3.org/TR/css-grid-1/
+	//==========================================================================
+	// https://www.w3.org/TR/css-grid-1/#track-sizing
+	SimpleProp{"grid-template-columns", typeTrackList, "grid.TrackList{}", false},
+	SimpleProp{"grid-template-rows", typeTrackList, "grid.TrackList{}", false},
+	// https://www.w3.org/TR/css-grid-1/#auto-tracks
+	SimpleProp{"grid-auto-columns", typeTrackSize, "grid.TrackSize{}", false},
+	SimpleProp{"grid-auto-rows", typeTrackSize, "grid.TrackSize{}", false},
+	// https://www.w3.org/TR/css-grid-1/#grid-auto-flow-property
+	SimpleProp{"grid-auto-flow", typeGridAutoFlow, "grid.AutoFlow{}", false},
+	// https://www.w3.org/TR/css-grid-1/#line-placement
+	propGridRowStart, propGridRowEnd, propGridColumnStart, propGridColumnEnd,
+	// https://www.w3.org/TR/css-grid-1/#placement-shorthands
+	ShorthandPairProp{"grid-row", propGridRowStart, propGridRowEnd, false, true},
+	ShorthandPairProp{"grid-column", propGridColumnStart, propGridColumnEnd, false, true},
+	//==========================================================================
+	// https://www.w3.org/TR/css-tables-3/
+	//==========================================================================
+	// https://www.w3.org/TR/css-tables-3/#table-layout-property
+	SimpleProp{"table-layout", typeTableLayout, "tables.AutoLayout", false},
+	// https://www.w3.org/TR/css-tables-3/#border-collapse-property
+	SimpleProp{"border-collapse", typeBorderCollapse, "tables.Separate", true},
+	// https://www.w3.org/TR/css-tables-3/#border-spacing-property
+	SimpleProp{"border-spacing", typeBorderSpacing, "tables.BorderSpacing{Horizontal: values.LengthFromPx(0), Vertical: values.LengthFromPx(0)}", true},
+	// https://www.w3.org/TR/css-tables-3/#caption-side-property
+	SimpleProp{"caption-side", typeCaptionSide, "tables.CaptionTop", true},
+	//==========================================================================
+	// https://www.w3.org/TR/css-lists-3/
+	//==========================================================================
+	// https://www.w3.org/TR/css-lists-3/#image-markers
+	propListStyleImage,
+	// https://www.w3.org/TR/css-lists-3/#text-markers
+	propListStyleType,
+	// https://www.w3.org/TR/css-lists-3/#list-style-position-property
+	propListStylePosition,
+	// https://www.w3.org/TR/css-lists-3/#list-style-property
+	// NOTE: list-style-type comes before list-style-image, so that "list-style: none" sets list-style-type to none.
+	ShorthandAnyProp{"list-style", []CssProp{propListStylePosition, propListStyleType, propListStyleImage}, true},
+	// https://www.w3.org/TR/css-lists-3/#counter-reset
+	SimpleProp{"counter-reset", typeCounterReset, "lists.Counters{}", false},
+	// https://www.w3.org/TR/css-lists-3/#increment-set
+	SimpleProp{"counter-increment", typeCounterIncrement, "lists.Counters{}", false},
+	SimpleProp{"counter-set", typeCounterSet, "lists.Counters{}", false},
+	//==========================================================================
+	// https://www.w3.org/TR/css-content-3/
+	//==========================================================================
+	// https://www.w3.org/TR/css-content-3/#content-property
+	SimpleProp{"content", typeContent, "content.Content{Type: content.Normal}", false},
 }
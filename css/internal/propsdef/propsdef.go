@@ -10,8 +10,9 @@
 // There are two kinds of properties:
 //
 //   - Simple: Accepts single value of given type. See [SimpleProp].
-//   - Shorthand: Shorthand for set of Simple properties. See [ShorthandSidesProp]
-//     and [ShorthandAnyProp].
+//   - Shorthand: Shorthand for set of Simple properties. See [ShorthandSidesProp],
+//     [ShorthandCornersProp], [ShorthandPairProp], [ShorthandAnyProp],
+//     [ShorthandKeywordsProp] and [ShorthandLayersProp].
 //     Note that these properties also generate new Go types and parser
 //     function for the shorthand type.
 package propsdef
@@ -137,6 +138,116 @@ func (p ShorthandSidesProp) PropInitialValue(outsidePropsPkg bool) string {
 func (p ShorthandSidesProp) IsInheritable() bool { return p.Inheritable }
 func (p ShorthandSidesProp) IsShorthand() bool   { return true }
 
+// ShorthandCornersProp represents shorthand property, accepting top-left,
+// top-right, bottom-right, bottom-left values. Values are expanded the same way
+// as [ShorthandSidesProp], starting from top-left.
+//
+// Each corner value can be split by '/' in the middle of the shorthand value, so
+// parser for this type is not generated, and has to be written by hand(See
+// ParseMethodName).
+//
+// Examples: border-radius
+type ShorthandCornersProp struct {
+	Name            string  // Name of the property
+	PropTopLeft     CssProp // Top-left property
+	PropTopRight    CssProp // Top-right property
+	PropBottomRight CssProp // Bottom-right property
+	PropBottomLeft  CssProp // Bottom-left property
+	Inheritable     bool    // Can inherit?
+}
+
+// TypeName returns name that will be used to generate Go types for the shorthand type.
+func (p ShorthandCornersProp) TypeName(outsidePropsPkg bool) string {
+	prefix := ""
+	if outsidePropsPkg {
+		prefix = "props."
+	}
+	return fmt.Sprintf("%s%sShorthand", prefix, camelCaseName(p.Name, true))
+}
+
+// ParseMethodName returns name of parser function for the shorthand type.
+func (p ShorthandCornersProp) ParseMethodName() string {
+	return fmt.Sprintf("parse%s", camelCaseName(p.TypeName(false), true))
+}
+
+// Props returns properties of each corner, starting from top-left and going
+// clockwise.
+func (p ShorthandCornersProp) Props() [4]CssProp {
+	return [4]CssProp{p.PropTopLeft, p.PropTopRight, p.PropBottomRight, p.PropBottomLeft}
+}
+
+func (p ShorthandCornersProp) PropName() string { return p.Name }
+func (p ShorthandCornersProp) PropType(outsidePropsPkg bool) CssType {
+	return CssType{
+		TypeName:        p.TypeName(outsidePropsPkg),
+		ParseMethodName: p.ParseMethodName(),
+	}
+}
+func (p ShorthandCornersProp) PropInitialValue(outsidePropsPkg bool) string {
+	return fmt.Sprintf(
+		"%s{TopLeft: %s, TopRight: %s, BottomRight: %s, BottomLeft: %s}",
+		p.TypeName(outsidePropsPkg),
+		p.PropTopLeft.PropInitialValue(outsidePropsPkg),
+		p.PropTopRight.PropInitialValue(outsidePropsPkg),
+		p.PropBottomRight.PropInitialValue(outsidePropsPkg),
+		p.PropBottomLeft.PropInitialValue(outsidePropsPkg),
+	)
+}
+func (p ShorthandCornersProp) IsInheritable() bool { return p.Inheritable }
+func (p ShorthandCornersProp) IsShorthand() bool   { return true }
+
+// ShorthandPairProp represents shorthand property, accepting 1~2 values of the
+// same type:
+//
+//   - 1 value: <first-second> (Sets both at once)
+//   - 2 value: <first> <second>
+//
+// If SlashSeparated is set, values are separated by '/' instead:
+//
+//   - 1 value: <first> (Second one is set to its initial value)
+//   - 2 value: <first> / <second>
+//
+// Examples: gap, grid-row
+type ShorthandPairProp struct {
+	Name           string  // Name of the property
+	PropFirst      CssProp // First property
+	PropSecond     CssProp // Second property
+	Inheritable    bool    // Can inherit?
+	SlashSeparated bool    // Are values separated by '/'?
+}
+
+// TypeName returns name that will be used to generate Go types for the shorthand type.
+func (p ShorthandPairProp) TypeName(outsidePropsPkg bool) string {
+	prefix := ""
+	if outsidePropsPkg {
+		prefix = "props."
+	}
+	return fmt.Sprintf("%s%sShorthand", prefix, camelCaseName(p.Name, true))
+}
+
+// ParseMethodName returns name that will be used to generate parser function for the shorthand type.
+func (p ShorthandPairProp) ParseMethodName() string {
+	return fmt.Sprintf("parse%s", camelCaseName(p.TypeName(false), true))
+}
+
+func (p ShorthandPairProp) PropName() string { return p.Name }
+func (p ShorthandPairProp) PropType(outsidePropsPkg bool) CssType {
+	return CssType{
+		TypeName:        p.TypeName(outsidePropsPkg),
+		ParseMethodName: p.ParseMethodName(),
+	}
+}
+func (p ShorthandPairProp) PropInitialValue(outsidePropsPkg bool) string {
+	return fmt.Sprintf(
+		"%s{%s: %s, %s: %s}",
+		p.TypeName(outsidePropsPkg),
+		GoIdentNameOfProp(p.PropFirst), p.PropFirst.PropInitialValue(outsidePropsPkg),
+		GoIdentNameOfProp(p.PropSecond), p.PropSecond.PropInitialValue(outsidePropsPkg),
+	)
+}
+func (p ShorthandPairProp) IsInheritable() bool { return p.Inheritable }
+func (p ShorthandPairProp) IsShorthand() bool   { return true }
+
 // ShorthandAnyProp represents shorthand property, accepting any of accepted
 // types, in any order. If given value is missing values for certain properties,
 // they are filled with default value for the property.
@@ -187,6 +298,33 @@ func (p ShorthandAnyProp) PropInitialValue(outsidePropsPkg bool) string {
 func (p ShorthandAnyProp) IsInheritable() bool { return p.Inheritable }
 func (p ShorthandAnyProp) IsShorthand() bool   { return true }
 
+// ShorthandKeywordsProp is [ShorthandAnyProp], which also accepts keywords
+// setting all properties at once.
+//
+// Examples: white-space
+type ShorthandKeywordsProp struct {
+	ShorthandAnyProp
+	Keywords []ShorthandKeyword
+}
+
+// ShorthandKeyword is a keyword accepted by [ShorthandKeywordsProp].
+type ShorthandKeyword struct {
+	Keyword string   // The keyword
+	Values  []string // Values of properties (Go expression), in the same order as Props
+}
+
+// ShorthandLayersProp is [ShorthandAnyProp], whose value is comma-separated
+// list of layers instead. Each sub-property is a list with an item for each
+// layer.
+//
+// Layer syntax can't be described by sub-properties alone, so parser for this
+// type is not generated, and has to be written by hand(See ParseMethodName).
+//
+// Examples: background
+type ShorthandLayersProp struct {
+	ShorthandAnyProp
+}
+
 // Returns Go CamelCase identifier name for given CSS property's name.
 // For example, this turns "text-decoration-color" into TextDecorationColor.
 func GoIdentNameOfProp(prop CssProp) string {
@@ -0,0 +1,291 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package lists
+
+import (
+	"math"
+	"strings"
+)
+
+// System is the [counter system] of a counter style, which determines how
+// counter values are turned into strings using symbols.
+//
+// [counter system]: https://www.w3.org/TR/css-counter-styles-3/#counter-style-system
+type System uint8
+
+const (
+	CyclicSystem     System = iota // Cycles through symbols
+	NumericSystem                  // Place-value numbering system, using symbols as digits
+	AlphabeticSystem               // Like numeric, but without the zero digit (e.g. a, b, ..., z, aa, ab)
+	AdditiveSystem                 // Sign-value numbering system (e.g. roman numerals)
+)
+
+// AdditiveSymbol is a symbol representing Weight in additive counter styles.
+type AdditiveSymbol struct {
+	Weight int
+	Symbol string
+}
+
+// CounterStyle is a [counter style], which determines how counter values are
+// represented as strings.
+//
+// [counter style]: https://www.w3.org/TR/css-counter-styles-3/#counter-style
+type CounterStyle struct {
+	System          System
+	Symbols         []string         // Symbols of non-additive systems
+	AdditiveSymbols []AdditiveSymbol // Symbols of additive system, sorted by descending weight
+	Negative        string           // Prepended to representations of negative values
+	Prefix, Suffix  string           // Prepended and appended to representations in markers
+
+	// Range of values the counter style can represent. If it's not set, the
+	// range is determined by the system.
+	// https://www.w3.org/TR/css-counter-styles-3/#counter-style-range
+	HasRange           bool
+	RangeMin, RangeMax int
+}
+
+// newCounterStyle returns a counter style with default descriptors.
+// https://www.w3.org/TR/css-counter-styles-3/#the-counter-style-rule
+func newCounterStyle(system System, symbols ...string) *CounterStyle {
+	return &CounterStyle{System: system, Symbols: symbols, Negative: "-", Suffix: ". "}
+}
+
+// koreanAdditiveSymbols returns additive symbols for Korean numbering, where
+// digits are symbols for 0 to 9, and units are symbols for 10, 100, and 1000.
+// If omitOne is set, multiples of units that start with one omit the digit
+// (e.g. 十 instead of 一十).
+func koreanAdditiveSymbols(digits [10]string, units [3]string, omitOne bool) []AdditiveSymbol {
+	res := []AdditiveSymbol{}
+	for i := len(units) - 1; 0 <= i; i-- {
+		weight := int(math.Pow10(i + 1))
+		for d := 9; 1 <= d; d-- {
+			sym := digits[d] + units[i]
+			if d == 1 && omitOne {
+				sym = units[i]
+			}
+			res = append(res, AdditiveSymbol{d * weight, sym})
+		}
+	}
+	for d := 9; 0 <= d; d-- {
+		res = append(res, AdditiveSymbol{d, digits[d]})
+	}
+	return res
+}
+
+// newKoreanCounterStyle returns one of Korean counter styles using additive
+// symbols from [koreanAdditiveSymbols].
+// https://www.w3.org/TR/css-counter-styles-3/#korean-hangul-formal
+func newKoreanCounterStyle(digits [10]string, units [3]string, omitOne bool) *CounterStyle {
+	s := newCounterStyle(AdditiveSystem)
+	s.AdditiveSymbols = koreanAdditiveSymbols(digits, units, omitOne)
+	s.Negative = "마이너스 "
+	s.Suffix = ", "
+	s.HasRange, s.RangeMin, s.RangeMax = true, -9999, 9999
+	return s
+}
+
+// romanAdditiveSymbols returns additive symbols for roman numerals, using
+// lowercase letters if lower is set.
+func romanAdditiveSymbols(lower bool) []AdditiveSymbol {
+	res := []AdditiveSymbol{
+		{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+		{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+		{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+	}
+	if lower {
+		for i := range res {
+			res[i].Symbol = strings.ToLower(res[i].Symbol)
+		}
+	}
+	return res
+}
+
+// newRomanCounterStyle returns lower-roman or upper-roman counter style.
+// https://www.w3.org/TR/css-counter-styles-3/#simple-numeric
+func newRomanCounterStyle(lower bool) *CounterStyle {
+	s := newCounterStyle(AdditiveSystem)
+	s.AdditiveSymbols = romanAdditiveSymbols(lower)
+	s.HasRange, s.RangeMin, s.RangeMax = true, 1, 3999
+	return s
+}
+
+// newSymbolCounterStyle returns a cyclic counter style using single symbol,
+// which is used for bullets (e.g. disc).
+// https://www.w3.org/TR/css-counter-styles-3/#simple-symbolic
+func newSymbolCounterStyle(symbol string) *CounterStyle {
+	s := newCounterStyle(CyclicSystem, symbol)
+	s.Suffix = " "
+	return s
+}
+
+// lettersBetween returns letters from first to last.
+func lettersBetween(first, last rune) []string {
+	res := []string{}
+	for r := first; r <= last; r++ {
+		res = append(res, string(r))
+	}
+	return res
+}
+
+// decimalCounterStyle is the counter style used when others can't be used.
+// https://www.w3.org/TR/css-counter-styles-3/#decimal
+var decimalCounterStyle = newCounterStyle(NumericSystem, lettersBetween('0', '9')...)
+
+// predefinedCounterStyles is list of counter styles that we support, keyed by
+// their names.
+//
+// https://www.w3.org/TR/css-counter-styles-3/#predefined-counters
+var predefinedCounterStyles = map[string]*CounterStyle{
+	"decimal":           decimalCounterStyle,
+	"disc":              newSymbolCounterStyle("•"),
+	"circle":            newSymbolCounterStyle("◦"),
+	"square":            newSymbolCounterStyle("▪"),
+	"disclosure-open":   newSymbolCounterStyle("▾"),
+	"disclosure-closed": newSymbolCounterStyle("▸"),
+	"lower-alpha":       newCounterStyle(AlphabeticSystem, lettersBetween('a', 'z')...),
+	"lower-latin":       newCounterStyle(AlphabeticSystem, lettersBetween('a', 'z')...),
+	"upper-alpha":       newCounterStyle(AlphabeticSystem, lettersBetween('A', 'Z')...),
+	"upper-latin":       newCounterStyle(AlphabeticSystem, lettersBetween('A', 'Z')...),
+	// Final sigma(ς) is not used.
+	"lower-greek": newCounterStyle(AlphabeticSystem, append(lettersBetween('α', 'ρ'), lettersBetween('σ', 'ω')...)...),
+	"lower-roman": newRomanCounterStyle(true),
+	"upper-roman": newRomanCounterStyle(false),
+	// https://www.w3.org/TR/css-counter-styles-3/#korean-hangul-formal
+	"hangul":           newCounterStyle(AlphabeticSystem, "가", "나", "다", "라", "마", "바", "사", "아", "자", "차", "카", "타", "파", "하"),
+	"hangul-consonant": newCounterStyle(AlphabeticSystem, "ㄱ", "ㄴ", "ㄷ", "ㄹ", "ㅁ", "ㅂ", "ㅅ", "ㅇ", "ㅈ", "ㅊ", "ㅋ", "ㅌ", "ㅍ", "ㅎ"),
+	"korean-hangul-formal": newKoreanCounterStyle(
+		[10]string{"영", "일", "이", "삼", "사", "오", "육", "칠", "팔", "구"},
+		[3]string{"십", "백", "천"}, false,
+	),
+	"korean-hanja-informal": newKoreanCounterStyle(
+		[10]string{"零", "一", "二", "三", "四", "五", "六", "七", "八", "九"},
+		[3]string{"十", "百", "千"}, true,
+	),
+	"korean-hanja-formal": newKoreanCounterStyle(
+		[10]string{"零", "壹", "貳", "參", "四", "五", "六", "七", "八", "九"},
+		[3]string{"拾", "百", "仟"}, false,
+	),
+}
+
+// CounterStyleByName returns the counter style named name. If there's no such
+// counter style, decimal is returned, and ok is false.
+//
+// Spec: https://www.w3.org/TR/css-counter-styles-3/#counter-style-name
+func CounterStyleByName(name string) (s *CounterStyle, ok bool) {
+	if s, ok := predefinedCounterStyles[name]; ok {
+		return s, true
+	}
+	return decimalCounterStyle, false
+}
+
+// inRange reports whether value is in the range of the counter style.
+//
+// Spec: https://www.w3.org/TR/css-counter-styles-3/#counter-style-range
+func (s CounterStyle) inRange(value int) bool {
+	if s.HasRange {
+		return s.RangeMin <= value && value <= s.RangeMax
+	}
+	switch s.System {
+	case AlphabeticSystem:
+		return 1 <= value
+	case AdditiveSystem:
+		return 0 <= value
+	}
+	return true
+}
+
+// usesNegative reports whether the counter style represents negative values
+// using Negative.
+//
+// Spec: https://www.w3.org/TR/css-counter-styles-3/#counter-style-negative
+func (s CounterStyle) usesNegative() bool {
+	return s.System != CyclicSystem
+}
+
+// initialRepresentation returns representation of value, which must be
+// non-negative unless it's a cyclic counter style, using the counter system.
+// ok is false if the value can't be represented.
+//
+// Spec: https://www.w3.org/TR/css-counter-styles-3/#counter-style-system
+func (s CounterStyle) initialRepresentation(value int) (res string, ok bool) {
+	switch s.System {
+	case CyclicSystem:
+		// https://www.w3.org/TR/css-counter-styles-3/#cyclic-system
+		n := len(s.Symbols)
+		return s.Symbols[((value-1)%n+n)%n], true
+	case NumericSystem:
+		// https://www.w3.org/TR/css-counter-styles-3/#numeric-system
+		n := len(s.Symbols)
+		if value == 0 {
+			return s.Symbols[0], true
+		}
+		for value != 0 {
+			res = s.Symbols[value%n] + res
+			value /= n
+		}
+		return res, true
+	case AlphabeticSystem:
+		// https://www.w3.org/TR/css-counter-styles-3/#alphabetic-system
+		n := len(s.Symbols)
+		for value != 0 {
+			value--
+			res = s.Symbols[value%n] + res
+			value /= n
+		}
+		return res, true
+	case AdditiveSystem:
+		// https://www.w3.org/TR/css-counter-styles-3/#additive-system
+		sb := strings.Builder{}
+		for _, sym := range s.AdditiveSymbols {
+			if sym.Weight == 0 {
+				if value == 0 && sb.Len() == 0 {
+					return sym.Symbol, true
+				}
+				continue
+			}
+			for sym.Weight <= value {
+				sb.WriteString(sym.Symbol)
+				value -= sym.Weight
+			}
+		}
+		if value != 0 || sb.Len() == 0 {
+			return "", false
+		}
+		return sb.String(), true
+	}
+	return "", false
+}
+
+// Representation returns representation of the counter value using the
+// counter style. If the counter style can't represent it, decimal is used
+// instead.
+//
+// Spec: https://www.w3.org/TR/css-counter-styles-3/#generate-a-counter
+func (s CounterStyle) Representation(value int) string {
+	if !s.inRange(value) {
+		return decimalCounterStyle.Representation(value)
+	}
+	isNegative := value < 0 && s.usesNegative()
+	absValue := value
+	if isNegative {
+		absValue = -value
+	}
+	res, ok := s.initialRepresentation(absValue)
+	if !ok {
+		return decimalCounterStyle.Representation(value)
+	}
+	if isNegative {
+		res = s.Negative + res
+	}
+	return res
+}
+
+// MarkerRepresentation returns representation of the counter value used in
+// list item markers, which includes the prefix and the suffix.
+//
+// Spec: https://www.w3.org/TR/css-counter-styles-3/#counter-style-prefix
+func (s CounterStyle) MarkerRepresentation(value int) string {
+	return s.Prefix + s.Representation(value) + s.Suffix
+}
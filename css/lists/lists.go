@@ -0,0 +1,135 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+// Package lists provides types and values for [CSS Lists and Counters Module Level 3],
+// as well as predefined counter styles from [CSS Counter Styles Level 3].
+//
+// [CSS Lists and Counters Module Level 3]: https://www.w3.org/TR/css-lists-3/
+// [CSS Counter Styles Level 3]: https://www.w3.org/TR/css-counter-styles-3/
+package lists
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// StyleType represents value of [CSS list-style-type] property.
+//
+// [CSS list-style-type]: https://www.w3.org/TR/css-lists-3/#text-markers
+type StyleType struct {
+	Type  StyleTypeKind // Type of the marker
+	Value string        // Counter style name for CounterStyleType, and the string for StringStyleType
+}
+
+// Type of [StyleType]
+type StyleTypeKind uint8
+
+const (
+	NoStyleType      StyleTypeKind = iota // list-style-type: none
+	CounterStyleType                      // list-style-type: <counter-style>
+	StringStyleType                       // list-style-type: <string>
+)
+
+func (t StyleType) String() string {
+	switch t.Type {
+	case NoStyleType:
+		return "none"
+	case CounterStyleType:
+		return t.Value
+	case StringStyleType:
+		return strconv.Quote(t.Value)
+	}
+	return fmt.Sprintf("<bad StyleType type %d>", t.Type)
+}
+
+// Position represents value of [CSS list-style-position] property.
+//
+// [CSS list-style-position]: https://www.w3.org/TR/css-lists-3/#list-style-position-property
+type Position uint8
+
+const (
+	Outside Position = iota // list-style-position: outside
+	Inside                  // list-style-position: inside
+)
+
+func (p Position) String() string {
+	switch p {
+	case Outside:
+		return "outside"
+	case Inside:
+		return "inside"
+	}
+	return fmt.Sprintf("<bad Position %d>", p)
+}
+
+// Image represents value of [CSS list-style-image] property.
+//
+// TODO: Support <image> values.
+//
+// [CSS list-style-image]: https://www.w3.org/TR/css-lists-3/#image-markers
+type Image uint8
+
+const (
+	NoImage Image = iota // list-style-image: none
+)
+
+func (i Image) String() string {
+	switch i {
+	case NoImage:
+		return "none"
+	}
+	return fmt.Sprintf("<bad Image %d>", i)
+}
+
+// Counter is a single entry of [CSS counter-reset], [CSS counter-increment],
+// or [CSS counter-set] property.
+//
+// [CSS counter-reset]: https://www.w3.org/TR/css-lists-3/#counter-reset
+// [CSS counter-increment]: https://www.w3.org/TR/css-lists-3/#increment-set
+// [CSS counter-set]: https://www.w3.org/TR/css-lists-3/#increment-set
+type Counter struct {
+	Name       string // Name of the counter
+	Value      int    // Value to reset, increment, or set. If it was omitted, this is the default value of the property.
+	HasValue   bool   // Whether the value was given
+	IsReversed bool   // reversed(<counter-name>) in counter-reset
+}
+
+func (c Counter) String() string {
+	name := c.Name
+	if c.IsReversed {
+		name = fmt.Sprintf("reversed(%s)", c.Name)
+	}
+	if !c.HasValue {
+		return name
+	}
+	return fmt.Sprintf("%s %d", name, c.Value)
+}
+
+// Counters represents value of counter-reset, counter-increment, or
+// counter-set property. Empty Counters means none.
+type Counters []Counter
+
+func (c Counters) String() string {
+	if len(c) == 0 {
+		return "none"
+	}
+	strs := []string{}
+	for _, counter := range c {
+		strs = append(strs, counter.String())
+	}
+	return strings.Join(strs, " ")
+}
+
+// Find returns the last entry for the counter named name, if any.
+//
+// https://www.w3.org/TR/css-lists-3/#counter-reset
+func (c Counters) Find(name string) (Counter, bool) {
+	for i := len(c) - 1; 0 <= i; i-- {
+		if c[i].Name == name {
+			return c[i], true
+		}
+	}
+	return Counter{}, false
+}
@@ -0,0 +1,70 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+// Package overflow provides types and values for [CSS Overflow Module Level 3].
+//
+// [CSS Overflow Module Level 3]: https://www.w3.org/TR/css-overflow-3/
+package overflow
+
+import "fmt"
+
+// Overflow represents value of [CSS overflow-x and overflow-y] properties.
+//
+// [CSS overflow-x and overflow-y]: https://www.w3.org/TR/css-overflow-3/#overflow-control
+type Overflow uint8
+
+const (
+	Visible Overflow = iota // overflow: visible
+	Hidden                  // overflow: hidden
+	Clip                    // overflow: clip
+	Scroll                  // overflow: scroll
+	Auto                    // overflow: auto
+)
+
+func (o Overflow) String() string {
+	switch o {
+	case Visible:
+		return "visible"
+	case Hidden:
+		return "hidden"
+	case Clip:
+		return "clip"
+	case Scroll:
+		return "scroll"
+	case Auto:
+		return "auto"
+	}
+	return fmt.Sprintf("<bad Overflow %d>", o)
+}
+
+// IsScrollable reports whether the box with the overflow value is a scroll
+// container.
+func (o Overflow) IsScrollable() bool {
+	return o == Hidden || o == Scroll || o == Auto
+}
+
+// ClipsContents reports whether contents are clipped with the overflow value.
+func (o Overflow) ClipsContents() bool {
+	return o != Visible
+}
+
+// TextOverflow represents value of [CSS text-overflow] property.
+//
+// [CSS text-overflow]: https://www.w3.org/TR/css-overflow-3/#text-overflow
+type TextOverflow uint8
+
+const (
+	ClipText     TextOverflow = iota // text-overflow: clip
+	EllipsisText                     // text-overflow: ellipsis
+)
+
+func (o TextOverflow) String() string {
+	switch o {
+	case ClipText:
+		return "clip"
+	case EllipsisText:
+		return "ellipsis"
+	}
+	return fmt.Sprintf("<bad TextOverflow %d>", o)
+}
@@ -0,0 +1,88 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+// Package position provides types and values for [CSS Positioned Layout Module Level 3].
+//
+// [CSS Positioned Layout Module Level 3]: https://www.w3.org/TR/css-position-3/
+package position
+
+import (
+	"fmt"
+
+	"github.com/inseo-oh/yw/css/values"
+	"github.com/inseo-oh/yw/util"
+)
+
+// Position represents value of [CSS position] property.
+//
+// [CSS position]: https://www.w3.org/TR/css-position-3/#position-property
+type Position uint8
+
+const (
+	Static   Position = iota // position: static
+	Relative                 // position: relative
+	Sticky                   // position: sticky
+	Absolute                 // position: absolute
+	Fixed                    // position: fixed
+)
+
+// IsPositioned reports whether it's a [positioned box].
+//
+// [positioned box]: https://www.w3.org/TR/css-position-3/#positioned-box
+func (p Position) IsPositioned() bool { return p != Static }
+
+// IsAbsolutelyPositioned reports whether it's an [absolutely positioned box].
+//
+// [absolutely positioned box]: https://www.w3.org/TR/css-position-3/#absolute-position
+func (p Position) IsAbsolutelyPositioned() bool { return p == Absolute || p == Fixed }
+
+func (p Position) String() string {
+	switch p {
+	case Static:
+		return "static"
+	case Relative:
+		return "relative"
+	case Sticky:
+		return "sticky"
+	case Absolute:
+		return "absolute"
+	case Fixed:
+		return "fixed"
+	}
+	return fmt.Sprintf("<bad Position type %d>", p)
+}
+
+// Inset represents value of [CSS inset properties] (top, right, bottom, and left).
+//
+// Zero value for Inset means "auto".
+//
+// [CSS inset properties]: https://www.w3.org/TR/css-position-3/#insets
+type Inset struct {
+	Value values.LengthResolvable // nil means auto
+}
+
+// IsAuto reports whether it's auto inset.
+func (i Inset) IsAuto() bool { return util.IsNil(i.Value) }
+
+func (i Inset) String() string {
+	if i.IsAuto() {
+		return "auto"
+	}
+	return fmt.Sprintf("%v", i.Value)
+}
+
+// ZIndex represents value of [CSS z-index] property.
+//
+// [CSS z-index]: https://www.w3.org/TR/CSS2/visuren.html#z-index
+type ZIndex struct {
+	IsAuto bool // z-index: auto
+	Value  int  // Stack level, if it isn't auto
+}
+
+func (z ZIndex) String() string {
+	if z.IsAuto {
+		return "auto"
+	}
+	return fmt.Sprintf("%d", z.Value)
+}
@@ -19,16 +19,28 @@ import (
 var requiredImports = []string{
 	"fmt",
 	"github.com/inseo-oh/yw/util",
+	"github.com/inseo-oh/yw/css",
 	"github.com/inseo-oh/yw/css/csscolor",
 	"github.com/inseo-oh/yw/css/box",
 	"github.com/inseo-oh/yw/css/backgrounds",
+	"github.com/inseo-oh/yw/css/images",
 	"github.com/inseo-oh/yw/css/values",
 	"github.com/inseo-oh/yw/css/fonts",
 	"github.com/inseo-oh/yw/css/sizing",
 	"github.com/inseo-oh/yw/css/display",
 	"github.com/inseo-oh/yw/css/text",
+	"github.com/inseo-oh/yw/css/overflow",
 	"github.com/inseo-oh/yw/css/textdecor",
+	"github.com/inseo-oh/yw/css/content",
 	"github.com/inseo-oh/yw/css/float",
+	"github.com/inseo-oh/yw/css/position",
+	"github.com/inseo-oh/yw/css/flexbox",
+	"github.com/inseo-oh/yw/css/align",
+	"github.com/inseo-oh/yw/css/grid",
+	"github.com/inseo-oh/yw/css/tables",
+	"github.com/inseo-oh/yw/css/lists",
+	"github.com/inseo-oh/yw/css/writingmodes",
+	"github.com/inseo-oh/yw/css/inline",
 }
 
 var (
@@ -54,7 +66,7 @@ func main() {
 	// Define shorthand struct and parser functions ----------------------------
 	for _, prop := range propsdef.Props {
 		sbInner := strings.Builder{}
-		switch sh := prop.(type) {
+		switch sh := withoutKeywords(prop).(type) {
 		case propsdef.ShorthandSidesProp:
 			sbInner.WriteString(fmt.Sprintf("type %s struct {\n", sh.TypeName(false)))
 			sbInner.WriteString(fmt.Sprintf("\tTop    %s\n", sh.PropTop.PropType(false).TypeName))
@@ -66,6 +78,30 @@ func main() {
 			sbInner.WriteString(fmt.Sprintf("func (sh %s) String() string {\n", sh.TypeName(false)))
 			sbInner.WriteString( /*      */ "\treturn fmt.Sprintf(\"%v %v %v %v\", sh.Top, sh.Right, sh.Bottom, sh.Left)\n")
 			sbInner.WriteString( /*      */ "}\n\n")
+		case propsdef.ShorthandCornersProp:
+			sbInner.WriteString(fmt.Sprintf("type %s struct {\n", sh.TypeName(false)))
+			sbInner.WriteString(fmt.Sprintf("\tTopLeft     %s\n", sh.PropTopLeft.PropType(false).TypeName))
+			sbInner.WriteString(fmt.Sprintf("\tTopRight    %s\n", sh.PropTopRight.PropType(false).TypeName))
+			sbInner.WriteString(fmt.Sprintf("\tBottomRight %s\n", sh.PropBottomRight.PropType(false).TypeName))
+			sbInner.WriteString(fmt.Sprintf("\tBottomLeft  %s\n", sh.PropBottomLeft.PropType(false).TypeName))
+			sbInner.WriteString( /*      */ "}\n")
+			sbInner.WriteString( /*      */ "\n")
+			sbInner.WriteString(fmt.Sprintf("func (sh %s) String() string {\n", sh.TypeName(false)))
+			sbInner.WriteString( /*      */ "\treturn fmt.Sprintf(\"%v %v %v %v\", sh.TopLeft, sh.TopRight, sh.BottomRight, sh.BottomLeft)\n")
+			sbInner.WriteString( /*      */ "}\n\n")
+		case propsdef.ShorthandPairProp:
+			sbInner.WriteString(fmt.Sprintf("type %s struct {\n", sh.TypeName(false)))
+			sbInner.WriteString(fmt.Sprintf("\t%s %s\n", propsdef.GoIdentNameOfProp(sh.PropFirst), sh.PropFirst.PropType(false).TypeName))
+			sbInner.WriteString(fmt.Sprintf("\t%s %s\n", propsdef.GoIdentNameOfProp(sh.PropSecond), sh.PropSecond.PropType(false).TypeName))
+			sbInner.WriteString( /*      */ "}\n")
+			sbInner.WriteString( /*      */ "\n")
+			sbInner.WriteString(fmt.Sprintf("func (sh %s) String() string {\n", sh.TypeName(false)))
+			format := "%v %v"
+			if sh.SlashSeparated {
+				format = "%v / %v"
+			}
+			sbInner.WriteString(fmt.Sprintf("\treturn fmt.Sprintf(%s, sh.%s, sh.%s)\n", strconv.Quote(format), propsdef.GoIdentNameOfProp(sh.PropFirst), propsdef.GoIdentNameOfProp(sh.PropSecond)))
+			sbInner.WriteString( /*      */ "}\n\n")
 		case propsdef.ShorthandAnyProp:
 			sbInner.WriteString(fmt.Sprintf("type %s struct {\n", sh.TypeName(false)))
 			for _, prop := range sh.Props {
@@ -90,18 +126,7 @@ func main() {
 		sbInner.WriteString(fmt.Sprintf("\t\tInitial: %s,\n", prop.PropInitialValue(false)))
 		sbInner.WriteString( /*      */ "\t\tApplyFunc: func(dest *ComputedStyleSet, value any) {\n")
 		sbInner.WriteString(fmt.Sprintf("\t\t\tv := value.(%s)\n", prop.PropType(false).TypeName))
-		sbInner.WriteString(fmt.Sprintf("\t\t\tdest.%sValue = &v\n", propsdef.GoIdentNameOfProp(prop)))
-		switch sh := prop.(type) {
-		case propsdef.ShorthandSidesProp:
-			sbInner.WriteString(fmt.Sprintf("\t\t\tdest.%sValue = &v.Top\n", propsdef.GoIdentNameOfProp(sh.PropTop)))
-			sbInner.WriteString(fmt.Sprintf("\t\t\tdest.%sValue = &v.Right\n", propsdef.GoIdentNameOfProp(sh.PropRight)))
-			sbInner.WriteString(fmt.Sprintf("\t\t\tdest.%sValue = &v.Bottom\n", propsdef.GoIdentNameOfProp(sh.PropBottom)))
-			sbInner.WriteString(fmt.Sprintf("\t\t\tdest.%sValue = &v.Left\n", propsdef.GoIdentNameOfProp(sh.PropLeft)))
-		case propsdef.ShorthandAnyProp:
-			for _, shProp := range sh.Props {
-				sbInner.WriteString(fmt.Sprintf("\t\t\tdest.%sValue = &v.%s\n", propsdef.GoIdentNameOfProp(shProp), propsdef.GoIdentNameOfProp(shProp)))
-			}
-		}
+		writeApplyStatements(&sbInner, prop, "v")
 		sbInner.WriteString( /*      */ "\t\t},\n")
 		sbInner.WriteString( /*      */ "\t},\n")
 		sb.WriteString(sbInner.String())
@@ -132,12 +157,20 @@ func main() {
 			sbInner.WriteString(fmt.Sprintf("func (css *ComputedStyleSet) inherit%sFromParent(parentSrc ComputedStyleSetSource) {\n", propsdef.GoIdentNameOfProp(prop)))
 			sbInner.WriteString( /*      */ "\tparentCss := parentSrc.ComputedStyleSet()\n")
 			sbInner.WriteString(fmt.Sprintf("\tif !util.IsNil(parentCss.%sValue) {\n", propsdef.GoIdentNameOfProp(prop)))
-			switch sh := prop.(type) {
+			switch sh := withoutKeywords(prop).(type) {
 			case propsdef.ShorthandSidesProp:
 				sbInner.WriteString(fmt.Sprintf("\t\t\tcss.%sValue = &parentCss.%sValue.Top\n", propsdef.GoIdentNameOfProp(sh.PropTop), propsdef.GoIdentNameOfProp(prop)))
 				sbInner.WriteString(fmt.Sprintf("\t\t\tcss.%sValue = &parentCss.%sValue.Right\n", propsdef.GoIdentNameOfProp(sh.PropRight), propsdef.GoIdentNameOfProp(prop)))
 				sbInner.WriteString(fmt.Sprintf("\t\t\tcss.%sValue = &parentCss.%sValue.Bottom\n", propsdef.GoIdentNameOfProp(sh.PropBottom), propsdef.GoIdentNameOfProp(prop)))
 				sbInner.WriteString(fmt.Sprintf("\t\t\tcss.%sValue = &parentCss.%sValue.Left\n", propsdef.GoIdentNameOfProp(sh.PropLeft), propsdef.GoIdentNameOfProp(prop)))
+			case propsdef.ShorthandCornersProp:
+				for i, field := range cornerFieldNames {
+					sbInner.WriteString(fmt.Sprintf("\t\t\tcss.%sValue = &parentCss.%sValue.%s\n", propsdef.GoIdentNameOfProp(sh.Props()[i]), propsdef.GoIdentNameOfProp(prop), field))
+				}
+			case propsdef.ShorthandPairProp:
+				for _, shProp := range []propsdef.CssProp{sh.PropFirst, sh.PropSecond} {
+					sbInner.WriteString(fmt.Sprintf("\t\t\tcss.%sValue = &parentCss.%sValue.%s\n", propsdef.GoIdentNameOfProp(shProp), propsdef.GoIdentNameOfProp(prop), propsdef.GoIdentNameOfProp(shProp)))
+				}
 			case propsdef.ShorthandAnyProp:
 				for _, shProp := range sh.Props {
 					sbInner.WriteString(fmt.Sprintf("\t\t\tcss.%sValue = &parentCss.%sValue.%s\n", propsdef.GoIdentNameOfProp(shProp), propsdef.GoIdentNameOfProp(prop), propsdef.GoIdentNameOfProp(shProp)))
@@ -176,3 +209,45 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+// Field names of corners in shorthand types generated for
+// [propsdef.ShorthandCornersProp], in the same order as Props().
+var cornerFieldNames = [4]string{"TopLeft", "TopRight", "BottomRight", "BottomLeft"}
+
+// writeApplyStatements writes statements that set prop's value to valueExpr.
+// If prop is a shorthand, its sub-properties are set as well, including
+// ones inside nested shorthands(e.g. border -> border-width -> border-top-width).
+func writeApplyStatements(sb *strings.Builder, prop propsdef.CssProp, valueExpr string) {
+	sb.WriteString(fmt.Sprintf("\t\t\tdest.%sValue = &%s\n", propsdef.GoIdentNameOfProp(prop), valueExpr))
+	switch sh := withoutKeywords(prop).(type) {
+	case propsdef.ShorthandSidesProp:
+		writeApplyStatements(sb, sh.PropTop, valueExpr+".Top")
+		writeApplyStatements(sb, sh.PropRight, valueExpr+".Right")
+		writeApplyStatements(sb, sh.PropBottom, valueExpr+".Bottom")
+		writeApplyStatements(sb, sh.PropLeft, valueExpr+".Left")
+	case propsdef.ShorthandCornersProp:
+		for i, field := range cornerFieldNames {
+			writeApplyStatements(sb, sh.Props()[i], valueExpr+"."+field)
+		}
+	case propsdef.ShorthandPairProp:
+		writeApplyStatements(sb, sh.PropFirst, valueExpr+"."+propsdef.GoIdentNameOfProp(sh.PropFirst))
+		writeApplyStatements(sb, sh.PropSecond, valueExpr+"."+propsdef.GoIdentNameOfProp(sh.PropSecond))
+	case propsdef.ShorthandAnyProp:
+		for _, shProp := range sh.Props {
+			writeApplyStatements(sb, shProp, valueExpr+"."+propsdef.GoIdentNameOfProp(shProp))
+		}
+	}
+}
+
+// withoutKeywords returns the [propsdef.ShorthandAnyProp] inside prop if it's
+// [propsdef.ShorthandKeywordsProp] or [propsdef.ShorthandLayersProp], since
+// these only affect the parser. Otherwise prop is returned as-is.
+func withoutKeywords(prop propsdef.CssProp) propsdef.CssProp {
+	switch sh := prop.(type) {
+	case propsdef.ShorthandKeywordsProp:
+		return sh.ShorthandAnyProp
+	case propsdef.ShorthandLayersProp:
+		return sh.ShorthandAnyProp
+	}
+	return prop
+}
@@ -5,19 +5,53 @@ package props
 
 import (
 	"fmt"
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/align"
 	"github.com/inseo-oh/yw/css/backgrounds"
 	"github.com/inseo-oh/yw/css/box"
+	"github.com/inseo-oh/yw/css/content"
 	"github.com/inseo-oh/yw/css/csscolor"
 	"github.com/inseo-oh/yw/css/display"
+	"github.com/inseo-oh/yw/css/flexbox"
 	"github.com/inseo-oh/yw/css/float"
 	"github.com/inseo-oh/yw/css/fonts"
+	"github.com/inseo-oh/yw/css/grid"
+	"github.com/inseo-oh/yw/css/images"
+	"github.com/inseo-oh/yw/css/inline"
+	"github.com/inseo-oh/yw/css/lists"
+	"github.com/inseo-oh/yw/css/overflow"
+	"github.com/inseo-oh/yw/css/position"
 	"github.com/inseo-oh/yw/css/sizing"
+	"github.com/inseo-oh/yw/css/tables"
 	"github.com/inseo-oh/yw/css/text"
 	"github.com/inseo-oh/yw/css/textdecor"
 	"github.com/inseo-oh/yw/css/values"
+	"github.com/inseo-oh/yw/css/writingmodes"
 	"github.com/inseo-oh/yw/util"
 )
 
+type BackgroundShorthand struct {
+	BackgroundColor    csscolor.Color
+	BackgroundImage    backgrounds.ImageList
+	BackgroundPosition backgrounds.PositionList
+	BackgroundSize     backgrounds.SizeList
+	BackgroundRepeat   backgrounds.RepeatList
+	BackgroundOrigin   backgrounds.BoxList
+	BackgroundClip     backgrounds.BoxList
+}
+
+func (sh BackgroundShorthand) String() string {
+	return fmt.Sprintf("%v %v %v %v %v %v %v",
+		sh.BackgroundColor,
+		sh.BackgroundImage,
+		sh.BackgroundPosition,
+		sh.BackgroundSize,
+		sh.BackgroundRepeat,
+		sh.BackgroundOrigin,
+		sh.BackgroundClip,
+	)
+}
+
 type BorderColorShorthand struct {
 	Top    csscolor.Color
 	Right  csscolor.Color
@@ -121,6 +155,17 @@ func (sh BorderShorthand) String() string {
 	)
 }
 
+type BorderRadiusShorthand struct {
+	TopLeft     backgrounds.Radius
+	TopRight    backgrounds.Radius
+	BottomRight backgrounds.Radius
+	BottomLeft  backgrounds.Radius
+}
+
+func (sh BorderRadiusShorthand) String() string {
+	return fmt.Sprintf("%v %v %v %v", sh.TopLeft, sh.TopRight, sh.BottomRight, sh.BottomLeft)
+}
+
 type MarginShorthand struct {
 	Top    box.Margin
 	Right  box.Margin
@@ -145,7 +190,7 @@ func (sh PaddingShorthand) String() string {
 
 type FontShorthand struct {
 	FontFamily  fonts.FamilyList
-	FontWeight  fonts.Weight
+	FontWeight  fonts.WeightValue
 	FontStretch fonts.Stretch
 	FontStyle   fonts.Style
 	FontSize    fonts.Size
@@ -161,6 +206,18 @@ func (sh FontShorthand) String() string {
 	)
 }
 
+type WhiteSpaceShorthand struct {
+	WhiteSpaceCollapse text.WhiteSpaceCollapse
+	TextWrapMode       text.WrapMode
+}
+
+func (sh WhiteSpaceShorthand) String() string {
+	return fmt.Sprintf("%v %v",
+		sh.WhiteSpaceCollapse,
+		sh.TextWrapMode,
+	)
+}
+
 type TextDecorationShorthand struct {
 	TextDecorationLine  textdecor.LineFlags
 	TextDecorationStyle textdecor.Style
@@ -175,6 +232,79 @@ func (sh TextDecorationShorthand) String() string {
 	)
 }
 
+type OverflowShorthand struct {
+	OverflowX overflow.Overflow
+	OverflowY overflow.Overflow
+}
+
+func (sh OverflowShorthand) String() string {
+	return fmt.Sprintf("%v %v", sh.OverflowX, sh.OverflowY)
+}
+
+type InsetShorthand struct {
+	Top    position.Inset
+	Right  position.Inset
+	Bottom position.Inset
+	Left   position.Inset
+}
+
+func (sh InsetShorthand) String() string {
+	return fmt.Sprintf("%v %v %v %v", sh.Top, sh.Right, sh.Bottom, sh.Left)
+}
+
+type FlexFlowShorthand struct {
+	FlexDirection flexbox.Direction
+	FlexWrap      flexbox.Wrap
+}
+
+func (sh FlexFlowShorthand) String() string {
+	return fmt.Sprintf("%v %v",
+		sh.FlexDirection,
+		sh.FlexWrap,
+	)
+}
+
+type GapShorthand struct {
+	RowGap    align.Gap
+	ColumnGap align.Gap
+}
+
+func (sh GapShorthand) String() string {
+	return fmt.Sprintf("%v %v", sh.RowGap, sh.ColumnGap)
+}
+
+type GridRowShorthand struct {
+	GridRowStart grid.Line
+	GridRowEnd   grid.Line
+}
+
+func (sh GridRowShorthand) String() string {
+	return fmt.Sprintf("%v / %v", sh.GridRowStart, sh.GridRowEnd)
+}
+
+type GridColumnShorthand struct {
+	GridColumnStart grid.Line
+	GridColumnEnd   grid.Line
+}
+
+func (sh GridColumnShorthand) String() string {
+	return fmt.Sprintf("%v / %v", sh.GridColumnStart, sh.GridColumnEnd)
+}
+
+type ListStyleShorthand struct {
+	ListStylePosition lists.Position
+	ListStyleType     lists.StyleType
+	ListStyleImage    lists.Image
+}
+
+func (sh ListStyleShorthand) String() string {
+	return fmt.Sprintf("%v %v %v",
+		sh.ListStylePosition,
+		sh.ListStyleType,
+		sh.ListStyleImage,
+	)
+}
+
 var DescriptorsMap = map[string]Descriptor{
 	"color": {
 		Initial: csscolor.CanvasText,
@@ -183,6 +313,13 @@ var DescriptorsMap = map[string]Descriptor{
 			dest.ColorValue = &v
 		},
 	},
+	"opacity": {
+		Initial: csscolor.AlphaValue(1),
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(csscolor.AlphaValue)
+			dest.OpacityValue = &v
+		},
+	},
 	"width": {
 		Initial: sizing.Size{Type: sizing.Auto},
 		ApplyFunc: func(dest *ComputedStyleSet, value any) {
@@ -225,6 +362,13 @@ var DescriptorsMap = map[string]Descriptor{
 			dest.MaxHeightValue = &v
 		},
 	},
+	"box-sizing": {
+		Initial: sizing.ContentBox,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(sizing.BoxSizing)
+			dest.BoxSizingValue = &v
+		},
+	},
 	"display": {
 		Initial: display.Display{Mode: display.OuterInnerMode, OuterMode: display.Inline, InnerMode: display.Flow},
 		ApplyFunc: func(dest *ComputedStyleSet, value any) {
@@ -246,6 +390,62 @@ var DescriptorsMap = map[string]Descriptor{
 			dest.BackgroundColorValue = &v
 		},
 	},
+	"background-image": {
+		Initial: backgrounds.ImageList{images.NoImage{}},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(backgrounds.ImageList)
+			dest.BackgroundImageValue = &v
+		},
+	},
+	"background-position": {
+		Initial: backgrounds.PositionList{backgrounds.InitialPosition},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(backgrounds.PositionList)
+			dest.BackgroundPositionValue = &v
+		},
+	},
+	"background-size": {
+		Initial: backgrounds.SizeList{{Type: backgrounds.ExplicitSize}},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(backgrounds.SizeList)
+			dest.BackgroundSizeValue = &v
+		},
+	},
+	"background-repeat": {
+		Initial: backgrounds.RepeatList{{X: backgrounds.RepeatTile, Y: backgrounds.RepeatTile}},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(backgrounds.RepeatList)
+			dest.BackgroundRepeatValue = &v
+		},
+	},
+	"background-origin": {
+		Initial: backgrounds.BoxList{backgrounds.PaddingBox},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(backgrounds.BoxList)
+			dest.BackgroundOriginValue = &v
+		},
+	},
+	"background-clip": {
+		Initial: backgrounds.BoxList{backgrounds.BorderBox},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(backgrounds.BoxList)
+			dest.BackgroundClipValue = &v
+		},
+	},
+	"background": {
+		Initial: BackgroundShorthand{BackgroundColor: csscolor.Transparent, BackgroundImage: backgrounds.ImageList{images.NoImage{}}, BackgroundPosition: backgrounds.PositionList{backgrounds.InitialPosition}, BackgroundSize: backgrounds.SizeList{{Type: backgrounds.ExplicitSize}}, BackgroundRepeat: backgrounds.RepeatList{{X: backgrounds.RepeatTile, Y: backgrounds.RepeatTile}}, BackgroundOrigin: backgrounds.BoxList{backgrounds.PaddingBox}, BackgroundClip: backgrounds.BoxList{backgrounds.BorderBox}},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(BackgroundShorthand)
+			dest.BackgroundShorthandValue = &v
+			dest.BackgroundColorValue = &v.BackgroundColor
+			dest.BackgroundImageValue = &v.BackgroundImage
+			dest.BackgroundPositionValue = &v.BackgroundPosition
+			dest.BackgroundSizeValue = &v.BackgroundSize
+			dest.BackgroundRepeatValue = &v.BackgroundRepeat
+			dest.BackgroundOriginValue = &v.BackgroundOrigin
+			dest.BackgroundClipValue = &v.BackgroundClip
+		},
+	},
 	"border-top-color": {
 		Initial: csscolor.Color{Type: csscolor.CurrentColor},
 		ApplyFunc: func(dest *ComputedStyleSet, value any) {
@@ -409,8 +609,66 @@ var DescriptorsMap = map[string]Descriptor{
 			v := value.(BorderShorthand)
 			dest.BorderShorthandValue = &v
 			dest.BorderWidthShorthandValue = &v.BorderWidthShorthand
+			dest.BorderTopWidthValue = &v.BorderWidthShorthand.Top
+			dest.BorderRightWidthValue = &v.BorderWidthShorthand.Right
+			dest.BorderBottomWidthValue = &v.BorderWidthShorthand.Bottom
+			dest.BorderLeftWidthValue = &v.BorderWidthShorthand.Left
 			dest.BorderStyleShorthandValue = &v.BorderStyleShorthand
+			dest.BorderTopStyleValue = &v.BorderStyleShorthand.Top
+			dest.BorderRightStyleValue = &v.BorderStyleShorthand.Right
+			dest.BorderBottomStyleValue = &v.BorderStyleShorthand.Bottom
+			dest.BorderLeftStyleValue = &v.BorderStyleShorthand.Left
 			dest.BorderColorShorthandValue = &v.BorderColorShorthand
+			dest.BorderTopColorValue = &v.BorderColorShorthand.Top
+			dest.BorderRightColorValue = &v.BorderColorShorthand.Right
+			dest.BorderBottomColorValue = &v.BorderColorShorthand.Bottom
+			dest.BorderLeftColorValue = &v.BorderColorShorthand.Left
+		},
+	},
+	"border-top-left-radius": {
+		Initial: backgrounds.ZeroRadius,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(backgrounds.Radius)
+			dest.BorderTopLeftRadiusValue = &v
+		},
+	},
+	"border-top-right-radius": {
+		Initial: backgrounds.ZeroRadius,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(backgrounds.Radius)
+			dest.BorderTopRightRadiusValue = &v
+		},
+	},
+	"border-bottom-right-radius": {
+		Initial: backgrounds.ZeroRadius,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(backgrounds.Radius)
+			dest.BorderBottomRightRadiusValue = &v
+		},
+	},
+	"border-bottom-left-radius": {
+		Initial: backgrounds.ZeroRadius,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(backgrounds.Radius)
+			dest.BorderBottomLeftRadiusValue = &v
+		},
+	},
+	"border-radius": {
+		Initial: BorderRadiusShorthand{TopLeft: backgrounds.ZeroRadius, TopRight: backgrounds.ZeroRadius, BottomRight: backgrounds.ZeroRadius, BottomLeft: backgrounds.ZeroRadius},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(BorderRadiusShorthand)
+			dest.BorderRadiusShorthandValue = &v
+			dest.BorderTopLeftRadiusValue = &v.TopLeft
+			dest.BorderTopRightRadiusValue = &v.TopRight
+			dest.BorderBottomRightRadiusValue = &v.BottomRight
+			dest.BorderBottomLeftRadiusValue = &v.BottomLeft
+		},
+	},
+	"box-shadow": {
+		Initial: backgrounds.ShadowList(nil),
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(backgrounds.ShadowList)
+			dest.BoxShadowValue = &v
 		},
 	},
 	"margin-top": {
@@ -501,7 +759,7 @@ var DescriptorsMap = map[string]Descriptor{
 	"font-weight": {
 		Initial: fonts.NormalWeight,
 		ApplyFunc: func(dest *ComputedStyleSet, value any) {
-			v := value.(fonts.Weight)
+			v := value.(fonts.WeightValue)
 			dest.FontWeightValue = &v
 		},
 	},
@@ -545,6 +803,113 @@ var DescriptorsMap = map[string]Descriptor{
 			dest.TextTransformValue = &v
 		},
 	},
+	"word-break": {
+		Initial: text.NormalWordBreak,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(text.WordBreak)
+			dest.WordBreakValue = &v
+		},
+	},
+	"overflow-wrap": {
+		Initial: text.NormalOverflowWrap,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(text.OverflowWrap)
+			dest.OverflowWrapValue = &v
+		},
+	},
+	"line-break": {
+		Initial: text.AutoLineBreak,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(text.LineBreak)
+			dest.LineBreakValue = &v
+		},
+	},
+	"text-align": {
+		Initial: text.StartAlign,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(text.Align)
+			dest.TextAlignValue = &v
+		},
+	},
+	"text-indent": {
+		Initial: text.Indent{Value: values.LengthFromPx(0)},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(text.Indent)
+			dest.TextIndentValue = &v
+		},
+	},
+	"tab-size": {
+		Initial: text.TabSize{Type: text.NumberTabSize, Number: css.NumFromInt(8)},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(text.TabSize)
+			dest.TabSizeValue = &v
+		},
+	},
+	"white-space-collapse": {
+		Initial: text.CollapseWhiteSpace,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(text.WhiteSpaceCollapse)
+			dest.WhiteSpaceCollapseValue = &v
+		},
+	},
+	"text-wrap-mode": {
+		Initial: text.Wrap,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(text.WrapMode)
+			dest.TextWrapModeValue = &v
+		},
+	},
+	"white-space": {
+		Initial: WhiteSpaceShorthand{WhiteSpaceCollapse: text.CollapseWhiteSpace, TextWrapMode: text.Wrap},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(WhiteSpaceShorthand)
+			dest.WhiteSpaceShorthandValue = &v
+			dest.WhiteSpaceCollapseValue = &v.WhiteSpaceCollapse
+			dest.TextWrapModeValue = &v.TextWrapMode
+		},
+	},
+	"line-height": {
+		Initial: inline.LineHeight{Type: inline.NormalLineHeight},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(inline.LineHeight)
+			dest.LineHeightValue = &v
+		},
+	},
+	"vertical-align": {
+		Initial: inline.VerticalAlign{Type: inline.Baseline},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(inline.VerticalAlign)
+			dest.VerticalAlignValue = &v
+		},
+	},
+	"direction": {
+		Initial: writingmodes.Ltr,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(writingmodes.Direction)
+			dest.DirectionValue = &v
+		},
+	},
+	"unicode-bidi": {
+		Initial: writingmodes.NormalUnicodeBidi,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(writingmodes.UnicodeBidi)
+			dest.UnicodeBidiValue = &v
+		},
+	},
+	"writing-mode": {
+		Initial: writingmodes.HorizontalTb,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(writingmodes.WritingMode)
+			dest.WritingModeValue = &v
+		},
+	},
+	"text-orientation": {
+		Initial: writingmodes.Mixed,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(writingmodes.TextOrientation)
+			dest.TextOrientationValue = &v
+		},
+	},
 	"text-decoration-line": {
 		Initial: textdecor.NoLine,
 		ApplyFunc: func(dest *ComputedStyleSet, value any) {
@@ -590,19 +955,367 @@ var DescriptorsMap = map[string]Descriptor{
 			dest.FloatValue = &v
 		},
 	},
+	"z-index": {
+		Initial: position.ZIndex{IsAuto: true},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(position.ZIndex)
+			dest.ZIndexValue = &v
+		},
+	},
+	"overflow-x": {
+		Initial: overflow.Visible,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(overflow.Overflow)
+			dest.OverflowXValue = &v
+		},
+	},
+	"overflow-y": {
+		Initial: overflow.Visible,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(overflow.Overflow)
+			dest.OverflowYValue = &v
+		},
+	},
+	"overflow": {
+		Initial: OverflowShorthand{OverflowX: overflow.Visible, OverflowY: overflow.Visible},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(OverflowShorthand)
+			dest.OverflowShorthandValue = &v
+			dest.OverflowXValue = &v.OverflowX
+			dest.OverflowYValue = &v.OverflowY
+		},
+	},
+	"text-overflow": {
+		Initial: overflow.ClipText,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(overflow.TextOverflow)
+			dest.TextOverflowValue = &v
+		},
+	},
+	"position": {
+		Initial: position.Static,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(position.Position)
+			dest.PositionValue = &v
+		},
+	},
+	"top": {
+		Initial: position.Inset{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(position.Inset)
+			dest.TopValue = &v
+		},
+	},
+	"right": {
+		Initial: position.Inset{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(position.Inset)
+			dest.RightValue = &v
+		},
+	},
+	"bottom": {
+		Initial: position.Inset{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(position.Inset)
+			dest.BottomValue = &v
+		},
+	},
+	"left": {
+		Initial: position.Inset{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(position.Inset)
+			dest.LeftValue = &v
+		},
+	},
+	"inset": {
+		Initial: InsetShorthand{Left: position.Inset{}, Top: position.Inset{}, Right: position.Inset{}, Bottom: position.Inset{}},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(InsetShorthand)
+			dest.InsetShorthandValue = &v
+			dest.TopValue = &v.Top
+			dest.RightValue = &v.Right
+			dest.BottomValue = &v.Bottom
+			dest.LeftValue = &v.Left
+		},
+	},
+	"flex-direction": {
+		Initial: flexbox.Row,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(flexbox.Direction)
+			dest.FlexDirectionValue = &v
+		},
+	},
+	"flex-wrap": {
+		Initial: flexbox.NoWrap,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(flexbox.Wrap)
+			dest.FlexWrapValue = &v
+		},
+	},
+	"flex-flow": {
+		Initial: FlexFlowShorthand{FlexDirection: flexbox.Row, FlexWrap: flexbox.NoWrap},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(FlexFlowShorthand)
+			dest.FlexFlowShorthandValue = &v
+			dest.FlexDirectionValue = &v.FlexDirection
+			dest.FlexWrapValue = &v.FlexWrap
+		},
+	},
+	"flex-grow": {
+		Initial: flexbox.Factor(0),
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(flexbox.Factor)
+			dest.FlexGrowValue = &v
+		},
+	},
+	"flex-shrink": {
+		Initial: flexbox.Factor(1),
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(flexbox.Factor)
+			dest.FlexShrinkValue = &v
+		},
+	},
+	"flex-basis": {
+		Initial: sizing.Size{Type: sizing.Auto},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(sizing.Size)
+			dest.FlexBasisValue = &v
+		},
+	},
+	"justify-content": {
+		Initial: align.ContentNormal,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(align.Content)
+			dest.JustifyContentValue = &v
+		},
+	},
+	"align-items": {
+		Initial: align.ItemNormal,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(align.Item)
+			dest.AlignItemsValue = &v
+		},
+	},
+	"align-self": {
+		Initial: align.ItemAuto,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(align.Item)
+			dest.AlignSelfValue = &v
+		},
+	},
+	"row-gap": {
+		Initial: align.Gap{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(align.Gap)
+			dest.RowGapValue = &v
+		},
+	},
+	"column-gap": {
+		Initial: align.Gap{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(align.Gap)
+			dest.ColumnGapValue = &v
+		},
+	},
+	"gap": {
+		Initial: GapShorthand{RowGap: align.Gap{}, ColumnGap: align.Gap{}},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(GapShorthand)
+			dest.GapShorthandValue = &v
+			dest.RowGapValue = &v.RowGap
+			dest.ColumnGapValue = &v.ColumnGap
+		},
+	},
+	"grid-template-columns": {
+		Initial: grid.TrackList{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(grid.TrackList)
+			dest.GridTemplateColumnsValue = &v
+		},
+	},
+	"grid-template-rows": {
+		Initial: grid.TrackList{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(grid.TrackList)
+			dest.GridTemplateRowsValue = &v
+		},
+	},
+	"grid-auto-columns": {
+		Initial: grid.TrackSize{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(grid.TrackSize)
+			dest.GridAutoColumnsValue = &v
+		},
+	},
+	"grid-auto-rows": {
+		Initial: grid.TrackSize{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(grid.TrackSize)
+			dest.GridAutoRowsValue = &v
+		},
+	},
+	"grid-auto-flow": {
+		Initial: grid.AutoFlow{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(grid.AutoFlow)
+			dest.GridAutoFlowValue = &v
+		},
+	},
+	"grid-row-start": {
+		Initial: grid.Line{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(grid.Line)
+			dest.GridRowStartValue = &v
+		},
+	},
+	"grid-row-end": {
+		Initial: grid.Line{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(grid.Line)
+			dest.GridRowEndValue = &v
+		},
+	},
+	"grid-column-start": {
+		Initial: grid.Line{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(grid.Line)
+			dest.GridColumnStartValue = &v
+		},
+	},
+	"grid-column-end": {
+		Initial: grid.Line{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(grid.Line)
+			dest.GridColumnEndValue = &v
+		},
+	},
+	"grid-row": {
+		Initial: GridRowShorthand{GridRowStart: grid.Line{}, GridRowEnd: grid.Line{}},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(GridRowShorthand)
+			dest.GridRowShorthandValue = &v
+			dest.GridRowStartValue = &v.GridRowStart
+			dest.GridRowEndValue = &v.GridRowEnd
+		},
+	},
+	"grid-column": {
+		Initial: GridColumnShorthand{GridColumnStart: grid.Line{}, GridColumnEnd: grid.Line{}},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(GridColumnShorthand)
+			dest.GridColumnShorthandValue = &v
+			dest.GridColumnStartValue = &v.GridColumnStart
+			dest.GridColumnEndValue = &v.GridColumnEnd
+		},
+	},
+	"table-layout": {
+		Initial: tables.AutoLayout,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(tables.Layout)
+			dest.TableLayoutValue = &v
+		},
+	},
+	"border-collapse": {
+		Initial: tables.Separate,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(tables.BorderCollapse)
+			dest.BorderCollapseValue = &v
+		},
+	},
+	"border-spacing": {
+		Initial: tables.BorderSpacing{Horizontal: values.LengthFromPx(0), Vertical: values.LengthFromPx(0)},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(tables.BorderSpacing)
+			dest.BorderSpacingValue = &v
+		},
+	},
+	"caption-side": {
+		Initial: tables.CaptionTop,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(tables.CaptionSide)
+			dest.CaptionSideValue = &v
+		},
+	},
+	"list-style-image": {
+		Initial: lists.NoImage,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(lists.Image)
+			dest.ListStyleImageValue = &v
+		},
+	},
+	"list-style-type": {
+		Initial: lists.StyleType{Type: lists.CounterStyleType, Value: "disc"},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(lists.StyleType)
+			dest.ListStyleTypeValue = &v
+		},
+	},
+	"list-style-position": {
+		Initial: lists.Outside,
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(lists.Position)
+			dest.ListStylePositionValue = &v
+		},
+	},
+	"list-style": {
+		Initial: ListStyleShorthand{ListStylePosition: lists.Outside, ListStyleType: lists.StyleType{Type: lists.CounterStyleType, Value: "disc"}, ListStyleImage: lists.NoImage},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(ListStyleShorthand)
+			dest.ListStyleShorthandValue = &v
+			dest.ListStylePositionValue = &v.ListStylePosition
+			dest.ListStyleTypeValue = &v.ListStyleType
+			dest.ListStyleImageValue = &v.ListStyleImage
+		},
+	},
+	"counter-reset": {
+		Initial: lists.Counters{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(lists.Counters)
+			dest.CounterResetValue = &v
+		},
+	},
+	"counter-increment": {
+		Initial: lists.Counters{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(lists.Counters)
+			dest.CounterIncrementValue = &v
+		},
+	},
+	"counter-set": {
+		Initial: lists.Counters{},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(lists.Counters)
+			dest.CounterSetValue = &v
+		},
+	},
+	"content": {
+		Initial: content.Content{Type: content.Normal},
+		ApplyFunc: func(dest *ComputedStyleSet, value any) {
+			v := value.(content.Content)
+			dest.ContentValue = &v
+		},
+	},
 }
 
 type ComputedStyleSet struct {
 	ColorValue                   *csscolor.Color
+	OpacityValue                 *csscolor.AlphaValue
 	WidthValue                   *sizing.Size
 	HeightValue                  *sizing.Size
 	MinWidthValue                *sizing.Size
 	MinHeightValue               *sizing.Size
 	MaxWidthValue                *sizing.Size
 	MaxHeightValue               *sizing.Size
+	BoxSizingValue               *sizing.BoxSizing
 	DisplayValue                 *display.Display
 	VisibilityValue              *display.Visibility
 	BackgroundColorValue         *csscolor.Color
+	BackgroundImageValue         *backgrounds.ImageList
+	BackgroundPositionValue      *backgrounds.PositionList
+	BackgroundSizeValue          *backgrounds.SizeList
+	BackgroundRepeatValue        *backgrounds.RepeatList
+	BackgroundOriginValue        *backgrounds.BoxList
+	BackgroundClipValue          *backgrounds.BoxList
+	BackgroundShorthandValue     *BackgroundShorthand
 	BorderTopColorValue          *csscolor.Color
 	BorderRightColorValue        *csscolor.Color
 	BorderBottomColorValue       *csscolor.Color
@@ -623,6 +1336,12 @@ type ComputedStyleSet struct {
 	BorderBottomShorthandValue   *BorderBottomShorthand
 	BorderLeftShorthandValue     *BorderLeftShorthand
 	BorderShorthandValue         *BorderShorthand
+	BorderTopLeftRadiusValue     *backgrounds.Radius
+	BorderTopRightRadiusValue    *backgrounds.Radius
+	BorderBottomRightRadiusValue *backgrounds.Radius
+	BorderBottomLeftRadiusValue  *backgrounds.Radius
+	BorderRadiusShorthandValue   *BorderRadiusShorthand
+	BoxShadowValue               *backgrounds.ShadowList
 	MarginTopValue               *box.Margin
 	MarginRightValue             *box.Margin
 	MarginBottomValue            *box.Margin
@@ -634,18 +1353,79 @@ type ComputedStyleSet struct {
 	PaddingLeftValue             *values.LengthResolvable
 	PaddingShorthandValue        *PaddingShorthand
 	FontFamilyValue              *fonts.FamilyList
-	FontWeightValue              *fonts.Weight
+	FontWeightValue              *fonts.WeightValue
 	FontStretchValue             *fonts.Stretch
 	FontStyleValue               *fonts.Style
 	FontSizeValue                *fonts.Size
 	FontShorthandValue           *FontShorthand
 	TextTransformValue           *text.Transform
+	WordBreakValue               *text.WordBreak
+	OverflowWrapValue            *text.OverflowWrap
+	LineBreakValue               *text.LineBreak
+	TextAlignValue               *text.Align
+	TextIndentValue              *text.Indent
+	TabSizeValue                 *text.TabSize
+	WhiteSpaceCollapseValue      *text.WhiteSpaceCollapse
+	TextWrapModeValue            *text.WrapMode
+	WhiteSpaceShorthandValue     *WhiteSpaceShorthand
+	LineHeightValue              *inline.LineHeight
+	VerticalAlignValue           *inline.VerticalAlign
+	DirectionValue               *writingmodes.Direction
+	UnicodeBidiValue             *writingmodes.UnicodeBidi
+	WritingModeValue             *writingmodes.WritingMode
+	TextOrientationValue         *writingmodes.TextOrientation
 	TextDecorationLineValue      *textdecor.LineFlags
 	TextDecorationStyleValue     *textdecor.Style
 	TextDecorationColorValue     *csscolor.Color
 	TextDecorationShorthandValue *TextDecorationShorthand
 	TextUnderlinePositionValue   *textdecor.PositionFlags
 	FloatValue                   *float.Float
+	ZIndexValue                  *position.ZIndex
+	OverflowXValue               *overflow.Overflow
+	OverflowYValue               *overflow.Overflow
+	OverflowShorthandValue       *OverflowShorthand
+	TextOverflowValue            *overflow.TextOverflow
+	PositionValue                *position.Position
+	TopValue                     *position.Inset
+	RightValue                   *position.Inset
+	BottomValue                  *position.Inset
+	LeftValue                    *position.Inset
+	InsetShorthandValue          *InsetShorthand
+	FlexDirectionValue           *flexbox.Direction
+	FlexWrapValue                *flexbox.Wrap
+	FlexFlowShorthandValue       *FlexFlowShorthand
+	FlexGrowValue                *flexbox.Factor
+	FlexShrinkValue              *flexbox.Factor
+	FlexBasisValue               *sizing.Size
+	JustifyContentValue          *align.Content
+	AlignItemsValue              *align.Item
+	AlignSelfValue               *align.Item
+	RowGapValue                  *align.Gap
+	ColumnGapValue               *align.Gap
+	GapShorthandValue            *GapShorthand
+	GridTemplateColumnsValue     *grid.TrackList
+	GridTemplateRowsValue        *grid.TrackList
+	GridAutoColumnsValue         *grid.TrackSize
+	GridAutoRowsValue            *grid.TrackSize
+	GridAutoFlowValue            *grid.AutoFlow
+	GridRowStartValue            *grid.Line
+	GridRowEndValue              *grid.Line
+	GridColumnStartValue         *grid.Line
+	GridColumnEndValue           *grid.Line
+	GridRowShorthandValue        *GridRowShorthand
+	GridColumnShorthandValue     *GridColumnShorthand
+	TableLayoutValue             *tables.Layout
+	BorderCollapseValue          *tables.BorderCollapse
+	BorderSpacingValue           *tables.BorderSpacing
+	CaptionSideValue             *tables.CaptionSide
+	ListStyleImageValue          *lists.Image
+	ListStyleTypeValue           *lists.StyleType
+	ListStylePositionValue       *lists.Position
+	ListStyleShorthandValue      *ListStyleShorthand
+	CounterResetValue            *lists.Counters
+	CounterIncrementValue        *lists.Counters
+	CounterSetValue              *lists.Counters
+	ContentValue                 *content.Content
 }
 
 func (css *ComputedStyleSet) Color() csscolor.Color {
@@ -663,6 +1443,13 @@ func (css *ComputedStyleSet) inheritColorFromParent(parentSrc ComputedStyleSetSo
 		css.inheritColorFromParent(parentParentSrc)
 	}
 }
+func (css *ComputedStyleSet) Opacity() csscolor.AlphaValue {
+	if css.OpacityValue == nil {
+		initial := DescriptorsMap["opacity"].Initial.(csscolor.AlphaValue)
+		css.OpacityValue = &initial
+	}
+	return *css.OpacityValue
+}
 func (css *ComputedStyleSet) Width() sizing.Size {
 	if css.WidthValue == nil {
 		initial := DescriptorsMap["width"].Initial.(sizing.Size)
@@ -705,6 +1492,13 @@ func (css *ComputedStyleSet) MaxHeight() sizing.Size {
 	}
 	return *css.MaxHeightValue
 }
+func (css *ComputedStyleSet) BoxSizing() sizing.BoxSizing {
+	if css.BoxSizingValue == nil {
+		initial := DescriptorsMap["box-sizing"].Initial.(sizing.BoxSizing)
+		css.BoxSizingValue = &initial
+	}
+	return *css.BoxSizingValue
+}
 func (css *ComputedStyleSet) Display() display.Display {
 	if css.DisplayValue == nil {
 		initial := DescriptorsMap["display"].Initial.(display.Display)
@@ -734,6 +1528,48 @@ func (css *ComputedStyleSet) BackgroundColor() csscolor.Color {
 	}
 	return *css.BackgroundColorValue
 }
+func (css *ComputedStyleSet) BackgroundImage() backgrounds.ImageList {
+	if css.BackgroundImageValue == nil {
+		initial := DescriptorsMap["background-image"].Initial.(backgrounds.ImageList)
+		css.BackgroundImageValue = &initial
+	}
+	return *css.BackgroundImageValue
+}
+func (css *ComputedStyleSet) BackgroundPosition() backgrounds.PositionList {
+	if css.BackgroundPositionValue == nil {
+		initial := DescriptorsMap["background-position"].Initial.(backgrounds.PositionList)
+		css.BackgroundPositionValue = &initial
+	}
+	return *css.BackgroundPositionValue
+}
+func (css *ComputedStyleSet) BackgroundSize() backgrounds.SizeList {
+	if css.BackgroundSizeValue == nil {
+		initial := DescriptorsMap["background-size"].Initial.(backgrounds.SizeList)
+		css.BackgroundSizeValue = &initial
+	}
+	return *css.BackgroundSizeValue
+}
+func (css *ComputedStyleSet) BackgroundRepeat() backgrounds.RepeatList {
+	if css.BackgroundRepeatValue == nil {
+		initial := DescriptorsMap["background-repeat"].Initial.(backgrounds.RepeatList)
+		css.BackgroundRepeatValue = &initial
+	}
+	return *css.BackgroundRepeatValue
+}
+func (css *ComputedStyleSet) BackgroundOrigin() backgrounds.BoxList {
+	if css.BackgroundOriginValue == nil {
+		initial := DescriptorsMap["background-origin"].Initial.(backgrounds.BoxList)
+		css.BackgroundOriginValue = &initial
+	}
+	return *css.BackgroundOriginValue
+}
+func (css *ComputedStyleSet) BackgroundClip() backgrounds.BoxList {
+	if css.BackgroundClipValue == nil {
+		initial := DescriptorsMap["background-clip"].Initial.(backgrounds.BoxList)
+		css.BackgroundClipValue = &initial
+	}
+	return *css.BackgroundClipValue
+}
 func (css *ComputedStyleSet) BorderTopColor() csscolor.Color {
 	if css.BorderTopColorValue == nil {
 		initial := DescriptorsMap["border-top-color"].Initial.(csscolor.Color)
@@ -818,6 +1654,41 @@ func (css *ComputedStyleSet) BorderLeftWidth() values.Length {
 	}
 	return *css.BorderLeftWidthValue
 }
+func (css *ComputedStyleSet) BorderTopLeftRadius() backgrounds.Radius {
+	if css.BorderTopLeftRadiusValue == nil {
+		initial := DescriptorsMap["border-top-left-radius"].Initial.(backgrounds.Radius)
+		css.BorderTopLeftRadiusValue = &initial
+	}
+	return *css.BorderTopLeftRadiusValue
+}
+func (css *ComputedStyleSet) BorderTopRightRadius() backgrounds.Radius {
+	if css.BorderTopRightRadiusValue == nil {
+		initial := DescriptorsMap["border-top-right-radius"].Initial.(backgrounds.Radius)
+		css.BorderTopRightRadiusValue = &initial
+	}
+	return *css.BorderTopRightRadiusValue
+}
+func (css *ComputedStyleSet) BorderBottomRightRadius() backgrounds.Radius {
+	if css.BorderBottomRightRadiusValue == nil {
+		initial := DescriptorsMap["border-bottom-right-radius"].Initial.(backgrounds.Radius)
+		css.BorderBottomRightRadiusValue = &initial
+	}
+	return *css.BorderBottomRightRadiusValue
+}
+func (css *ComputedStyleSet) BorderBottomLeftRadius() backgrounds.Radius {
+	if css.BorderBottomLeftRadiusValue == nil {
+		initial := DescriptorsMap["border-bottom-left-radius"].Initial.(backgrounds.Radius)
+		css.BorderBottomLeftRadiusValue = &initial
+	}
+	return *css.BorderBottomLeftRadiusValue
+}
+func (css *ComputedStyleSet) BoxShadow() backgrounds.ShadowList {
+	if css.BoxShadowValue == nil {
+		initial := DescriptorsMap["box-shadow"].Initial.(backgrounds.ShadowList)
+		css.BoxShadowValue = &initial
+	}
+	return *css.BoxShadowValue
+}
 func (css *ComputedStyleSet) MarginTop() box.Margin {
 	if css.MarginTopValue == nil {
 		initial := DescriptorsMap["margin-top"].Initial.(box.Margin)
@@ -889,9 +1760,9 @@ func (css *ComputedStyleSet) inheritFontFamilyFromParent(parentSrc ComputedStyle
 		css.inheritFontFamilyFromParent(parentParentSrc)
 	}
 }
-func (css *ComputedStyleSet) FontWeight() fonts.Weight {
+func (css *ComputedStyleSet) FontWeight() fonts.WeightValue {
 	if css.FontWeightValue == nil {
-		initial := DescriptorsMap["font-weight"].Initial.(fonts.Weight)
+		initial := DescriptorsMap["font-weight"].Initial.(fonts.WeightValue)
 		css.FontWeightValue = &initial
 	}
 	return *css.FontWeightValue
@@ -977,6 +1848,210 @@ func (css *ComputedStyleSet) inheritTextTransformFromParent(parentSrc ComputedSt
 		css.inheritTextTransformFromParent(parentParentSrc)
 	}
 }
+func (css *ComputedStyleSet) WordBreak() text.WordBreak {
+	if css.WordBreakValue == nil {
+		initial := DescriptorsMap["word-break"].Initial.(text.WordBreak)
+		css.WordBreakValue = &initial
+	}
+	return *css.WordBreakValue
+}
+func (css *ComputedStyleSet) inheritWordBreakFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.WordBreakValue) {
+		css.WordBreakValue = parentCss.WordBreakValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritWordBreakFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) OverflowWrap() text.OverflowWrap {
+	if css.OverflowWrapValue == nil {
+		initial := DescriptorsMap["overflow-wrap"].Initial.(text.OverflowWrap)
+		css.OverflowWrapValue = &initial
+	}
+	return *css.OverflowWrapValue
+}
+func (css *ComputedStyleSet) inheritOverflowWrapFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.OverflowWrapValue) {
+		css.OverflowWrapValue = parentCss.OverflowWrapValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritOverflowWrapFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) LineBreak() text.LineBreak {
+	if css.LineBreakValue == nil {
+		initial := DescriptorsMap["line-break"].Initial.(text.LineBreak)
+		css.LineBreakValue = &initial
+	}
+	return *css.LineBreakValue
+}
+func (css *ComputedStyleSet) inheritLineBreakFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.LineBreakValue) {
+		css.LineBreakValue = parentCss.LineBreakValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritLineBreakFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) TextAlign() text.Align {
+	if css.TextAlignValue == nil {
+		initial := DescriptorsMap["text-align"].Initial.(text.Align)
+		css.TextAlignValue = &initial
+	}
+	return *css.TextAlignValue
+}
+func (css *ComputedStyleSet) inheritTextAlignFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.TextAlignValue) {
+		css.TextAlignValue = parentCss.TextAlignValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritTextAlignFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) TextIndent() text.Indent {
+	if css.TextIndentValue == nil {
+		initial := DescriptorsMap["text-indent"].Initial.(text.Indent)
+		css.TextIndentValue = &initial
+	}
+	return *css.TextIndentValue
+}
+func (css *ComputedStyleSet) inheritTextIndentFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.TextIndentValue) {
+		css.TextIndentValue = parentCss.TextIndentValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritTextIndentFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) TabSize() text.TabSize {
+	if css.TabSizeValue == nil {
+		initial := DescriptorsMap["tab-size"].Initial.(text.TabSize)
+		css.TabSizeValue = &initial
+	}
+	return *css.TabSizeValue
+}
+func (css *ComputedStyleSet) inheritTabSizeFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.TabSizeValue) {
+		css.TabSizeValue = parentCss.TabSizeValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritTabSizeFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) WhiteSpaceCollapse() text.WhiteSpaceCollapse {
+	if css.WhiteSpaceCollapseValue == nil {
+		initial := DescriptorsMap["white-space-collapse"].Initial.(text.WhiteSpaceCollapse)
+		css.WhiteSpaceCollapseValue = &initial
+	}
+	return *css.WhiteSpaceCollapseValue
+}
+func (css *ComputedStyleSet) inheritWhiteSpaceCollapseFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.WhiteSpaceCollapseValue) {
+		css.WhiteSpaceCollapseValue = parentCss.WhiteSpaceCollapseValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritWhiteSpaceCollapseFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) TextWrapMode() text.WrapMode {
+	if css.TextWrapModeValue == nil {
+		initial := DescriptorsMap["text-wrap-mode"].Initial.(text.WrapMode)
+		css.TextWrapModeValue = &initial
+	}
+	return *css.TextWrapModeValue
+}
+func (css *ComputedStyleSet) inheritTextWrapModeFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.TextWrapModeValue) {
+		css.TextWrapModeValue = parentCss.TextWrapModeValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritTextWrapModeFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) inheritWhiteSpaceShorthandFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.WhiteSpaceShorthandValue) {
+		css.WhiteSpaceCollapseValue = &parentCss.WhiteSpaceShorthandValue.WhiteSpaceCollapse
+		css.TextWrapModeValue = &parentCss.WhiteSpaceShorthandValue.TextWrapMode
+		css.WhiteSpaceShorthandValue = parentCss.WhiteSpaceShorthandValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritWhiteSpaceShorthandFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) LineHeight() inline.LineHeight {
+	if css.LineHeightValue == nil {
+		initial := DescriptorsMap["line-height"].Initial.(inline.LineHeight)
+		css.LineHeightValue = &initial
+	}
+	return *css.LineHeightValue
+}
+func (css *ComputedStyleSet) inheritLineHeightFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.LineHeightValue) {
+		css.LineHeightValue = parentCss.LineHeightValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritLineHeightFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) VerticalAlign() inline.VerticalAlign {
+	if css.VerticalAlignValue == nil {
+		initial := DescriptorsMap["vertical-align"].Initial.(inline.VerticalAlign)
+		css.VerticalAlignValue = &initial
+	}
+	return *css.VerticalAlignValue
+}
+func (css *ComputedStyleSet) Direction() writingmodes.Direction {
+	if css.DirectionValue == nil {
+		initial := DescriptorsMap["direction"].Initial.(writingmodes.Direction)
+		css.DirectionValue = &initial
+	}
+	return *css.DirectionValue
+}
+func (css *ComputedStyleSet) inheritDirectionFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.DirectionValue) {
+		css.DirectionValue = parentCss.DirectionValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritDirectionFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) UnicodeBidi() writingmodes.UnicodeBidi {
+	if css.UnicodeBidiValue == nil {
+		initial := DescriptorsMap["unicode-bidi"].Initial.(writingmodes.UnicodeBidi)
+		css.UnicodeBidiValue = &initial
+	}
+	return *css.UnicodeBidiValue
+}
+func (css *ComputedStyleSet) WritingMode() writingmodes.WritingMode {
+	if css.WritingModeValue == nil {
+		initial := DescriptorsMap["writing-mode"].Initial.(writingmodes.WritingMode)
+		css.WritingModeValue = &initial
+	}
+	return *css.WritingModeValue
+}
+func (css *ComputedStyleSet) inheritWritingModeFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.WritingModeValue) {
+		css.WritingModeValue = parentCss.WritingModeValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritWritingModeFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) TextOrientation() writingmodes.TextOrientation {
+	if css.TextOrientationValue == nil {
+		initial := DescriptorsMap["text-orientation"].Initial.(writingmodes.TextOrientation)
+		css.TextOrientationValue = &initial
+	}
+	return *css.TextOrientationValue
+}
+func (css *ComputedStyleSet) inheritTextOrientationFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.TextOrientationValue) {
+		css.TextOrientationValue = parentCss.TextOrientationValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritTextOrientationFromParent(parentParentSrc)
+	}
+}
 func (css *ComputedStyleSet) TextDecorationLine() textdecor.LineFlags {
 	if css.TextDecorationLineValue == nil {
 		initial := DescriptorsMap["text-decoration-line"].Initial.(textdecor.LineFlags)
@@ -1020,6 +2095,338 @@ func (css *ComputedStyleSet) Float() float.Float {
 	}
 	return *css.FloatValue
 }
+func (css *ComputedStyleSet) ZIndex() position.ZIndex {
+	if css.ZIndexValue == nil {
+		initial := DescriptorsMap["z-index"].Initial.(position.ZIndex)
+		css.ZIndexValue = &initial
+	}
+	return *css.ZIndexValue
+}
+func (css *ComputedStyleSet) OverflowX() overflow.Overflow {
+	if css.OverflowXValue == nil {
+		initial := DescriptorsMap["overflow-x"].Initial.(overflow.Overflow)
+		css.OverflowXValue = &initial
+	}
+	return *css.OverflowXValue
+}
+func (css *ComputedStyleSet) OverflowY() overflow.Overflow {
+	if css.OverflowYValue == nil {
+		initial := DescriptorsMap["overflow-y"].Initial.(overflow.Overflow)
+		css.OverflowYValue = &initial
+	}
+	return *css.OverflowYValue
+}
+func (css *ComputedStyleSet) TextOverflow() overflow.TextOverflow {
+	if css.TextOverflowValue == nil {
+		initial := DescriptorsMap["text-overflow"].Initial.(overflow.TextOverflow)
+		css.TextOverflowValue = &initial
+	}
+	return *css.TextOverflowValue
+}
+func (css *ComputedStyleSet) Position() position.Position {
+	if css.PositionValue == nil {
+		initial := DescriptorsMap["position"].Initial.(position.Position)
+		css.PositionValue = &initial
+	}
+	return *css.PositionValue
+}
+func (css *ComputedStyleSet) Top() position.Inset {
+	if css.TopValue == nil {
+		initial := DescriptorsMap["top"].Initial.(position.Inset)
+		css.TopValue = &initial
+	}
+	return *css.TopValue
+}
+func (css *ComputedStyleSet) Right() position.Inset {
+	if css.RightValue == nil {
+		initial := DescriptorsMap["right"].Initial.(position.Inset)
+		css.RightValue = &initial
+	}
+	return *css.RightValue
+}
+func (css *ComputedStyleSet) Bottom() position.Inset {
+	if css.BottomValue == nil {
+		initial := DescriptorsMap["bottom"].Initial.(position.Inset)
+		css.BottomValue = &initial
+	}
+	return *css.BottomValue
+}
+func (css *ComputedStyleSet) Left() position.Inset {
+	if css.LeftValue == nil {
+		initial := DescriptorsMap["left"].Initial.(position.Inset)
+		css.LeftValue = &initial
+	}
+	return *css.LeftValue
+}
+func (css *ComputedStyleSet) FlexDirection() flexbox.Direction {
+	if css.FlexDirectionValue == nil {
+		initial := DescriptorsMap["flex-direction"].Initial.(flexbox.Direction)
+		css.FlexDirectionValue = &initial
+	}
+	return *css.FlexDirectionValue
+}
+func (css *ComputedStyleSet) FlexWrap() flexbox.Wrap {
+	if css.FlexWrapValue == nil {
+		initial := DescriptorsMap["flex-wrap"].Initial.(flexbox.Wrap)
+		css.FlexWrapValue = &initial
+	}
+	return *css.FlexWrapValue
+}
+func (css *ComputedStyleSet) FlexGrow() flexbox.Factor {
+	if css.FlexGrowValue == nil {
+		initial := DescriptorsMap["flex-grow"].Initial.(flexbox.Factor)
+		css.FlexGrowValue = &initial
+	}
+	return *css.FlexGrowValue
+}
+func (css *ComputedStyleSet) FlexShrink() flexbox.Factor {
+	if css.FlexShrinkValue == nil {
+		initial := DescriptorsMap["flex-shrink"].Initial.(flexbox.Factor)
+		css.FlexShrinkValue = &initial
+	}
+	return *css.FlexShrinkValue
+}
+func (css *ComputedStyleSet) FlexBasis() sizing.Size {
+	if css.FlexBasisValue == nil {
+		initial := DescriptorsMap["flex-basis"].Initial.(sizing.Size)
+		css.FlexBasisValue = &initial
+	}
+	return *css.FlexBasisValue
+}
+func (css *ComputedStyleSet) JustifyContent() align.Content {
+	if css.JustifyContentValue == nil {
+		initial := DescriptorsMap["justify-content"].Initial.(align.Content)
+		css.JustifyContentValue = &initial
+	}
+	return *css.JustifyContentValue
+}
+func (css *ComputedStyleSet) AlignItems() align.Item {
+	if css.AlignItemsValue == nil {
+		initial := DescriptorsMap["align-items"].Initial.(align.Item)
+		css.AlignItemsValue = &initial
+	}
+	return *css.AlignItemsValue
+}
+func (css *ComputedStyleSet) AlignSelf() align.Item {
+	if css.AlignSelfValue == nil {
+		initial := DescriptorsMap["align-self"].Initial.(align.Item)
+		css.AlignSelfValue = &initial
+	}
+	return *css.AlignSelfValue
+}
+func (css *ComputedStyleSet) RowGap() align.Gap {
+	if css.RowGapValue == nil {
+		initial := DescriptorsMap["row-gap"].Initial.(align.Gap)
+		css.RowGapValue = &initial
+	}
+	return *css.RowGapValue
+}
+func (css *ComputedStyleSet) ColumnGap() align.Gap {
+	if css.ColumnGapValue == nil {
+		initial := DescriptorsMap["column-gap"].Initial.(align.Gap)
+		css.ColumnGapValue = &initial
+	}
+	return *css.ColumnGapValue
+}
+func (css *ComputedStyleSet) GridTemplateColumns() grid.TrackList {
+	if css.GridTemplateColumnsValue == nil {
+		initial := DescriptorsMap["grid-template-columns"].Initial.(grid.TrackList)
+		css.GridTemplateColumnsValue = &initial
+	}
+	return *css.GridTemplateColumnsValue
+}
+func (css *ComputedStyleSet) GridTemplateRows() grid.TrackList {
+	if css.GridTemplateRowsValue == nil {
+		initial := DescriptorsMap["grid-template-rows"].Initial.(grid.TrackList)
+		css.GridTemplateRowsValue = &initial
+	}
+	return *css.GridTemplateRowsValue
+}
+func (css *ComputedStyleSet) GridAutoColumns() grid.TrackSize {
+	if css.GridAutoColumnsValue == nil {
+		initial := DescriptorsMap["grid-auto-columns"].Initial.(grid.TrackSize)
+		css.GridAutoColumnsValue = &initial
+	}
+	return *css.GridAutoColumnsValue
+}
+func (css *ComputedStyleSet) GridAutoRows() grid.TrackSize {
+	if css.GridAutoRowsValue == nil {
+		initial := DescriptorsMap["grid-auto-rows"].Initial.(grid.TrackSize)
+		css.GridAutoRowsValue = &initial
+	}
+	return *css.GridAutoRowsValue
+}
+func (css *ComputedStyleSet) GridAutoFlow() grid.AutoFlow {
+	if css.GridAutoFlowValue == nil {
+		initial := DescriptorsMap["grid-auto-flow"].Initial.(grid.AutoFlow)
+		css.GridAutoFlowValue = &initial
+	}
+	return *css.GridAutoFlowValue
+}
+func (css *ComputedStyleSet) GridRowStart() grid.Line {
+	if css.GridRowStartValue == nil {
+		initial := DescriptorsMap["grid-row-start"].Initial.(grid.Line)
+		css.GridRowStartValue = &initial
+	}
+	return *css.GridRowStartValue
+}
+func (css *ComputedStyleSet) GridRowEnd() grid.Line {
+	if css.GridRowEndValue == nil {
+		initial := DescriptorsMap["grid-row-end"].Initial.(grid.Line)
+		css.GridRowEndValue = &initial
+	}
+	return *css.GridRowEndValue
+}
+func (css *ComputedStyleSet) GridColumnStart() grid.Line {
+	if css.GridColumnStartValue == nil {
+		initial := DescriptorsMap["grid-column-start"].Initial.(grid.Line)
+		css.GridColumnStartValue = &initial
+	}
+	return *css.GridColumnStartValue
+}
+func (css *ComputedStyleSet) GridColumnEnd() grid.Line {
+	if css.GridColumnEndValue == nil {
+		initial := DescriptorsMap["grid-column-end"].Initial.(grid.Line)
+		css.GridColumnEndValue = &initial
+	}
+	return *css.GridColumnEndValue
+}
+func (css *ComputedStyleSet) TableLayout() tables.Layout {
+	if css.TableLayoutValue == nil {
+		initial := DescriptorsMap["table-layout"].Initial.(tables.Layout)
+		css.TableLayoutValue = &initial
+	}
+	return *css.TableLayoutValue
+}
+func (css *ComputedStyleSet) BorderCollapse() tables.BorderCollapse {
+	if css.BorderCollapseValue == nil {
+		initial := DescriptorsMap["border-collapse"].Initial.(tables.BorderCollapse)
+		css.BorderCollapseValue = &initial
+	}
+	return *css.BorderCollapseValue
+}
+func (css *ComputedStyleSet) inheritBorderCollapseFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.BorderCollapseValue) {
+		css.BorderCollapseValue = parentCss.BorderCollapseValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritBorderCollapseFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) BorderSpacing() tables.BorderSpacing {
+	if css.BorderSpacingValue == nil {
+		initial := DescriptorsMap["border-spacing"].Initial.(tables.BorderSpacing)
+		css.BorderSpacingValue = &initial
+	}
+	return *css.BorderSpacingValue
+}
+func (css *ComputedStyleSet) inheritBorderSpacingFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.BorderSpacingValue) {
+		css.BorderSpacingValue = parentCss.BorderSpacingValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritBorderSpacingFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) CaptionSide() tables.CaptionSide {
+	if css.CaptionSideValue == nil {
+		initial := DescriptorsMap["caption-side"].Initial.(tables.CaptionSide)
+		css.CaptionSideValue = &initial
+	}
+	return *css.CaptionSideValue
+}
+func (css *ComputedStyleSet) inheritCaptionSideFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.CaptionSideValue) {
+		css.CaptionSideValue = parentCss.CaptionSideValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritCaptionSideFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) ListStyleImage() lists.Image {
+	if css.ListStyleImageValue == nil {
+		initial := DescriptorsMap["list-style-image"].Initial.(lists.Image)
+		css.ListStyleImageValue = &initial
+	}
+	return *css.ListStyleImageValue
+}
+func (css *ComputedStyleSet) inheritListStyleImageFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.ListStyleImageValue) {
+		css.ListStyleImageValue = parentCss.ListStyleImageValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritListStyleImageFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) ListStyleType() lists.StyleType {
+	if css.ListStyleTypeValue == nil {
+		initial := DescriptorsMap["list-style-type"].Initial.(lists.StyleType)
+		css.ListStyleTypeValue = &initial
+	}
+	return *css.ListStyleTypeValue
+}
+func (css *ComputedStyleSet) inheritListStyleTypeFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.ListStyleTypeValue) {
+		css.ListStyleTypeValue = parentCss.ListStyleTypeValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritListStyleTypeFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) ListStylePosition() lists.Position {
+	if css.ListStylePositionValue == nil {
+		initial := DescriptorsMap["list-style-position"].Initial.(lists.Position)
+		css.ListStylePositionValue = &initial
+	}
+	return *css.ListStylePositionValue
+}
+func (css *ComputedStyleSet) inheritListStylePositionFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.ListStylePositionValue) {
+		css.ListStylePositionValue = parentCss.ListStylePositionValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritListStylePositionFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) inheritListStyleShorthandFromParent(parentSrc ComputedStyleSetSource) {
+	parentCss := parentSrc.ComputedStyleSet()
+	if !util.IsNil(parentCss.ListStyleShorthandValue) {
+		css.ListStylePositionValue = &parentCss.ListStyleShorthandValue.ListStylePosition
+		css.ListStyleTypeValue = &parentCss.ListStyleShorthandValue.ListStyleType
+		css.ListStyleImageValue = &parentCss.ListStyleShorthandValue.ListStyleImage
+		css.ListStyleShorthandValue = parentCss.ListStyleShorthandValue
+	} else if parentParentSrc := parentSrc.ParentSource(); !util.IsNil(parentParentSrc) {
+		css.inheritListStyleShorthandFromParent(parentParentSrc)
+	}
+}
+func (css *ComputedStyleSet) CounterReset() lists.Counters {
+	if css.CounterResetValue == nil {
+		initial := DescriptorsMap["counter-reset"].Initial.(lists.Counters)
+		css.CounterResetValue = &initial
+	}
+	return *css.CounterResetValue
+}
+func (css *ComputedStyleSet) CounterIncrement() lists.Counters {
+	if css.CounterIncrementValue == nil {
+		initial := DescriptorsMap["counter-increment"].Initial.(lists.Counters)
+		css.CounterIncrementValue = &initial
+	}
+	return *css.CounterIncrementValue
+}
+func (css *ComputedStyleSet) CounterSet() lists.Counters {
+	if css.CounterSetValue == nil {
+		initial := DescriptorsMap["counter-set"].Initial.(lists.Counters)
+		css.CounterSetValue = &initial
+	}
+	return *css.CounterSetValue
+}
+func (css *ComputedStyleSet) Content() content.Content {
+	if css.ContentValue == nil {
+		initial := DescriptorsMap["content"].Initial.(content.Content)
+		css.ContentValue = &initial
+	}
+	return *css.ContentValue
+}
 func (css *ComputedStyleSet) InheritPropertiesFromParent(parentSrc ComputedStyleSetSource) {
 	if util.IsNil(css.ColorValue) {
 		css.inheritColorFromParent(parentSrc)
@@ -1048,7 +2455,67 @@ func (css *ComputedStyleSet) InheritPropertiesFromParent(parentSrc ComputedStyle
 	if util.IsNil(css.TextTransformValue) {
 		css.inheritTextTransformFromParent(parentSrc)
 	}
+	if util.IsNil(css.WordBreakValue) {
+		css.inheritWordBreakFromParent(parentSrc)
+	}
+	if util.IsNil(css.OverflowWrapValue) {
+		css.inheritOverflowWrapFromParent(parentSrc)
+	}
+	if util.IsNil(css.LineBreakValue) {
+		css.inheritLineBreakFromParent(parentSrc)
+	}
+	if util.IsNil(css.TextAlignValue) {
+		css.inheritTextAlignFromParent(parentSrc)
+	}
+	if util.IsNil(css.TextIndentValue) {
+		css.inheritTextIndentFromParent(parentSrc)
+	}
+	if util.IsNil(css.TabSizeValue) {
+		css.inheritTabSizeFromParent(parentSrc)
+	}
+	if util.IsNil(css.WhiteSpaceCollapseValue) {
+		css.inheritWhiteSpaceCollapseFromParent(parentSrc)
+	}
+	if util.IsNil(css.TextWrapModeValue) {
+		css.inheritTextWrapModeFromParent(parentSrc)
+	}
+	if util.IsNil(css.WhiteSpaceShorthandValue) {
+		css.inheritWhiteSpaceShorthandFromParent(parentSrc)
+	}
+	if util.IsNil(css.LineHeightValue) {
+		css.inheritLineHeightFromParent(parentSrc)
+	}
+	if util.IsNil(css.DirectionValue) {
+		css.inheritDirectionFromParent(parentSrc)
+	}
+	if util.IsNil(css.WritingModeValue) {
+		css.inheritWritingModeFromParent(parentSrc)
+	}
+	if util.IsNil(css.TextOrientationValue) {
+		css.inheritTextOrientationFromParent(parentSrc)
+	}
 	if util.IsNil(css.TextUnderlinePositionValue) {
 		css.inheritTextUnderlinePositionFromParent(parentSrc)
 	}
+	if util.IsNil(css.BorderCollapseValue) {
+		css.inheritBorderCollapseFromParent(parentSrc)
+	}
+	if util.IsNil(css.BorderSpacingValue) {
+		css.inheritBorderSpacingFromParent(parentSrc)
+	}
+	if util.IsNil(css.CaptionSideValue) {
+		css.inheritCaptionSideFromParent(parentSrc)
+	}
+	if util.IsNil(css.ListStyleImageValue) {
+		css.inheritListStyleImageFromParent(parentSrc)
+	}
+	if util.IsNil(css.ListStyleTypeValue) {
+		css.inheritListStyleTypeFromParent(parentSrc)
+	}
+	if util.IsNil(css.ListStylePositionValue) {
+		css.inheritListStylePositionFromParent(parentSrc)
+	}
+	if util.IsNil(css.ListStyleShorthandValue) {
+		css.inheritListStyleShorthandFromParent(parentSrc)
+	}
 }
@@ -6,8 +6,11 @@ package selector
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/util"
 )
 
 // AttrSelector represents a [CSS attribute selector] (e.g. [attr=value])
@@ -82,7 +85,40 @@ func (sel AttrSelector) Equals(other Selector) bool {
 	return true
 }
 
+func (sel AttrSelector) Specificity() Specificity { return Specificity{0, 1, 0} }
+
 func (sel AttrSelector) MatchAgainst(element dom.Element) bool {
-	// STUB
+	// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#attribute-representation
+	// TODO: Handle namespace
+	name := sel.AttrName.Ident
+	if element.IsHtmlElement(element.LocalName()) {
+		// HTML attribute names are always lowercase.
+		name = util.ToAsciiLowercase(name)
+	}
+	value, ok := element.AttrWithoutNamespace(name)
+	if !ok {
+		return false
+	}
+	expected := sel.AttrValue
+	if !sel.IsCaseSensitive {
+		value = util.ToAsciiLowercase(value)
+		expected = util.ToAsciiLowercase(expected)
+	}
+	switch sel.Matcher {
+	case NoMatcher:
+		return true
+	case NormalMatcher:
+		return value == expected
+	case TildeMatcher:
+		return slices.Contains(strings.Fields(value), expected)
+	case BarMatcher:
+		return value == expected || strings.HasPrefix(value, expected+"-")
+	case CaretMatcher:
+		return expected != "" && strings.HasPrefix(value, expected)
+	case DollarMatcher:
+		return expected != "" && strings.HasSuffix(value, expected)
+	case AsteriskMatcher:
+		return expected != "" && strings.Contains(value, expected)
+	}
 	return false
 }
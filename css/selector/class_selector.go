@@ -28,12 +28,13 @@ func (sel ClassSelector) Equals(other Selector) bool {
 	}
 	return true
 }
+func (sel ClassSelector) Specificity() Specificity { return Specificity{0, 1, 0} }
 func (sel ClassSelector) MatchAgainst(element dom.Element) bool {
 	class := sel.Class
 	classes, ok := element.AttrWithoutNamespace("class")
 	if !ok {
 		return false
 	}
-	classList := strings.Split(classes, " ")
+	classList := strings.Fields(classes)
 	return slices.Contains(classList, class)
 }
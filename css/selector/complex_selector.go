@@ -6,6 +6,7 @@ package selector
 
 import (
 	"fmt"
+	"iter"
 	"log"
 	"strings"
 
@@ -77,56 +78,116 @@ func (sel ComplexSelector) Equals(other Selector) bool {
 	return true
 }
 
+func (s ComplexSelector) Specificity() Specificity {
+	res := s.Base.Specificity()
+	for _, r := range s.Rest {
+		res = res.Add(r.Selector.Specificity())
+	}
+	return res
+}
+
 func (s ComplexSelector) MatchAgainst(element dom.Element) bool {
 	// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#match-a-complex-selector-against-an-element
 
 	// Test each compound selector, from right to left
-	for i := len(s.Rest) - 1; 0 < i; i-- {
-		prevSel := s.Base
-		if i != 0 {
-			prevSel = s.Rest[i-1].Selector
-		}
+	return s.matchCompoundAt(len(s.Rest)-1, element)
+}
 
-		sel := s.Rest[i].Selector
-		if sel.MatchAgainst(element) {
+// Rightmost returns the rightmost compound selector, which is the one that
+// selects the subject element.
+func (s ComplexSelector) Rightmost() CompoundSelector {
+	if len(s.Rest) == 0 {
+		return s.Base
+	}
+	return s.Rest[len(s.Rest)-1].Selector
+}
+
+// matchCompoundAt matches compound selector at index idx of Rest (or Base if idx is -1)
+// against element, and then compound selectors on the left side of it against elements
+// in relationship described by each combinator.
+func (s ComplexSelector) matchCompoundAt(idx int, element dom.Element) bool {
+	if idx < 0 {
+		return s.Base.MatchAgainst(element)
+	}
+	if !s.Rest[idx].Selector.MatchAgainst(element) {
+		return false
+	}
+	switch s.Rest[idx].Combinator {
+	case ChildCombinator:
+		// A B
+		for _, n := range dom.Ancestors(element) {
+			if parentElem, ok := n.(dom.Element); !ok {
+				break
+			} else if s.matchCompoundAt(idx-1, parentElem) {
+				return true
+			}
+		}
+		return false
+	case DirectChildCombinator:
+		// A > B
+		if util.IsNil(element.Parent()) {
 			return false
 		}
-		switch s.Rest[i].Combinator {
-		case ChildCombinator:
-			// A B
-			currElem := element.Parent()
-			found := false
-			for !util.IsNil(currElem) {
-				if _, ok := (currElem).(dom.Element); !ok {
-					break
-				}
-				if prevSel.MatchAgainst(currElem.(dom.Element)) {
-					found = true
-					break
-				}
-				currElem = currElem.Parent()
-			}
-			if !found {
-				return false
+		if parentElem, ok := element.Parent().(dom.Element); ok {
+			return s.matchCompoundAt(idx-1, parentElem)
+		}
+		return false
+	case PlusCombinator:
+		// A + B
+		for prevElem := range prevElementSiblings(element) {
+			return s.matchCompoundAt(idx-1, prevElem)
+		}
+		return false
+	case TildeCombinator:
+		// A ~ B
+		for prevElem := range prevElementSiblings(element) {
+			if s.matchCompoundAt(idx-1, prevElem) {
+				return true
 			}
-		case DirectChildCombinator:
-			// A > B
-			if util.IsNil(element.Parent()) {
-				return false
+		}
+		return false
+	case TwoBarsCombinator:
+		// A || B
+		// TODO: Support column combinator once we have table columns
+		return false
+	default:
+		log.Printf("BUG: bad Combinator %d while matching selector: %v", s.Rest[idx].Combinator, s)
+		return false
+	}
+}
+
+// prevElementSiblings returns sibling elements before elem, starting from the
+// closest one.
+func prevElementSiblings(elem dom.Element) iter.Seq[dom.Element] {
+	return func(yield func(dom.Element) bool) {
+		siblings, idx := siblingsOf(elem)
+		for i := idx - 1; 0 <= i; i-- {
+			if e, ok := siblings[i].(dom.Element); ok && !yield(e) {
+				return
 			}
-			if parent, ok := element.Parent().(dom.Element); !ok {
-				if !prevSel.MatchAgainst(parent) {
-					return false
-				}
-			} else {
-				return false
+		}
+	}
+}
+
+// nextElementSiblings returns sibling elements after elem, starting from the
+// closest one.
+func nextElementSiblings(elem dom.Element) iter.Seq[dom.Element] {
+	return func(yield func(dom.Element) bool) {
+		siblings, idx := siblingsOf(elem)
+		for i := idx + 1; i < len(siblings); i++ {
+			if e, ok := siblings[i].(dom.Element); ok && !yield(e) {
+				return
 			}
-		case PlusCombinator, TildeCombinator, TwoBarsCombinator:
-			panic("TODO")
-		default:
-			log.Printf("BUG: bad Combinator %d while parsing selector: %v", s.Rest[i].Combinator, s)
-			continue
 		}
 	}
-	return s.Base.MatchAgainst(element)
+}
+
+// siblingsOf returns children of elem's parent, and index of elem in it.
+// If elem has no parent, elem itself is the only sibling.
+func siblingsOf(elem dom.Element) ([]dom.Node, int) {
+	parent := elem.Parent()
+	if util.IsNil(parent) {
+		return []dom.Node{elem}, 0
+	}
+	return parent.Children(), dom.Index(elem)
 }
@@ -22,7 +22,7 @@ type CompoundSelector struct {
 
 // CompundSelectorPseudoItem is entry for [CompoundSelector]'s PseudoItems field.
 type CompundSelectorPseudoItem struct {
-	ElementSelector PseudoClassSelector
+	ElementSelector PseudoElementSelector
 	ClassSelector   []PseudoClassSelector
 }
 
@@ -78,6 +78,22 @@ func (sel CompoundSelector) Equals(other Selector) bool {
 	}
 	return true
 }
+func (sel CompoundSelector) Specificity() Specificity {
+	res := Specificity{}
+	if sel.TypeSelector != nil {
+		res = res.Add(sel.TypeSelector.Specificity())
+	}
+	for _, ss := range sel.SubclassSelector {
+		res = res.Add(ss.Specificity())
+	}
+	for _, p := range sel.PseudoItems {
+		res = res.Add(p.ElementSelector.Specificity())
+		for _, cs := range p.ClassSelector {
+			res = res.Add(cs.Specificity())
+		}
+	}
+	return res
+}
 func (sel CompoundSelector) MatchAgainst(element dom.Element) bool {
 	if sel.TypeSelector != nil && !sel.TypeSelector.MatchAgainst(element) {
 		return false
@@ -88,9 +104,9 @@ func (sel CompoundSelector) MatchAgainst(element dom.Element) bool {
 		}
 	}
 	if len(sel.PseudoItems) != 0 {
-		for i := len(sel.PseudoItems) - 1; 0 < i; i-- {
-			// TODO
-		}
+		// Selector with pseudo-elements never matches the element itself.
+		// See MatchAgainstPseudoElement.
+		return false
 	}
 	return true
 }
@@ -26,6 +26,7 @@ func (sel IdSelector) Equals(other Selector) bool {
 	}
 	return true
 }
+func (sel IdSelector) Specificity() Specificity { return Specificity{1, 0, 0} }
 func (sel IdSelector) MatchAgainst(element dom.Element) bool {
 	id := sel.Id
 	elemId, ok := element.AttrWithoutNamespace("id")
@@ -21,6 +21,12 @@ func (sel NodePtrSelector) Equals(other Selector) bool {
 		return otherSel.Element == sel.Element
 	}
 }
+
+// Specificity returns zero specificity, as NodePtrSelector is only used for
+// presentational hints.
+//
+// Spec: https://www.w3.org/TR/css-cascade-4/#preshint
+func (sel NodePtrSelector) Specificity() Specificity { return Specificity{0, 0, 0} }
 func (sel NodePtrSelector) MatchAgainst(element dom.Element) bool {
 	return sel.Element == element
 }
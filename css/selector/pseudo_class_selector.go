@@ -6,22 +6,119 @@ package selector
 
 import (
 	"fmt"
+	"iter"
+	"slices"
+	"strings"
 
 	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/util"
 )
 
 // PseudoClassSelector represents a [CSS pseudo class selector] (e.g. :first-letter)
 //
+// Args holds arguments of functional pseudo-classes, and its contents depend
+// on the pseudo-class:
+//   - :nth-child(), :nth-last-child(): [AnPlusB], optionally followed by []Selector (from "of S")
+//   - :nth-of-type(), :nth-last-of-type(): [AnPlusB]
+//   - :is(), :where(), :not(): []Selector
+//   - :has(): [][RelativeSelector] values
+//   - Others: Raw CSS tokens
+//
 // [CSS pseudo class selector]: https://www.w3.org/TR/2022/WD-selectors-4-20221111/#pseudo-class
 type PseudoClassSelector struct {
 	Name string
 	Args []any
 }
 
+// AnPlusB represents the [An+B] notation, used by :nth-child() and similar pseudo-classes.
+//
+// [An+B]: https://www.w3.org/TR/css-syntax-3/#anb-microsyntax
+type AnPlusB struct{ A, B int }
+
+func (v AnPlusB) String() string { return fmt.Sprintf("%dn%+d", v.A, v.B) }
+
+// Matches reports whether there's non-negative integer n where An+B equals to index.
+func (v AnPlusB) Matches(index int) bool {
+	if v.A == 0 {
+		return index == v.B
+	}
+	diff := index - v.B
+	return diff%v.A == 0 && 0 <= diff/v.A
+}
+
+// RelativeSelector represents a [CSS relative selector] (e.g. > img), used by :has().
+//
+// [CSS relative selector]: https://www.w3.org/TR/2022/WD-selectors-4-20221111/#relative
+type RelativeSelector struct {
+	Combinator Combinator // Relationship between the anchor element and Selector
+	Selector   ComplexSelector
+}
+
+func (sel RelativeSelector) String() string {
+	switch sel.Combinator {
+	case DirectChildCombinator:
+		return fmt.Sprintf("> %v", sel.Selector)
+	case PlusCombinator:
+		return fmt.Sprintf("+ %v", sel.Selector)
+	case TildeCombinator:
+		return fmt.Sprintf("~ %v", sel.Selector)
+	case TwoBarsCombinator:
+		return fmt.Sprintf("|| %v", sel.Selector)
+	}
+	return sel.Selector.String()
+}
+
+// Equals reports whether two relative selectors are equal.
+func (sel RelativeSelector) Equals(other RelativeSelector) bool {
+	return sel.Combinator == other.Combinator && sel.Selector.Equals(other.Selector)
+}
+
+// MatchAgainst reports whether the relative selector matches any element,
+// using anchor as the anchor element.
+//
+// Spec: https://www.w3.org/TR/2022/WD-selectors-4-20221111/#relative
+func (sel RelativeSelector) MatchAgainst(anchor dom.Element) bool {
+	// We turn the relative selector into an absolute one, by putting the
+	// anchor element on the left side.
+	absSel := ComplexSelector{
+		Base: CompoundSelector{SubclassSelector: []Selector{NodePtrSelector{Element: anchor}}},
+		Rest: append([]ComplexSelectorRest{{Combinator: sel.Combinator, Selector: sel.Selector.Base}}, sel.Selector.Rest...),
+	}
+	candidates := []dom.Node{}
+	switch sel.Combinator {
+	case ChildCombinator, DirectChildCombinator:
+		candidates = dom.Descendants(anchor)
+	case PlusCombinator, TildeCombinator:
+		for next := range nextElementSiblings(anchor) {
+			candidates = append(candidates, dom.InclusiveDescendants(next)...)
+		}
+	}
+	for _, n := range candidates {
+		if elem, ok := n.(dom.Element); ok && absSel.MatchAgainst(elem) {
+			return true
+		}
+	}
+	return false
+}
+
 func (sel PseudoClassSelector) String() string {
 	if len(sel.Args) != 0 {
-		// TODO: Display arguments in better way
-		return fmt.Sprintf(":%s(%v)", sel.Name, sel.Args)
+		argStrs := []string{}
+		for _, arg := range sel.Args {
+			switch arg := arg.(type) {
+			case []Selector:
+				argStrs = append(argStrs, selectorListString(arg))
+			case []RelativeSelector:
+				strs := []string{}
+				for _, s := range arg {
+					strs = append(strs, s.String())
+				}
+				argStrs = append(argStrs, strings.Join(strs, ", "))
+			default:
+				argStrs = append(argStrs, fmt.Sprintf("%v", arg))
+			}
+		}
+		return fmt.Sprintf(":%s(%s)", sel.Name, strings.Join(argStrs, " of "))
 	} else {
 		return fmt.Sprintf(":%s", sel.Name)
 	}
@@ -36,11 +133,236 @@ func (sel PseudoClassSelector) Equals(other Selector) bool {
 		if len(sel.Args) != len(otherSel.Args) {
 			return false
 		}
-		// TODO: Compare actual arguments
+		for i := range sel.Args {
+			if !pseudoClassArgEquals(sel.Args[i], otherSel.Args[i]) {
+				return false
+			}
+		}
 	}
 	return true
 }
+func pseudoClassArgEquals(a, b any) bool {
+	switch a := a.(type) {
+	case AnPlusB:
+		if b, ok := b.(AnPlusB); ok {
+			return a == b
+		}
+		return false
+	case []Selector:
+		if b, ok := b.([]Selector); ok {
+			return slices.EqualFunc(a, b, Selector.Equals)
+		}
+		return false
+	case []RelativeSelector:
+		if b, ok := b.([]RelativeSelector); ok {
+			return slices.EqualFunc(a, b, RelativeSelector.Equals)
+		}
+		return false
+	}
+	// Raw tokens. We compare them using CSS representation, as tokens also
+	// carry their source location.
+	return fmt.Sprintf("%v", a) == fmt.Sprintf("%v", b)
+}
+
+// Spec: https://www.w3.org/TR/2022/WD-selectors-4-20221111/#specificity-rules
+func (sel PseudoClassSelector) Specificity() Specificity {
+	switch sel.Name {
+	case "where":
+		return Specificity{0, 0, 0}
+	case "is", "not":
+		if len(sel.Args) == 1 {
+			if list, ok := sel.Args[0].([]Selector); ok {
+				return maxSpecificity(list)
+			}
+		}
+	case "has":
+		if len(sel.Args) == 1 {
+			if list, ok := sel.Args[0].([]RelativeSelector); ok {
+				res := Specificity{}
+				for _, s := range list {
+					if spec := s.Selector.Specificity(); 0 < spec.Compare(res) {
+						res = spec
+					}
+				}
+				return res
+			}
+		}
+	case "nth-child", "nth-last-child":
+		if len(sel.Args) == 2 {
+			if list, ok := sel.Args[1].([]Selector); ok {
+				return Specificity{0, 1, 0}.Add(maxSpecificity(list))
+			}
+		}
+	}
+	return Specificity{0, 1, 0}
+}
+
 func (sel PseudoClassSelector) MatchAgainst(element dom.Element) bool {
-	// STUB
+	switch sel.Name {
+	//==========================================================================
+	// Logical combinations
+	//==========================================================================
+	// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#logical-combination
+	case "is", "where":
+		if list, ok := sel.argSelectorList(); ok {
+			return MatchAgainstElement(list, element)
+		}
+	case "not":
+		if list, ok := sel.argSelectorList(); ok {
+			return !MatchAgainstElement(list, element)
+		}
+	case "has":
+		if len(sel.Args) == 1 {
+			if list, ok := sel.Args[0].([]RelativeSelector); ok {
+				return slices.ContainsFunc(list, func(s RelativeSelector) bool { return s.MatchAgainst(element) })
+			}
+		}
+
+	//==========================================================================
+	// Location pseudo-classes
+	//==========================================================================
+	// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#location
+	case "any-link", "link":
+		// https://html.spec.whatwg.org/multipage/semantics-other.html#selector-link
+		// NOTE: We don't have browsing history, so every link is unvisited.
+		if element.IsHtmlElement("a") || element.IsHtmlElement("area") {
+			_, ok := element.AttrWithoutNamespace("href")
+			return ok
+		}
+		return false
+	case "visited":
+		return false
+
+	//==========================================================================
+	// Tree-structural pseudo-classes
+	//==========================================================================
+	// https://www.w3.org/TR/2022/WD-selectors-4-20221111/#structural-pseudos
+	case "root":
+		if util.IsNil(element.Parent()) {
+			return false
+		}
+		_, ok := element.Parent().(dom.Document)
+		return ok
+	case "empty":
+		for _, child := range element.Children() {
+			switch child := child.(type) {
+			case dom.Element:
+				return false
+			case dom.CharacterData:
+				if child.CharacterDataType() == dom.TextCharacterData && child.Text() != "" {
+					return false
+				}
+			}
+		}
+		return true
+	case "first-child":
+		return noElements(prevElementSiblings(element), nil)
+	case "last-child":
+		return noElements(nextElementSiblings(element), nil)
+	case "only-child":
+		return noElements(prevElementSiblings(element), nil) && noElements(nextElementSiblings(element), nil)
+	case "nth-child", "nth-last-child":
+		anb, ok := sel.argAnPlusB()
+		if !ok {
+			return false
+		}
+		var filter func(e dom.Element) bool
+		if len(sel.Args) == 2 {
+			list, ok := sel.Args[1].([]Selector)
+			if !ok {
+				return false
+			}
+			if !MatchAgainstElement(list, element) {
+				return false
+			}
+			filter = func(e dom.Element) bool { return MatchAgainstElement(list, e) }
+		}
+		if sel.Name == "nth-child" {
+			return anb.Matches(countElements(prevElementSiblings(element), filter) + 1)
+		}
+		return anb.Matches(countElements(nextElementSiblings(element), filter) + 1)
+	case "first-of-type":
+		return noElements(prevElementSiblings(element), sameTypeFilter(element))
+	case "last-of-type":
+		return noElements(nextElementSiblings(element), sameTypeFilter(element))
+	case "only-of-type":
+		return noElements(prevElementSiblings(element), sameTypeFilter(element)) &&
+			noElements(nextElementSiblings(element), sameTypeFilter(element))
+	case "nth-of-type":
+		if anb, ok := sel.argAnPlusB(); ok {
+			return anb.Matches(countElements(prevElementSiblings(element), sameTypeFilter(element)) + 1)
+		}
+	case "nth-last-of-type":
+		if anb, ok := sel.argAnPlusB(); ok {
+			return anb.Matches(countElements(nextElementSiblings(element), sameTypeFilter(element)) + 1)
+		}
+	}
+	// STUB: Other pseudo-classes (e.g. user action pseudo-classes) never match for now.
 	return false
 }
+
+func (sel PseudoClassSelector) argSelectorList() ([]Selector, bool) {
+	if len(sel.Args) != 1 {
+		return nil, false
+	}
+	list, ok := sel.Args[0].([]Selector)
+	return list, ok
+}
+func (sel PseudoClassSelector) argAnPlusB() (AnPlusB, bool) {
+	if len(sel.Args) == 0 {
+		return AnPlusB{}, false
+	}
+	anb, ok := sel.Args[0].(AnPlusB)
+	return anb, ok
+}
+
+func maxSpecificity(list []Selector) Specificity {
+	res := Specificity{}
+	for _, s := range list {
+		if spec := s.Specificity(); 0 < spec.Compare(res) {
+			res = spec
+		}
+	}
+	return res
+}
+
+func selectorListString(list []Selector) string {
+	strs := []string{}
+	for _, s := range list {
+		strs = append(strs, s.String())
+	}
+	return strings.Join(strs, ", ")
+}
+
+// sameTypeFilter returns filter for countElements and noElements,
+// that accepts elements with the same type as elem.
+func sameTypeFilter(elem dom.Element) func(e dom.Element) bool {
+	ns, hasNs := elem.Namespace()
+	return func(e dom.Element) bool {
+		otherNs, otherHasNs := e.Namespace()
+		return e.LocalName() == elem.LocalName() && hasNs == otherHasNs && ns == otherNs
+	}
+}
+
+// countElements returns number of elements in seq that are accepted by the
+// filter. If filter is nil, all elements are accepted.
+func countElements(seq iter.Seq[dom.Element], filter func(e dom.Element) bool) int {
+	cnt := 0
+	for e := range seq {
+		if filter == nil || filter(e) {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+// noElements reports whether seq has no elements accepted by the filter.
+// If filter is nil, all elements are accepted.
+func noElements(seq iter.Seq[dom.Element], filter func(e dom.Element) bool) bool {
+	for e := range seq {
+		if filter == nil || filter(e) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,111 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package selector
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/inseo-oh/yw/dom"
+)
+
+// PseudoElementSelector represents a [CSS pseudo element selector] (e.g. ::before)
+//
+// Args holds raw CSS tokens of functional pseudo-elements (e.g. ::part()).
+//
+// [CSS pseudo element selector]: https://www.w3.org/TR/2022/WD-selectors-4-20221111/#pseudo-elements
+type PseudoElementSelector struct {
+	Name string
+	Args []any
+}
+
+func (sel PseudoElementSelector) String() string {
+	if len(sel.Args) != 0 {
+		return fmt.Sprintf("::%s(%v)", sel.Name, sel.Args)
+	}
+	return fmt.Sprintf("::%s", sel.Name)
+}
+func (sel PseudoElementSelector) Equals(other Selector) bool {
+	if otherSel, ok := other.(PseudoElementSelector); !ok {
+		return false
+	} else {
+		if sel.Name != otherSel.Name {
+			return false
+		}
+		return slices.EqualFunc(sel.Args, otherSel.Args, pseudoClassArgEquals)
+	}
+}
+
+// Spec: https://www.w3.org/TR/2022/WD-selectors-4-20221111/#specificity-rules
+func (sel PseudoElementSelector) Specificity() Specificity {
+	// Pseudo-elements count as type selectors
+	return Specificity{0, 0, 1}
+}
+
+// MatchAgainst always returns false, as pseudo-elements never match an element
+// itself. Use [MatchAgainstPseudoElement] instead.
+func (sel PseudoElementSelector) MatchAgainst(element dom.Element) bool {
+	return false
+}
+
+// PseudoElementOf returns name of the pseudo-element selected by sel (e.g.
+// "before" for "p::before"), or an empty string if sel selects elements.
+func PseudoElementOf(sel Selector) string {
+	var compound CompoundSelector
+	switch sel := sel.(type) {
+	case ComplexSelector:
+		compound = sel.Rightmost()
+	case CompoundSelector:
+		compound = sel
+	default:
+		return ""
+	}
+	if len(compound.PseudoItems) == 0 {
+		return ""
+	}
+	return compound.PseudoItems[len(compound.PseudoItems)-1].ElementSelector.Name
+}
+
+// MatchAgainstPseudoElement reports whether sel matches the pseudo-element
+// named pseudoElem (e.g. "before"), originating from element.
+//
+// Spec: https://www.w3.org/TR/2022/WD-selectors-4-20221111/#match-a-selector-against-a-pseudo-element
+func MatchAgainstPseudoElement(sel Selector, element dom.Element, pseudoElem string) bool {
+	var compound CompoundSelector
+	switch s := sel.(type) {
+	case ComplexSelector:
+		compound = s.Rightmost()
+	case CompoundSelector:
+		compound = s
+	default:
+		return false
+	}
+	if len(compound.PseudoItems) != 1 {
+		// TODO: Support sub-pseudo-elements (e.g. ::before::marker)
+		return false
+	}
+	pseudoItem := compound.PseudoItems[0]
+	if pseudoItem.ElementSelector.Name != pseudoElem {
+		return false
+	}
+	if len(pseudoItem.ClassSelector) != 0 {
+		// TODO: Support pseudo-classes on pseudo-elements (e.g. ::before:hover)
+		return false
+	}
+	// Rest of the selector should match the originating element.
+	compound.PseudoItems = nil
+	switch s := sel.(type) {
+	case ComplexSelector:
+		if len(s.Rest) == 0 {
+			s.Base = compound
+		} else {
+			s.Rest = slices.Clone(s.Rest)
+			s.Rest[len(s.Rest)-1].Selector = compound
+		}
+		return s.MatchAgainst(element)
+	default:
+		return compound.MatchAgainst(element)
+	}
+}
@@ -13,6 +13,8 @@
 //   - [ComplexSelector]: Complex selector (e.g. .foo > #bar)
 //   - [CompoundSelector] Compound selector (e.g. div#foo.bar)
 //   - [IdSelector]: ID selector (e.g. #foo)
+//   - [PseudoClassSelector]: Pseudo-class selector (e.g. :first-child)
+//   - [PseudoElementSelector]: Pseudo-element selector (e.g. ::before)
 //
 // [CSS Selector Module Level 4]: https://www.w3.org/TR/2022/WD-selectors-4-20221111/
 package selector
@@ -34,6 +36,9 @@ type Selector interface {
 
 	// MatchAgainst reports whether the selector matches given element.
 	MatchAgainst(element dom.Element) bool
+
+	// Specificity returns specificity of the selector.
+	Specificity() Specificity
 }
 
 // NsPrefix represents CSS namespace prefix (e.g. foo|)
@@ -101,9 +106,41 @@ func MatchAgainstTree(selector []Selector, roots []dom.Node) []dom.Node {
 			if MatchAgainstElement(selector, n.(dom.Element)) {
 				selectorMatchList = append(selectorMatchList, n)
 			}
-			// TODO: Pseudo element
+			// NOTE: Pseudo-elements aren't DOM nodes. See MatchPseudoElementsAgainstTree.
 		}
 
 	}
 	return selectorMatchList
 }
+
+// PseudoElementRef refers to a pseudo-element named Name (e.g. "before"),
+// originating from Element.
+type PseudoElementRef struct {
+	Element dom.Element
+	Name    string
+}
+
+// MatchPseudoElementsAgainstTree matches given selectors against pseudo-elements
+// named pseudoElems, originating from elements in given DOM trees.
+//
+// Spec: https://www.w3.org/TR/2022/WD-selectors-4-20221111/#match-a-selector-against-a-tree
+func MatchPseudoElementsAgainstTree(selector []Selector, roots []dom.Node, pseudoElems []string) []PseudoElementRef {
+	res := []PseudoElementRef{}
+	for _, root := range roots {
+		for _, n := range dom.InclusiveDescendants(root) {
+			elem, ok := n.(dom.Element)
+			if !ok {
+				continue
+			}
+			for _, name := range pseudoElems {
+				for _, s := range selector {
+					if MatchAgainstPseudoElement(s, elem, name) {
+						res = append(res, PseudoElementRef{elem, name})
+						break
+					}
+				}
+			}
+		}
+	}
+	return res
+}
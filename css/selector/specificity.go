@@ -0,0 +1,59 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package selector
+
+import (
+	"fmt"
+
+	"github.com/inseo-oh/yw/dom"
+)
+
+// Specificity represents [specificity] of a selector, in (A, B, C) form.
+//
+// [specificity]: https://www.w3.org/TR/2022/WD-selectors-4-20221111/#specificity-rules
+type Specificity struct {
+	A int // Number of ID selectors
+	B int // Number of class selectors, attribute selectors, and pseudo-classes
+	C int // Number of type selectors and pseudo-elements
+}
+
+func (s Specificity) String() string { return fmt.Sprintf("(%d, %d, %d)", s.A, s.B, s.C) }
+
+// Add returns sum of two specificities.
+func (s Specificity) Add(other Specificity) Specificity {
+	return Specificity{s.A + other.A, s.B + other.B, s.C + other.C}
+}
+
+// Compare returns negative value if s is less specific than other, positive
+// value if s is more specific than other, and zero if both are equal.
+//
+// Components are compared in A, B, C order.
+func (s Specificity) Compare(other Specificity) int {
+	if s.A != other.A {
+		return s.A - other.A
+	}
+	if s.B != other.B {
+		return s.B - other.B
+	}
+	return s.C - other.C
+}
+
+// MatchingSpecificity matches given selectors against an DOM element, and
+// returns specificity of the most specific selector that matched.
+// ok is false if none of selectors matched.
+//
+// Spec: https://www.w3.org/TR/2022/WD-selectors-4-20221111/#specificity-rules
+func MatchingSpecificity(selector []Selector, element dom.Element) (res Specificity, ok bool) {
+	for _, s := range selector {
+		if !s.MatchAgainst(element) {
+			continue
+		}
+		if spec := s.Specificity(); !ok || 0 < spec.Compare(res) {
+			res = spec
+		}
+		ok = true
+	}
+	return res, ok
+}
@@ -28,6 +28,7 @@ func (sel TypeSelector) Equals(other Selector) bool {
 	}
 	return true
 }
+func (sel TypeSelector) Specificity() Specificity { return Specificity{0, 0, 1} }
 func (sel TypeSelector) MatchAgainst(element dom.Element) bool {
 	// TODO: Handle namespace
 	name := sel.TypeName.Ident
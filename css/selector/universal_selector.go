@@ -31,6 +31,7 @@ func (sel UniversalSelector) Equals(other Selector) bool {
 	}
 	return true
 }
+func (sel UniversalSelector) Specificity() Specificity { return Specificity{0, 0, 0} }
 func (sel UniversalSelector) MatchAgainst(element dom.Element) bool {
 	return true
 }
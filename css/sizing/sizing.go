@@ -72,3 +72,23 @@ func (s Size) ComputeUsedValue(containerSize func() css.Num) values.Length {
 	log.Panicf("<bad Size type %v>", s.Type)
 	return values.Length{}
 }
+
+// BoxSizing represents value of [CSS box-sizing] property.
+//
+// [CSS box-sizing]: https://www.w3.org/TR/2021/WD-css-sizing-3-20211217/#box-sizing
+type BoxSizing uint8
+
+const (
+	ContentBox BoxSizing = iota // box-sizing: content-box
+	BorderBox                   // box-sizing: border-box
+)
+
+func (b BoxSizing) String() string {
+	switch b {
+	case ContentBox:
+		return "content-box"
+	case BorderBox:
+		return "border-box"
+	}
+	return fmt.Sprintf("<bad BoxSizing %d>", b)
+}
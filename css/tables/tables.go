@@ -0,0 +1,89 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+// Package tables provides types and values for [CSS Table Module Level 3].
+//
+// [CSS Table Module Level 3]: https://www.w3.org/TR/css-tables-3/
+package tables
+
+import (
+	"fmt"
+
+	"github.com/inseo-oh/yw/css/values"
+)
+
+// BorderCollapse represents value of [CSS border-collapse] property.
+//
+// [CSS border-collapse]: https://www.w3.org/TR/css-tables-3/#border-collapse-property
+type BorderCollapse uint8
+
+const (
+	Separate BorderCollapse = iota // border-collapse: separate
+	Collapse                       // border-collapse: collapse
+)
+
+func (c BorderCollapse) String() string {
+	switch c {
+	case Separate:
+		return "separate"
+	case Collapse:
+		return "collapse"
+	}
+	return fmt.Sprintf("<bad BorderCollapse %d>", c)
+}
+
+// BorderSpacing represents value of [CSS border-spacing] property.
+//
+// [CSS border-spacing]: https://www.w3.org/TR/css-tables-3/#border-spacing-property
+type BorderSpacing struct {
+	Horizontal values.Length
+	Vertical   values.Length
+}
+
+func (s BorderSpacing) String() string {
+	if s.Horizontal == s.Vertical {
+		return s.Horizontal.String()
+	}
+	return fmt.Sprintf("%v %v", s.Horizontal, s.Vertical)
+}
+
+// Layout represents value of [CSS table-layout] property.
+//
+// [CSS table-layout]: https://www.w3.org/TR/css-tables-3/#table-layout-property
+type Layout uint8
+
+const (
+	AutoLayout  Layout = iota // table-layout: auto
+	FixedLayout               // table-layout: fixed
+)
+
+func (l Layout) String() string {
+	switch l {
+	case AutoLayout:
+		return "auto"
+	case FixedLayout:
+		return "fixed"
+	}
+	return fmt.Sprintf("<bad Layout %d>", l)
+}
+
+// CaptionSide represents value of [CSS caption-side] property.
+//
+// [CSS caption-side]: https://www.w3.org/TR/css-tables-3/#caption-side-property
+type CaptionSide uint8
+
+const (
+	CaptionTop    CaptionSide = iota // caption-side: top
+	CaptionBottom                    // caption-side: bottom
+)
+
+func (s CaptionSide) String() string {
+	switch s {
+	case CaptionTop:
+		return "top"
+	case CaptionBottom:
+		return "bottom"
+	}
+	return fmt.Sprintf("<bad CaptionSide %d>", s)
+}
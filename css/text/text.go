@@ -8,8 +8,12 @@
 package text
 
 import (
+	"fmt"
 	"log"
 	"strings"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/values"
 )
 
 // Transform represents value of [CSS text-transform] property.
@@ -83,3 +87,229 @@ func (t Transform) Apply(text string) string {
 	}
 	return outText
 }
+
+// WordBreak represents value of [CSS word-break] property.
+//
+// [CSS word-break]: https://www.w3.org/TR/css-text-3/#word-break-property
+type WordBreak uint8
+
+const (
+	NormalWordBreak WordBreak = iota // word-break: normal
+	KeepAll                          // word-break: keep-all
+	BreakAll                         // word-break: break-all
+	BreakWord                        // word-break: break-word (Same as normal with overflow-wrap: anywhere)
+)
+
+func (w WordBreak) String() string {
+	switch w {
+	case NormalWordBreak:
+		return "normal"
+	case KeepAll:
+		return "keep-all"
+	case BreakAll:
+		return "break-all"
+	case BreakWord:
+		return "break-word"
+	}
+	return fmt.Sprintf("<bad WordBreak %d>", w)
+}
+
+// OverflowWrap represents value of [CSS overflow-wrap] property.
+//
+// [CSS overflow-wrap]: https://www.w3.org/TR/css-text-3/#overflow-wrap-property
+type OverflowWrap uint8
+
+const (
+	NormalOverflowWrap    OverflowWrap = iota // overflow-wrap: normal
+	AnywhereOverflowWrap                      // overflow-wrap: anywhere
+	BreakWordOverflowWrap                     // overflow-wrap: break-word
+)
+
+func (o OverflowWrap) String() string {
+	switch o {
+	case NormalOverflowWrap:
+		return "normal"
+	case AnywhereOverflowWrap:
+		return "anywhere"
+	case BreakWordOverflowWrap:
+		return "break-word"
+	}
+	return fmt.Sprintf("<bad OverflowWrap %d>", o)
+}
+
+// LineBreak represents value of [CSS line-break] property.
+//
+// [CSS line-break]: https://www.w3.org/TR/css-text-3/#line-break-property
+type LineBreak uint8
+
+const (
+	AutoLineBreak     LineBreak = iota // line-break: auto
+	LooseLineBreak                     // line-break: loose
+	NormalLineBreak                    // line-break: normal
+	StrictLineBreak                    // line-break: strict
+	AnywhereLineBreak                  // line-break: anywhere
+)
+
+func (l LineBreak) String() string {
+	switch l {
+	case AutoLineBreak:
+		return "auto"
+	case LooseLineBreak:
+		return "loose"
+	case NormalLineBreak:
+		return "normal"
+	case StrictLineBreak:
+		return "strict"
+	case AnywhereLineBreak:
+		return "anywhere"
+	}
+	return fmt.Sprintf("<bad LineBreak %d>", l)
+}
+
+// Align represents value of [CSS text-align] property.
+//
+// [CSS text-align]: https://www.w3.org/TR/css-text-3/#text-align-property
+type Align uint8
+
+const (
+	StartAlign   Align = iota // text-align: start
+	EndAlign                  // text-align: end
+	LeftAlign                 // text-align: left
+	RightAlign                // text-align: right
+	CenterAlign               // text-align: center
+	JustifyAlign              // text-align: justify
+	MatchParent               // text-align: match-parent
+	JustifyAll                // text-align: justify-all
+)
+
+func (a Align) String() string {
+	switch a {
+	case StartAlign:
+		return "start"
+	case EndAlign:
+		return "end"
+	case LeftAlign:
+		return "left"
+	case RightAlign:
+		return "right"
+	case CenterAlign:
+		return "center"
+	case JustifyAlign:
+		return "justify"
+	case MatchParent:
+		return "match-parent"
+	case JustifyAll:
+		return "justify-all"
+	}
+	return fmt.Sprintf("<bad Align %d>", a)
+}
+
+// Indent represents value of [CSS text-indent] property.
+//
+// [CSS text-indent]: https://www.w3.org/TR/css-text-3/#text-indent-property
+type Indent struct {
+	Value    values.LengthResolvable // Amount of indentation
+	Hanging  bool                    // Corresponds to "hanging" of text-indent
+	EachLine bool                    // Corresponds to "each-line" of text-indent
+}
+
+func (i Indent) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(i.Value.String())
+	if i.Hanging {
+		sb.WriteString(" hanging")
+	}
+	if i.EachLine {
+		sb.WriteString(" each-line")
+	}
+	return sb.String()
+}
+
+// WhiteSpaceCollapse represents value of [CSS white-space-collapse] property.
+//
+// [CSS white-space-collapse]: https://www.w3.org/TR/css-text-4/#white-space-collapsing
+type WhiteSpaceCollapse uint8
+
+const (
+	CollapseWhiteSpace WhiteSpaceCollapse = iota // white-space-collapse: collapse
+	DiscardWhiteSpace                            // white-space-collapse: discard
+	PreserveWhiteSpace                           // white-space-collapse: preserve
+	PreserveBreaks                               // white-space-collapse: preserve-breaks
+	PreserveSpaces                               // white-space-collapse: preserve-spaces
+	BreakSpaces                                  // white-space-collapse: break-spaces
+)
+
+func (c WhiteSpaceCollapse) String() string {
+	switch c {
+	case CollapseWhiteSpace:
+		return "collapse"
+	case DiscardWhiteSpace:
+		return "discard"
+	case PreserveWhiteSpace:
+		return "preserve"
+	case PreserveBreaks:
+		return "preserve-breaks"
+	case PreserveSpaces:
+		return "preserve-spaces"
+	case BreakSpaces:
+		return "break-spaces"
+	}
+	return fmt.Sprintf("<bad WhiteSpaceCollapse %d>", c)
+}
+
+// PreservesSpaces reports whether spaces and tabs are preserved.
+func (c WhiteSpaceCollapse) PreservesSpaces() bool {
+	return c == PreserveWhiteSpace || c == PreserveSpaces || c == BreakSpaces
+}
+
+// PreservesBreaks reports whether segment breaks are preserved.
+func (c WhiteSpaceCollapse) PreservesBreaks() bool {
+	return c == PreserveWhiteSpace || c == PreserveBreaks || c == BreakSpaces
+}
+
+// WrapMode represents value of [CSS text-wrap-mode] property.
+//
+// [CSS text-wrap-mode]: https://www.w3.org/TR/css-text-4/#text-wrap-mode
+type WrapMode uint8
+
+const (
+	Wrap   WrapMode = iota // text-wrap-mode: wrap
+	NoWrap                 // text-wrap-mode: nowrap
+)
+
+func (m WrapMode) String() string {
+	switch m {
+	case Wrap:
+		return "wrap"
+	case NoWrap:
+		return "nowrap"
+	}
+	return fmt.Sprintf("<bad WrapMode %d>", m)
+}
+
+// TabSize represents value of [CSS tab-size] property.
+//
+// [CSS tab-size]: https://www.w3.org/TR/css-text-3/#tab-size-property
+type TabSize struct {
+	Type   TabSizeType   // Type of tab size value
+	Number css.Num       // Multiplier of the advance width of space, when Type is NumberTabSize
+	Length values.Length // Tab size when Type is LengthTabSize
+}
+
+// Type of [TabSize]
+type TabSizeType uint8
+
+const (
+	NumberTabSize TabSizeType = iota // <number>
+	LengthTabSize                    // <length>
+)
+
+func (s TabSize) String() string {
+	switch s.Type {
+	case NumberTabSize:
+		return s.Number.String()
+	case LengthTabSize:
+		return s.Length.String()
+	}
+	return fmt.Sprintf("<bad TabSize type %d>", s.Type)
+}
@@ -0,0 +1,58 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package values
+
+import (
+	"fmt"
+
+	"github.com/inseo-oh/yw/css"
+)
+
+// Position represents CSS <position> type, which is a position of an object
+// area(e.g. background image) inside of a positioning area.
+//
+// https://www.w3.org/TR/css-values-4/#position
+type Position struct {
+	X, Y PositionOffset
+}
+
+// PositionOffset is an offset of [Position] in one axis. Percentages refer to
+// size of the positioning area minus size of the object area.
+type PositionOffset struct {
+	FromEnd bool             // Offset is from the right or bottom edge, instead of the left or top edge.
+	Offset  LengthResolvable // The offset
+}
+
+// CenterPosition is the center of the positioning area.
+var CenterPosition = Position{
+	X: PositionOffset{Offset: Percentage{50}},
+	Y: PositionOffset{Offset: Percentage{50}},
+}
+
+func (p Position) String() string {
+	xEdge, yEdge := "left", "top"
+	if p.X.FromEnd {
+		xEdge = "right"
+	}
+	if p.Y.FromEnd {
+		yEdge = "bottom"
+	}
+	return fmt.Sprintf("%s %v %s %v", xEdge, p.X.Offset, yEdge, p.Y.Offset)
+}
+
+// Resolve returns offsets of the object area of given size, from the top-left
+// corner of the positioning area of given size.
+//
+// fontSize is used to resolve font-relative lengths.
+func (p Position) Resolve(areaWidth, areaHeight, objWidth, objHeight float64, fontSize func() css.Num) (x, y float64) {
+	resolve := func(o PositionOffset, space float64) float64 {
+		v := o.Offset.AsLength(func() css.Num { return css.NumFromFloat(space) }).ToPx(fontSize)
+		if o.FromEnd {
+			return space - v
+		}
+		return v
+	}
+	return resolve(p.X, areaWidth-objWidth), resolve(p.Y, areaHeight-objHeight)
+}
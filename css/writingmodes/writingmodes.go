@@ -0,0 +1,114 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+// Package writingmodes provides types and values for [CSS Writing Modes Level 3].
+//
+// [CSS Writing Modes Level 3]: https://www.w3.org/TR/css-writing-modes-3/
+package writingmodes
+
+import "fmt"
+
+// Direction represents value of [CSS direction] property.
+//
+// [CSS direction]: https://www.w3.org/TR/css-writing-modes-3/#direction
+type Direction uint8
+
+const (
+	Ltr Direction = iota // direction: ltr
+	Rtl                  // direction: rtl
+)
+
+func (d Direction) String() string {
+	switch d {
+	case Ltr:
+		return "ltr"
+	case Rtl:
+		return "rtl"
+	}
+	return fmt.Sprintf("<bad Direction %d>", d)
+}
+
+// UnicodeBidi represents value of [CSS unicode-bidi] property.
+//
+// [CSS unicode-bidi]: https://www.w3.org/TR/css-writing-modes-3/#unicode-bidi
+type UnicodeBidi uint8
+
+const (
+	NormalUnicodeBidi UnicodeBidi = iota // unicode-bidi: normal
+	Embed                                // unicode-bidi: embed
+	Isolate                              // unicode-bidi: isolate
+	BidiOverride                         // unicode-bidi: bidi-override
+	IsolateOverride                      // unicode-bidi: isolate-override
+	Plaintext                            // unicode-bidi: plaintext
+)
+
+func (u UnicodeBidi) String() string {
+	switch u {
+	case NormalUnicodeBidi:
+		return "normal"
+	case Embed:
+		return "embed"
+	case Isolate:
+		return "isolate"
+	case BidiOverride:
+		return "bidi-override"
+	case IsolateOverride:
+		return "isolate-override"
+	case Plaintext:
+		return "plaintext"
+	}
+	return fmt.Sprintf("<bad UnicodeBidi %d>", u)
+}
+
+// WritingMode represents value of [CSS writing-mode] property.
+//
+// [CSS writing-mode]: https://www.w3.org/TR/css-writing-modes-3/#block-flow
+type WritingMode uint8
+
+const (
+	HorizontalTb WritingMode = iota // writing-mode: horizontal-tb
+	VerticalRl                      // writing-mode: vertical-rl
+	VerticalLr                      // writing-mode: vertical-lr
+)
+
+func (m WritingMode) String() string {
+	switch m {
+	case HorizontalTb:
+		return "horizontal-tb"
+	case VerticalRl:
+		return "vertical-rl"
+	case VerticalLr:
+		return "vertical-lr"
+	}
+	return fmt.Sprintf("<bad WritingMode %d>", m)
+}
+
+// IsVertical reports whether m is one of vertical writing modes, where lines
+// are laid out vertically.
+func (m WritingMode) IsVertical() bool {
+	return m == VerticalRl || m == VerticalLr
+}
+
+// TextOrientation represents value of [CSS text-orientation] property.
+//
+// [CSS text-orientation]: https://www.w3.org/TR/css-writing-modes-3/#text-orientation
+type TextOrientation uint8
+
+const (
+	Mixed    TextOrientation = iota // text-orientation: mixed
+	Upright                         // text-orientation: upright
+	Sideways                        // text-orientation: sideways
+)
+
+func (o TextOrientation) String() string {
+	switch o {
+	case Mixed:
+		return "mixed"
+	case Upright:
+		return "upright"
+	case Sideways:
+		return "sideways"
+	}
+	return fmt.Sprintf("<bad TextOrientation %d>", o)
+}
@@ -6,6 +6,7 @@ package dom
 
 import (
 	"fmt"
+	"image"
 	"net/url"
 )
 
@@ -109,6 +110,18 @@ type Document interface {
 	//
 	// [relevant settings object]: https://html.spec.whatwg.org/multipage/webappapis.html#relevant-settings-object
 	SetReleavntSettings(settings DocumentEnvironmentSettings)
+
+	// AvailableImage returns the image fetched from url in the document's
+	// [list of available images]. ok is false if there's no such image.
+	//
+	// [list of available images]: https://html.spec.whatwg.org/multipage/images.html#list-of-available-images
+	AvailableImage(url string) (img image.Image, ok bool)
+
+	// AddAvailableImage adds img fetched from url to the document's
+	// [list of available images].
+	//
+	// [list of available images]: https://html.spec.whatwg.org/multipage/images.html#list-of-available-images
+	AddAvailableImage(url string, img image.Image)
 }
 
 // DocumentMode represents Document's [mode]
@@ -138,6 +151,7 @@ type documentImpl struct {
 	iframeSrcdocDocument   bool
 	parserCannotChangeMode bool
 	baseURL                url.URL
+	availableImages        map[string]image.Image
 
 	// Below are STUB
 	environmentSettings DocumentEnvironmentSettings
@@ -146,7 +160,7 @@ type documentImpl struct {
 
 // NewDocument constructs a new [Document].
 func NewDocument() Document {
-	doc := &documentImpl{}
+	doc := &documentImpl{availableImages: map[string]image.Image{}}
 	doc.Node = NewNode(doc)
 	return doc
 }
@@ -188,6 +202,14 @@ func (doc *documentImpl) SetBaseURL(url url.URL) {
 	doc.baseURL = url
 }
 
+func (doc documentImpl) AvailableImage(url string) (img image.Image, ok bool) {
+	img, ok = doc.availableImages[url]
+	return img, ok
+}
+func (doc *documentImpl) AddAvailableImage(url string, img image.Image) {
+	doc.availableImages[url] = img
+}
+
 func (doc documentImpl) Mode() DocumentMode           { return doc.mode }
 func (doc *documentImpl) SetMode(mode DocumentMode)   { doc.mode = mode }
 func (d documentImpl) IsParserCannotChangeMode() bool { return d.parserCannotChangeMode }
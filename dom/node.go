@@ -467,7 +467,7 @@ func Insert(node, parent, beforeChild Node, suppressObservers bool) {
 			// S7-3.
 			children := parent.Children()
 			insertIndex := slices.Index(children, beforeChild)
-			children = append(append(children[:insertIndex], node), children[insertIndex:]...)
+			children = slices.Insert(children, insertIndex, node)
 			parent.SetChildren(children)
 		}
 		// S7-4.
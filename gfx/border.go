@@ -0,0 +1,29 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package gfx
+
+import "image/color"
+
+// BorderSide represents a single side of a box border.
+type BorderSide struct {
+	Width int         // Border width in pixels
+	Style BorderStyle // Border style
+	Color color.Color // Border color
+}
+
+// Style of border
+type BorderStyle uint8
+
+const (
+	NoBorder     BorderStyle = iota // No border
+	SolidBorder                     // Solid line
+	DottedBorder                    // Series of round dots
+	DashedBorder                    // Series of square-ended dashes
+	DoubleBorder                    // Two parallel solid lines
+	GrooveBorder                    // Looks as if it were carved into the canvas
+	RidgeBorder                     // Looks as if it were coming out of the canvas
+	InsetBorder                     // Box looks as if it were embedded in the canvas
+	OutsetBorder                    // Box looks as if it were coming out of the canvas
+)
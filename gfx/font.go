@@ -46,6 +46,10 @@ type Font interface {
 	// DrawText can also perform dry-run. To do so, pass nil to dest.
 	// Dry-runs can be used to measure dimensions of text.
 	DrawText(text string, dest *image.RGBA, offsetX, offsetY int, textColor color.Color) image.Rectangle
+
+	// HasGlyph reports whether the font has a glyph for the character r.
+	// (In other words, whether r is covered by the font's character map)
+	HasGlyph(r rune) bool
 }
 
 // MeasureText performs dry-run text drawing, and returns dimensions of the text.
@@ -0,0 +1,118 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package gfx
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// GradientStop is a color stop of a gradient.
+type GradientStop struct {
+	Offset float64     // Position along the gradient ray. 0 is the start, and 1 is the end.
+	Color  color.Color // Color at the stop
+}
+
+// LinearGradient is an image filled with colors changing along the line from
+// Start to End. Colors are same along lines perpendicular to it.
+type LinearGradient struct {
+	Rect       image.Rectangle // Bounds of the image
+	Start, End [2]float64      // Start and end point of the gradient line
+	Stops      []GradientStop
+	Repeating  bool // Repeat stops beyond the first and the last one?
+}
+
+// RadialGradient is an image filled with colors changing from Center to the
+// ellipse with given radii.
+type RadialGradient struct {
+	Rect             image.Rectangle // Bounds of the image
+	Center           [2]float64      // Center of the gradient
+	RadiusX, RadiusY float64         // Radii of the ellipse, where offset of stops is 1.
+	Stops            []GradientStop
+	Repeating        bool // Repeat stops beyond the first and the last one?
+}
+
+// ConicGradient is an image filled with colors changing around Center.
+type ConicGradient struct {
+	Rect       image.Rectangle // Bounds of the image
+	Center     [2]float64      // Center of the gradient
+	StartAngle float64         // Angle where offset of stops is 0, in radians clockwise from top.
+	Stops      []GradientStop  // Offset 1 is a full turn.
+	Repeating  bool            // Repeat stops beyond the first and the last one?
+}
+
+func (g LinearGradient) ColorModel() color.Model { return color.RGBA64Model }
+func (g LinearGradient) Bounds() image.Rectangle { return g.Rect }
+func (g LinearGradient) At(x, y int) color.Color {
+	dx, dy := g.End[0]-g.Start[0], g.End[1]-g.Start[1]
+	lenSq := dx*dx + dy*dy
+	if lenSq == 0 {
+		return gradientColorAt(g.Stops, 0, g.Repeating)
+	}
+	// Project the pixel center onto the gradient line.
+	px, py := float64(x)+0.5-g.Start[0], float64(y)+0.5-g.Start[1]
+	return gradientColorAt(g.Stops, (px*dx+py*dy)/lenSq, g.Repeating)
+}
+
+func (g RadialGradient) ColorModel() color.Model { return color.RGBA64Model }
+func (g RadialGradient) Bounds() image.Rectangle { return g.Rect }
+func (g RadialGradient) At(x, y int) color.Color {
+	if g.RadiusX <= 0 || g.RadiusY <= 0 {
+		// Degenerate ending shape is painted as if everything is outside of it.
+		return gradientColorAt(g.Stops, math.Inf(1), false)
+	}
+	dx := (float64(x) + 0.5 - g.Center[0]) / g.RadiusX
+	dy := (float64(y) + 0.5 - g.Center[1]) / g.RadiusY
+	return gradientColorAt(g.Stops, math.Hypot(dx, dy), g.Repeating)
+}
+
+func (g ConicGradient) ColorModel() color.Model { return color.RGBA64Model }
+func (g ConicGradient) Bounds() image.Rectangle { return g.Rect }
+func (g ConicGradient) At(x, y int) color.Color {
+	dx, dy := float64(x)+0.5-g.Center[0], float64(y)+0.5-g.Center[1]
+	angle := math.Atan2(dx, -dy) - g.StartAngle
+	turn := math.Mod(angle/(2*math.Pi), 1)
+	if turn < 0 {
+		turn++
+	}
+	return gradientColorAt(g.Stops, turn, g.Repeating)
+}
+
+// gradientColorAt returns color at given offset of the gradient ray.
+// Colors are interpolated in premultiplied form, so that transparent stops
+// don't darken colors next to them.
+func gradientColorAt(stops []GradientStop, offset float64, repeating bool) color.Color {
+	if len(stops) == 0 {
+		return color.Transparent
+	}
+	first, last := stops[0], stops[len(stops)-1]
+	if period := last.Offset - first.Offset; repeating && 0 < period {
+		offset = first.Offset + math.Mod(offset-first.Offset, period)
+		if offset < first.Offset {
+			offset += period
+		}
+	}
+	if offset <= first.Offset {
+		return first.Color
+	}
+	for i := 1; i < len(stops); i++ {
+		prev, next := stops[i-1], stops[i]
+		if next.Offset < offset {
+			continue
+		}
+		if next.Offset == prev.Offset {
+			return next.Color
+		}
+		t := (offset - prev.Offset) / (next.Offset - prev.Offset)
+		r0, g0, b0, a0 := prev.Color.RGBA()
+		r1, g1, b1, a1 := next.Color.RGBA()
+		lerp := func(a, b uint32) uint16 {
+			return uint16(math.Round(float64(a)*(1-t) + float64(b)*t))
+		}
+		return color.RGBA64{lerp(r0, r1), lerp(g0, g1), lerp(b0, b1), lerp(a0, a1)}
+	}
+	return last.Color
+}
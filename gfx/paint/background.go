@@ -0,0 +1,61 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package paint
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+)
+
+// TiledImagePaint is Node that paints an image repeatedly, which is used to
+// paint a background image layer.
+//
+// Spec: https://www.w3.org/TR/css-backgrounds-3/#background-repeat
+type TiledImagePaint struct {
+	Image image.Image     // Image to paint
+	Tile  image.Rectangle // Rect of a tile. Image is scaled to fill it, and other tiles are placed next to it. (Max is exclusive)
+	Clip  image.Rectangle // Rect where tiles are visible (Max is exclusive)
+
+	RepeatX, RepeatY   bool // Repeat tiles horizontally/vertically?
+	SpacingX, SpacingY int  // Space between repeated tiles
+}
+
+func (t TiledImagePaint) Paint(dest *image.RGBA) {
+	clip := t.Clip.Intersect(dest.Rect)
+	if clip.Empty() || t.Tile.Empty() || t.Image.Bounds().Empty() {
+		return
+	}
+	tileSize := t.Tile.Size()
+	tile := scaleImage(t.Image, image.Rectangle{Max: tileSize}, image.Rectangle{Max: tileSize})
+
+	// Find range of tiles covering the clip rect.
+	tileRange := func(start, size, spacing int, repeat bool, clipMin, clipMax int) (first, last int) {
+		if !repeat {
+			return start, start
+		}
+		step := size + spacing
+		first = start - ((start-clipMin+step-1)/step)*step
+		if start < clipMin {
+			first = start + ((clipMin-start)/step)*step
+		}
+		return first, clipMax
+	}
+	firstX, lastX := tileRange(t.Tile.Min.X, tileSize.X, t.SpacingX, t.RepeatX, clip.Min.X, clip.Max.X)
+	firstY, lastY := tileRange(t.Tile.Min.Y, tileSize.Y, t.SpacingY, t.RepeatY, clip.Min.Y, clip.Max.Y)
+	for y := firstY; y <= lastY; y += tileSize.Y + t.SpacingY {
+		for x := firstX; x <= lastX; x += tileSize.X + t.SpacingX {
+			r := image.Rectangle{Min: image.Pt(x, y), Max: image.Pt(x, y).Add(tileSize)}.Intersect(clip)
+			if r.Empty() {
+				continue
+			}
+			draw.Draw(dest, r, tile, r.Min.Sub(image.Pt(x, y)), draw.Over)
+		}
+	}
+}
+func (t TiledImagePaint) String() string {
+	return fmt.Sprintf("tiled-image-paint(tile=%v, clip=%v, repeat=%v/%v, spacing=%d/%d, image-size=%v)",
+		t.Tile, t.Clip, t.RepeatX, t.RepeatY, t.SpacingX, t.SpacingY, t.Image.Bounds().Size())
+}
@@ -0,0 +1,185 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package paint
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+
+	"github.com/inseo-oh/yw/gfx"
+)
+
+// BorderPaint is Node that paints borders of a box.
+//
+// Spec: https://www.w3.org/TR/css-backgrounds-3/#border-style
+type BorderPaint struct {
+	Rect                     image.Rectangle // Border box (Max is exclusive)
+	Top, Right, Bottom, Left gfx.BorderSide
+	Radii                    gfx.CornerRadii // Radii of outer border edge
+}
+
+// Sides of the border, in the order used by BorderPaint.sides.
+const (
+	sideTop = iota
+	sideRight
+	sideBottom
+	sideLeft
+)
+
+func (b BorderPaint) sides() [4]gfx.BorderSide {
+	return [4]gfx.BorderSide{b.Top, b.Right, b.Bottom, b.Left}
+}
+
+func (b BorderPaint) Paint(dest *image.RGBA) {
+	sides := b.sides()
+	for i := range sides {
+		if sides[i].Style == gfx.NoBorder || sides[i].Width < 0 || sides[i].Color == nil {
+			sides[i].Width = 0
+		}
+	}
+	r := b.Rect.Intersect(dest.Rect)
+	rounded := !b.Radii.IsZero()
+	// With rounded corners, the border is the area between outer and inner
+	// border edges, which are curved at corners.
+	//
+	// Spec: https://www.w3.org/TR/css-backgrounds-3/#corner-shaping
+	var mask *image.Alpha
+	if rounded {
+		outer := gfx.NewRoundedRect(b.Rect, b.Radii)
+		inner := outer.Inset(float64(sides[sideTop].Width), float64(sides[sideRight].Width), float64(sides[sideBottom].Width), float64(sides[sideLeft].Width))
+		path := gfx.Path{}
+		path.AddRoundedRect(outer)
+		path.AddRoundedRect(inner)
+		mask = path.Mask(r, gfx.EvenOdd)
+	}
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			coverage := uint8(255)
+			if mask != nil {
+				coverage = mask.AlphaAt(x, y).A
+				if coverage == 0 {
+					continue
+				}
+			}
+			// Distance from the outer edge of each side
+			dists := [4]int{y - b.Rect.Min.Y, b.Rect.Max.X - 1 - x, b.Rect.Max.Y - 1 - y, x - b.Rect.Min.X}
+
+			// Find out which side the pixel belongs to. At corners, two sides
+			// are joined along the line from the outer corner to the inner
+			// corner, so we pick the side the pixel is relatively closer to.
+			// Curved corners may reach further than width of the sides.
+			side := -1
+			for i, s := range sides {
+				if s.Width == 0 || (!rounded && s.Width <= dists[i]) {
+					continue
+				}
+				if side == -1 || (dists[i]*2+1)*sides[side].Width < (dists[side]*2+1)*s.Width {
+					side = i
+				}
+			}
+			if side == -1 {
+				continue
+			}
+			// Position along the side
+			var pos int
+			switch side {
+			case sideTop, sideBottom:
+				pos = x - r.Min.X
+			case sideLeft, sideRight:
+				pos = y - r.Min.Y
+			}
+			col := borderPixelColor(sides[side], side, min(dists[side], sides[side].Width-1), pos)
+			if col == nil {
+				continue
+			}
+			if coverage < 255 {
+				mask := image.NewUniform(color.Alpha{coverage})
+				draw.DrawMask(dest, image.Rect(x, y, x+1, y+1), image.NewUniform(col), image.Point{0, 0}, mask, image.Point{}, draw.Over)
+			} else {
+				draw.Draw(dest, image.Rect(x, y, x+1, y+1), image.NewUniform(col), image.Point{0, 0}, draw.Over)
+			}
+		}
+	}
+}
+
+// borderPixelColor returns color of the pixel in given border side, where depth
+// is distance from outer edge of the border, and pos is position along the side.
+// Returns nil if the pixel should not be painted.
+func borderPixelColor(s gfx.BorderSide, side, depth, pos int) color.Color {
+	w := s.Width
+	switch s.Style {
+	case gfx.SolidBorder:
+		return s.Color
+	case gfx.DoubleBorder:
+		// Two lines and the space between them are about the same width.
+		if w < 3 {
+			return s.Color
+		}
+		lineWidth := (w + 1) / 3
+		if depth < lineWidth || w-lineWidth <= depth {
+			return s.Color
+		}
+		return nil
+	case gfx.DottedBorder:
+		// Round dots, with the same amount of space between them.
+		radius := float64(w) / 2
+		period := float64(w * 2)
+		p := float64(pos) + 0.5
+		dx := p - (math.Floor(p/period)*period + radius)
+		dy := float64(depth) + 0.5 - radius
+		if dx*dx+dy*dy <= radius*radius {
+			return s.Color
+		}
+		return nil
+	case gfx.DashedBorder:
+		if pos%(w*6) < w*3 {
+			return s.Color
+		}
+		return nil
+	case gfx.InsetBorder, gfx.OutsetBorder, gfx.GrooveBorder, gfx.RidgeBorder:
+		// Top and left sides are the ones facing the light source.
+		isLit := side == sideTop || side == sideLeft
+		style := s.Style
+		switch style {
+		case gfx.GrooveBorder:
+			// Outer half is inset, and inner half is outset.
+			if depth < w/2 {
+				style = gfx.InsetBorder
+			} else {
+				style = gfx.OutsetBorder
+			}
+		case gfx.RidgeBorder:
+			// Outer half is outset, and inner half is inset.
+			if depth < w/2 {
+				style = gfx.OutsetBorder
+			} else {
+				style = gfx.InsetBorder
+			}
+		}
+		if (style == gfx.InsetBorder) == isLit {
+			return darkerColor(s.Color)
+		}
+		return s.Color
+	}
+	return nil
+}
+
+// darkerColor returns darker version of col, used for 3D-looking borders.
+func darkerColor(col color.Color) color.Color {
+	c := color.RGBAModel.Convert(col).(color.RGBA)
+	return color.RGBA{R: uint8(int(c.R) * 2 / 3), G: uint8(int(c.G) * 2 / 3), B: uint8(int(c.B) * 2 / 3), A: c.A}
+}
+
+func (b BorderPaint) String() string {
+	sides := b.sides()
+	strs := [4]string{}
+	for i, s := range sides {
+		strs[i] = fmt.Sprintf("%d %d %v", s.Width, s.Style, s.Color)
+	}
+	return fmt.Sprintf("border-paint(rect=%v, top=[%s], right=[%s], bottom=[%s], left=[%s], radii=%v)", b.Rect, strs[0], strs[1], strs[2], strs[3], b.Radii)
+}
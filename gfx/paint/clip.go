@@ -0,0 +1,46 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package paint
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+)
+
+// ClipPaint is Node that paints child nodes only inside of a rect, scrolled by
+// Offset.
+//
+// Spec: https://www.w3.org/TR/css-overflow-3/#overflow-control
+type ClipPaint struct {
+	Items  []Node          // Child nodes
+	Rect   image.Rectangle // Rect where child nodes are visible (Max is exclusive)
+	Offset image.Point     // Scroll offset. Child nodes are moved by -Offset.
+}
+
+func (c ClipPaint) Paint(dest *image.RGBA) {
+	r := c.Rect.Intersect(dest.Rect)
+	if r.Empty() {
+		return
+	}
+	if c.Offset == (image.Point{}) {
+		sub := dest.SubImage(r).(*image.RGBA)
+		for _, item := range c.Items {
+			item.Paint(sub)
+		}
+		return
+	}
+	// Child nodes are painted to the image whose coordinates are moved by
+	// the offset, and then copied back to the visible rect.
+	scrolled := image.NewRGBA(r.Add(c.Offset))
+	draw.Draw(scrolled, scrolled.Rect, dest, r.Min, draw.Src)
+	for _, item := range c.Items {
+		item.Paint(scrolled)
+	}
+	draw.Draw(dest, r, scrolled, scrolled.Rect.Min, draw.Src)
+}
+func (c ClipPaint) String() string {
+	return fmt.Sprintf("clip-paint(rect=%v, offset=%v, %d items)", c.Rect, c.Offset, len(c.Items))
+}
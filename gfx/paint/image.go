@@ -0,0 +1,71 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package paint
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"math"
+)
+
+// ImagePaint is Node that paints an image, scaled to fill the rect.
+type ImagePaint struct {
+	Image image.Image     // Image to paint
+	Rect  image.Rectangle // Rect to paint the image into (Max is exclusive)
+}
+
+func (i ImagePaint) Paint(dest *image.RGBA) {
+	r := i.Rect.Intersect(dest.Rect)
+	if r.Empty() || i.Image.Bounds().Empty() {
+		return
+	}
+	draw.Draw(dest, r, scaleImage(i.Image, i.Rect, r), r.Min, draw.Over)
+}
+func (i ImagePaint) String() string {
+	return fmt.Sprintf("image-paint(rect=%v, image-size=%v)", i.Rect, i.Image.Bounds().Size())
+}
+
+// scaleImage returns img scaled to fill rect. Only the part inside of visible
+// is returned.
+func scaleImage(img image.Image, rect, visible image.Rectangle) *image.RGBA {
+	srcBounds := img.Bounds()
+	// Decoded images come in various color models, so these are converted
+	// first.
+	src := image.NewRGBA(image.Rect(0, 0, srcBounds.Dx(), srcBounds.Dy()))
+	draw.Draw(src, src.Rect, img, srcBounds.Min, draw.Src)
+	if rect.Size() == srcBounds.Size() {
+		src.Rect = src.Rect.Add(rect.Min)
+		return src.SubImage(visible).(*image.RGBA)
+	}
+	scaled := image.NewRGBA(visible)
+	scaleX := float64(srcBounds.Dx()) / float64(rect.Dx())
+	scaleY := float64(srcBounds.Dy()) / float64(rect.Dy())
+	for y := visible.Min.Y; y < visible.Max.Y; y++ {
+		srcY := (float64(y-rect.Min.Y)+0.5)*scaleY - 0.5
+		for x := visible.Min.X; x < visible.Max.X; x++ {
+			srcX := (float64(x-rect.Min.X)+0.5)*scaleX - 0.5
+			copy(scaled.Pix[scaled.PixOffset(x, y):], sampleBilinear(src, srcX, srcY))
+		}
+	}
+	return scaled
+}
+
+// sampleBilinear returns RGBA components of src at (x, y), interpolated from
+// four nearest pixels. Pixels outside of src are clamped to the edges.
+func sampleBilinear(src *image.RGBA, x, y float64) []uint8 {
+	x = min(max(x, 0), float64(src.Rect.Dx()-1))
+	y = min(max(y, 0), float64(src.Rect.Dy()-1))
+	x0, y0 := int(math.Floor(x)), int(math.Floor(y))
+	x1, y1 := min(x0+1, src.Rect.Dx()-1), min(y0+1, src.Rect.Dy()-1)
+	fx, fy := x-float64(x0), y-float64(y0)
+	res := make([]uint8, 4)
+	for c := range 4 {
+		top := float64(src.Pix[src.PixOffset(x0, y0)+c])*(1-fx) + float64(src.Pix[src.PixOffset(x1, y0)+c])*fx
+		bottom := float64(src.Pix[src.PixOffset(x0, y1)+c])*(1-fx) + float64(src.Pix[src.PixOffset(x1, y1)+c])*fx
+		res[c] = uint8(math.Round(top*(1-fy) + bottom*fy))
+	}
+	return res
+}
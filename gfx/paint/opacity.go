@@ -0,0 +1,44 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package paint
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// OpacityPaint is Node that paints child nodes as a single group, and then
+// composites the group with given opacity.
+//
+// Spec: https://www.w3.org/TR/css-color-4/#transparency
+type OpacityPaint struct {
+	Items   []Node  // Child nodes
+	Opacity float64 // Opacity of the group, in range [0, 1]
+}
+
+func (o OpacityPaint) Paint(dest *image.RGBA) {
+	if o.Opacity <= 0 {
+		return
+	}
+	if 1 <= o.Opacity {
+		for _, item := range o.Items {
+			item.Paint(dest)
+		}
+		return
+	}
+	// Child nodes are painted to a transparent layer first, so that overlapping
+	// child nodes don't show through each other.
+	layer := image.NewRGBA(dest.Rect)
+	for _, item := range o.Items {
+		item.Paint(layer)
+	}
+	mask := image.NewUniform(color.Alpha{uint8(o.Opacity * 255)})
+	draw.DrawMask(dest, dest.Rect, layer, dest.Rect.Min, mask, image.Point{}, draw.Over)
+}
+func (o OpacityPaint) String() string {
+	return fmt.Sprintf("opacity-paint(opacity=%g, %d items)", o.Opacity, len(o.Items))
+}
@@ -13,7 +13,6 @@ import (
 	"image"
 	"image/color"
 	"image/draw"
-	"math"
 	"strings"
 
 	"github.com/inseo-oh/yw/gfx"
@@ -49,11 +48,18 @@ type TextPaint struct {
 	Size      float64
 	Color     color.Color
 	Decors    []gfx.TextDecorOptions
+
+	Orientation gfx.TextOrientation
 }
 
 func (t TextPaint) Paint(dest *image.RGBA) {
 	t.Font.SetTextSize(int(t.Size))
 	metrics := t.Font.Metrics()
+	if t.Orientation.IsVertical() {
+		// TODO: Support text decorations in vertical text
+		gfx.DrawVerticalText(t.Font, t.Text, t.Orientation, dest, t.Left, t.Top, t.Color)
+		return
+	}
 	x := t.Left
 	baselineY := int(float64(t.Top) + metrics.Ascender)
 	text := t.Text
@@ -83,21 +89,29 @@ func (t TextPaint) Paint(dest *image.RGBA) {
 			if decor.Style == gfx.DashedLine {
 				width = DashWidth
 			} else {
-				width = decorRect.Dy()
+				// Dots are at least 1px wide, even if the line is thinner than
+				// that. Otherwise x below would never advance.
+				width = max(decorRect.Dy(), 1)
 			}
 			for x := decorRect.Min.X; x < decorRect.Max.X; x += width * 2 {
 				dotRect := image.Rect(x, decorRect.Min.Y, x+width-1, decorRect.Max.Y)
 				fillRect(dest, dotRect, decor.Color)
 			}
 		case gfx.WavyLine:
-			sinInput := 0.0
-			currYOff := 0.0
-			for x := decorRect.Min.X; x < decorRect.Max.X; x++ {
-				dotRect := image.Rect(x, int(float64(decorRect.Min.Y)+currYOff), x, int(float64(decorRect.Max.Y)+currYOff))
-				fillRect(dest, dotRect, decor.Color)
-				currYOff += math.Sin(sinInput)
-				sinInput += 0.5
+			// Wave is made of quadratic curves, alternating above and below
+			// the center of the line.
+			amplitude := max(thickness, 1) * 1.5
+			halfWave := amplitude * 2
+			centerY := float64(decorRect.Min.Y) + thickness/2
+			path := gfx.Path{}
+			path.MoveTo(float64(decorRect.Min.X), centerY)
+			dir := -1.0
+			for x := float64(decorRect.Min.X); x < float64(decorRect.Max.X); x += halfWave {
+				path.QuadTo(x+halfWave/2, centerY+amplitude*dir*2, x+halfWave, centerY)
+				dir = -dir
 			}
+			stroke := path.Stroke(gfx.StrokeOptions{Width: max(thickness, 1), MiterLimit: 4})
+			gfx.FillPath(dest, stroke, gfx.NonZero, decor.Color)
 		}
 	}
 }
@@ -105,22 +119,16 @@ func (t TextPaint) String() string {
 	return fmt.Sprintf("text-paint(%s) %v %g", t.Text, t.Color, t.Size)
 }
 
-// Text is Node that paints a box.
+// BoxPaint is Node that paints a box.
 type BoxPaint struct {
-	Items []Node
-	Rect  image.Rectangle
-	Color color.Color
+	Items []Node          // Child nodes, painted on top of the box
+	Rect  image.Rectangle // Rect to fill with Color (Max is exclusive)
+	Color color.Color     // Background color
 }
 
 func (g BoxPaint) Paint(dest *image.RGBA) {
 	if !util.IsNil(g.Color) {
-		colorImg := image.NewRGBA(image.Rect(0, 0, int(g.Rect.Dx())-1, int(g.Rect.Dy())-1))
-		for yOff := range int(g.Rect.Dy()) {
-			for xOff := range int(g.Rect.Dx()) {
-				colorImg.Set(xOff, yOff, g.Color)
-			}
-		}
-		draw.Draw(dest, g.Rect, colorImg, image.Point{0, 0}, draw.Over)
+		draw.Draw(dest, g.Rect, image.NewUniform(g.Color), image.Point{0, 0}, draw.Over)
 	}
 	for _, item := range g.Items {
 		item.Paint(dest)
@@ -135,10 +143,19 @@ func PrintTree(node Node, indentLevel int) {
 	currNode := node
 	indent := strings.Repeat(" ", indentLevel*4)
 	fmt.Printf("%s%v\n", indent, node)
-	if gpaint, ok := currNode.(BoxPaint); ok {
-		for _, child := range gpaint.Items {
-			PrintTree(child, indentLevel+1)
-		}
+	var children []Node
+	switch n := currNode.(type) {
+	case BoxPaint:
+		children = n.Items
+	case ClipPaint:
+		children = n.Items
+	case OpacityPaint:
+		children = n.Items
+	case RoundedClipPaint:
+		children = n.Items
+	}
+	for _, child := range children {
+		PrintTree(child, indentLevel+1)
 	}
 
 }
@@ -0,0 +1,52 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package paint
+
+import (
+	"image"
+	"image/color"
+	"testing"
+	"time"
+
+	"github.com/inseo-oh/yw/gfx"
+)
+
+// thinFont is a font with zero underline thickness, and every character is
+// 10x10px.
+type thinFont struct{}
+
+func (fnt thinFont) SetTextSize(size int) {}
+func (fnt thinFont) Metrics() gfx.FontMetrics {
+	return gfx.FontMetrics{Ascender: 8, Descender: 2, LineHeight: 10}
+}
+func (fnt thinFont) DrawText(text string, dest *image.RGBA, offsetX, offsetY int, textColor color.Color) image.Rectangle {
+	return image.Rect(offsetX, offsetY-8, offsetX+10*len(text), offsetY+2)
+}
+func (fnt thinFont) HasGlyph(r rune) bool { return true }
+
+func TestTextDecorationWithoutThickness(t *testing.T) {
+	black := color.RGBA{0, 0, 0, 255}
+	for _, style := range []gfx.TextDecorStyle{gfx.SolidLine, gfx.DoubleLine, gfx.DottedLine, gfx.DashedLine, gfx.WavyLine} {
+		txt := TextPaint{
+			Text:  "abcdefghij",
+			Font:  thinFont{},
+			Size:  10,
+			Color: black,
+			Decors: []gfx.TextDecorOptions{
+				{Type: gfx.Underline, Color: black, Style: style},
+			},
+		}
+		done := make(chan struct{})
+		go func() {
+			txt.Paint(image.NewRGBA(image.Rect(0, 0, 100, 20)))
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("painting decoration with style %v didn't finish", style)
+		}
+	}
+}
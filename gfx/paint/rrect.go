@@ -0,0 +1,82 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package paint
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/inseo-oh/yw/gfx"
+)
+
+// RoundedRectPaint is Node that fills a rounded rect with anti-aliased edges.
+//
+// Spec: https://www.w3.org/TR/css-backgrounds-3/#border-radius
+type RoundedRectPaint struct {
+	Shape gfx.RoundedRect
+	Color color.Color
+}
+
+func (r RoundedRectPaint) Paint(dest *image.RGBA) {
+	area := r.Shape.Rect.Intersect(dest.Rect)
+	if area.Empty() {
+		return
+	}
+	if r.Shape.Radii.IsZero() {
+		draw.Draw(dest, area, image.NewUniform(r.Color), image.Point{}, draw.Over)
+		return
+	}
+	draw.DrawMask(dest, area, image.NewUniform(r.Color), image.Point{}, r.Shape.Mask(area), area.Min, draw.Over)
+}
+func (r RoundedRectPaint) String() string {
+	return fmt.Sprintf("rounded-rect-paint(rect=%v, radii=%v, color=%v)", r.Shape.Rect, r.Shape.Radii, r.Color)
+}
+
+// RoundedClipPaint is Node that paints child nodes only inside of a rounded
+// rect. Pixels along curves of corners are partially painted.
+//
+// Spec: https://www.w3.org/TR/css-backgrounds-3/#corner-clipping
+type RoundedClipPaint struct {
+	Items []Node // Child nodes
+	Shape gfx.RoundedRect
+}
+
+func (c RoundedClipPaint) Paint(dest *image.RGBA) {
+	area := c.Shape.Rect.Intersect(dest.Rect)
+	if area.Empty() {
+		return
+	}
+	if c.Shape.Radii.IsZero() {
+		ClipPaint{Items: c.Items, Rect: area}.Paint(dest)
+		return
+	}
+	// Child nodes are painted to a copy of the area first, and then copied
+	// back through the mask.
+	layer := image.NewRGBA(area)
+	draw.Draw(layer, area, dest, area.Min, draw.Src)
+	for _, item := range c.Items {
+		item.Paint(layer)
+	}
+	mask := c.Shape.Mask(area)
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			a := uint32(mask.Pix[mask.PixOffset(x, y)])
+			if a == 0 {
+				continue
+			}
+			i, j := dest.PixOffset(x, y), layer.PixOffset(x, y)
+			for k := range 4 {
+				// Blend between the original and painted pixels.
+				orig, painted := uint32(dest.Pix[i+k]), uint32(layer.Pix[j+k])
+				dest.Pix[i+k] = uint8((orig*(255-a) + painted*a + 127) / 255)
+			}
+		}
+	}
+}
+func (c RoundedClipPaint) String() string {
+	return fmt.Sprintf("rounded-clip-paint(rect=%v, radii=%v, %d items)", c.Shape.Rect, c.Shape.Radii, len(c.Items))
+}
@@ -0,0 +1,124 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package paint
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+
+	"github.com/inseo-oh/yw/gfx"
+)
+
+// BoxShadowPaint is Node that paints a shadow of a box.
+//
+// Spec: https://www.w3.org/TR/css-backgrounds-3/#box-shadow
+type BoxShadowPaint struct {
+	// Border box for outer shadows, and padding box for inner shadows.
+	// Outer shadows are only painted outside of it, and inner shadows are only
+	// painted inside of it.
+	Box gfx.RoundedRect
+
+	Color            color.Color
+	OffsetX, OffsetY int     // Offset of the shadow
+	Blur             float64 // Blur radius
+	Spread           float64 // Spread distance
+	Inset            bool    // Is it inner shadow?
+}
+
+func (s BoxShadowPaint) Paint(dest *image.RGBA) {
+	// Shape of the shadow before blurring. For inner shadows, the shadow is
+	// painted outside of this shape.
+	shape := s.Box.Inset(-s.Spread, -s.Spread, -s.Spread, -s.Spread)
+	if s.Inset {
+		shape = s.Box.Inset(s.Spread, s.Spread, s.Spread, s.Spread)
+	}
+	shape.Rect = shape.Rect.Add(image.Pt(s.OffsetX, s.OffsetY))
+
+	// Gaussian blur with standard deviation of half the blur radius spreads
+	// the shadow by about 1.5 times the blur radius.
+	margin := int(math.Ceil(s.Blur*1.5)) + 1
+	bounds := shape.Rect.Inset(-margin)
+	if s.Inset {
+		bounds = s.Box.Rect.Inset(-margin)
+	}
+	area := bounds.Intersect(dest.Rect)
+	if area.Empty() {
+		return
+	}
+	mask := shape.Mask(bounds)
+	if s.Inset {
+		for i, a := range mask.Pix {
+			mask.Pix[i] = 255 - a
+		}
+	}
+	blurAlpha(mask, s.Blur/2)
+	// Clip to the outside(or inside) of the box.
+	boxMask := s.Box.Mask(area)
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			boxCoverage := uint32(boxMask.AlphaAt(x, y).A)
+			if !s.Inset {
+				boxCoverage = 255 - boxCoverage
+			}
+			i := mask.PixOffset(x, y)
+			mask.Pix[i] = uint8((uint32(mask.Pix[i])*boxCoverage + 127) / 255)
+		}
+	}
+	draw.DrawMask(dest, area, image.NewUniform(s.Color), image.Point{}, mask, area.Min, draw.Over)
+}
+func (s BoxShadowPaint) String() string {
+	return fmt.Sprintf("box-shadow-paint(box=%v, color=%v, offset=%d/%d, blur=%g, spread=%g, inset=%v)",
+		s.Box.Rect, s.Color, s.OffsetX, s.OffsetY, s.Blur, s.Spread, s.Inset)
+}
+
+// blurAlpha applies approximated Gaussian blur with given standard deviation
+// to the mask, by applying box blur three times in each direction.
+func blurAlpha(mask *image.Alpha, sigma float64) {
+	// Variance of three box blurs with width w is (w^2 - 1) / 4.
+	radius := int(math.Round((math.Sqrt(4*sigma*sigma+1) - 1) / 2))
+	if radius <= 0 {
+		return
+	}
+	w, h := mask.Rect.Dx(), mask.Rect.Dy()
+	values := make([]float64, w*h)
+	for y := range h {
+		for x := range w {
+			values[y*w+x] = float64(mask.Pix[y*mask.Stride+x])
+		}
+	}
+	// Blurs n values starting at start, which are step apart.
+	temp := make([]float64, max(w, h))
+	blurLine := func(start, step, n int) {
+		for i := range n {
+			temp[i] = values[start+i*step]
+		}
+		sum := 0.0
+		// Values outside of the mask are treated as the value at the edge.
+		at := func(i int) float64 { return temp[min(max(i, 0), n-1)] }
+		for i := -radius; i <= radius; i++ {
+			sum += at(i)
+		}
+		for i := range n {
+			values[start+i*step] = sum / float64(radius*2+1)
+			sum += at(i+radius+1) - at(i-radius)
+		}
+	}
+	for range 3 {
+		for y := range h {
+			blurLine(y*w, 1, w)
+		}
+		for x := range w {
+			blurLine(x, w, h)
+		}
+	}
+	for y := range h {
+		for x := range w {
+			mask.Pix[y*mask.Stride+x] = uint8(math.Round(min(max(values[y*w+x], 0), 255)))
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package gfx
+
+import (
+	"math"
+)
+
+// Point is a point in the 2D space, where Y axis goes downward.
+type Point struct{ X, Y float64 }
+
+func (p Point) add(q Point) Point             { return Point{p.X + q.X, p.Y + q.Y} }
+func (p Point) sub(q Point) Point             { return Point{p.X - q.X, p.Y - q.Y} }
+func (p Point) mul(s float64) Point           { return Point{p.X * s, p.Y * s} }
+func (p Point) dot(q Point) float64           { return p.X*q.X + p.Y*q.Y }
+func (p Point) cross(q Point) float64         { return p.X*q.Y - p.Y*q.X }
+func (p Point) length() float64               { return math.Hypot(p.X, p.Y) }
+func (p Point) lerp(q Point, t float64) Point { return p.add(q.sub(p).mul(t)) }
+
+// pathOp is type of a segment in the path.
+type pathOp uint8
+
+const (
+	moveToOp  pathOp = iota // Starts a new subpath at Points[0]
+	lineToOp                // Line to Points[0]
+	quadToOp                // Quadratic Bézier curve with control point Points[0], to Points[1]
+	cubicToOp               // Cubic Bézier curve with control points Points[0] and Points[1], to Points[2]
+	closeOp                 // Line to the start of the subpath, and closes it
+)
+
+type pathSegment struct {
+	op     pathOp
+	points [3]Point
+}
+
+// Path is a shape made of subpaths, each of them is a series of lines and
+// Bézier curves. It can be filled with [Path.Mask] and [FillPath], and
+// stroked with [Path.Stroke].
+//
+// The zero value is an empty path.
+type Path struct {
+	segments []pathSegment
+	start    Point // Start of the current subpath
+	current  Point // Current point
+	hasStart bool  // Is there a current subpath?
+}
+
+// MoveTo starts a new subpath at (x, y).
+func (p *Path) MoveTo(x, y float64) {
+	p.segments = append(p.segments, pathSegment{op: moveToOp, points: [3]Point{{x, y}}})
+	p.start, p.current, p.hasStart = Point{x, y}, Point{x, y}, true
+}
+
+// ensureStart starts a new subpath at the current point, if there's no
+// subpath to add segments to.
+func (p *Path) ensureStart() {
+	if !p.hasStart {
+		p.MoveTo(p.current.X, p.current.Y)
+	}
+}
+
+// LineTo adds a line from the current point to (x, y).
+func (p *Path) LineTo(x, y float64) {
+	p.ensureStart()
+	p.segments = append(p.segments, pathSegment{op: lineToOp, points: [3]Point{{x, y}}})
+	p.current = Point{x, y}
+}
+
+// QuadTo adds a quadratic Bézier curve from the current point to (x, y), with
+// control point (cx, cy).
+func (p *Path) QuadTo(cx, cy, x, y float64) {
+	p.ensureStart()
+	p.segments = append(p.segments, pathSegment{op: quadToOp, points: [3]Point{{cx, cy}, {x, y}}})
+	p.current = Point{x, y}
+}
+
+// CubicTo adds a cubic Bézier curve from the current point to (x, y), with
+// control points (c1x, c1y) and (c2x, c2y).
+func (p *Path) CubicTo(c1x, c1y, c2x, c2y, x, y float64) {
+	p.ensureStart()
+	p.segments = append(p.segments, pathSegment{op: cubicToOp, points: [3]Point{{c1x, c1y}, {c2x, c2y}, {x, y}}})
+	p.current = Point{x, y}
+}
+
+// Close closes the current subpath with a line back to its start. The next
+// subpath starts there, unless MoveTo is called.
+func (p *Path) Close() {
+	if !p.hasStart {
+		return
+	}
+	p.segments = append(p.segments, pathSegment{op: closeOp})
+	p.current, p.hasStart = p.start, false
+}
+
+// AddRect adds the rect as a closed subpath, in clockwise direction.
+func (p *Path) AddRect(x, y, w, h float64) {
+	p.MoveTo(x, y)
+	p.LineTo(x+w, y)
+	p.LineTo(x+w, y+h)
+	p.LineTo(x, y+h)
+	p.Close()
+}
+
+// Distance of control points from the end points of a cubic Bézier curve that
+// approximates a quarter ellipse, relative to radius.
+const ellipseKappa = 0.5522847498307936
+
+// AddEllipse adds an ellipse centered at (cx, cy) as a closed subpath, in
+// clockwise direction.
+func (p *Path) AddEllipse(cx, cy, rx, ry float64) {
+	kx, ky := rx*ellipseKappa, ry*ellipseKappa
+	p.MoveTo(cx+rx, cy)
+	p.CubicTo(cx+rx, cy+ky, cx+kx, cy+ry, cx, cy+ry)
+	p.CubicTo(cx-kx, cy+ry, cx-rx, cy+ky, cx-rx, cy)
+	p.CubicTo(cx-rx, cy-ky, cx-kx, cy-ry, cx, cy-ry)
+	p.CubicTo(cx+kx, cy-ry, cx+rx, cy-ky, cx+rx, cy)
+	p.Close()
+}
+
+// AddRoundedRect adds r as a closed subpath, in clockwise direction.
+func (p *Path) AddRoundedRect(r RoundedRect) {
+	minX, minY := float64(r.Rect.Min.X), float64(r.Rect.Min.Y)
+	maxX, maxY := float64(r.Rect.Max.X), float64(r.Rect.Max.Y)
+	rad := r.Radii
+	for i := range rad {
+		if rad[i][0] <= 0 || rad[i][1] <= 0 {
+			rad[i] = [2]float64{}
+		}
+	}
+	p.MoveTo(minX+rad[0][0], minY)
+	p.LineTo(maxX-rad[1][0], minY)
+	p.CubicTo(maxX-rad[1][0]*(1-ellipseKappa), minY, maxX, minY+rad[1][1]*(1-ellipseKappa), maxX, minY+rad[1][1])
+	p.LineTo(maxX, maxY-rad[2][1])
+	p.CubicTo(maxX, maxY-rad[2][1]*(1-ellipseKappa), maxX-rad[2][0]*(1-ellipseKappa), maxY, maxX-rad[2][0], maxY)
+	p.LineTo(minX+rad[3][0], maxY)
+	p.CubicTo(minX+rad[3][0]*(1-ellipseKappa), maxY, minX, maxY-rad[3][1]*(1-ellipseKappa), minX, maxY-rad[3][1])
+	p.LineTo(minX, minY+rad[0][1])
+	p.CubicTo(minX, minY+rad[0][1]*(1-ellipseKappa), minX+rad[0][0]*(1-ellipseKappa), minY, minX+rad[0][0], minY)
+	p.Close()
+}
+
+// AddPath adds all subpaths of other to the path.
+func (p *Path) AddPath(other *Path) {
+	p.segments = append(p.segments, other.segments...)
+	p.start, p.current, p.hasStart = other.start, other.current, other.hasStart
+}
+
+// polyline is a subpath flattened into a series of points.
+type polyline struct {
+	points []Point
+	closed bool
+}
+
+// Maximum distance between curves and lines approximating them, in pixels.
+const flattenTolerance = 0.1
+
+// flatten approximates curves of the path with lines.
+func (p *Path) flatten() []polyline {
+	res := []polyline{}
+	var curr *polyline
+	last := Point{}
+	for _, seg := range p.segments {
+		if seg.op == moveToOp {
+			res = append(res, polyline{points: []Point{seg.points[0]}})
+			curr = &res[len(res)-1]
+			last = seg.points[0]
+			continue
+		}
+		switch seg.op {
+		case lineToOp:
+			curr.points = append(curr.points, seg.points[0])
+		case quadToOp:
+			p0, p1, p2 := last, seg.points[0], seg.points[1]
+			// Distance between the curve and lines is at most 1/4 of the
+			// second difference, divided by n^2.
+			n := segmentCount(p0.sub(p1.mul(2)).add(p2).length() / 4)
+			for i := 1; i <= n; i++ {
+				t := float64(i) / float64(n)
+				curr.points = append(curr.points, p0.lerp(p1, t).lerp(p1.lerp(p2, t), t))
+			}
+		case cubicToOp:
+			p0, p1, p2, p3 := last, seg.points[0], seg.points[1], seg.points[2]
+			dd := max(p0.sub(p1.mul(2)).add(p2).length(), p1.sub(p2.mul(2)).add(p3).length())
+			n := segmentCount(dd * 3 / 4)
+			for i := 1; i <= n; i++ {
+				t := float64(i) / float64(n)
+				a, b, c := p0.lerp(p1, t), p1.lerp(p2, t), p2.lerp(p3, t)
+				curr.points = append(curr.points, a.lerp(b, t).lerp(b.lerp(c, t), t))
+			}
+		case closeOp:
+			curr.closed = true
+			last = curr.points[0]
+			continue
+		}
+		last = curr.points[len(curr.points)-1]
+	}
+	return res
+}
+
+// segmentCount returns number of lines needed to approximate a curve, whose
+// distance from a single line is at most maxDist, within flattenTolerance.
+func segmentCount(maxDist float64) int {
+	n := int(math.Ceil(math.Sqrt(maxDist / flattenTolerance)))
+	return min(max(n, 1), 1000)
+}
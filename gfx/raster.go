@@ -0,0 +1,173 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package gfx
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"slices"
+)
+
+// FillRule decides which areas are inside of a path, where its subpaths
+// overlap or intersect themselves.
+type FillRule uint8
+
+const (
+	NonZero FillRule = iota // Inside if winding number is non-zero
+	EvenOdd                 // Inside if the point is surrounded by odd number of edges
+)
+
+// Number of scanlines sampled in each pixel row. Horizontal coverage is
+// computed exactly, so this only affects quality of nearly horizontal edges.
+const rasterSubsamples = 16
+
+// rasterEdge is a non-horizontal line of a flattened path.
+type rasterEdge struct {
+	x0, y0, x1, y1 float64 // y0 < y1
+	winding        int     // +1 if the line goes downward, -1 otherwise.
+}
+
+// Mask rasterizes the path filled with given fill rule, and returns coverage
+// of pixels in bounds as an alpha mask. Pixels along edges of the path are
+// partially covered, which is used to draw anti-aliased edges.
+//
+// Subpaths are closed implicitly.
+func (p *Path) Mask(bounds image.Rectangle, rule FillRule) *image.Alpha {
+	mask := image.NewAlpha(bounds)
+	edges := []rasterEdge{}
+	for _, line := range p.flatten() {
+		pts := line.points
+		for i := range pts {
+			a, b := pts[i], pts[(i+1)%len(pts)]
+			switch {
+			case a.Y < b.Y:
+				edges = append(edges, rasterEdge{a.X, a.Y, b.X, b.Y, 1})
+			case b.Y < a.Y:
+				edges = append(edges, rasterEdge{b.X, b.Y, a.X, a.Y, -1})
+			}
+		}
+	}
+	if len(edges) == 0 || bounds.Empty() {
+		return mask
+	}
+	slices.SortFunc(edges, func(a, b rasterEdge) int {
+		switch {
+		case a.y0 < b.y0:
+			return -1
+		case b.y0 < a.y0:
+			return 1
+		}
+		return 0
+	})
+
+	type crossing struct {
+		x       float64
+		winding int
+	}
+	width := bounds.Dx()
+	acc := make([]float64, width) // Coverage of pixels in the current row
+	active := []rasterEdge{}
+	crossings := []crossing{}
+	nextEdge := 0
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		// Update edges overlapping with the row.
+		rowTop, rowBottom := float64(y), float64(y+1)
+		active = slices.DeleteFunc(active, func(e rasterEdge) bool { return e.y1 <= rowTop })
+		for nextEdge < len(edges) && edges[nextEdge].y0 < rowBottom {
+			if rowTop < edges[nextEdge].y1 {
+				active = append(active, edges[nextEdge])
+			}
+			nextEdge++
+		}
+		if len(active) == 0 {
+			continue
+		}
+		clear(acc)
+		for s := range rasterSubsamples {
+			sy := rowTop + (float64(s)+0.5)/rasterSubsamples
+			crossings = crossings[:0]
+			for _, e := range active {
+				if sy < e.y0 || e.y1 <= sy {
+					continue
+				}
+				x := e.x0 + (sy-e.y0)*(e.x1-e.x0)/(e.y1-e.y0)
+				crossings = append(crossings, crossing{x, e.winding})
+			}
+			slices.SortFunc(crossings, func(a, b crossing) int {
+				switch {
+				case a.x < b.x:
+					return -1
+				case b.x < a.x:
+					return 1
+				}
+				return 0
+			})
+			winding := 0
+			for i, c := range crossings {
+				winding += c.winding
+				inside := winding != 0
+				if rule == EvenOdd {
+					inside = winding%2 != 0
+				}
+				if inside && i+1 < len(crossings) {
+					addSpanCoverage(acc, c.x-float64(bounds.Min.X), crossings[i+1].x-float64(bounds.Min.X), 1.0/rasterSubsamples)
+				}
+			}
+		}
+		row := mask.Pix[mask.PixOffset(bounds.Min.X, y):]
+		for x, v := range acc {
+			row[x] = uint8(math.Round(min(v, 1) * 255))
+		}
+	}
+	return mask
+}
+
+// addSpanCoverage adds coverage of the horizontal span from x0 to x1 to acc,
+// where pixels partially covered by the span get partial coverage.
+func addSpanCoverage(acc []float64, x0, x1, weight float64) {
+	x0, x1 = max(x0, 0), min(x1, float64(len(acc)))
+	if x1 <= x0 {
+		return
+	}
+	i0, i1 := int(x0), int(x1)
+	if i0 == i1 {
+		acc[i0] += (x1 - x0) * weight
+		return
+	}
+	acc[i0] += (float64(i0+1) - x0) * weight
+	for i := i0 + 1; i < i1; i++ {
+		acc[i] += weight
+	}
+	if i1 < len(acc) {
+		acc[i1] += (x1 - float64(i1)) * weight
+	}
+}
+
+// FillPath fills the path with color c, using given fill rule.
+func FillPath(dest draw.Image, path *Path, rule FillRule, c color.Color) {
+	bounds := path.bounds().Intersect(dest.Bounds())
+	if bounds.Empty() {
+		return
+	}
+	draw.DrawMask(dest, bounds, image.NewUniform(c), image.Point{}, path.Mask(bounds, rule), bounds.Min, draw.Over)
+}
+
+// bounds returns the smallest rect containing all pixels touched by the path.
+func (p *Path) bounds() image.Rectangle {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, line := range p.flatten() {
+		for _, pt := range line.points {
+			minX, minY = min(minX, pt.X), min(minY, pt.Y)
+			maxX, maxY = max(maxX, pt.X), max(maxY, pt.Y)
+		}
+	}
+	if maxX < minX {
+		return image.Rectangle{}
+	}
+	return image.Rect(int(math.Floor(minX)), int(math.Floor(minY)), int(math.Ceil(maxX)), int(math.Ceil(maxY)))
+}
@@ -0,0 +1,114 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package gfx
+
+import (
+	"image"
+	"testing"
+)
+
+func TestPathMask(t *testing.T) {
+	bounds := image.Rect(0, 0, 20, 20)
+	rect := func(x, y, w, h float64, clockwise bool) *Path {
+		p := &Path{}
+		if clockwise {
+			p.AddRect(x, y, w, h)
+		} else {
+			p.MoveTo(x, y)
+			p.LineTo(x, y+h)
+			p.LineTo(x+w, y+h)
+			p.LineTo(x+w, y)
+			p.Close()
+		}
+		return p
+	}
+	nested := func(clockwise bool) *Path {
+		p := rect(2, 2, 16, 16, true)
+		p.AddPath(rect(6, 6, 8, 8, clockwise))
+		return p
+	}
+	triangle := &Path{}
+	triangle.MoveTo(0, 0)
+	triangle.LineTo(20, 0)
+	triangle.LineTo(0, 20)
+	triangle.Close()
+	circle := &Path{}
+	circle.AddEllipse(10, 10, 8, 8)
+	curve := &Path{}
+	curve.MoveTo(0, 20)
+	curve.QuadTo(10, -20, 20, 20)
+
+	cases := []struct {
+		name     string
+		path     *Path
+		rule     FillRule
+		pt       image.Point
+		expected uint8
+	}{
+		{"rect inside", rect(2, 2, 10, 10, true), NonZero, image.Pt(5, 5), 255},
+		{"rect outside", rect(2, 2, 10, 10, true), NonZero, image.Pt(12, 5), 0},
+		{"rect half pixel", rect(2.5, 2, 10, 10, true), NonZero, image.Pt(2, 5), 128},
+		{"rect quarter pixel", rect(2.5, 2.5, 10, 10, true), NonZero, image.Pt(2, 2), 64},
+		{"nonzero same direction", nested(true), NonZero, image.Pt(10, 10), 255},
+		{"nonzero opposite direction", nested(false), NonZero, image.Pt(10, 10), 0},
+		{"evenodd same direction", nested(true), EvenOdd, image.Pt(10, 10), 0},
+		{"evenodd ring", nested(true), EvenOdd, image.Pt(4, 10), 255},
+		{"triangle diagonal", triangle, NonZero, image.Pt(10, 9), 128},
+		{"circle center", circle, NonZero, image.Pt(10, 10), 255},
+		{"circle corner", circle, NonZero, image.Pt(3, 3), 0},
+		{"curve implicitly closed", curve, NonZero, image.Pt(10, 5), 255},
+		{"curve outside", curve, NonZero, image.Pt(2, 5), 0},
+	}
+	for _, cs := range cases {
+		got := cs.path.Mask(bounds, cs.rule).AlphaAt(cs.pt.X, cs.pt.Y).A
+		if got != cs.expected {
+			t.Errorf("%s: expected coverage %d at %v, got %d", cs.name, cs.expected, cs.pt, got)
+		}
+	}
+}
+
+func TestPathStroke(t *testing.T) {
+	bounds := image.Rect(0, 0, 40, 40)
+	line := &Path{}
+	line.MoveTo(10, 10.5)
+	line.LineTo(30, 10.5)
+	corner := &Path{}
+	corner.MoveTo(10, 30)
+	corner.LineTo(30, 30)
+	corner.LineTo(30, 10)
+	dot := &Path{}
+	dot.MoveTo(20, 20)
+	dot.LineTo(20, 20)
+
+	cases := []struct {
+		name     string
+		path     *Path
+		opts     StrokeOptions
+		pt       image.Point
+		expected uint8
+	}{
+		{"line", line, StrokeOptions{Width: 1}, image.Pt(20, 10), 255},
+		{"line above", line, StrokeOptions{Width: 1}, image.Pt(20, 9), 0},
+		{"butt cap", line, StrokeOptions{Width: 4}, image.Pt(9, 10), 0},
+		{"square cap", line, StrokeOptions{Width: 4, Cap: SquareCap}, image.Pt(9, 10), 255},
+		{"round cap", line, StrokeOptions{Width: 4, Cap: RoundCap}, image.Pt(9, 10), 255},
+		{"round cap end", line, StrokeOptions{Width: 4, Cap: RoundCap}, image.Pt(7, 10), 0},
+		{"miter join", corner, StrokeOptions{Width: 4, MiterLimit: 10}, image.Pt(31, 31), 255},
+		{"miter limit", corner, StrokeOptions{Width: 4, MiterLimit: 1}, image.Pt(31, 31), 0},
+		{"bevel join", corner, StrokeOptions{Width: 4, Join: BevelJoin}, image.Pt(31, 31), 0},
+		{"dash", line, StrokeOptions{Width: 2, Dashes: []float64{4, 2}}, image.Pt(12, 10), 255},
+		{"dash gap", line, StrokeOptions{Width: 2, Dashes: []float64{4, 2}}, image.Pt(14, 10), 0},
+		{"dash offset", line, StrokeOptions{Width: 2, Dashes: []float64{4, 2}, DashOffset: 4}, image.Pt(10, 10), 0},
+		{"odd dashes", line, StrokeOptions{Width: 2, Dashes: []float64{3}}, image.Pt(16, 10), 255},
+		{"dot with round cap", dot, StrokeOptions{Width: 6, Cap: RoundCap}, image.Pt(20, 20), 255},
+		{"dot with butt cap", dot, StrokeOptions{Width: 6}, image.Pt(20, 20), 0},
+	}
+	for _, cs := range cases {
+		got := cs.path.Stroke(cs.opts).Mask(bounds, NonZero).AlphaAt(cs.pt.X, cs.pt.Y).A
+		if got != cs.expected {
+			t.Errorf("%s: expected coverage %d at %v, got %d", cs.name, cs.expected, cs.pt, got)
+		}
+	}
+}
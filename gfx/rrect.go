@@ -0,0 +1,91 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package gfx
+
+import (
+	"image"
+	"math"
+)
+
+// CornerRadii holds horizontal and vertical radii of each corner of a rounded
+// rectangle, in top-left, top-right, bottom-right, bottom-left order.
+type CornerRadii [4][2]float64
+
+// IsZero reports whether all corners are square.
+func (r CornerRadii) IsZero() bool {
+	for _, rad := range r {
+		if 0 < rad[0] && 0 < rad[1] {
+			return false
+		}
+	}
+	return true
+}
+
+// RoundedRect is a rectangle with quarter ellipses at its corners.
+type RoundedRect struct {
+	Rect  image.Rectangle // Bounds of the shape (Max is exclusive)
+	Radii CornerRadii
+}
+
+// NewRoundedRect returns RoundedRect with given radii. If curves of adjacent
+// corners would overlap, all radii are scaled down until they don't.
+//
+// Spec: https://www.w3.org/TR/css-backgrounds-3/#corner-overlap
+func NewRoundedRect(rect image.Rectangle, radii CornerRadii) RoundedRect {
+	w, h := float64(rect.Dx()), float64(rect.Dy())
+	scale := 1.0
+	for _, pair := range [][3]float64{
+		{w, radii[0][0], radii[1][0]}, // Top
+		{h, radii[1][1], radii[2][1]}, // Right
+		{w, radii[2][0], radii[3][0]}, // Bottom
+		{h, radii[3][1], radii[0][1]}, // Left
+	} {
+		if sum := pair[1] + pair[2]; 0 < sum {
+			scale = min(scale, pair[0]/sum)
+		}
+	}
+	for i := range radii {
+		for j := range radii[i] {
+			radii[i][j] = max(radii[i][j]*scale, 0)
+		}
+	}
+	return RoundedRect{Rect: rect, Radii: radii}
+}
+
+// Inset returns the shape moved inward by given distance from each side, and
+// radii of corners are reduced by the same amount. Negative distances move it
+// outward, and radii of round corners grow instead. (Square corners stay
+// square.)
+func (r RoundedRect) Inset(top, right, bottom, left float64) RoundedRect {
+	rect := image.Rectangle{
+		Min: image.Pt(r.Rect.Min.X+int(math.Round(left)), r.Rect.Min.Y+int(math.Round(top))),
+		Max: image.Pt(r.Rect.Max.X-int(math.Round(right)), r.Rect.Max.Y-int(math.Round(bottom))),
+	}
+	if rect.Dx() < 0 || rect.Dy() < 0 {
+		return RoundedRect{Rect: image.Rectangle{Min: rect.Min, Max: rect.Min}}
+	}
+	// Sides that each radius goes along
+	dists := [4][2]float64{{left, top}, {right, top}, {right, bottom}, {left, bottom}}
+	radii := r.Radii
+	for i := range radii {
+		if radii[i][0] <= 0 || radii[i][1] <= 0 {
+			radii[i] = [2]float64{}
+			continue
+		}
+		for j := range radii[i] {
+			radii[i][j] = max(radii[i][j]-dists[i][j], 0)
+		}
+	}
+	return NewRoundedRect(rect, radii)
+}
+
+// Mask returns coverage of pixels in bounds as an alpha mask. Pixels along
+// curves of corners are partially covered, which is used to draw anti-aliased
+// edges.
+func (r RoundedRect) Mask(bounds image.Rectangle) *image.Alpha {
+	path := Path{}
+	path.AddRoundedRect(r)
+	return path.Mask(bounds, NonZero)
+}
@@ -0,0 +1,236 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package gfx
+
+import (
+	"math"
+)
+
+// LineJoin is shape of the outer side of corners between lines of a stroke.
+type LineJoin uint8
+
+const (
+	MiterJoin LineJoin = iota // Sharp corner, which becomes BevelJoin if it's longer than MiterLimit.
+	RoundJoin                 // Round corner
+	BevelJoin                 // Corner is cut off by a straight line
+)
+
+// LineCap is shape of the ends of open subpaths in a stroke.
+type LineCap uint8
+
+const (
+	ButtCap   LineCap = iota // Stroke ends exactly at the end point
+	RoundCap                 // Half circle is added at the end point
+	SquareCap                // Half square is added at the end point
+)
+
+// StrokeOptions holds parameters used by [Path.Stroke].
+type StrokeOptions struct {
+	Width      float64
+	Join       LineJoin
+	MiterLimit float64 // Maximum ratio of the miter length to Width. Values less than 1 are treated as 1.
+	Cap        LineCap
+
+	// Lengths of dashes and gaps between them, alternating. If the length is
+	// odd, it's repeated twice. The stroke is solid if it's empty.
+	Dashes     []float64
+	DashOffset float64 // Distance into the dash pattern to start at
+}
+
+// Stroke returns outline of the path stroked with given options, which
+// should be filled with [NonZero] fill rule.
+//
+// The outline is made of overlapping pieces, one for each line, join and cap,
+// all in the same direction so that non-zero fill rule fills their union.
+func (p *Path) Stroke(opts StrokeOptions) *Path {
+	res := &Path{}
+	if opts.Width <= 0 {
+		return res
+	}
+	lines := p.flatten()
+	if len(opts.Dashes) != 0 {
+		lines = dashPolylines(lines, opts.Dashes, opts.DashOffset)
+	}
+	hw := opts.Width / 2
+	for _, line := range lines {
+		pts := removeDuplicatePoints(line.points, line.closed)
+		if len(pts) == 1 {
+			// Zero-length subpaths are only visible with round and square caps.
+			switch opts.Cap {
+			case RoundCap:
+				res.AddEllipse(pts[0].X, pts[0].Y, hw, hw)
+			case SquareCap:
+				res.AddRect(pts[0].X-hw, pts[0].Y-hw, opts.Width, opts.Width)
+			}
+			continue
+		}
+		n := len(pts) - 1
+		if line.closed {
+			n = len(pts)
+		}
+		for i := range n {
+			a, b := pts[i], pts[(i+1)%len(pts)]
+			dir := b.sub(a).mul(1 / b.sub(a).length())
+			// Square caps extend the first and last lines.
+			if !line.closed && opts.Cap == SquareCap {
+				if i == 0 {
+					a = a.sub(dir.mul(hw))
+				}
+				if i == n-1 {
+					b = b.add(dir.mul(hw))
+				}
+			}
+			normal := Point{-dir.Y, dir.X}.mul(hw)
+			addPolygon(res, a.add(normal), b.add(normal), b.sub(normal), a.sub(normal))
+		}
+		// Joins between lines
+		for i := range len(pts) {
+			if !line.closed && (i == 0 || i == len(pts)-1) {
+				continue
+			}
+			prev, curr, next := pts[(i+len(pts)-1)%len(pts)], pts[i], pts[(i+1)%len(pts)]
+			addJoin(res, prev, curr, next, hw, opts)
+		}
+		if !line.closed && opts.Cap == RoundCap {
+			res.AddEllipse(pts[0].X, pts[0].Y, hw, hw)
+			res.AddEllipse(pts[len(pts)-1].X, pts[len(pts)-1].Y, hw, hw)
+		}
+	}
+	return res
+}
+
+// addJoin adds the join at curr, between lines from prev and to next.
+func addJoin(res *Path, prev, curr, next Point, hw float64, opts StrokeOptions) {
+	d0 := curr.sub(prev).mul(1 / curr.sub(prev).length())
+	d1 := next.sub(curr).mul(1 / next.sub(curr).length())
+	cross := d0.cross(d1)
+	if math.Abs(cross) < 1e-9 && 0 < d0.dot(d1) {
+		// Lines are going straight, so there's no gap to fill.
+		return
+	}
+	if opts.Join == RoundJoin {
+		res.AddEllipse(curr.X, curr.Y, hw, hw)
+		return
+	}
+	// The gap is on the outer side, which is opposite of the turning direction.
+	side := -1.0
+	if cross < 0 {
+		side = 1
+	}
+	n0 := Point{-d0.Y, d0.X}.mul(hw * side)
+	n1 := Point{-d1.Y, d1.X}.mul(hw * side)
+	a, b := curr.add(n0), curr.add(n1)
+	if opts.Join == MiterJoin {
+		// Ratio of miter length to the line width is 1/sin(θ/2), where θ is
+		// the angle between lines.
+		cosTheta := -d0.dot(d1)
+		sinHalf := math.Sqrt(max((1-cosTheta)/2, 0))
+		if 1e-9 < sinHalf && 1/sinHalf <= max(opts.MiterLimit, 1) {
+			// Miter tip is where outer edges of two lines meet.
+			mid := n0.add(n1)
+			tip := curr.add(mid.mul(hw * hw / mid.dot(n0)))
+			addPolygon(res, curr, a, tip, b)
+			return
+		}
+	}
+	addPolygon(res, curr, a, b)
+}
+
+// addPolygon adds a closed polygon to res, always in clockwise direction.
+func addPolygon(res *Path, pts ...Point) {
+	area := 0.0
+	for i := range pts {
+		area += pts[i].cross(pts[(i+1)%len(pts)])
+	}
+	if area < 0 {
+		for i, j := 0, len(pts)-1; i < j; i, j = i+1, j-1 {
+			pts[i], pts[j] = pts[j], pts[i]
+		}
+	}
+	res.MoveTo(pts[0].X, pts[0].Y)
+	for _, pt := range pts[1:] {
+		res.LineTo(pt.X, pt.Y)
+	}
+	res.Close()
+}
+
+// removeDuplicatePoints removes consecutive points at the same position, which
+// would make zero-length lines.
+func removeDuplicatePoints(pts []Point, closed bool) []Point {
+	res := []Point{pts[0]}
+	for _, pt := range pts[1:] {
+		if pt != res[len(res)-1] {
+			res = append(res, pt)
+		}
+	}
+	if closed && 1 < len(res) && res[0] == res[len(res)-1] {
+		res = res[:len(res)-1]
+	}
+	return res
+}
+
+// dashPolylines splits lines into dashes.
+func dashPolylines(lines []polyline, dashes []float64, offset float64) []polyline {
+	if len(dashes)%2 != 0 {
+		dashes = append(append([]float64{}, dashes...), dashes...)
+	}
+	total := 0.0
+	for _, d := range dashes {
+		if d < 0 {
+			return lines
+		}
+		total += d
+	}
+	if total <= 0 {
+		return lines
+	}
+	res := []polyline{}
+	for _, line := range lines {
+		pts := line.points
+		if line.closed {
+			pts = append(append([]Point{}, pts...), pts[0])
+		}
+		// Find where in the pattern the line starts.
+		idx := 0
+		remaining := math.Mod(offset, total)
+		if remaining < 0 {
+			remaining += total
+		}
+		for dashes[idx] <= remaining {
+			remaining -= dashes[idx]
+			idx = (idx + 1) % len(dashes)
+		}
+		remaining = dashes[idx] - remaining // Length left in the current dash or gap
+
+		var curr *polyline
+		if idx%2 == 0 {
+			res = append(res, polyline{points: []Point{pts[0]}})
+			curr = &res[len(res)-1]
+		}
+		for i := 1; i < len(pts); i++ {
+			a, b := pts[i-1], pts[i]
+			segLen := b.sub(a).length()
+			pos := 0.0
+			for remaining < segLen-pos {
+				pos += remaining
+				pt := a.lerp(b, pos/segLen)
+				if curr != nil {
+					curr.points = append(curr.points, pt)
+					curr = nil
+				} else {
+					res = append(res, polyline{points: []Point{pt}})
+					curr = &res[len(res)-1]
+				}
+				idx = (idx + 1) % len(dashes)
+				remaining = dashes[idx]
+			}
+			remaining -= segLen - pos
+			if curr != nil {
+				curr.points = append(curr.points, b)
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,140 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package gfx
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"unicode"
+)
+
+// TextOrientation is orientation of text inside of a line.
+type TextOrientation uint8
+
+const (
+	HorizontalText       TextOrientation = iota // Horizontal line
+	VerticalMixedText                           // Vertical line, where only characters that are upright by default are upright
+	VerticalUprightText                         // Vertical line, where all characters are upright
+	VerticalSidewaysText                        // Vertical line, where all characters are rotated 90 degrees clockwise
+)
+
+// IsVertical reports whether the text is laid out vertically.
+func (o TextOrientation) IsVertical() bool {
+	return o != HorizontalText
+}
+
+// uprightRanges are ranges of characters that are upright by default in
+// vertical text. This is simplified version of the Vertical_Orientation property,
+// and characters that are transformed(Tu and Tr) are treated as upright, except
+// for brackets which are rotated.
+//
+// Spec: https://www.unicode.org/reports/tr50/
+var uprightRanges = [][2]rune{
+	{0x00a7, 0x00a7}, {0x00a9, 0x00a9}, {0x00ae, 0x00ae}, {0x00b1, 0x00b1},
+	{0x00bc, 0x00be}, {0x00d7, 0x00d7}, {0x00f7, 0x00f7},
+	{0x1100, 0x11ff}, // Hangul Jamo
+	{0x2e80, 0x3007}, // CJK Radicals Supplement ~ CJK Symbols and Punctuation
+	{0x3012, 0x3013},
+	{0x301c, 0x30fb},                                                       // ~ Hiragana, Katakana
+	{0x30fd, 0xa4cf},                                                       // ~ CJK Unified Ideographs, Yi
+	{0xa960, 0xa97f},                                                       // Hangul Jamo Extended-A
+	{0xac00, 0xd7ff},                                                       // Hangul Syllables, Hangul Jamo Extended-B
+	{0xf900, 0xfaff},                                                       // CJK Compatibility Ideographs
+	{0xfe10, 0xfe1f},                                                       // Vertical Forms
+	{0xfe30, 0xfe58},                                                       // CJK Compatibility Forms, Small Form Variants
+	{0xff01, 0xff07}, {0xff0a, 0xff3a}, {0xff3c, 0xff3c}, {0xff3e, 0xff5a}, // Fullwidth forms (except for brackets)
+	{0xffe0, 0xffe7},
+	{0x1f000, 0x1faff}, // Mahjong tiles ~ Symbols and Pictographs Extended-A
+	{0x20000, 0x3fffd}, // Supplementary Ideographic Plane, Tertiary Ideographic Plane
+}
+
+// IsUprightInVertical reports whether r is upright by default, when displayed
+// in vertical text.
+func IsUprightInVertical(r rune) bool {
+	for _, rng := range uprightRanges {
+		if r < rng[0] {
+			break
+		}
+		if r <= rng[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// verticalTextRuns splits text into runs of characters having the same
+// orientation. Combining marks are kept together with preceding characters.
+func verticalTextRuns(text string, orientation TextOrientation) (runs []string, isUpright []bool) {
+	start := 0
+	runUpright := false
+	for i, r := range text {
+		upright := orientation == VerticalUprightText || (orientation == VerticalMixedText && IsUprightInVertical(r))
+		if unicode.Is(unicode.Mn, r) && i != 0 {
+			continue
+		}
+		// Upright characters are placed one by one.
+		if i != 0 && (upright || upright != runUpright) {
+			runs = append(runs, text[start:i])
+			isUpright = append(isUpright, runUpright)
+			start = i
+		}
+		runUpright = upright
+	}
+	if start != len(text) {
+		runs = append(runs, text[start:])
+		isUpright = append(isUpright, runUpright)
+	}
+	return runs, isUpright
+}
+
+// DrawVerticalText draws the text vertically, starting from (left, top) of
+// the dest and going downwards. Upright characters take line height of the
+// font, and rotated characters take their width.
+//
+// Same as [Font.DrawText], DrawVerticalText can perform dry-run by passing nil
+// to dest.
+func DrawVerticalText(font Font, text string, orientation TextOrientation, dest *image.RGBA, left, top int, textColor color.Color) image.Rectangle {
+	metrics := font.Metrics()
+	lineHeight := int(metrics.LineHeight)
+	baseline := int(metrics.Ascender)
+	y := top
+	runs, isUpright := verticalTextRuns(text, orientation)
+	for i, run := range runs {
+		if isUpright[i] {
+			if dest != nil {
+				w, _ := MeasureText(font, run)
+				font.DrawText(run, dest, left+(lineHeight-w)/2, y+baseline, textColor)
+			}
+			y += lineHeight
+			continue
+		}
+		w, _ := MeasureText(font, run)
+		if dest != nil && w != 0 {
+			// Draw horizontally, and then rotate it 90 degrees clockwise.
+			horizontal := image.NewRGBA(image.Rect(0, 0, w, lineHeight))
+			font.DrawText(run, horizontal, 0, baseline, textColor)
+			rotated := image.NewRGBA(image.Rect(0, 0, lineHeight, w))
+			for hy := range lineHeight {
+				for hx := range w {
+					rotated.SetRGBA(lineHeight-1-hy, hx, horizontal.RGBAAt(hx, hy))
+				}
+			}
+			draw.Draw(dest, image.Rect(left, y, left+lineHeight, y+w), rotated, image.Point{}, draw.Over)
+		}
+		y += w
+	}
+	return image.Rect(left, top, left+lineHeight, y)
+}
+
+// MeasureOrientedText performs dry-run text drawing in given orientation,
+// and returns advance of the text in the inline axis.
+func MeasureOrientedText(font Font, text string, orientation TextOrientation) int {
+	if !orientation.IsVertical() {
+		w, _ := MeasureText(font, text)
+		return w
+	}
+	return DrawVerticalText(font, text, orientation, nil, 0, 0, color.RGBA{}).Dy()
+}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/inseo-oh/yw/css/csscolor"
 	"github.com/inseo-oh/yw/css/cssom"
-	"github.com/inseo-oh/yw/css/selector"
 	"github.com/inseo-oh/yw/dom"
 	"github.com/inseo-oh/yw/util"
 )
@@ -109,8 +108,7 @@ func parseLegacyColor(input string) (csscolor.Color, bool) {
 func NewHTMLBodyElement(options dom.ElementCreationCommonOptions) HTMLElement {
 	elem := NewHTMLElement(options)
 
-	cbs := elem.Callbacks()
-	cbs.PresentationalHints = func() any {
+	setPresentationalHints(elem, func() []cssom.Declaration {
 		decls := []cssom.Declaration{}
 
 		// https://html.spec.whatwg.org/multipage/rendering.html#the-page
@@ -126,12 +124,7 @@ func NewHTMLBodyElement(options dom.ElementCreationCommonOptions) HTMLElement {
 				decls = append(decls, cssom.Declaration{Name: "color", Value: color, IsImportant: false})
 			}
 		}
-		rule := cssom.StyleRule{
-			SelectorList: []selector.Selector{selector.NodePtrSelector{Element: elem}},
-			Declarations: decls,
-		}
-		return []cssom.StyleRule{rule}
-
-	}
+		return decls
+	})
 	return elem
 }
@@ -7,7 +7,12 @@ package elements
 import (
 	"slices"
 
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/selector"
+	"github.com/inseo-oh/yw/css/writingmodes"
 	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/text/bidi"
+	"github.com/inseo-oh/yw/util"
 )
 
 // HTMLElement represents a [HTML element].
@@ -55,12 +60,48 @@ type HTMLElement interface {
 	//
 	// [contributes a script-blocking style sheet]: https://html.spec.whatwg.org/multipage/semantics.html#contributes-a-script-blocking-style-sheet
 	ContributesScriptBlockingStylesheet() bool
+
+	// Directionality returns the [directionality] of the element.
+	//
+	// [directionality]: https://html.spec.whatwg.org/multipage/dom.html#the-directionality
+	Directionality() writingmodes.Direction
 }
 type htmlElementImpl struct{ dom.Element }
 
 // NewHTMLElement constructs a new [HTMLElement] node.
 func NewHTMLElement(options dom.ElementCreationCommonOptions) HTMLElement {
-	return htmlElementImpl{dom.NewElement(options)}
+	elem := htmlElementImpl{dom.NewElement(options)}
+	setPresentationalHints(elem, nil)
+	return elem
+}
+
+// setPresentationalHints sets presentational hints callback of elem. Elements
+// that wrap another HTMLElement must call this again, so that the hints apply
+// to the outer element.
+//
+// hints returns declarations specific to the element, and may be nil.
+// Declarations common to all HTML elements are added before these.
+func setPresentationalHints(elem HTMLElement, hints func() []cssom.Declaration) {
+	cbs := elem.Callbacks()
+	cbs.PresentationalHints = func() any {
+		decls := []cssom.Declaration{}
+
+		// https://html.spec.whatwg.org/multipage/rendering.html#bidi-rendering
+		if _, ok := elem.AttrWithoutNamespace("dir"); ok || elem.IsHtmlElement("bdi") {
+			decls = append(decls, cssom.Declaration{Name: "direction", Value: elem.Directionality(), IsImportant: false})
+		}
+		if hints != nil {
+			decls = append(decls, hints()...)
+		}
+		if len(decls) == 0 {
+			return []cssom.StyleRule{}
+		}
+		rule := cssom.StyleRule{
+			SelectorList: []selector.Selector{selector.NodePtrSelector{Element: elem}},
+			Declarations: decls,
+		}
+		return []cssom.StyleRule{rule}
+	}
 }
 
 func (elem htmlElementImpl) IsFormAssociatedCustomElement() bool {
@@ -126,3 +167,76 @@ func (elem htmlElementImpl) ContributesScriptBlockingStylesheet() bool {
 	// STUB
 	return false
 }
+
+func (elem htmlElementImpl) Directionality() writingmodes.Direction {
+	return directionalityOf(elem)
+}
+
+// dirAttrOf returns the state of dir attribute of elem, which is one of "ltr",
+// "rtl", "auto", or "" if it's missing or invalid.
+//
+// Spec: https://html.spec.whatwg.org/multipage/dom.html#the-dir-attribute
+func dirAttrOf(elem dom.Element) string {
+	attr, ok := elem.AttrWithoutNamespace("dir")
+	if !ok {
+		return ""
+	}
+	switch attr = util.ToAsciiLowercase(attr); attr {
+	case "ltr", "rtl", "auto":
+		return attr
+	}
+	return ""
+}
+
+// https://html.spec.whatwg.org/multipage/dom.html#the-directionality
+func directionalityOf(elem dom.Element) writingmodes.Direction {
+	switch dir := dirAttrOf(elem); {
+	case dir == "ltr":
+		return writingmodes.Ltr
+	case dir == "rtl":
+		return writingmodes.Rtl
+	case dir == "auto", dir == "" && elem.IsHtmlElement("bdi"):
+		if dir, ok := containedTextAutoDirectionality(elem); ok {
+			return dir
+		}
+		return writingmodes.Ltr
+	}
+	if parent, ok := elem.Parent().(dom.Element); ok {
+		return directionalityOf(parent)
+	}
+	return writingmodes.Ltr
+}
+
+// containedTextAutoDirectionality returns direction of the first strong
+// character in descendant text of elem. Elements that have their own
+// directionality are skipped. ok is false if there's no such character.
+//
+// Spec: https://html.spec.whatwg.org/multipage/dom.html#contained-text-auto-directionality
+func containedTextAutoDirectionality(elem dom.Element) (dir writingmodes.Direction, ok bool) {
+	for _, child := range elem.Children() {
+		switch child := child.(type) {
+		case dom.Element:
+			skippedElems := []string{"bdi", "script", "style", "textarea"}
+			if slices.ContainsFunc(skippedElems, child.IsHtmlElement) || dirAttrOf(child) != "" {
+				continue
+			}
+			if dir, ok := containedTextAutoDirectionality(child); ok {
+				return dir, true
+			}
+		case dom.CharacterData:
+			if child.CharacterDataType() != dom.TextCharacterData {
+				continue
+			}
+			// https://html.spec.whatwg.org/multipage/dom.html#text-node-directionality
+			for _, r := range child.Text() {
+				switch bidi.ClassOf(r) {
+				case bidi.L:
+					return writingmodes.Ltr, true
+				case bidi.R, bidi.AL:
+					return writingmodes.Rtl, true
+				}
+			}
+		}
+	}
+	return writingmodes.Ltr, false
+}
@@ -0,0 +1,341 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package elements
+
+import (
+	"bytes"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/sizing"
+	"github.com/inseo-oh/yw/css/values"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/html/fetch"
+	"github.com/inseo-oh/yw/util"
+)
+
+// HTMLImageElement represents an [img] element.
+//
+// [img]: https://html.spec.whatwg.org/multipage/embedded-content.html#the-img-element
+type HTMLImageElement interface {
+	HTMLElement
+
+	// Alt returns value of the alt attribute, which is the text the element
+	// represents when the image is not available.
+	Alt() string
+
+	// UpdateImageData selects the image source for the viewport of given
+	// width, and fetches it if it's different from the current one.
+	//
+	// Spec: https://html.spec.whatwg.org/multipage/images.html#update-the-image-data
+	UpdateImageData(viewportWidth float64)
+
+	// CurrentImage returns decoded image of the current request, or nil if
+	// it's not available (e.g. there's no image source, or the image is broken).
+	CurrentImage() image.Image
+}
+type htmlImageElementImpl struct {
+	HTMLElement
+	currentURL     string      // URL of the current request
+	currentImage   image.Image // Decoded image of the current request. nil if it's broken.
+	currentDensity float64     // Pixel density of the current request
+}
+
+// NewHTMLImageElement constructs a new [HTMLImageElement] node.
+func NewHTMLImageElement(options dom.ElementCreationCommonOptions) HTMLImageElement {
+	elem := &htmlImageElementImpl{HTMLElement: NewHTMLElement(options), currentDensity: 1}
+	setPresentationalHints(elem, func() []cssom.Declaration {
+		decls := []cssom.Declaration{}
+
+		// https://html.spec.whatwg.org/multipage/rendering.html#attributes-for-embedded-content-and-images
+		for _, name := range []string{"width", "height"} {
+			if attr, ok := elem.AttrWithoutNamespace(name); ok {
+				if size, ok := parseDimensionValue(attr); ok {
+					decls = append(decls, cssom.Declaration{Name: name, Value: sizing.Size{Type: sizing.ManualSize, Size: size}, IsImportant: false})
+				}
+			}
+		}
+		return decls
+	})
+
+	cbs := elem.Callbacks()
+	cbs.IntrinsicSize = func() (width float64, height float64) {
+		if elem.currentImage == nil {
+			return 0, 0
+		}
+		// https://html.spec.whatwg.org/multipage/images.html#density-corrected-intrinsic-width-and-height
+		size := elem.currentImage.Bounds().Size()
+		return float64(size.X) / elem.currentDensity, float64(size.Y) / elem.currentDensity
+	}
+	return elem
+}
+
+func (elem htmlImageElementImpl) Alt() string {
+	attr, _ := elem.AttrWithoutNamespace("alt")
+	return attr
+}
+
+func (elem *htmlImageElementImpl) UpdateImageData(viewportWidth float64) {
+	candidate, ok := elem.selectImageSource(viewportWidth)
+	if !ok {
+		elem.currentURL, elem.currentImage, elem.currentDensity = "", nil, 1
+		return
+	}
+	baseURL := elem.NodeDocument().BaseURL()
+	ref, err := url.Parse(candidate.url)
+	if err != nil {
+		log.Printf("<img %s>: %v", candidate.url, err)
+		elem.currentURL, elem.currentImage, elem.currentDensity = "", nil, 1
+		return
+	}
+	u := baseURL.ResolveReference(ref)
+	elem.currentDensity = candidate.density
+	if u.String() == elem.currentURL {
+		return
+	}
+	elem.currentURL = u.String()
+	elem.currentImage = nil
+	data, err := fetch.FetchBytes(*u)
+	if err != nil {
+		log.Printf("<img %s>: %v", elem.currentURL, err)
+		return
+	}
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		log.Printf("<img %s>: failed to decode image: %v", elem.currentURL, err)
+		return
+	}
+	log.Printf("<img %s>: %s image loaded", elem.currentURL, format)
+	elem.currentImage = img
+}
+
+func (elem htmlImageElementImpl) CurrentImage() image.Image {
+	return elem.currentImage
+}
+
+// imageCandidate is an [image candidate string].
+//
+// [image candidate string]: https://html.spec.whatwg.org/multipage/images.html#image-candidate-string
+type imageCandidate struct {
+	url     string
+	width   int     // Width descriptor. 0 if there's none.
+	density float64 // Pixel density descriptor. 0 if there's none.
+}
+
+// selectImageSource selects image source of the element, from src and srcset
+// attributes. Device pixel ratio is assumed to be 1.
+//
+// Spec: https://html.spec.whatwg.org/multipage/images.html#select-an-image-source
+func (elem htmlImageElementImpl) selectImageSource(viewportWidth float64) (imageCandidate, bool) {
+	// https://html.spec.whatwg.org/multipage/images.html#update-the-source-set
+	candidates := []imageCandidate{}
+	if attr, ok := elem.AttrWithoutNamespace("srcset"); ok {
+		candidates = parseSrcset(attr)
+	}
+	hasDensityOf1, hasWidth := false, false
+	for _, c := range candidates {
+		hasWidth = hasWidth || c.width != 0
+		hasDensityOf1 = hasDensityOf1 || (c.width == 0 && (c.density == 0 || c.density == 1))
+	}
+	if attr, ok := elem.AttrWithoutNamespace("src"); ok && attr != "" && !hasDensityOf1 && !hasWidth {
+		candidates = append(candidates, imageCandidate{url: attr})
+	}
+	if len(candidates) == 0 {
+		return imageCandidate{}, false
+	}
+
+	// https://html.spec.whatwg.org/multipage/images.html#normalise-the-source-densities
+	sourceSize := viewportWidth
+	if attr, ok := elem.AttrWithoutNamespace("sizes"); ok {
+		sourceSize = parseSizes(attr, viewportWidth)
+	}
+	for i, c := range candidates {
+		switch {
+		case c.density != 0:
+		case c.width != 0 && sourceSize != 0:
+			candidates[i].density = float64(c.width) / sourceSize
+		default:
+			candidates[i].density = 1
+		}
+	}
+
+	// Pick the smallest image that is dense enough, or the densest one if
+	// there's none.
+	var best imageCandidate
+	for _, c := range candidates {
+		switch {
+		case best.url == "":
+			best = c
+		case 1 <= c.density && (best.density < 1 || c.density < best.density):
+			best = c
+		case best.density < 1 && best.density < c.density:
+			best = c
+		}
+	}
+	return best, true
+}
+
+// https://html.spec.whatwg.org/multipage/images.html#parse-a-srcset-attribute
+func parseSrcset(input string) []imageCandidate {
+	res := []imageCandidate{}
+	for {
+		input = strings.TrimLeftFunc(input, func(r rune) bool { return util.IsAsciiWhitespace(r) || r == ',' })
+		if input == "" {
+			return res
+		}
+		urlEnd := strings.IndexFunc(input, util.IsAsciiWhitespace)
+		if urlEnd == -1 {
+			urlEnd = len(input)
+		}
+		c := imageCandidate{url: input[:urlEnd]}
+		input = input[urlEnd:]
+		descriptors := []string{}
+		if strings.HasSuffix(c.url, ",") {
+			c.url = strings.TrimRight(c.url, ",")
+		} else {
+			// Descriptors end at the next comma outside of parentheses.
+			descEnd, depth := len(input), 0
+		loop:
+			for i, r := range input {
+				switch {
+				case r == '(':
+					depth++
+				case r == ')' && depth != 0:
+					depth--
+				case r == ',' && depth == 0:
+					descEnd = i
+					break loop
+				}
+			}
+			descriptors = strings.FieldsFunc(input[:descEnd], util.IsAsciiWhitespace)
+			input = input[descEnd:]
+		}
+		if ok := c.parseDescriptors(descriptors); ok {
+			res = append(res, c)
+		}
+	}
+}
+
+// parseDescriptors parses descriptors of the image candidate. Returns false if
+// the candidate should be dropped.
+//
+// Spec: https://html.spec.whatwg.org/multipage/images.html#parse-a-srcset-attribute
+func (c *imageCandidate) parseDescriptors(descriptors []string) bool {
+	hasHeight := false
+	for _, desc := range descriptors {
+		if len(desc) < 2 {
+			return false
+		}
+		value := desc[:len(desc)-1]
+		switch desc[len(desc)-1] {
+		case 'w':
+			v, ok := parseNonNegativeInteger(value)
+			if c.width != 0 || c.density != 0 || !ok || v == 0 || strconv.Itoa(v) != value {
+				return false
+			}
+			c.width = v
+		case 'x':
+			v, err := strconv.ParseFloat(value, 64)
+			if c.width != 0 || c.density != 0 || hasHeight || err != nil || v < 0 {
+				return false
+			}
+			c.density = v
+		case 'h':
+			// Height descriptors are only allowed along with width descriptors,
+			// and are otherwise ignored for now.
+			v, ok := parseNonNegativeInteger(value)
+			if hasHeight || c.density != 0 || !ok || v == 0 {
+				return false
+			}
+			hasHeight = true
+		default:
+			return false
+		}
+	}
+	return !hasHeight || c.width != 0
+}
+
+// parseSizes parses sizes attribute, and returns the source size.
+//
+// TODO: Media conditions are not supported, so source sizes with them are
+// skipped.
+//
+// Spec: https://html.spec.whatwg.org/multipage/images.html#parse-a-sizes-attribute
+func parseSizes(input string, viewportWidth float64) float64 {
+	for size := range strings.SplitSeq(input, ",") {
+		size = strings.TrimFunc(size, util.IsAsciiWhitespace)
+		if strings.ContainsFunc(size, util.IsAsciiWhitespace) {
+			continue
+		}
+		if v, ok := parseSourceSizeValue(size, viewportWidth); ok {
+			return v
+		}
+	}
+	return viewportWidth
+}
+
+// parseSourceSizeValue parses a length in sizes attribute, in pixels. Relative
+// lengths are resolved as if the font size was 16px.
+func parseSourceSizeValue(input string, viewportWidth float64) (float64, bool) {
+	if input == "0" {
+		return 0, true
+	}
+	units := []struct {
+		name  string
+		scale float64
+	}{
+		{"px", 1},
+		{"rem", 16},
+		{"em", 16},
+		{"vw", viewportWidth / 100},
+	}
+	for _, unit := range units {
+		if v, ok := strings.CutSuffix(input, unit.name); ok {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil || f < 0 {
+				return 0, false
+			}
+			return f * unit.scale, true
+		}
+	}
+	return 0, false
+}
+
+// https://html.spec.whatwg.org/multipage/common-microsyntaxes.html#rules-for-parsing-dimension-values
+func parseDimensionValue(input string) (values.LengthResolvable, bool) {
+	input = strings.TrimLeftFunc(input, util.IsAsciiWhitespace)
+	digits := 0
+	for digits < len(input) && '0' <= input[digits] && input[digits] <= '9' {
+		digits++
+	}
+	if digits == 0 {
+		return nil, false
+	}
+	end := digits
+	if end < len(input) && input[end] == '.' {
+		fraction := end + 1
+		for fraction < len(input) && '0' <= input[fraction] && input[fraction] <= '9' {
+			fraction++
+		}
+		if fraction != end+1 {
+			end = fraction
+		}
+	}
+	value, err := strconv.ParseFloat(input[:end], 64)
+	if err != nil {
+		return nil, false
+	}
+	if strings.HasPrefix(input[end:], "%") {
+		return values.Percentage{Value: value}, true
+	}
+	return values.LengthFromPx(value), true
+}
@@ -0,0 +1,52 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package elements
+
+import (
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/lists"
+	"github.com/inseo-oh/yw/dom"
+)
+
+// HTMLLIElement represents an [li] element.
+//
+// [li]: https://html.spec.whatwg.org/multipage/grouping-content.html#the-li-element
+type HTMLLIElement interface {
+	HTMLElement
+
+	// Value returns the ordinal value of the list item, from the value
+	// attribute. ok is false if the attribute is missing or invalid.
+	//
+	// Spec: https://html.spec.whatwg.org/multipage/grouping-content.html#attr-li-value
+	Value() (value int, ok bool)
+}
+type htmlLIElementImpl struct {
+	HTMLElement
+}
+
+// NewHTMLLIElement constructs a new [HTMLLIElement] node.
+func NewHTMLLIElement(options dom.ElementCreationCommonOptions) HTMLLIElement {
+	elem := htmlLIElementImpl{HTMLElement: NewHTMLElement(options)}
+
+	setPresentationalHints(elem, func() []cssom.Declaration {
+		decls := []cssom.Declaration{}
+
+		// https://html.spec.whatwg.org/multipage/rendering.html#lists
+		if value, ok := elem.Value(); ok {
+			counter := lists.Counter{Name: "list-item", Value: value, HasValue: true}
+			decls = append(decls, cssom.Declaration{Name: "counter-set", Value: lists.Counters{counter}, IsImportant: false})
+		}
+		return decls
+	})
+	return elem
+}
+
+func (elem htmlLIElementImpl) Value() (value int, ok bool) {
+	attr, ok := elem.AttrWithoutNamespace("value")
+	if !ok {
+		return 0, false
+	}
+	return parseInteger(attr)
+}
@@ -38,6 +38,7 @@ func NewHTMLLinkElement(options dom.ElementCreationCommonOptions) HTMLLinkElemen
 	elem := &htmlLinkElementImpl{
 		HTMLElement: NewHTMLElement(options),
 	}
+	setPresentationalHints(elem, nil)
 
 	cbs := elem.Callbacks()
 
@@ -0,0 +1,73 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package elements
+
+import (
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/lists"
+	"github.com/inseo-oh/yw/dom"
+)
+
+// HTMLOListElement represents an [ol] element.
+//
+// [ol]: https://html.spec.whatwg.org/multipage/grouping-content.html#the-ol-element
+type HTMLOListElement interface {
+	HTMLElement
+
+	// Start returns the ordinal value of the first list item, from the start
+	// attribute. ok is false if the attribute is missing or invalid.
+	//
+	// Spec: https://html.spec.whatwg.org/multipage/grouping-content.html#attr-ol-start
+	Start() (start int, ok bool)
+
+	// Reversed reports whether the list is in descending order, from the
+	// reversed attribute.
+	//
+	// Spec: https://html.spec.whatwg.org/multipage/grouping-content.html#attr-ol-reversed
+	Reversed() bool
+}
+type htmlOListElementImpl struct {
+	HTMLElement
+}
+
+// NewHTMLOListElement constructs a new [HTMLOListElement] node.
+func NewHTMLOListElement(options dom.ElementCreationCommonOptions) HTMLOListElement {
+	elem := htmlOListElementImpl{HTMLElement: NewHTMLElement(options)}
+
+	setPresentationalHints(elem, func() []cssom.Declaration {
+		decls := []cssom.Declaration{}
+
+		// https://html.spec.whatwg.org/multipage/rendering.html#lists
+		counter := lists.Counter{Name: "list-item", IsReversed: elem.Reversed()}
+		if start, ok := elem.Start(); ok {
+			// list-item is incremented (or decremented if reversed) before
+			// the first list item, so we start from the value before it.
+			counter.HasValue = true
+			if counter.IsReversed {
+				counter.Value = start + 1
+			} else {
+				counter.Value = start - 1
+			}
+		}
+		if counter.HasValue || counter.IsReversed {
+			decls = append(decls, cssom.Declaration{Name: "counter-reset", Value: lists.Counters{counter}, IsImportant: false})
+		}
+		return decls
+	})
+	return elem
+}
+
+func (elem htmlOListElementImpl) Start() (start int, ok bool) {
+	attr, ok := elem.AttrWithoutNamespace("start")
+	if !ok {
+		return 0, false
+	}
+	return parseInteger(attr)
+}
+
+func (elem htmlOListElementImpl) Reversed() bool {
+	_, ok := elem.AttrWithoutNamespace("reversed")
+	return ok
+}
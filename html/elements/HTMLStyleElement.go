@@ -27,6 +27,7 @@ func NewHTMLStyleElement(options dom.ElementCreationCommonOptions) HTMLStyleElem
 	elem := htmlStyleElementImpl{
 		HTMLElement: NewHTMLElement(options),
 	}
+	setPresentationalHints(elem, nil)
 
 	cbs := elem.Callbacks()
 
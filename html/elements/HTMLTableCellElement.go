@@ -0,0 +1,60 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package elements
+
+import "github.com/inseo-oh/yw/dom"
+
+// HTMLTableCellElement represents a [td] or [th] element.
+//
+// [td]: https://html.spec.whatwg.org/multipage/tables.html#the-td-element
+// [th]: https://html.spec.whatwg.org/multipage/tables.html#the-th-element
+type HTMLTableCellElement interface {
+	HTMLElement
+
+	// ColSpan returns number of columns the cell spans, from the colspan attribute.
+	//
+	// Spec: https://html.spec.whatwg.org/multipage/tables.html#algorithm-for-processing-rows
+	ColSpan() int
+
+	// RowSpan returns number of rows the cell spans, from the rowspan attribute.
+	// Zero means the cell spans all remaining rows of the row group.
+	//
+	// Spec: https://html.spec.whatwg.org/multipage/tables.html#algorithm-for-processing-rows
+	RowSpan() int
+}
+type htmlTableCellElementImpl struct {
+	HTMLElement
+}
+
+// NewHTMLTableCellElement constructs a new [HTMLTableCellElement] node.
+func NewHTMLTableCellElement(options dom.ElementCreationCommonOptions) HTMLTableCellElement {
+	elem := htmlTableCellElementImpl{HTMLElement: NewHTMLElement(options)}
+	setPresentationalHints(elem, nil)
+	return elem
+}
+
+func (elem htmlTableCellElementImpl) ColSpan() int {
+	attr, ok := elem.AttrWithoutNamespace("colspan")
+	if !ok {
+		return 1
+	}
+	v, ok := parseNonNegativeInteger(attr)
+	if !ok || v == 0 {
+		return 1
+	}
+	return min(v, 1000)
+}
+
+func (elem htmlTableCellElementImpl) RowSpan() int {
+	attr, ok := elem.AttrWithoutNamespace("rowspan")
+	if !ok {
+		return 1
+	}
+	v, ok := parseNonNegativeInteger(attr)
+	if !ok {
+		return 1
+	}
+	return min(v, 65534)
+}
@@ -0,0 +1,42 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package elements
+
+import "github.com/inseo-oh/yw/dom"
+
+// HTMLTableColElement represents a [col] or [colgroup] element.
+//
+// [col]: https://html.spec.whatwg.org/multipage/tables.html#the-col-element
+// [colgroup]: https://html.spec.whatwg.org/multipage/tables.html#the-colgroup-element
+type HTMLTableColElement interface {
+	HTMLElement
+
+	// Span returns number of columns the element spans, from the span attribute.
+	//
+	// Spec: https://html.spec.whatwg.org/multipage/tables.html#forming-a-table
+	Span() int
+}
+type htmlTableColElementImpl struct {
+	HTMLElement
+}
+
+// NewHTMLTableColElement constructs a new [HTMLTableColElement] node.
+func NewHTMLTableColElement(options dom.ElementCreationCommonOptions) HTMLTableColElement {
+	elem := htmlTableColElementImpl{HTMLElement: NewHTMLElement(options)}
+	setPresentationalHints(elem, nil)
+	return elem
+}
+
+func (elem htmlTableColElementImpl) Span() int {
+	attr, ok := elem.AttrWithoutNamespace("span")
+	if !ok {
+		return 1
+	}
+	v, ok := parseNonNegativeInteger(attr)
+	if !ok || v == 0 {
+		return 1
+	}
+	return min(v, 1000)
+}
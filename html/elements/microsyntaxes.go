@@ -0,0 +1,46 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package elements
+
+import (
+	"strings"
+
+	"github.com/inseo-oh/yw/util"
+)
+
+// https://html.spec.whatwg.org/multipage/common-microsyntaxes.html#rules-for-parsing-integers
+func parseInteger(input string) (int, bool) {
+	input = strings.TrimLeftFunc(input, util.IsAsciiWhitespace)
+	isNegative := false
+	if strings.HasPrefix(input, "-") {
+		isNegative = true
+		input = input[1:]
+	} else if strings.HasPrefix(input, "+") {
+		input = input[1:]
+	}
+	digits := 0
+	value := 0
+	for digits < len(input) && '0' <= input[digits] && input[digits] <= '9' {
+		// Values beyond what we care about are clamped by callers anyway.
+		value = min(value*10+int(input[digits]-'0'), 1<<30)
+		digits++
+	}
+	if digits == 0 {
+		return 0, false
+	}
+	if isNegative {
+		value = -value
+	}
+	return value, true
+}
+
+// https://html.spec.whatwg.org/multipage/common-microsyntaxes.html#rules-for-parsing-non-negative-integers
+func parseNonNegativeInteger(input string) (int, bool) {
+	value, ok := parseInteger(input)
+	if !ok || value < 0 {
+		return 0, false
+	}
+	return value, true
+}
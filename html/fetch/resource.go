@@ -0,0 +1,44 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package fetch
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// FetchBytes fetches the resource at u, and returns its body.
+// Only file, http and https URLs are supported.
+//
+// STUB: This doesn't follow the [Fetch Standard] at all, and it only exists so
+// that subresources can be loaded for now.
+//
+// [Fetch Standard]: https://fetch.spec.whatwg.org/
+func FetchBytes(u url.URL) ([]byte, error) {
+	switch u.Scheme {
+	case "file":
+		return os.ReadFile(u.Path)
+	case "http", "https":
+		req, err := http.NewRequest("GET", u.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		// TODO: Set a real user agent
+		req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/142.0.0.0 Safari/537.36")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || 299 < resp.StatusCode {
+			return nil, fmt.Errorf("%s: %s", u.String(), resp.Status)
+		}
+		return io.ReadAll(resp.Body)
+	}
+	return nil, fmt.Errorf("%s: unsupported URL scheme %q", u.String(), u.Scheme)
+}
@@ -189,8 +189,10 @@ func (p *Parser) Run() dom.Document {
 		p.Document = dom.NewDocument()
 	}
 	p.tokenizer.onTokenEmitted = func(tk htmlToken) {
-		if p.onNextToken != nil {
-			switch p.onNextToken(tk) {
+		if onNextToken := p.onNextToken; onNextToken != nil {
+			// It only applies to the very next token.
+			p.onNextToken = nil
+			switch onNextToken(tk) {
 			case parserControlIgnoreToken:
 				return
 			case parserControlContinue:
@@ -408,11 +410,13 @@ func (p *Parser) haveElementInTableScope(isTargetNode func(n dom.Element) bool)
 type insertionLocation struct {
 	parentNode dom.Node
 	tp         insertionLocationType
+	child      dom.Node // Only used by insertionLocationBeforeChild
 }
 type insertionLocationType uint8
 
 const (
 	insertionLocationAfterLastChild insertionLocationType = iota
+	insertionLocationBeforeChild                          // Before the child node
 )
 
 // https://html.spec.whatwg.org/multipage/parsing.html#appropriate-place-for-inserting-a-node
@@ -430,9 +434,28 @@ func (p *Parser) appropriatePlaceForInsertionNode(overrideTarget dom.Element) in
 		targetElem.IsHtmlElement("tfoot") ||
 		targetElem.IsHtmlElement("thead") ||
 		targetElem.IsHtmlElement("tr")) {
-		panic("TODO[https://html.spec.whatwg.org/multipage/parsing.html#appropriate-place-for-inserting-a-node]")
+		// Foster parenting
+		lastTemplateIdx, lastTableIdx := -1, -1
+		for i, n := range p.stackOfOpenElements {
+			if n.IsHtmlElement("template") {
+				lastTemplateIdx = i
+			} else if n.IsHtmlElement("table") {
+				lastTableIdx = i
+			}
+		}
+		if lastTemplateIdx != -1 && (lastTableIdx == -1 || lastTableIdx < lastTemplateIdx) {
+			panic("TODO[https://html.spec.whatwg.org/multipage/parsing.html#appropriate-place-for-inserting-a-node]")
+		}
+		if lastTableIdx == -1 {
+			// Fragment case
+			res = insertionLocation{parentNode: p.stackOfOpenElements[0], tp: insertionLocationAfterLastChild}
+		} else if lastTable := p.stackOfOpenElements[lastTableIdx]; !util.IsNil(lastTable.Parent()) {
+			res = insertionLocation{parentNode: lastTable.Parent(), tp: insertionLocationBeforeChild, child: lastTable}
+		} else {
+			res = insertionLocation{parentNode: p.stackOfOpenElements[lastTableIdx-1], tp: insertionLocationAfterLastChild}
+		}
 	} else {
-		res = insertionLocation{target, insertionLocationAfterLastChild}
+		res = insertionLocation{parentNode: target, tp: insertionLocationAfterLastChild}
 	}
 	if targetElem := target; targetElem.IsInside(dom.NamePair{Namespace: namespaces.Html, LocalName: "template"}) {
 		panic("TODO[https://html.spec.whatwg.org/multipage/parsing.html#appropriate-place-for-inserting-a-node]")
@@ -467,10 +490,20 @@ func (p *Parser) createElementForToken(token tagToken, namespace namespaces.Name
 			factoryFn = func(opt dom.ElementCreationCommonOptions) dom.Element { return elements.NewHTMLHtmlElement(opt) }
 		} else if namespace != nil && *namespace == namespaces.Html && localName == "body" {
 			factoryFn = func(opt dom.ElementCreationCommonOptions) dom.Element { return elements.NewHTMLBodyElement(opt) }
+		} else if namespace != nil && *namespace == namespaces.Html && localName == "img" {
+			factoryFn = func(opt dom.ElementCreationCommonOptions) dom.Element { return elements.NewHTMLImageElement(opt) }
 		} else if namespace != nil && *namespace == namespaces.Html && localName == "link" {
 			factoryFn = func(opt dom.ElementCreationCommonOptions) dom.Element { return elements.NewHTMLLinkElement(opt) }
 		} else if namespace != nil && *namespace == namespaces.Html && localName == "style" {
 			factoryFn = func(opt dom.ElementCreationCommonOptions) dom.Element { return elements.NewHTMLStyleElement(opt) }
+		} else if namespace != nil && *namespace == namespaces.Html && (localName == "td" || localName == "th") {
+			factoryFn = func(opt dom.ElementCreationCommonOptions) dom.Element { return elements.NewHTMLTableCellElement(opt) }
+		} else if namespace != nil && *namespace == namespaces.Html && (localName == "col" || localName == "colgroup") {
+			factoryFn = func(opt dom.ElementCreationCommonOptions) dom.Element { return elements.NewHTMLTableColElement(opt) }
+		} else if namespace != nil && *namespace == namespaces.Html && localName == "ol" {
+			factoryFn = func(opt dom.ElementCreationCommonOptions) dom.Element { return elements.NewHTMLOListElement(opt) }
+		} else if namespace != nil && *namespace == namespaces.Html && localName == "li" {
+			factoryFn = func(opt dom.ElementCreationCommonOptions) dom.Element { return elements.NewHTMLLIElement(opt) }
 		}
 		return factoryFn
 	})
@@ -513,6 +546,8 @@ func (p *Parser) insertAtLocation(elem dom.Node, position insertionLocation) {
 	switch position.tp {
 	case insertionLocationAfterLastChild:
 		dom.AppendChild(position.parentNode, elem)
+	case insertionLocationBeforeChild:
+		dom.Insert(elem, position.parentNode, position.child, false)
 	default:
 		log.Panicf("unknown insertion mode %v", position.tp)
 	}
@@ -565,23 +600,24 @@ func (p *Parser) insertCharacter(data rune) {
 		// Document node cannot have text as children
 		return
 	}
-	switch insertionLocation.tp {
-	case insertionLocationAfterLastChild:
-		parentNode := insertionLocation.parentNode
-		parentChildren := parentNode.Children()
-		var existingText dom.Text
-		if len(parentChildren) != 0 {
-			if t, ok := parentChildren[len(parentChildren)-1].(dom.Text); ok {
-				existingText = t
-			}
+	parentNode := insertionLocation.parentNode
+	parentChildren := parentNode.Children()
+	prevIdx := len(parentChildren) - 1
+	if insertionLocation.tp == insertionLocationBeforeChild {
+		prevIdx = slices.Index(parentChildren, insertionLocation.child) - 1
+	}
+	var existingText dom.Text
+	if 0 <= prevIdx {
+		if t, ok := parentChildren[prevIdx].(dom.Text); ok {
+			existingText = t
 		}
+	}
 
-		if !util.IsNil(existingText) {
-			existingText.AppendText(string(data))
-		} else {
-			text := dom.NewText(parentNode.NodeDocument(), string(data))
-			p.insertAtLocation(text, insertionLocation)
-		}
+	if !util.IsNil(existingText) {
+		existingText.AppendText(string(data))
+	} else {
+		text := dom.NewText(parentNode.NodeDocument(), string(data))
+		p.insertAtLocation(text, insertionLocation)
 	}
 }
 
@@ -634,7 +670,46 @@ func (p *Parser) generateAllImpliedEndTagsThroughly(excludeFilter func(n dom.Ele
 
 // https://html.spec.whatwg.org/multipage/parsing.html#reset-the-insertion-mode-appropriately
 func (p *Parser) resetInsertionModeAppropriately() {
-	panic("TODO[https://html.spec.whatwg.org/multipage/parsing.html#reset-the-insertion-mode-appropriately]")
+	for i := len(p.stackOfOpenElements) - 1; 0 <= i; i-- {
+		node := p.stackOfOpenElements[i]
+		last := i == 0
+		if last && p.isFragmentParsing {
+			panic("TODO[https://html.spec.whatwg.org/multipage/parsing.html#reset-the-insertion-mode-appropriately]")
+		}
+		switch {
+		case !last && slices.ContainsFunc([]string{"td", "th"}, node.IsHtmlElement):
+			p.insertionMode = inCellInsertionMode
+		case node.IsHtmlElement("tr"):
+			p.insertionMode = inRowInsertionMode
+		case slices.ContainsFunc([]string{"tbody", "thead", "tfoot"}, node.IsHtmlElement):
+			p.insertionMode = inTableBodyInsertionMode
+		case node.IsHtmlElement("caption"):
+			p.insertionMode = inCaptionInsertionMode
+		case node.IsHtmlElement("colgroup"):
+			p.insertionMode = inColumnGroupInsertionMode
+		case node.IsHtmlElement("table"):
+			p.insertionMode = inTableInsertionMode
+		case node.IsHtmlElement("template"):
+			p.insertionMode = p.stackOfTemplateInsertionModes[len(p.stackOfTemplateInsertionModes)-1]
+		case !last && node.IsHtmlElement("head"):
+			p.insertionMode = inHeadInsertionMode
+		case node.IsHtmlElement("body"):
+			p.insertionMode = inBodyInsertionMode
+		case node.IsHtmlElement("frameset"):
+			p.insertionMode = inFramesetInsertionMode
+		case node.IsHtmlElement("html"):
+			if util.IsNil(p.headElementPointer) {
+				p.insertionMode = beforeHeadInsertionMode
+			} else {
+				p.insertionMode = afterHeadInsertionMode
+			}
+		case last:
+			p.insertionMode = inBodyInsertionMode
+		default:
+			continue
+		}
+		return
+	}
 }
 
 // https://html.spec.whatwg.org/multipage/parsing.html#the-initial-insertion-mode
@@ -642,7 +717,7 @@ func (p *Parser) applyInitialInsertionModeRules(token htmlToken) {
 	if tk, ok := token.(*charToken); ok && tk.isCharTokenWithOneOf("\t\n\u000c\r ") {
 		return
 	} else if tk, ok := token.(*commentToken); ok {
-		p.insertComment(tk.data, &insertionLocation{p.Document, insertionLocationAfterLastChild})
+		p.insertComment(tk.data, &insertionLocation{parentNode: p.Document, tp: insertionLocationAfterLastChild})
 	} else if tk, ok := token.(*doctypeToken); ok {
 		if tk.name == nil || *tk.name != "html" || tk.publicId != nil || (tk.systemId != nil && *tk.systemId != "about:legacy-compat") {
 			p.parseErrorEncountered(token)
@@ -755,7 +830,7 @@ func (p *Parser) applyBeforeHtmlInsertionModeRules(token htmlToken) {
 		p.parseErrorEncountered(token)
 		return
 	} else if tk, ok := token.(*commentToken); ok {
-		p.insertComment(tk.data, &insertionLocation{p.Document, insertionLocationAfterLastChild})
+		p.insertComment(tk.data, &insertionLocation{parentNode: p.Document, tp: insertionLocationAfterLastChild})
 	} else if tk, ok := token.(*charToken); ok && tk.isCharTokenWithOneOf("\t\n\u000c\r ") {
 		return
 	} else if tk, ok := token.(*tagToken); ok && tk.isStartTag() && tk.tagName == "html" {
@@ -1586,12 +1661,22 @@ func (p *Parser) applyTextInsertionModeRules(token htmlToken) {
 	}
 }
 
+// https://html.spec.whatwg.org/multipage/parsing.html#parsing-main-intable
 func (p *Parser) applyInTableInsertionModeRules(token htmlToken) {
+	// https://html.spec.whatwg.org/multipage/parsing.html#clear-the-stack-back-to-a-table-context
 	clearStackBackToTableContext := func() {
 		for !slices.ContainsFunc([]string{"table", "template", "html"}, p.currentNode().IsHtmlElement) {
 			p.stackOfOpenElements.pop()
 		}
 	}
+	popUntilTable := func() {
+		for {
+			poppedElem := p.stackOfOpenElements.pop()
+			if poppedElem.IsHtmlElement("table") {
+				break
+			}
+		}
+	}
 
 	if _, ok := token.(*charToken); ok && slices.ContainsFunc([]string{
 		"table", "tbody", "template", "tfoot", "thead", "tr",
@@ -1621,36 +1706,27 @@ func (p *Parser) applyInTableInsertionModeRules(token htmlToken) {
 		p.applyInColumnGroupInsertionModeRules(token)
 	} else if tk, ok := token.(*tagToken); ok && tk.isStartTag() && slices.Contains([]string{"tbody", "tfoot", "thead"}, tk.tagName) {
 		clearStackBackToTableContext()
+		p.insertHtmlElement(*tk)
 		p.insertionMode = inTableBodyInsertionMode
 	} else if tk, ok := token.(*tagToken); ok && tk.isStartTag() && slices.Contains([]string{"td", "th", "tr"}, tk.tagName) {
 		clearStackBackToTableContext()
 		p.insertHtmlElement(tagToken{tagName: "tbody"})
+		p.insertionMode = inTableBodyInsertionMode
 		p.inTableBodyInsertionModeRules(token)
 	} else if tk, ok := token.(*tagToken); ok && tk.isStartTag() && tk.tagName == "table" {
 		p.parseErrorEncountered(token)
-		if !p.haveElementInScope(func(n dom.Element) bool { return n.IsHtmlElement("table") }) {
+		if !p.haveElementInTableScope(func(n dom.Element) bool { return n.IsHtmlElement("table") }) {
 			return
-		} else {
-			for {
-				poppedElem := p.stackOfOpenElements.pop()
-				if poppedElem.IsHtmlElement("table") {
-					break
-				}
-			}
-			p.resetInsertionModeAppropriately()
-			p.applyCurrentInsertionModeRules(token)
 		}
+		popUntilTable()
+		p.resetInsertionModeAppropriately()
+		p.applyCurrentInsertionModeRules(token)
 	} else if tk, ok := token.(*tagToken); ok && tk.isEndTag() && tk.tagName == "table" {
-		if !p.haveElementInScope(func(n dom.Element) bool { return n.IsHtmlElement("table") }) {
+		if !p.haveElementInTableScope(func(n dom.Element) bool { return n.IsHtmlElement("table") }) {
 			p.parseErrorEncountered(token)
 			return
 		}
-		for {
-			poppedElem := p.stackOfOpenElements.pop()
-			if poppedElem.IsHtmlElement("table") {
-				break
-			}
-		}
+		popUntilTable()
 		p.resetInsertionModeAppropriately()
 	} else if tk, ok := token.(*tagToken); ok && tk.isEndTag() && slices.Contains([]string{
 		"body", "caption", "col", "colgroup", "html", "tbody", "td", "tfoot", "th", "thead", "tr",
@@ -1658,8 +1734,8 @@ func (p *Parser) applyInTableInsertionModeRules(token htmlToken) {
 		p.parseErrorEncountered(token)
 		return
 	} else if tk, ok := token.(*tagToken); ok &&
-		(tk.isStartTag() && slices.Contains([]string{"style", "script", "template"}, tk.tagName)) ||
-		(tk.isEndTag() && tk.tagName == "template") {
+		((tk.isStartTag() && slices.Contains([]string{"style", "script", "template"}, tk.tagName)) ||
+			(tk.isEndTag() && tk.tagName == "template")) {
 		p.applyInHeadInsertionModeRules(token)
 	} else if tk, ok := token.(*tagToken); ok && tk.isStartTag() && tk.tagName == "input" &&
 		func() bool {
@@ -1673,28 +1749,13 @@ func (p *Parser) applyInTableInsertionModeRules(token htmlToken) {
 		p.stackOfOpenElements.pop()
 		tk.selfClosingAcknowledged = true
 	} else if tk, ok := token.(*tagToken); ok && tk.isStartTag() && tk.tagName == "form" {
-		if p.stackOfOpenElements.hasElem("template") {
-			node := p.formElementPointer
-			p.formElementPointer = nil
-			if util.IsNil(node) || !p.haveElementInScope(func(n dom.Element) bool { return n == node }) {
-				p.parseErrorEncountered(token)
-				return
-			}
-			p.generateImpliedEndTags(nil)
-			if p.currentNode() != node {
-				p.parseErrorEncountered(token)
-			}
-			removeIdx := slices.Index(p.stackOfOpenElements, node)
-			p.stackOfOpenElements.remove(removeIdx)
-		} else {
-			p.parseErrorEncountered(token)
-			if p.haveElementInScope(func(n dom.Element) bool { return n.IsHtmlElement("form") }) ||
-				!util.IsNil(p.formElementPointer) {
-				return
-			}
-			p.insertHtmlElement(*tk)
-			p.stackOfOpenElements.pop()
+		p.parseErrorEncountered(token)
+		if slices.ContainsFunc(p.stackOfOpenElements, func(n dom.Element) bool { return n.IsHtmlElement("template") }) ||
+			!util.IsNil(p.formElementPointer) {
+			return
 		}
+		p.formElementPointer = p.insertHtmlElement(*tk)
+		p.stackOfOpenElements.pop()
 	} else if _, ok := token.(*eofToken); ok {
 		p.applyInBodyInsertionModeRules(token)
 	} else {
@@ -1718,7 +1779,7 @@ func (p *Parser) applyInTableTextInsertionModeRules(token htmlToken) {
 			// Below do the same thing as "else" in "in table" insertion mode.
 			p.enableFosterParenting = true
 			for _, tk := range p.pendingTableCharTokens {
-				p.applyInBodyInsertionModeRules(tk)
+				p.applyInBodyInsertionModeRules(&tk)
 			}
 			p.enableFosterParenting = false
 		} else {
@@ -1733,10 +1794,11 @@ func (p *Parser) applyInTableTextInsertionModeRules(token htmlToken) {
 
 // https://html.spec.whatwg.org/multipage/parsing.html#parsing-main-incaption
 func (p *Parser) applyInCaptionInsertionModeRules(token htmlToken) {
-	if tk, ok := token.(*tagToken); ok && tk.isEndTag() && tk.tagName == "caption" {
+	// Returns false if there's no caption to close.
+	closeCaption := func() bool {
 		if !p.haveElementInTableScope(func(n dom.Element) bool { return n.IsHtmlElement("caption") }) {
 			p.parseErrorEncountered(token)
-			return
+			return false
 		}
 		p.generateImpliedEndTags(nil)
 		if !p.currentNode().IsHtmlElement("caption") {
@@ -1750,26 +1812,17 @@ func (p *Parser) applyInCaptionInsertionModeRules(token htmlToken) {
 		}
 		p.listOfActiveFormattingElements.clearUpToLastMarker()
 		p.insertionMode = inTableInsertionMode
+		return true
+	}
+
+	if tk, ok := token.(*tagToken); ok && tk.isEndTag() && tk.tagName == "caption" {
+		closeCaption()
 	} else if tk, ok := token.(*tagToken); ok &&
-		(tk.isStartTag() && slices.Contains([]string{"caption", "col", "colgroup", "tbody", "td", "tfoot", "th", "thead", "tr"}, tk.tagName)) ||
-		(tk.isEndTag() && tk.tagName == "table") {
-		if !p.haveElementInTableScope(func(n dom.Element) bool { return n.IsHtmlElement("caption") }) {
-			p.parseErrorEncountered(token)
-			return
-		}
-		p.generateImpliedEndTags(nil)
-		if !p.currentNode().IsHtmlElement("caption") {
-			p.parseErrorEncountered(token)
-		}
-		for {
-			poppedElem := p.stackOfOpenElements.pop()
-			if poppedElem.IsHtmlElement("caption") {
-				break
-			}
+		((tk.isStartTag() && slices.Contains([]string{"caption", "col", "colgroup", "tbody", "td", "tfoot", "th", "thead", "tr"}, tk.tagName)) ||
+			(tk.isEndTag() && tk.tagName == "table")) {
+		if closeCaption() {
+			p.applyInTableInsertionModeRules(token)
 		}
-		p.listOfActiveFormattingElements.clearUpToLastMarker()
-		p.insertionMode = inTableInsertionMode
-		p.inTableBodyInsertionModeRules(token)
 	} else if tk, ok := token.(*tagToken); ok && (tk.isEndTag() && slices.Contains([]string{
 		"body", "col", "colgroup", "html", "tbody", "td", "tfoot", "th", "thead", "tr",
 	}, tk.tagName)) {
@@ -1807,9 +1860,7 @@ func (p *Parser) applyInColumnGroupInsertionModeRules(token htmlToken) {
 	} else if tk, ok := token.(*tagToken); ok && tk.isEndTag() && tk.tagName == "col" {
 		p.parseErrorEncountered(token)
 		return
-	} else if tk, ok := token.(*tagToken); ok &&
-		(tk.isStartTag() && tk.tagName == "template") ||
-		(tk.isEndTag() && tk.tagName == "template") {
+	} else if tk, ok := token.(*tagToken); ok && tk.tagName == "template" {
 		p.applyInHeadInsertionModeRules(token)
 	} else if _, ok := token.(*eofToken); ok {
 		p.applyInBodyInsertionModeRules(token)
@@ -1826,8 +1877,9 @@ func (p *Parser) applyInColumnGroupInsertionModeRules(token htmlToken) {
 
 // https://html.spec.whatwg.org/multipage/parsing.html#parsing-main-intbody
 func (p *Parser) inTableBodyInsertionModeRules(token htmlToken) {
+	// https://html.spec.whatwg.org/multipage/parsing.html#clear-the-stack-back-to-a-table-body-context
 	clearStackBackToTableBodyContext := func() {
-		for slices.ContainsFunc([]string{"tbody", "tfoot", "thead", "template", "html"}, p.currentNode().IsHtmlElement) {
+		for !slices.ContainsFunc([]string{"tbody", "tfoot", "thead", "template", "html"}, p.currentNode().IsHtmlElement) {
 			p.stackOfOpenElements.pop()
 		}
 	}
@@ -1850,8 +1902,8 @@ func (p *Parser) inTableBodyInsertionModeRules(token htmlToken) {
 		p.stackOfOpenElements.pop()
 		p.insertionMode = inTableInsertionMode
 	} else if tk, ok := token.(*tagToken); ok &&
-		(tk.isStartTag() && slices.Contains([]string{"caption", "col", "colgroup", "tbody", "tfoot", "thead"}, tk.tagName)) ||
-		(tk.isEndTag() && tk.tagName == "table") {
+		((tk.isStartTag() && slices.Contains([]string{"caption", "col", "colgroup", "tbody", "tfoot", "thead"}, tk.tagName)) ||
+			(tk.isEndTag() && tk.tagName == "table")) {
 		if !p.haveElementInTableScope(func(n dom.Element) bool {
 			return slices.ContainsFunc([]string{"tbody", "thead", "tfoot"}, n.IsHtmlElement)
 		}) {
@@ -1861,60 +1913,57 @@ func (p *Parser) inTableBodyInsertionModeRules(token htmlToken) {
 		clearStackBackToTableBodyContext()
 		p.stackOfOpenElements.pop()
 		p.insertionMode = inTableInsertionMode
-	} else if tk.isEndTag() && slices.Contains([]string{"body", "caption", "col", "colgroup", "html", "td", "th", "tr"}, tk.tagName) {
+		p.applyInTableInsertionModeRules(token)
+	} else if tk, ok := token.(*tagToken); ok && tk.isEndTag() && slices.Contains([]string{"body", "caption", "col", "colgroup", "html", "td", "th", "tr"}, tk.tagName) {
 		p.parseErrorEncountered(token)
 		return
 	} else {
 		p.applyInTableInsertionModeRules(token)
 	}
-
 }
 
 // https://html.spec.whatwg.org/multipage/parsing.html#parsing-main-intr
 func (p *Parser) applyInRowInsertionModeRules(token htmlToken) {
+	// https://html.spec.whatwg.org/multipage/parsing.html#clear-the-stack-back-to-a-table-row-context
 	clearStackBackToTableRowContext := func() {
-		for slices.ContainsFunc([]string{"tr", "template", "html"}, p.currentNode().IsHtmlElement) {
+		for !slices.ContainsFunc([]string{"tr", "template", "html"}, p.currentNode().IsHtmlElement) {
 			p.stackOfOpenElements.pop()
 		}
-		panic("TODO[https://html.spec.whatwg.org/multipage/parsing.html#parsing-main-intr]")
 	}
-	if tk, ok := token.(*tagToken); ok && tk.isStartTag() && slices.Contains([]string{"th", "td"}, tk.tagName) {
-		clearStackBackToTableRowContext()
-		p.insertHtmlElement(*tk)
-		p.insertionMode = inCellInsertionMode
-		p.listOfActiveFormattingElements = append(p.listOfActiveFormattingElements, activeFormattingElemMarker)
-	} else if tk, ok := token.(*tagToken); ok && tk.isStartTag() && tk.tagName == "tr" {
+	// Returns false if there's no row to close.
+	closeRow := func() bool {
 		if !p.haveElementInTableScope(func(n dom.Element) bool { return n.IsHtmlElement("tr") }) {
 			p.parseErrorEncountered(token)
-			return
+			return false
 		}
 		clearStackBackToTableRowContext()
 		p.stackOfOpenElements.pop()
 		p.insertionMode = inTableBodyInsertionMode
+		return true
+	}
+
+	if tk, ok := token.(*tagToken); ok && tk.isStartTag() && slices.Contains([]string{"th", "td"}, tk.tagName) {
+		clearStackBackToTableRowContext()
+		p.insertHtmlElement(*tk)
+		p.insertionMode = inCellInsertionMode
+		p.listOfActiveFormattingElements = append(p.listOfActiveFormattingElements, activeFormattingElemMarker)
+	} else if tk, ok := token.(*tagToken); ok && tk.isEndTag() && tk.tagName == "tr" {
+		closeRow()
 	} else if tk, ok := token.(*tagToken); ok &&
-		(tk.isStartTag() && slices.Contains([]string{"caption", "col", "colgroup", "tbody", "tfoot", "thead", "tr"}, tk.tagName)) ||
-		(tk.isEndTag() && tk.tagName == "table") {
-		if !p.haveElementInTableScope(func(n dom.Element) bool { return n.IsHtmlElement("tr") }) {
-			p.parseErrorEncountered(token)
-			return
+		((tk.isStartTag() && slices.Contains([]string{"caption", "col", "colgroup", "tbody", "tfoot", "thead", "tr"}, tk.tagName)) ||
+			(tk.isEndTag() && tk.tagName == "table")) {
+		if closeRow() {
+			p.inTableBodyInsertionModeRules(token)
 		}
-		clearStackBackToTableRowContext()
-		p.stackOfOpenElements.pop()
-		p.insertionMode = inTableBodyInsertionMode
-		p.inTableBodyInsertionModeRules(token)
-	} else if tk.isEndTag() && slices.Contains([]string{"tbody", "tfoot", "thead"}, tk.tagName) {
+	} else if tk, ok := token.(*tagToken); ok && tk.isEndTag() && slices.Contains([]string{"tbody", "tfoot", "thead"}, tk.tagName) {
 		if !p.haveElementInTableScope(func(n dom.Element) bool { return n.IsHtmlElement(tk.tagName) }) {
 			p.parseErrorEncountered(token)
 			return
 		}
-		if !p.haveElementInTableScope(func(n dom.Element) bool { return n.IsHtmlElement("tr") }) {
-			return
-		} else {
-			clearStackBackToTableRowContext()
-			p.stackOfOpenElements.pop()
-			p.insertionMode = inTableBodyInsertionMode
+		if closeRow() {
+			p.inTableBodyInsertionModeRules(token)
 		}
-	} else if tk.isEndTag() && slices.Contains([]string{"body", "caption", "col", "colgroup", "html", "td", "th"}, tk.tagName) {
+	} else if tk, ok := token.(*tagToken); ok && tk.isEndTag() && slices.Contains([]string{"body", "caption", "col", "colgroup", "html", "td", "th"}, tk.tagName) {
 		p.parseErrorEncountered(token)
 		return
 	} else {
@@ -1922,7 +1971,9 @@ func (p *Parser) applyInRowInsertionModeRules(token htmlToken) {
 	}
 }
 
+// https://html.spec.whatwg.org/multipage/parsing.html#parsing-main-intd
 func (p *Parser) applyInCellInsertionModeRules(token htmlToken) {
+	// https://html.spec.whatwg.org/multipage/parsing.html#close-the-cell
 	closeCell := func() {
 		p.generateImpliedEndTags(nil)
 		if !slices.ContainsFunc([]string{"td", "th"}, p.currentNode().IsHtmlElement) {
@@ -1961,14 +2012,16 @@ func (p *Parser) applyInCellInsertionModeRules(token htmlToken) {
 		if !p.haveElementInTableScope(func(n dom.Element) bool {
 			return slices.ContainsFunc([]string{"td", "th"}, n.IsHtmlElement)
 		}) {
-			panic("we should have td or th in SOE at this point")
+			// Only happens in the fragment case
+			p.parseErrorEncountered(token)
+			return
 		}
 		closeCell()
 		p.applyInRowInsertionModeRules(token)
-	} else if tk.isEndTag() && slices.Contains([]string{"body", "caption", "col", "colgroup", "html"}, tk.tagName) {
+	} else if tk, ok := token.(*tagToken); ok && tk.isEndTag() && slices.Contains([]string{"body", "caption", "col", "colgroup", "html"}, tk.tagName) {
 		p.parseErrorEncountered(token)
 		return
-	} else if tk.isEndTag() && slices.Contains([]string{"table", "tbody", "tfoot", "thead", "tr"}, tk.tagName) {
+	} else if tk, ok := token.(*tagToken); ok && tk.isEndTag() && slices.Contains([]string{"table", "tbody", "tfoot", "thead", "tr"}, tk.tagName) {
 		if !p.haveElementInTableScope(func(n dom.Element) bool { return n.IsHtmlElement(tk.tagName) }) {
 			p.parseErrorEncountered(token)
 			return
@@ -140,6 +140,19 @@ func TestHtmlParser(t *testing.T) {
 		}
 		return elem
 	}
+	// makeTableDoc makes no-quirks document, with body containing nodes
+	// returned by initBody.
+	makeTableDoc := func(initBody func(doc dom.Document) []dom.Node) dom.Document {
+		return makeDoc(dom.NoQuirks, func(doc dom.Document) []dom.Node {
+			return []dom.Node{
+				dom.NewDocumentType(doc, "html", "", ""),
+				makeElem(doc, "html", &namespaces.Html, []dom.Node{
+					makeElem(doc, "head", &namespaces.Html, nil),
+					makeElem(doc, "body", &namespaces.Html, initBody(doc)),
+				}),
+			}
+		})
+	}
 	testCases := []struct {
 		input   string
 		domToot dom.Node
@@ -237,6 +250,140 @@ func TestHtmlParser(t *testing.T) {
 				}
 			},
 		)},
+		// Text directly inside of <table> is foster parented, unless it's whitespace.
+		{"<!doctype html><body><table>abc<tr><td>x</td></tr></table>", makeTableDoc(
+			func(doc dom.Document) []dom.Node {
+				return []dom.Node{
+					dom.NewText(doc, "abc"),
+					makeElem(doc, "table", &namespaces.Html, []dom.Node{
+						makeElem(doc, "tbody", &namespaces.Html, []dom.Node{
+							makeElem(doc, "tr", &namespaces.Html, []dom.Node{
+								makeElem(doc, "td", &namespaces.Html, []dom.Node{dom.NewText(doc, "x")}),
+							}),
+						}),
+					}),
+				}
+			},
+		)},
+		{"<!doctype html><body><table> <tr><td>x</td></tr></table>", makeTableDoc(
+			func(doc dom.Document) []dom.Node {
+				return []dom.Node{
+					makeElem(doc, "table", &namespaces.Html, []dom.Node{
+						dom.NewText(doc, " "),
+						makeElem(doc, "tbody", &namespaces.Html, []dom.Node{
+							makeElem(doc, "tr", &namespaces.Html, []dom.Node{
+								makeElem(doc, "td", &namespaces.Html, []dom.Node{dom.NewText(doc, "x")}),
+							}),
+						}),
+					}),
+				}
+			},
+		)},
+		// Elements directly inside of <table> are foster parented too.
+		{"<!doctype html><body><table><b>x</b><tr><td>y</table>", makeTableDoc(
+			func(doc dom.Document) []dom.Node {
+				return []dom.Node{
+					makeElem(doc, "b", &namespaces.Html, []dom.Node{dom.NewText(doc, "x")}),
+					makeElem(doc, "table", &namespaces.Html, []dom.Node{
+						makeElem(doc, "tbody", &namespaces.Html, []dom.Node{
+							makeElem(doc, "tr", &namespaces.Html, []dom.Node{
+								makeElem(doc, "td", &namespaces.Html, []dom.Node{dom.NewText(doc, "y")}),
+							}),
+						}),
+					}),
+				}
+			},
+		)},
+		// Misnested table tags: Missing <tbody> and <tr> are inserted, stray end
+		// tags are ignored, and </table> closes everything inside of it.
+		{"<!doctype html><body><table><td>a</tr></th><td>b</tbody></caption><tr><td>c</table>d", makeTableDoc(
+			func(doc dom.Document) []dom.Node {
+				return []dom.Node{
+					makeElem(doc, "table", &namespaces.Html, []dom.Node{
+						makeElem(doc, "tbody", &namespaces.Html, []dom.Node{
+							makeElem(doc, "tr", &namespaces.Html, []dom.Node{
+								makeElem(doc, "td", &namespaces.Html, []dom.Node{dom.NewText(doc, "a")}),
+							}),
+							makeElem(doc, "tr", &namespaces.Html, []dom.Node{
+								makeElem(doc, "td", &namespaces.Html, []dom.Node{dom.NewText(doc, "b")}),
+							}),
+						}),
+						makeElem(doc, "tbody", &namespaces.Html, []dom.Node{
+							makeElem(doc, "tr", &namespaces.Html, []dom.Node{
+								makeElem(doc, "td", &namespaces.Html, []dom.Node{dom.NewText(doc, "c")}),
+							}),
+						}),
+					}),
+					dom.NewText(doc, "d"),
+				}
+			},
+		)},
+		// <table> inside of a cell, and a cell started without closing the
+		// previous one.
+		{"<!doctype html><body><table><tr><td><table><tr><th>a</table><td>b</table>", makeTableDoc(
+			func(doc dom.Document) []dom.Node {
+				return []dom.Node{
+					makeElem(doc, "table", &namespaces.Html, []dom.Node{
+						makeElem(doc, "tbody", &namespaces.Html, []dom.Node{
+							makeElem(doc, "tr", &namespaces.Html, []dom.Node{
+								makeElem(doc, "td", &namespaces.Html, []dom.Node{
+									makeElem(doc, "table", &namespaces.Html, []dom.Node{
+										makeElem(doc, "tbody", &namespaces.Html, []dom.Node{
+											makeElem(doc, "tr", &namespaces.Html, []dom.Node{
+												makeElem(doc, "th", &namespaces.Html, []dom.Node{dom.NewText(doc, "a")}),
+											}),
+										}),
+									}),
+								}),
+								makeElem(doc, "td", &namespaces.Html, []dom.Node{dom.NewText(doc, "b")}),
+							}),
+						}),
+					}),
+				}
+			},
+		)},
+		// <caption> is closed by </caption>, or implicitly by table contents
+		// and </table>.
+		{"<!doctype html><body><table><caption>a</caption><tr><td>b</table>", makeTableDoc(
+			func(doc dom.Document) []dom.Node {
+				return []dom.Node{
+					makeElem(doc, "table", &namespaces.Html, []dom.Node{
+						makeElem(doc, "caption", &namespaces.Html, []dom.Node{dom.NewText(doc, "a")}),
+						makeElem(doc, "tbody", &namespaces.Html, []dom.Node{
+							makeElem(doc, "tr", &namespaces.Html, []dom.Node{
+								makeElem(doc, "td", &namespaces.Html, []dom.Node{dom.NewText(doc, "b")}),
+							}),
+						}),
+					}),
+				}
+			},
+		)},
+		{"<!doctype html><body><table><caption><b>a<tr><td>b</table>", makeTableDoc(
+			func(doc dom.Document) []dom.Node {
+				return []dom.Node{
+					makeElem(doc, "table", &namespaces.Html, []dom.Node{
+						makeElem(doc, "caption", &namespaces.Html, []dom.Node{
+							makeElem(doc, "b", &namespaces.Html, []dom.Node{dom.NewText(doc, "a")}),
+						}),
+						makeElem(doc, "tbody", &namespaces.Html, []dom.Node{
+							makeElem(doc, "tr", &namespaces.Html, []dom.Node{
+								makeElem(doc, "td", &namespaces.Html, []dom.Node{dom.NewText(doc, "b")}),
+							}),
+						}),
+					}),
+				}
+			},
+		)},
+		{"<!doctype html><body><table><caption>a</table>b", makeTableDoc(
+			func(doc dom.Document) []dom.Node {
+				return []dom.Node{
+					makeElem(doc, "table", &namespaces.Html, []dom.Node{
+						makeElem(doc, "caption", &namespaces.Html, []dom.Node{dom.NewText(doc, "a")}),
+					}),
+					dom.NewText(doc, "b"),
+				}
+			},
+		)},
 	}
 	var fixChildrenParentPtr func(node dom.Node)
 	fixChildrenParentPtr = func(node dom.Node) {
@@ -0,0 +1,316 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package layout
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/backgrounds"
+	"github.com/inseo-oh/yw/css/images"
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/gfx/paint"
+)
+
+// Background holds resources needed to paint background images of a box,
+// other than computed values of its element.
+type Background struct {
+	// Decoded url() images of background-image, in the same order as the
+	// property. Entries for other images, and images that failed to load, are
+	// nil.
+	Images []image.Image
+
+	// Font size of the element, used to resolve font-relative lengths.
+	FontSize float64
+}
+
+// backgroundAreaRect returns rect of the box area given by background-clip or
+// background-origin.
+func (bx boxCommon) backgroundAreaRect(area backgrounds.Box) PhysicalRect {
+	switch area {
+	case backgrounds.PaddingBox:
+		return bx.BoxPaddingRect().ToPhysicalRect()
+	case backgrounds.ContentBox:
+		return bx.BoxContentRect().ToPhysicalRect()
+	}
+	return bx.BoxBorderRect().ToPhysicalRect()
+}
+
+// makeBackgroundLayerNodes paints layers of background-image, from the
+// bottom-most one. Values of other background properties are repeated if
+// they have fewer layers.
+//
+// TODO: Support background-attachment
+//
+// Spec: https://www.w3.org/TR/css-backgrounds-3/#layering
+func (bx boxCommon) makeBackgroundLayerNodes(styleSet *props.ComputedStyleSet, currentColor color.Color, borderShape gfx.RoundedRect) []paint.Node {
+	imgs := styleSet.BackgroundImage()
+	positions, sizes, repeats := styleSet.BackgroundPosition(), styleSet.BackgroundSize(), styleSet.BackgroundRepeat()
+	origins, clips := styleSet.BackgroundOrigin(), styleSet.BackgroundClip()
+	fontSize := func() css.Num { return css.NumFromFloat(bx.Background.FontSize) }
+
+	nodes := []paint.Node{}
+	for i := len(imgs) - 1; 0 <= i; i-- {
+		var decoded image.Image
+		if i < len(bx.Background.Images) {
+			decoded = bx.Background.Images[i]
+		}
+		switch imgs[i].(type) {
+		case images.NoImage:
+			continue
+		case images.URLImage:
+			if decoded == nil {
+				continue
+			}
+		}
+		area := bx.backgroundAreaRect(origins[i%len(origins)])
+		areaW, areaH := float64(area.Width), float64(area.Height)
+		repeat := repeats[i%len(repeats)]
+
+		// Size of a tile ------------------------------------------------------
+		// https://www.w3.org/TR/css-backgrounds-3/#background-size
+		var naturalSize *image.Point
+		if decoded != nil {
+			size := decoded.Bounds().Size()
+			naturalSize = &size
+		}
+		size := sizes[i%len(sizes)]
+		w, h := backgroundTileSize(size, areaW, areaH, naturalSize, fontSize)
+		// Round tiles are scaled so that whole number of them fits in the area.
+		// If the other size is auto, it's scaled as well to keep aspect ratio.
+		scaleX, scaleY := 1.0, 1.0
+		if repeat.X == backgrounds.RoundTile && 0 < w {
+			scaleX = areaW / max(math.Round(areaW/w), 1) / w
+		}
+		if repeat.Y == backgrounds.RoundTile && 0 < h {
+			scaleY = areaH / max(math.Round(areaH/h), 1) / h
+		}
+		if size.Type == backgrounds.ExplicitSize && repeat.Y != backgrounds.RoundTile && size.Height == nil {
+			scaleY = scaleX
+		} else if size.Type == backgrounds.ExplicitSize && repeat.X != backgrounds.RoundTile && size.Width == nil {
+			scaleX = scaleY
+		}
+		tileW, tileH := int(math.Round(w*scaleX)), int(math.Round(h*scaleY))
+		if tileW <= 0 || tileH <= 0 {
+			continue
+		}
+
+		// Position and spacing of tiles ---------------------------------------
+		// https://www.w3.org/TR/css-backgrounds-3/#background-position
+		// https://www.w3.org/TR/css-backgrounds-3/#background-repeat
+		x, y := positions[i%len(positions)].Resolve(areaW, areaH, float64(tileW), float64(tileH), fontSize)
+		node := paint.TiledImagePaint{
+			Clip:    bx.backgroundAreaRect(clips[i%len(clips)]).toImageRect(),
+			RepeatX: repeat.X == backgrounds.RepeatTile || repeat.X == backgrounds.RoundTile,
+			RepeatY: repeat.Y == backgrounds.RepeatTile || repeat.Y == backgrounds.RoundTile,
+		}
+		// Spaced tiles touch edges of the area, unless only one of them fits.
+		if n := int(areaW) / tileW; repeat.X == backgrounds.SpaceTile && 2 <= n {
+			x, node.RepeatX = 0, true
+			node.SpacingX = (int(areaW) - n*tileW) / (n - 1)
+		}
+		if n := int(areaH) / tileH; repeat.Y == backgrounds.SpaceTile && 2 <= n {
+			y, node.RepeatY = 0, true
+			node.SpacingY = (int(areaH) - n*tileH) / (n - 1)
+		}
+		tileMin := image.Pt(int(math.Round(float64(area.Left)+x)), int(math.Round(float64(area.Top)+y)))
+		node.Tile = image.Rectangle{Min: tileMin, Max: tileMin.Add(image.Pt(tileW, tileH))}
+
+		node.Image = decoded
+		if node.Image == nil {
+			node.Image = gradientImage(imgs[i], tileW, tileH, fontSize, currentColor)
+		}
+		if !borderShape.Radii.IsZero() {
+			// Layers are clipped to the curve of the area as well.
+			nodes = append(nodes, paint.RoundedClipPaint{Items: []paint.Node{node}, Shape: bx.areaShape(borderShape, clips[i%len(clips)])})
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// backgroundTileSize returns size of background image tile in the area of
+// given size, before adjusting for round tiles. naturalSize is nil for images
+// without natural size, such as gradients.
+//
+// Spec: https://www.w3.org/TR/css-backgrounds-3/#background-size
+func backgroundTileSize(size backgrounds.Size, areaW, areaH float64, naturalSize *image.Point, fontSize func() css.Num) (w, h float64) {
+	hasRatio := naturalSize != nil && naturalSize.X != 0 && naturalSize.Y != 0
+	if size.Type != backgrounds.ExplicitSize {
+		if !hasRatio {
+			return areaW, areaH
+		}
+		scaleX, scaleY := areaW/float64(naturalSize.X), areaH/float64(naturalSize.Y)
+		scale := min(scaleX, scaleY)
+		if size.Type == backgrounds.CoverSize {
+			scale = max(scaleX, scaleY)
+		}
+		return float64(naturalSize.X) * scale, float64(naturalSize.Y) * scale
+	}
+	if size.Width != nil {
+		w = size.Width.AsLength(func() css.Num { return css.NumFromFloat(areaW) }).ToPx(fontSize)
+	}
+	if size.Height != nil {
+		h = size.Height.AsLength(func() css.Num { return css.NumFromFloat(areaH) }).ToPx(fontSize)
+	}
+	switch {
+	case size.Width != nil && size.Height != nil:
+		return w, h
+	case size.Width != nil && hasRatio:
+		return w, w * float64(naturalSize.Y) / float64(naturalSize.X)
+	case size.Width != nil:
+		return w, areaH
+	case size.Height != nil && hasRatio:
+		return h * float64(naturalSize.X) / float64(naturalSize.Y), h
+	case size.Height != nil:
+		return areaW, h
+	case naturalSize != nil:
+		return float64(naturalSize.X), float64(naturalSize.Y)
+	}
+	return areaW, areaH
+}
+
+// gradientImage returns gradient image of given size, for gradient value img.
+//
+// Spec: https://www.w3.org/TR/css-images-3/#gradients
+func gradientImage(img images.Image, w, h int, fontSize func() css.Num, currentColor color.Color) image.Image {
+	rect := image.Rect(0, 0, w, h)
+	width, height := float64(w), float64(h)
+	switch g := img.(type) {
+	case images.LinearGradient:
+		// https://www.w3.org/TR/css-images-3/#linear-gradient-syntax
+		angle := g.Angle * math.Pi / 180
+		if g.Corner != images.NoCorner {
+			// The gradient line is perpendicular to the line connecting two
+			// other corners.
+			dirX, dirY := height, width
+			if g.Corner == images.TopLeft || g.Corner == images.BottomLeft {
+				dirX = -dirX
+			}
+			if g.Corner == images.TopLeft || g.Corner == images.TopRight {
+				dirY = -dirY
+			}
+			angle = math.Atan2(dirX, -dirY)
+		}
+		sin, cos := math.Sincos(angle)
+		length := math.Abs(width*sin) + math.Abs(height*cos)
+		dx, dy := sin*length/2, -cos*length/2
+		return gfx.LinearGradient{
+			Rect:      rect,
+			Start:     [2]float64{width/2 - dx, height/2 - dy},
+			End:       [2]float64{width/2 + dx, height/2 + dy},
+			Stops:     resolveColorStops(g.Stops, length, fontSize, currentColor),
+			Repeating: g.Repeating,
+		}
+	case images.RadialGradient:
+		// https://www.w3.org/TR/css-images-3/#radial-gradient-syntax
+		cx, cy := g.Position.Resolve(width, height, 0, 0, fontSize)
+		closestX, closestY := min(math.Abs(cx), math.Abs(width-cx)), min(math.Abs(cy), math.Abs(height-cy))
+		farthestX, farthestY := max(math.Abs(cx), math.Abs(width-cx)), max(math.Abs(cy), math.Abs(height-cy))
+		var rx, ry float64
+		isCircle := g.Shape == images.Circle
+		switch {
+		case g.Size[0] != nil:
+			rx = g.Size[0].AsLength(func() css.Num { return css.NumFromFloat(width) }).ToPx(fontSize)
+			ry = rx
+			if !isCircle {
+				ry = g.Size[1].AsLength(func() css.Num { return css.NumFromFloat(height) }).ToPx(fontSize)
+			}
+		case g.Extent == images.ClosestSide && isCircle:
+			rx, ry = min(closestX, closestY), min(closestX, closestY)
+		case g.Extent == images.ClosestSide:
+			rx, ry = closestX, closestY
+		case g.Extent == images.FarthestSide && isCircle:
+			rx, ry = max(farthestX, farthestY), max(farthestX, farthestY)
+		case g.Extent == images.FarthestSide:
+			rx, ry = farthestX, farthestY
+		case g.Extent == images.ClosestCorner && isCircle:
+			rx, ry = math.Hypot(closestX, closestY), math.Hypot(closestX, closestY)
+		case g.Extent == images.ClosestCorner:
+			// Ellipses keep the aspect ratio of the closest-side one.
+			rx, ry = closestX*math.Sqrt2, closestY*math.Sqrt2
+		case isCircle:
+			rx, ry = math.Hypot(farthestX, farthestY), math.Hypot(farthestX, farthestY)
+		default:
+			rx, ry = farthestX*math.Sqrt2, farthestY*math.Sqrt2
+		}
+		return gfx.RadialGradient{
+			Rect:      rect,
+			Center:    [2]float64{cx, cy},
+			RadiusX:   rx,
+			RadiusY:   ry,
+			Stops:     resolveColorStops(g.Stops, rx, fontSize, currentColor),
+			Repeating: g.Repeating,
+		}
+	case images.ConicGradient:
+		// https://www.w3.org/TR/css-images-4/#conic-gradient-syntax
+		cx, cy := g.Position.Resolve(width, height, 0, 0, fontSize)
+		return gfx.ConicGradient{
+			Rect:       rect,
+			Center:     [2]float64{cx, cy},
+			StartAngle: g.FromAngle * math.Pi / 180,
+			Stops:      resolveColorStops(g.Stops, 1, fontSize, currentColor),
+			Repeating:  g.Repeating,
+		}
+	}
+	return image.NewUniform(color.Transparent)
+}
+
+// resolveColorStops returns color stops with their offsets resolved, on the
+// gradient ray of given length.
+//
+// Spec: https://www.w3.org/TR/css-images-3/#color-stop-fixup
+func resolveColorStops(stops []images.ColorStop, length float64, fontSize func() css.Num, currentColor color.Color) []gfx.GradientStop {
+	if length <= 0 {
+		length = 1
+	}
+	res := make([]gfx.GradientStop, len(stops))
+	known := make([]bool, len(stops))
+	for i, stop := range stops {
+		res[i].Color = stop.Color.ToStdColor(currentColor)
+		if stop.Position != nil {
+			res[i].Offset = stop.Position.AsLength(func() css.Num { return css.NumFromFloat(length) }).ToPx(fontSize) / length
+			known[i] = true
+		}
+	}
+	if len(res) == 0 {
+		return res
+	}
+	// First and last stops are at the start and the end if not given.
+	if !known[0] {
+		res[0].Offset, known[0] = 0, true
+	}
+	if !known[len(res)-1] {
+		res[len(res)-1].Offset, known[len(res)-1] = 1, true
+	}
+	// Stops can't be placed before stops preceding them.
+	maxOffset := res[0].Offset
+	for i := range res {
+		if known[i] {
+			res[i].Offset = max(res[i].Offset, maxOffset)
+			maxOffset = res[i].Offset
+		}
+	}
+	// Rest of stops are evenly spaced between stops around them.
+	for i := 1; i < len(res); i++ {
+		if known[i] {
+			continue
+		}
+		next := i
+		for !known[next] {
+			next++
+		}
+		prevOffset, nextOffset := res[i-1].Offset, res[next].Offset
+		for j := i; j < next; j++ {
+			res[j].Offset = prevOffset + (nextOffset-prevOffset)*float64(j-i+1)/float64(next-i+1)
+		}
+		i = next
+	}
+	return res
+}
@@ -8,9 +8,13 @@ import (
 	"image"
 	"image/color"
 
+	"github.com/inseo-oh/yw/css/backgrounds"
 	"github.com/inseo-oh/yw/css/csscolor"
 	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/display"
+	"github.com/inseo-oh/yw/css/overflow"
 	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/gfx"
 	"github.com/inseo-oh/yw/gfx/paint"
 	"github.com/inseo-oh/yw/util"
 )
@@ -29,6 +33,8 @@ type Box interface {
 	BoxParent() Box
 	BoxElement() dom.Element
 	BoxMarginRect() LogicalRect
+	BoxBorderRect() LogicalRect
+	BoxPaddingRect() LogicalRect
 	BoxContentRect() LogicalRect
 	LogicalWidth() LogicalPos
 	LogicalHeight() LogicalPos
@@ -36,23 +42,63 @@ type Box interface {
 	IsHeightAuto() bool
 	IncrementSize(logicalWidth, logicalHeight LogicalPos)
 	IncrementIfNeeded(minLogicalWidth, minLogicalHeight LogicalPos) (logicalWidthDiff, logicalHeightDiff LogicalPos)
+
+	// Translate moves the box and all of its descendants.
+	Translate(logicalX, logicalY LogicalPos)
+
+	// IsScrollContainer reports whether the box is a scroll container.
+	IsScrollContainer() bool
+
+	// ScrollTo scrolls contents of the box. See boxCommon.ScrollTo.
+	ScrollTo(physicalX, physicalY PhysicalPos)
+
+	makePaintNode(sc *stackingContext) paint.Node
 }
 type boxCommon struct {
 	Parent             Box
 	Elem               dom.Element
 	MarginRect         LogicalRect
 	Margin             PhysicalEdges
+	Border             PhysicalEdges
 	Padding            PhysicalEdges
 	PhysicalWidthAuto  bool
 	PhysicalHeightAuto bool
 	childBoxes         []Box
 	childTexts         []*Text
+
+	// Absolutely positioned boxes are taken out of flow, so these don't affect
+	// size of the parent.
+	IsAbsolutelyPositioned bool
+
+	// Table wrapper boxes share the element with table boxes inside of them,
+	// but backgrounds and borders are only painted by the latter.
+	IsTableWrapper bool
+
+	// Borders resolved by the collapsing border model. If it's non-nil, these
+	// are painted instead of borders of the element.
+	CollapsedBorders *CollapsedBorders
+
+	// Used values of overflow-x and overflow-y. These are visible for boxes
+	// the properties don't apply to.
+	OverflowX, OverflowY overflow.Overflow
+
+	// Scroll position of the scroll container, from the start of its
+	// scrollable overflow area.
+	ScrollX, ScrollY PhysicalPos
+
+	// Image of the replaced element (e.g. HTML img element), which is painted
+	// to fill the content area. nil for other boxes.
+	Image image.Image
+
+	// Resources needed to paint background images of the element.
+	Background Background
 }
 
 func (bx boxCommon) BoxParent() Box              { return bx.Parent }
 func (bx boxCommon) BoxElement() dom.Element     { return bx.Elem }
 func (bx boxCommon) BoxMarginRect() LogicalRect  { return bx.MarginRect }                              // Rect containing margin area
-func (bx boxCommon) BoxPaddingRect() LogicalRect { return bx.BoxMarginRect().addPadding(bx.Margin) }   // Rect containing padding area
+func (bx boxCommon) BoxBorderRect() LogicalRect  { return bx.BoxMarginRect().addPadding(bx.Margin) }   // Rect containing border area
+func (bx boxCommon) BoxPaddingRect() LogicalRect { return bx.BoxBorderRect().addPadding(bx.Border) }   // Rect containing padding area
 func (bx boxCommon) BoxContentRect() LogicalRect { return bx.BoxPaddingRect().addPadding(bx.Padding) } // Rect containing content area
 
 // https://www.w3.org/TR/css-writing-modes-4/#logical-width
@@ -68,13 +114,13 @@ func (bx boxCommon) LogicalHeight() LogicalPos {
 func (bx boxCommon) ChildBoxes() []Box {
 	return bx.childBoxes
 }
-func (bx boxCommon) AddChildBox(b Box) {
+func (bx *boxCommon) AddChildBox(b Box) {
 	bx.childBoxes = append(bx.childBoxes, b)
 }
 func (bx boxCommon) ChildTexts() []*Text {
 	return bx.childTexts
 }
-func (bx boxCommon) AddChildText(t *Text) {
+func (bx *boxCommon) AddChildText(t *Text) {
 	bx.childTexts = append(bx.childTexts, t)
 }
 
@@ -88,9 +134,13 @@ func (bx *boxCommon) IncrementSize(logicalWidth, logicalHeight LogicalPos) {
 	bx.MarginRect.LogicalWidth += logicalWidth
 	bx.MarginRect.LogicalHeight += logicalHeight
 	parent := bx.Parent
-	if !util.IsNil(parent) {
+	if !util.IsNil(parent) && !bx.IsAbsolutelyPositioned {
 		w := logicalWidth
 		h := logicalHeight
+		if bx.MarginRect.WritingMode.IsOrthogonalTo(parent.BoxMarginRect().WritingMode) {
+			// Parent is in the orthogonal writing mode.
+			w, h = h, w
+		}
 		if !parent.IsWidthAuto() {
 			w = 0
 		}
@@ -107,30 +157,175 @@ func (bx *boxCommon) IncrementIfNeeded(minLogicalWidth, minLogicalHeight Logical
 	return wDiff, hDiff
 }
 
+func (bx *boxCommon) Translate(logicalX, logicalY LogicalPos) {
+	if logicalX == 0 && logicalY == 0 {
+		return
+	}
+	bx.MarginRect.LogicalX += logicalX
+	bx.MarginRect.LogicalY += logicalY
+	for _, child := range bx.childBoxes {
+		if child.BoxMarginRect().WritingMode != bx.MarginRect.WritingMode {
+			// Child is in the writing mode starting at the box, so it moves along with the box.
+			continue
+		}
+		child.Translate(logicalX, logicalY)
+	}
+	for _, child := range bx.childTexts {
+		child.Rect.LogicalX += logicalX
+		child.Rect.LogicalY += logicalY
+	}
+}
+
+// MakePaintNode paints the box as root of a stacking context.
+//
+// Spec: https://www.w3.org/TR/CSS2/zindex.html
 func (bx boxCommon) MakePaintNode() paint.Node {
+	sc := stackingContext{}
+	node := bx.makeBackgroundPaintNode()
+	contents := bx.makeContentPaintNodes(&sc)
+	node.Items = append(node.Items, sc.negativeNodes()...)
+	node.Items = append(node.Items, contents...)
+	node.Items = append(node.Items, sc.zeroOrAutoNodes()...)
+	node.Items = append(node.Items, sc.positiveNodes()...)
+	if opacity := boxOpacity(&bx); opacity < 1 {
+		// The whole stacking context is composited as a single group.
+		return paint.OpacityPaint{Items: []paint.Node{node}, Opacity: opacity}
+	}
+	return node
+}
+
+// makePaintNode paints the box and its descendants, except for ones that are
+// painted later in sc, the stacking context the box belongs to.
+func (bx boxCommon) makePaintNode(sc *stackingContext) paint.Node {
+	node := bx.makeBackgroundPaintNode()
+	node.Items = append(node.Items, bx.makeContentPaintNodes(sc)...)
+	return node
+}
+
+// makeBackgroundPaintNode paints background and borders of the box.
+func (bx boxCommon) makeBackgroundPaintNode() paint.BoxPaint {
 	var col color.Color
 	paintNodes := []paint.Node{}
-	if !util.IsNil(bx.Elem) {
+	borderRect := bx.BoxBorderRect().ToPhysicalRect().toImageRect()
+	colorRect := borderRect
+	if !util.IsNil(bx.Elem) && !bx.IsTableWrapper {
 		var color = csscolor.Transparent
 		styleSetSource := cssom.ComputedStyleSetSourceOf(bx.Elem)
 		styleSet := styleSetSource.ComputedStyleSet()
+		if bx.isHidden() {
+			// Invisible boxes still take up space, but their backgrounds and
+			// borders are not painted.
+			//
+			// TODO: visibility: collapse should remove table rows and columns
+			// as well.
+			return paint.BoxPaint{Items: paintNodes, Rect: borderRect}
+		}
 		if bx.Elem != nil {
 			color = styleSet.BackgroundColor()
 		}
 		col = color.ToStdColor(styleSetSource.CurrentColor())
+
+		currColor := styleSetSource.CurrentColor()
+		borderShape := bx.borderShape(styleSet)
+		paintNodes = append(paintNodes, bx.makeBoxShadowNodes(styleSet, currColor, borderShape, false)...)
+		// Background color is painted below the final layer, and clipped the
+		// same way.
+		clips := styleSet.BackgroundClip()
+		colorArea := clips[(len(styleSet.BackgroundImage())-1)%len(clips)]
+		colorRect = bx.backgroundAreaRect(colorArea).toImageRect()
+		if !borderShape.Radii.IsZero() {
+			paintNodes = append(paintNodes, paint.RoundedRectPaint{Shape: bx.areaShape(borderShape, colorArea), Color: col})
+			col = nil
+		}
+		paintNodes = append(paintNodes, bx.makeBackgroundLayerNodes(styleSet, currColor, borderShape)...)
+		paintNodes = append(paintNodes, bx.makeBoxShadowNodes(styleSet, currColor, borderShape, true)...)
+		border := paint.BorderPaint{
+			Rect:   borderRect,
+			Radii:  borderShape.Radii,
+			Top:    borderSide(bx.Border.Top, styleSet.BorderTopStyle(), styleSet.BorderTopColor().ToStdColor(currColor)),
+			Right:  borderSide(bx.Border.Right, styleSet.BorderRightStyle(), styleSet.BorderRightColor().ToStdColor(currColor)),
+			Bottom: borderSide(bx.Border.Bottom, styleSet.BorderBottomStyle(), styleSet.BorderBottomColor().ToStdColor(currColor)),
+			Left:   borderSide(bx.Border.Left, styleSet.BorderLeftStyle(), styleSet.BorderLeftColor().ToStdColor(currColor)),
+		}
+		if cb := bx.CollapsedBorders; cb != nil {
+			border.Top = borderSide(bx.Border.Top, cb.Top.Style, cb.Top.Color)
+			border.Right = borderSide(bx.Border.Right, cb.Right.Style, cb.Right.Color)
+			border.Bottom = borderSide(bx.Border.Bottom, cb.Bottom.Style, cb.Bottom.Color)
+			border.Left = borderSide(bx.Border.Left, cb.Left.Style, cb.Left.Color)
+		}
+		if border.Top.Width != 0 || border.Right.Width != 0 || border.Bottom.Width != 0 || border.Left.Width != 0 {
+			paintNodes = append(paintNodes, border)
+		}
+	}
+	return paint.BoxPaint{Items: paintNodes, Color: col, Rect: colorRect}
+}
+
+// makeContentPaintNodes paints contents of the box, except for ones that are
+// painted later in sc.
+func (bx boxCommon) makeContentPaintNodes(sc *stackingContext) []paint.Node {
+	paintNodes := []paint.Node{}
+	// Positioned descendants painted later in sc are clipped as well.
+	if bx.clipsContents() {
+		outerClip := sc.clip
+		sc.clip = func(nodes []paint.Node) []paint.Node {
+			nodes = bx.clipPaintNodes(nodes)
+			if outerClip != nil {
+				nodes = outerClip(nodes)
+			}
+			return nodes
+		}
+		defer func() { sc.clip = outerClip }()
 	}
 	for _, child := range bx.ChildBoxes() {
-		paintNodes = append(paintNodes, child.MakePaintNode())
+		if node := sc.addBox(child); node != nil {
+			paintNodes = append(paintNodes, node)
+		}
+	}
+	if bx.Image != nil && !bx.isHidden() {
+		paintNodes = append(paintNodes, paint.ImagePaint{
+			Image: bx.Image,
+			Rect:  bx.BoxContentRect().ToPhysicalRect().toImageRect(),
+		})
 	}
 	for _, child := range bx.ChildTexts() {
+		if child.IsHidden {
+			continue
+		}
 		paintNodes = append(paintNodes, child.MakePaintNode())
 	}
-	paddingPhysRect := bx.BoxPaddingRect().ToPhysicalRect()
-	contentRect := image.Rect(
-		int(paddingPhysRect.Left),
-		int(paddingPhysRect.Top),
-		int(paddingPhysRect.right()),
-		int(paddingPhysRect.bottom()),
-	)
-	return paint.BoxPaint{Items: paintNodes, Color: col, Rect: contentRect}
+	return bx.clipPaintNodes(paintNodes)
+}
+
+// isHidden reports whether the box is invisible due to visibility property.
+func (bx boxCommon) isHidden() bool {
+	if util.IsNil(bx.Elem) {
+		return false
+	}
+	return cssom.ComputedStyleSetSourceOf(bx.Elem).ComputedStyleSet().Visibility() != display.Visible
+}
+
+// borderSide returns border side with given width, style and color.
+func borderSide(width PhysicalPos, style backgrounds.LineStyle, col color.Color) gfx.BorderSide {
+	var borderStyle gfx.BorderStyle
+	switch style {
+	case backgrounds.NoLine, backgrounds.HiddenLine:
+		borderStyle = gfx.NoBorder
+	case backgrounds.DottedLine:
+		borderStyle = gfx.DottedBorder
+	case backgrounds.DashedLine:
+		borderStyle = gfx.DashedBorder
+	case backgrounds.SolidLine:
+		borderStyle = gfx.SolidBorder
+	case backgrounds.DoubleLine:
+		borderStyle = gfx.DoubleBorder
+	case backgrounds.GrovveLine:
+		borderStyle = gfx.GrooveBorder
+	case backgrounds.RidgeLine:
+		borderStyle = gfx.RidgeBorder
+	case backgrounds.InsetLine:
+		borderStyle = gfx.InsetBorder
+	case backgrounds.OutsetLine:
+		borderStyle = gfx.OutsetBorder
+	}
+	return gfx.BorderSide{Width: int(width), Style: borderStyle, Color: col}
 }
@@ -13,16 +13,22 @@ type BlockContainerBox struct {
 	boxCommon
 	Bfc              *BlockFormattingContext
 	Ifc              *InlineFormattingContext
+	Ffc              *FlexFormattingContext  // Non-nil if it's a flex container
+	Gfc              *GridFormattingContext  // Non-nil if it's a grid container
+	Tfc              *TableFormattingContext // Non-nil if it's a table box
 	ParentFctx       FormattingContext
 	ParentBcon       *BlockContainerBox
 	OwnsBfc          bool
 	OwnsIfc          bool
 	IsAnonymous      bool
 	IsInlineFlowRoot bool
+	IsOutsideMarker  bool // Outside ::marker box, which is placed outside of its list item
 
 	AccumulatedMarginLeft   PhysicalPos
+	AccumulatedBorderLeft   PhysicalPos
 	AccumulatedPaddingLeft  PhysicalPos
 	AccumulatedMarginRight  PhysicalPos
+	AccumulatedBorderRight  PhysicalPos
 	AccumulatedPaddingRight PhysicalPos
 }
 
@@ -34,11 +40,20 @@ func (bcon BlockContainerBox) String() string {
 	if bcon.OwnsIfc {
 		fcStr += "[IFC]"
 	}
+	if bcon.Ffc != nil {
+		fcStr += "[FFC]"
+	}
+	if bcon.Gfc != nil {
+		fcStr += "[GFC]"
+	}
+	if bcon.Tfc != nil {
+		fcStr += "[TFC]"
+	}
 	physMarginRect := bcon.MarginRect.ToPhysicalRect()
-	leftStr := fmt.Sprintf("%g+%g+%g", physMarginRect.Left, bcon.Margin.Left, bcon.Padding.Left)
-	topStr := fmt.Sprintf("%g+%g+%g", physMarginRect.Top, bcon.Margin.Top, bcon.Padding.Top)
-	rightStr := fmt.Sprintf("%g-%g-%g", physMarginRect.right(), bcon.Margin.Right, bcon.Padding.Right)
-	bottomStr := fmt.Sprintf("%g-%g-%g", physMarginRect.bottom(), bcon.Margin.Bottom, bcon.Padding.Bottom)
+	leftStr := fmt.Sprintf("%g+%g+%g+%g", physMarginRect.Left, bcon.Margin.Left, bcon.Border.Left, bcon.Padding.Left)
+	topStr := fmt.Sprintf("%g+%g+%g+%g", physMarginRect.Top, bcon.Margin.Top, bcon.Border.Top, bcon.Padding.Top)
+	rightStr := fmt.Sprintf("%g-%g-%g-%g", physMarginRect.right(), bcon.Margin.Right, bcon.Border.Right, bcon.Padding.Right)
+	bottomStr := fmt.Sprintf("%g-%g-%g-%g", physMarginRect.bottom(), bcon.Margin.Bottom, bcon.Border.Bottom, bcon.Padding.Bottom)
 	return fmt.Sprintf(
 		"block-container [elem %v] at [LTRB %s %s %s %s (%gx%g)] %s",
 		bcon.Elem, leftStr, topStr, rightStr, bottomStr, physMarginRect.Width, physMarginRect.Height, fcStr)
@@ -16,10 +16,10 @@ type InlineBox struct {
 
 func (bx InlineBox) String() string {
 	physMarginRect := bx.MarginRect.ToPhysicalRect()
-	leftStr := fmt.Sprintf("%g+%g+%g", physMarginRect.Left, bx.Margin.Left, bx.Padding.Left)
-	topStr := fmt.Sprintf("%g+%g+%g", physMarginRect.Top, bx.Margin.Top, bx.Padding.Top)
-	rightStr := fmt.Sprintf("%g-%g-%g", physMarginRect.right(), bx.Margin.Right, bx.Padding.Right)
-	bottomStr := fmt.Sprintf("%g-%g-%g", physMarginRect.bottom(), bx.Margin.Bottom, bx.Padding.Bottom)
+	leftStr := fmt.Sprintf("%g+%g+%g+%g", physMarginRect.Left, bx.Margin.Left, bx.Border.Left, bx.Padding.Left)
+	topStr := fmt.Sprintf("%g+%g+%g+%g", physMarginRect.Top, bx.Margin.Top, bx.Border.Top, bx.Padding.Top)
+	rightStr := fmt.Sprintf("%g-%g-%g-%g", physMarginRect.right(), bx.Margin.Right, bx.Border.Right, bx.Padding.Right)
+	bottomStr := fmt.Sprintf("%g-%g-%g-%g", physMarginRect.bottom(), bx.Margin.Bottom, bx.Border.Bottom, bx.Padding.Bottom)
 	return fmt.Sprintf(
 		"inline-box [elem %v] at [LTRB %s %s %s %s (%gx%g)]",
 		bx.Elem, leftStr, topStr, rightStr, bottomStr, physMarginRect.Width, physMarginRect.Height)
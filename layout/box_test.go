@@ -0,0 +1,34 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package layout
+
+import (
+	"testing"
+
+	"github.com/inseo-oh/yw/gfx/paint"
+)
+
+func TestAddChildren(t *testing.T) {
+	bcon := &BlockContainerBox{}
+	child := &InlineBox{}
+	txt := &Text{Text: "abc"}
+	bcon.AddChildBox(child)
+	bcon.AddChildText(txt)
+
+	if got := bcon.ChildBoxes(); len(got) != 1 || got[0] != child {
+		t.Errorf("expected child boxes [%v], got %v", child, got)
+	}
+	if got := bcon.ChildTexts(); len(got) != 1 || got[0] != txt {
+		t.Errorf("expected child texts [%v], got %v", txt, got)
+	}
+	// Children are painted on top of the box.
+	node, ok := bcon.MakePaintNode().(paint.BoxPaint)
+	if !ok {
+		t.Fatalf("expected box paint, got %T", bcon.MakePaintNode())
+	}
+	if len(node.Items) != 2 {
+		t.Errorf("expected 2 child paint nodes, got %v", node.Items)
+	}
+}
@@ -0,0 +1,78 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"bytes"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"log"
+	"net/url"
+
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/images"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/html/fetch"
+	"github.com/inseo-oh/yw/layout"
+	"github.com/inseo-oh/yw/util"
+)
+
+// backgroundOf returns resources needed to paint background images of elem.
+// url() images are fetched relative to the document's base URL.
+func backgroundOf(elem dom.Element) layout.Background {
+	if util.IsNil(elem) {
+		return layout.Background{}
+	}
+	styleSetSrc := cssom.ComputedStyleSetSourceOf(elem)
+	res := layout.Background{FontSize: fontSizeOf(styleSetSrc)}
+	for _, img := range styleSetSrc.ComputedStyleSet().BackgroundImage() {
+		var decoded image.Image
+		if img, ok := img.(images.URLImage); ok {
+			decoded = fetchBackgroundImage(elem.NodeDocument(), img.URL)
+		}
+		res.Images = append(res.Images, decoded)
+	}
+	return res
+}
+
+// fetchBackgroundImage fetches and decodes image at rawURL, which is relative
+// to the base URL of doc. Returns nil if it fails.
+//
+// Decoded images are kept in the list of available images of doc, so each
+// image is only fetched once. Images that failed to load are kept as nil, so
+// these aren't fetched again either.
+func fetchBackgroundImage(doc dom.Document, rawURL string) image.Image {
+	ref, err := url.Parse(rawURL)
+	if err != nil {
+		log.Printf("background-image %s: %v", rawURL, err)
+		return nil
+	}
+	baseURL := doc.BaseURL()
+	u := baseURL.ResolveReference(ref)
+	if img, ok := doc.AvailableImage(u.String()); ok {
+		return img
+	}
+	img := decodeBackgroundImage(u)
+	doc.AddAvailableImage(u.String(), img)
+	return img
+}
+
+// decodeBackgroundImage fetches and decodes image at u. Returns nil if it
+// fails.
+func decodeBackgroundImage(u *url.URL) image.Image {
+	data, err := fetch.FetchBytes(*u)
+	if err != nil {
+		log.Printf("background-image %s: %v", u, err)
+		return nil
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		log.Printf("background-image %s: failed to decode image: %v", u, err)
+		return nil
+	}
+	return img
+}
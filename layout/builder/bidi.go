@@ -0,0 +1,284 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"strings"
+
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/css/writingmodes"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/layout"
+	"github.com/inseo-oh/yw/text/bidi"
+	"github.com/inseo-oh/yw/util"
+)
+
+// bidiState holds inline contents of inline formatting contexts being laid
+// out, so that these can be reordered and aligned once all lines are made.
+type bidiState struct {
+	paragraphs map[*layout.InlineFormattingContext]*bidiParagraph
+}
+
+// bidiParagraph is inline contents of a block container, which forms a
+// paragraph for the bidirectional algorithm.
+type bidiParagraph struct {
+	text      strings.Builder // Text, including bidi control characters for inline boxes
+	fragments []bidiFragment  // Fragments placed on lines, in logical order
+}
+
+// bidiFragment is a part of text placed on a line, an atomic inline, or spaces
+// without text node.
+type bidiFragment struct {
+	text       *layout.Text // nil for spaces and atomic inlines
+	box        layout.Box   // Atomic inline, or nil
+	owner      layout.Box   // Box containing the text node or the atomic inline
+	lineIdx    int
+	start, end int // Byte offsets in the paragraph text
+	left       layout.LogicalPos
+	width      layout.LogicalPos
+}
+
+// bidiParagraphOf returns the paragraph of ifc, creating one if needed.
+func (tb treeBuilder) bidiParagraphOf(ifc *layout.InlineFormattingContext) *bidiParagraph {
+	para, ok := tb.bidi.paragraphs[ifc]
+	if !ok {
+		para = &bidiParagraph{}
+		tb.bidi.paragraphs[ifc] = para
+	}
+	return para
+}
+
+// appendBidiText appends s to the paragraph of ifc, and returns offset of it.
+func (tb treeBuilder) appendBidiText(ifc *layout.InlineFormattingContext, s string) int {
+	para := tb.bidiParagraphOf(ifc)
+	offset := para.text.Len()
+	para.text.WriteString(s)
+	return offset
+}
+
+// addBidiFragment records a fragment placed on the current line of ifc.
+// If text has trailing spaces, these are recorded as a separate fragment.
+func (tb treeBuilder) addBidiFragment(ifc *layout.InlineFormattingContext, owner layout.Box, txt *layout.Text, start, end int, left, width layout.LogicalPos) {
+	para := tb.bidiParagraphOf(ifc)
+	lineIdx := len(ifc.LineBoxes) - 1
+	if txt != nil && start+len(txt.Text) != end {
+		textEnd := start + len(txt.Text)
+		textWidth := layout.LogicalPos(gfx.MeasureOrientedText(txt.Font, txt.Text, txt.Orientation))
+		para.fragments = append(para.fragments,
+			bidiFragment{txt, nil, owner, lineIdx, start, textEnd, left, textWidth},
+			bidiFragment{nil, nil, owner, lineIdx, textEnd, end, left + textWidth, max(width-textWidth, 0)},
+		)
+		return
+	}
+	para.fragments = append(para.fragments, bidiFragment{txt, nil, owner, lineIdx, start, end, left, width})
+}
+
+// addAtomicInlineFragment records bx, an atomic inline placed on the current
+// line of ifc. Like other browsers, it's treated as U+FFFC OBJECT REPLACEMENT
+// CHARACTER in the paragraph.
+//
+// Spec: https://www.w3.org/TR/css-writing-modes-3/#bidi-atomic-inline
+func (tb treeBuilder) addAtomicInlineFragment(ifc *layout.InlineFormattingContext, owner layout.Box, bx layout.Box) {
+	start := tb.appendBidiText(ifc, "\uFFFC")
+	para := tb.bidiParagraphOf(ifc)
+	rect := bx.BoxMarginRect()
+	para.fragments = append(para.fragments, bidiFragment{nil, bx, owner, len(ifc.LineBoxes) - 1, start, para.text.Len(), rect.LogicalX, rect.LogicalWidth})
+}
+
+// bidiControlsOf returns bidi control characters inserted before and after
+// contents of a box, to apply unicode-bidi and direction of it. isBlockContainer
+// should be set if the box is a block container, where only overrides are
+// applied this way.
+//
+// Spec: https://www.w3.org/TR/css-writing-modes-3/#bidi-control-codes-injection-table
+func bidiControlsOf(styleSet *props.ComputedStyleSet, isBlockContainer bool) (before, after string) {
+	isRtl := styleSet.Direction() == writingmodes.Rtl
+	pick := func(ltr, rtl rune) string {
+		if isRtl {
+			return string(rtl)
+		}
+		return string(ltr)
+	}
+	embed := pick(bidi.LreChar, bidi.RleChar)
+	isolate := pick(bidi.LriChar, bidi.RliChar)
+	override := pick(bidi.LroChar, bidi.RloChar)
+	switch styleSet.UnicodeBidi() {
+	case writingmodes.Embed:
+		if !isBlockContainer {
+			return embed, string(bidi.PdfChar)
+		}
+	case writingmodes.Isolate:
+		if !isBlockContainer {
+			return isolate, string(bidi.PdiChar)
+		}
+	case writingmodes.BidiOverride:
+		return override, string(bidi.PdfChar)
+	case writingmodes.IsolateOverride:
+		if isBlockContainer {
+			return override, string(bidi.PdfChar)
+		}
+		return isolate + override, string(bidi.PdfChar) + string(bidi.PdiChar)
+	case writingmodes.Plaintext:
+		if !isBlockContainer {
+			return string(bidi.FsiChar), string(bidi.PdiChar)
+		}
+	}
+	return "", ""
+}
+
+// bidiControlsOfBox returns bidi control characters for an inline box of elem,
+// or the root inline box of parentBcon if elem is nil.
+func bidiControlsOfBox(parentBcon *layout.BlockContainerBox, elem dom.Element) (before, after string) {
+	if !util.IsNil(elem) {
+		return bidiControlsOf(cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet(), false)
+	}
+	if elem := closestDomElementForBox(parentBcon); !util.IsNil(elem) {
+		return bidiControlsOf(cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet(), true)
+	}
+	return "", ""
+}
+
+// reorderBidiText reorders fragments on each line of para, bcon's inline
+// contents, by applying the bidirectional algorithm. Fragments of para are
+// replaced with ones in visual order, and inline boxes under root are moved
+// to where their contents are.
+//
+// Spec: https://www.w3.org/TR/css-writing-modes-3/#text-direction
+func (tb treeBuilder) reorderBidiText(bcon *layout.BlockContainerBox, root *layout.InlineBox, para *bidiParagraph) {
+	if len(para.fragments) == 0 {
+		return
+	}
+	baseLevel := bidi.Level(0)
+	if elem := closestDomElementForBox(bcon); !util.IsNil(elem) {
+		styleSet := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet()
+		if styleSet.UnicodeBidi() == writingmodes.Plaintext {
+			baseLevel = bidi.AutoLevel
+		} else if styleSet.Direction() == writingmodes.Rtl {
+			baseLevel = 1
+		}
+	}
+	bp := bidi.NewParagraph(para.text.String(), baseLevel)
+	if bp.IsLtrOnly() {
+		return
+	}
+	reordered := []bidiFragment{}
+	for first := 0; first < len(para.fragments); {
+		last := first
+		for last < len(para.fragments) && para.fragments[last].lineIdx == para.fragments[first].lineIdx {
+			last++
+		}
+		reordered = append(reordered, reorderBidiLine(bp, para.fragments[first:last])...)
+		first = last
+	}
+	para.fragments = reordered
+	placeReorderedInlineBoxes(para.fragments, inlineParentsOf(root))
+}
+
+// placeReorderedInlineBoxes moves each inline box, so that it covers its
+// contents on the first line it appears, after these were reordered. Size is
+// also updated for boxes that are on a single line.
+//
+// TODO: Contents of an inline box can be split into multiple parts by
+// reordering, and each part should have its own fragment. Currently the box
+// covers all of them.
+//
+// Spec: https://www.w3.org/TR/css-writing-modes-3/#bidi-box-model
+func placeReorderedInlineBoxes(frags []bidiFragment, parents map[layout.Box]layout.Box) {
+	type span struct {
+		lineIdx      int
+		left, right  layout.LogicalPos
+		isSingleLine bool
+	}
+	spans := map[*layout.InlineBox]*span{}
+	for _, frag := range frags {
+		for bx := frag.owner; !util.IsNil(bx); bx = parents[bx] {
+			ibox, ok := bx.(*layout.InlineBox)
+			if !ok || util.IsNil(ibox.Elem) {
+				continue
+			}
+			sp, ok := spans[ibox]
+			if !ok {
+				spans[ibox] = &span{frag.lineIdx, frag.left, frag.left + frag.width, true}
+			} else if sp.lineIdx != frag.lineIdx {
+				sp.isSingleLine = false
+			} else {
+				sp.left, sp.right = min(sp.left, frag.left), max(sp.right, frag.left+frag.width)
+			}
+		}
+	}
+	for ibox, sp := range spans {
+		ibox.MarginRect.LogicalX = sp.left
+		if sp.isSingleLine {
+			ibox.MarginRect.LogicalWidth = sp.right - sp.left
+		}
+	}
+}
+
+// reorderBidiLine splits fragments on a line into runs having the same
+// embedding level, places these in visual order, and returns them in that order.
+//
+// Spec: https://www.unicode.org/reports/tr9/#Reordering_Resolved_Levels
+func reorderBidiLine(bp *bidi.Paragraph, frags []bidiFragment) []bidiFragment {
+	lineStart, lineEnd := frags[0].start, frags[len(frags)-1].end
+	levels := bp.LineLevels(lineStart, lineEnd)
+	levelAt := func(offset int) bidi.Level { return levels[offset-lineStart] }
+
+	runs := []bidiFragment{}
+	runLevels := []bidi.Level{}
+	lineLeft := frags[0].left
+	for _, frag := range frags {
+		lineLeft = min(lineLeft, frag.left)
+		if frag.text == nil {
+			runs = append(runs, frag)
+			runLevels = append(runLevels, levelAt(frag.start))
+			continue
+		}
+		// Split the text where the level changes. The original text node is
+		// used for the first run.
+		str := frag.text.Text
+		frag.text.Font.SetTextSize(int(frag.text.FontSize))
+		runStart := 0
+		for i := 1; i <= len(str); i++ {
+			if i != len(str) && levelAt(frag.start+i) == levelAt(frag.start+runStart) {
+				continue
+			}
+			run := frag
+			if runStart != 0 {
+				txt := *frag.text
+				run.text = &txt
+				frag.owner.AddChildText(run.text)
+			}
+			run.text.Text = str[runStart:i]
+			run.start, run.end = frag.start+runStart, frag.start+i
+			if runStart != 0 || i != len(str) {
+				run.width = layout.LogicalPos(gfx.MeasureOrientedText(run.text.Font, run.text.Text, run.text.Orientation))
+			}
+			runs = append(runs, run)
+			runLevels = append(runLevels, levelAt(run.start))
+			runStart = i
+		}
+	}
+
+	left := lineLeft
+	visualRuns := make([]bidiFragment, 0, len(runs))
+	for _, idx := range bidi.VisualOrder(runLevels) {
+		run := runs[idx]
+		if run.text != nil {
+			run.text.Rect.LogicalX = left
+			run.text.Rect.LogicalWidth = run.width
+			if runLevels[idx].IsRtl() {
+				run.text.Text = bidi.ReverseText(run.text.Text)
+			}
+		} else if run.box != nil {
+			run.box.Translate(left-run.box.BoxMarginRect().LogicalX, 0)
+		}
+		run.left = left
+		visualRuns = append(visualRuns, run)
+		left += run.width
+	}
+	return visualRuns
+}
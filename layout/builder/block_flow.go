@@ -0,0 +1,183 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"strings"
+
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/display"
+	"github.com/inseo-oh/yw/css/float"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/layout"
+	"github.com/inseo-oh/yw/util"
+)
+
+// establishesBfc reports whether elem establishes a new BFC for its contents,
+// even if it's a block container inside another BFC.
+//
+// https://www.w3.org/TR/CSS2/visuren.html#block-formatting
+func establishesBfc(elem dom.Element) bool {
+	if util.IsNil(elem) {
+		return false
+	}
+	// The root element always establishes an independent formatting context,
+	// and its margins don't collapse.
+	// https://www.w3.org/TR/css-display-3/#root
+	if _, ok := elem.Parent().(dom.Document); ok {
+		return true
+	}
+	styleSet := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet()
+	if parent, ok := elem.Parent().(dom.Element); ok {
+		// Boxes whose writing mode differs from their parent's establish
+		// independent formatting contexts.
+		// https://www.w3.org/TR/css-writing-modes-3/#block-flow
+		if styleSet.WritingMode() != cssom.ComputedStyleSetSourceOf(parent).ComputedStyleSet().WritingMode() {
+			return true
+		}
+	}
+	if x, _ := usedOverflowOf(elem); x.IsScrollable() {
+		// Scroll containers establish independent formatting contexts.
+		// https://www.w3.org/TR/css-overflow-3/#scroll-container
+		return true
+	}
+	return styleSet.Float() != float.None || isIndependentInnerMode(styleSet.Display().InnerMode) ||
+		styleSet.Position().IsAbsolutelyPositioned()
+}
+
+// isIndependentInnerMode reports whether boxes with inner display mode m always
+// establish an independent formatting context for their contents. Flex and
+// grid containers establish FFC and GFC instead, but they are the same as BFC
+// roots from the outside. Same goes for table wrapper boxes, which contain
+// table boxes establishing TFC.
+//
+// https://www.w3.org/TR/css-display-3/#independent-formatting-context
+func isIndependentInnerMode(m display.InnerMode) bool {
+	return m == display.FlowRoot || m == display.Flex || m == display.Grid || m == display.Table
+}
+
+// isOutOfFlow reports whether elem is a floating or absolutely positioned box.
+func isOutOfFlow(elem dom.Element) bool {
+	if util.IsNil(elem) {
+		return false
+	}
+	styleSet := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet()
+	return styleSet.Float() != float.None || styleSet.Position().IsAbsolutelyPositioned()
+}
+
+// isInBlockFlow reports whether block container for elem is a block-level box
+// participating in parentFctx, which is a BFC.
+func isInBlockFlow(parentFctx layout.FormattingContext, elem dom.Element, isInlineFlowRoot bool) bool {
+	_, inParentBfc := parentFctx.(*layout.BlockFormattingContext)
+	return inParentBfc && !isInlineFlowRoot && !isOutOfFlow(elem)
+}
+
+// hasInlineContent reports whether laying out nodes as inline contents
+// generates anything that separates margins around them (e.g. texts).
+func hasInlineContent(nodes []dom.Node) bool {
+	for _, node := range nodes {
+		if txt, ok := node.(dom.CharacterData); ok {
+			if txt.CharacterDataType() == dom.TextCharacterData && strings.TrimSpace(txt.Text()) != "" {
+				return true
+			}
+		} else if elem, ok := node.(dom.Element); ok {
+			if cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Display().Mode != display.DisplayNone && !isAbsolutelyPositioned(elem) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// enterBlockFlow places top edges of bcon, a block-level box participating in bfc.
+// This must be called before laying out its children.
+//
+// Top margin of the box may collapse with other margins adjoining it, so the
+// box's vertical position is determined when something that separates margins
+// is placed (i.e. its top border or padding, its line boxes, or its first child
+// that does).
+//
+// https://www.w3.org/TR/CSS2/box.html#collapsing-margins
+func enterBlockFlow(bfc *layout.BlockFormattingContext, bcon *layout.BlockContainerBox) {
+	wm := bcon.MarginRect.WritingMode
+	bfc.AddMargin(layout.LogicalPos(wm.LogicalEdges(bcon.Margin).BlockStart))
+	bfc.AddPendingBox(bcon)
+	if bcon.OwnsBfc {
+		// Margins of BFC roots don't collapse with their children.
+		bfc.ApplyMargins()
+	}
+	bfc.IncrementNaturalPos(layout.LogicalPos(wm.LogicalEdges(bcon.Border).BlockStart + wm.LogicalEdges(bcon.Padding).BlockStart))
+}
+
+// exitBlockFlow places bottom edges of bcon, a block-level box participating
+// in bfc, after its children were laid out. If the box's height is auto, it's
+// also calculated here.
+//
+// https://www.w3.org/TR/CSS2/box.html#collapsing-margins
+// https://www.w3.org/TR/CSS2/visudet.html#normal-block
+func exitBlockFlow(bfc *layout.BlockFormattingContext, bcon *layout.BlockContainerBox, heightRange sizeRange) {
+	wm := bcon.MarginRect.WritingMode
+	bottomEdges := wm.LogicalEdges(bcon.Border).BlockEnd + wm.LogicalEdges(bcon.Padding).BlockEnd
+	bottomMargin := wm.LogicalEdges(bcon.Margin).BlockEnd
+	baseLogicalY := bfc.ContextOwnerBox().BoxContentRect().LogicalY
+	isPending := bfc.IsPendingBox(bcon)
+
+	// Bottom margin of the box adjoins bottom margin of its last child, unless
+	// there's something between them, or the height is not auto.
+	adjoinsLastChild := bcon.IsHeightAuto() && bottomEdges == 0 && !bcon.OwnsBfc
+	if bcon.IsHeightAuto() {
+		if bcon.OwnsBfc {
+			resolveBfcRootHeight(bcon, heightRange)
+		} else {
+			if bottomEdges != 0 {
+				// Last child's bottom margin is inside of the box.
+				bfc.ApplyMargins()
+				isPending = false
+			}
+			var contentHeight layout.PhysicalPos
+			if !isPending {
+				contentHeight = layout.PhysicalPos(baseLogicalY + bfc.CurrentNaturalPos - bcon.BoxContentRect().LogicalY)
+			}
+			usedHeight := heightRange.clamp(contentHeight)
+			if usedHeight != contentHeight {
+				adjoinsLastChild = false
+			}
+			bcon.IncrementSize(0, layout.LogicalPos(usedHeight)-bcon.LogicalHeight())
+		}
+	}
+	if isPending && bcon.LogicalHeight() == 0 && bottomEdges == 0 {
+		// Nothing separates top and bottom margins of the box, so margins
+		// collapse through it.
+		bfc.CollapseThrough(bcon)
+		bfc.AddMargin(layout.LogicalPos(bottomMargin))
+		return
+	}
+	if !adjoinsLastChild {
+		// Margins inside of the box are no longer adjoining anything that follows.
+		bfc.ApplyMargins()
+		borderRect := bcon.BoxBorderRect()
+		bfc.CurrentNaturalPos = borderRect.LogicalY + borderRect.LogicalHeight - baseLogicalY
+	}
+	bfc.AddMargin(layout.LogicalPos(bottomMargin))
+}
+
+// resolveBfcRootHeight calculates auto height of bcon, which establishes its own BFC.
+//
+// https://www.w3.org/TR/CSS2/visudet.html#root-height
+func resolveBfcRootHeight(bcon *layout.BlockContainerBox, heightRange sizeRange) {
+	var contentHeight layout.PhysicalPos
+	if bcon.Ffc != nil {
+		// Flex containers place their contents in FFC instead.
+		contentHeight = heightRange.clamp(layout.PhysicalPos(bcon.Ffc.NaturalPos()))
+	} else if bcon.Gfc != nil {
+		// Same for grid containers.
+		contentHeight = heightRange.clamp(layout.PhysicalPos(bcon.Gfc.NaturalPos()))
+	} else {
+		// Margins of children are inside of the BFC root.
+		bcon.Bfc.ApplyMargins()
+		contentHeight = heightRange.clamp(layout.PhysicalPos(bcon.Bfc.CurrentNaturalPos))
+	}
+	bcon.IncrementSize(0, layout.LogicalPos(contentHeight)-bcon.LogicalHeight())
+}
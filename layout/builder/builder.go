@@ -11,36 +11,67 @@ import (
 	"strings"
 
 	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/backgrounds"
+	"github.com/inseo-oh/yw/css/box"
 	"github.com/inseo-oh/yw/css/cssom"
 	"github.com/inseo-oh/yw/css/display"
 	"github.com/inseo-oh/yw/css/float"
 	"github.com/inseo-oh/yw/css/fonts"
 	"github.com/inseo-oh/yw/css/props"
-	"github.com/inseo-oh/yw/css/sizing"
+	"github.com/inseo-oh/yw/css/selector"
+	"github.com/inseo-oh/yw/css/text"
 	"github.com/inseo-oh/yw/css/textdecor"
+	"github.com/inseo-oh/yw/css/values"
+	"github.com/inseo-oh/yw/css/writingmodes"
 	"github.com/inseo-oh/yw/dom"
 	"github.com/inseo-oh/yw/gfx"
 	"github.com/inseo-oh/yw/layout"
 	"github.com/inseo-oh/yw/platform"
+	"github.com/inseo-oh/yw/platform/fontdb"
 	"github.com/inseo-oh/yw/util"
 )
 
 // BuildLayout builds the layout starting from the DOM node root.
-func BuildLayout(root dom.Element, viewportWidth, viewportHeight float64, fontProvider platform.FontProvider) layout.Box {
+//
+// Fonts are selected from fontDB, and opened using fontProvider.
+func BuildLayout(root dom.Element, viewportWidth, viewportHeight float64, fontProvider platform.FontProvider, fontDB *fontdb.Database) layout.Box {
 	// https://www.w3.org/TR/css-display-3/#initial-containing-block
 	tb := treeBuilder{}
-	tb.font = fontProvider.OpenFont("this_is_not_real_filename.ttf")
-	tb.font.SetTextSize(32)
+	tb.gc = &generatedContentState{
+		pseudoElems: map[dom.Element]selector.PseudoElementRef{},
+		counters:    resolveCounters(root),
+	}
+	tb.pos = &positioningState{
+		pending:  map[layout.Box][]pendingPositionedBox{},
+		viewport: layout.PhysicalRect{Left: 0, Top: 0, Width: layout.PhysicalPos(viewportWidth), Height: layout.PhysicalPos(viewportHeight)},
+	}
+	tb.bidi = &bidiState{
+		paragraphs: map[*layout.InlineFormattingContext]*bidiParagraph{},
+	}
+	tb.lines = &lineAlignState{}
+	tb.principalWritingMode = principalWritingModeOf(root)
+	tb.fonts = &fontState{
+		provider:  fontProvider,
+		db:        fontDB,
+		opened:    map[fontKey]gfx.Font{},
+		fallbacks: map[string][]fontdb.Face{},
+	}
+	tb.textFonts = tb.fontChainOf(cssom.ComputedStyleSetSourceOf(root))
 	boxRect := layout.LogicalRect{
 		LogicalX:      0,
 		LogicalY:      0,
 		LogicalWidth:  layout.LogicalPos(viewportWidth),
 		LogicalHeight: layout.LogicalPos(viewportHeight),
 	}
+	// In vertical writing modes, contents overflow the ICB towards the block-end
+	// side(e.g. left side for vertical-rl) instead of growing it.
+	icbAuto := !tb.principalWritingMode.IsVertical()
 	icb := tb.newBlockContainer(
-		nil, nil, nil, nil, nil, boxRect, layout.PhysicalEdges{}, layout.PhysicalEdges{},
-		true, true, false, []dom.Node{root}, []gfx.TextDecorOptions{},
+		nil, nil, nil, nil, nil, boxRect, layout.PhysicalEdges{}, layout.PhysicalEdges{}, layout.PhysicalEdges{},
+		icbAuto, icbAuto, false, []dom.Node{root}, []gfx.TextDecorOptions{},
 	)
+	tb.placePositionedBoxes(icb)
+	tb.alignPendingLines()
 	return icb
 }
 
@@ -49,30 +80,46 @@ var (
 	spacesAndTabsBeforeSegmentBreak = regexp.MustCompile(" +\n")
 	multipleSegmentBreaks           = regexp.MustCompile("\n+")
 	multipleSpaces                  = regexp.MustCompile(" +")
+	allWhiteSpaces                  = regexp.MustCompile("[ \t\n]+")
 )
 
 // https://www.w3.org/TR/css-text-3/#white-space-phase-1
-func applyWhitespaceCollapsing(str string, ifc *layout.InlineFormattingContext) string {
-	// TODO: Add support for white-space: pre, white-space:pre-wrap, or white-space: break-spaces
+func applyWhitespaceCollapsing(str string, ifc *layout.InlineFormattingContext, collapse text.WhiteSpaceCollapse) string {
+	switch {
+	case collapse == text.DiscardWhiteSpace:
+		//======================================================================
+		// Remove all white spaces.
+		// "foo \n bar" -> "foobar"
+		//======================================================================
+		return allWhiteSpaces.ReplaceAllLiteralString(str, "")
+	case collapse.PreservesSpaces():
+		//======================================================================
+		// Spaces and tabs are kept as-is, and segment breaks become spaces
+		// unless these are preserved as well.
+		// "foo\nbar" -> "foo bar" (white-space-collapse: preserve-spaces)
+		//======================================================================
+		if !collapse.PreservesBreaks() {
+			str = strings.ReplaceAll(str, "\n", " ")
+		}
+		return str
+	}
 
 	//==========================================================================
 	// Ignore collapsible spaces and tabs immediately following/preceding segment break.
 	//==========================================================================
 	// "foo   \n   bar" --> "foo\nbar"
+	str = strings.ReplaceAll(str, "\t", " ")
 	str = spacesAndTabsAfterSegmentBreak.ReplaceAllLiteralString(str, "\n")
 	str = spacesAndTabsBeforeSegmentBreak.ReplaceAllLiteralString(str, "\n")
 
 	//==========================================================================
-	// Transform segment breaks according to segment break transform rules.
-	// "foo\n\nbar" -> "foo\nbar"
+	// Transform segment breaks according to segment break transform rules,
+	// unless these are preserved.
+	// "foo\n\nbar" -> "foo bar"
 	//==========================================================================
-	str = applySegmentBreakTransform(str)
-
-	//==========================================================================
-	// Replace tabs with spaces.
-	// "foo\t\tbar" -> "foo  bar"
-	//==========================================================================
-	str = strings.ReplaceAll(str, "\t", " ")
+	if !collapse.PreservesBreaks() {
+		str = applySegmentBreakTransform(str)
+	}
 
 	//==========================================================================
 	// Ignore any space following the another, including the ones outside of
@@ -82,7 +129,7 @@ func applyWhitespaceCollapsing(str string, ifc *layout.InlineFormattingContext)
 	// TODO: CSS says these extra sapces don't have zero-advance width, and thus invisible,
 	// but still retains its soft wrap opportunity, if any.
 	//==========================================================================
-	if strings.HasSuffix(ifc.WrittenText, " ") {
+	if strings.HasSuffix(ifc.WrittenText, " ") || (collapse.PreservesBreaks() && strings.HasSuffix(ifc.WrittenText, "\n")) {
 		str = strings.TrimLeft(str, " ")
 	}
 	str = multipleSpaces.ReplaceAllLiteralString(str, " ")
@@ -205,24 +252,42 @@ func elementTextDecoration(elem dom.Element, textDecors []gfx.TextDecorOptions)
 
 	return textDecors
 }
-func elementMarginAndPadding(elem dom.Element, boxParent layout.Box) (margin, padding layout.PhysicalEdges) {
+func elementBoxEdges(elem dom.Element, boxParent layout.Box) (margin, border, padding layout.PhysicalEdges) {
 	styleSetSrc := cssom.ComputedStyleSetSourceOf(elem)
 	styleSet := styleSetSrc.ComputedStyleSet()
 
-	if styleSet.MarginTop().IsAuto() || styleSet.MarginBottom().IsAuto() {
-		panic("TODO: Support auto margin")
-	}
-	if styleSet.MarginLeft().IsAuto() || styleSet.MarginRight().IsAuto() {
-		panic("TODO: Support auto margin")
-	}
-
 	parentLogicalWidth := func() css.Num { return css.NumFromFloat(float64(boxParent.LogicalWidth())) }
 	fontSize := func() css.Num { return css.NumFromFloat(fontSizeOf(styleSetSrc)) }
+	// Auto margins are 0 here. Absolutely positioned boxes resolve them later
+	// against their containing block(See resolveAutoMargins).
+	//
+	// TODO: Center block-level boxes with auto margins in normal flow
+	// https://www.w3.org/TR/CSS2/visudet.html#blockwidth
+	marginWidth := func(m box.Margin) layout.PhysicalPos {
+		if m.IsAuto() {
+			return 0
+		}
+		return layout.PhysicalPos(m.Value.AsLength(parentLogicalWidth).ToPx(fontSize))
+	}
 	margin = layout.PhysicalEdges{
-		Top:    layout.PhysicalPos(styleSet.MarginTop().Value.AsLength(parentLogicalWidth).ToPx(fontSize)),
-		Right:  layout.PhysicalPos(styleSet.MarginRight().Value.AsLength(parentLogicalWidth).ToPx(fontSize)),
-		Bottom: layout.PhysicalPos(styleSet.MarginBottom().Value.AsLength(parentLogicalWidth).ToPx(fontSize)),
-		Left:   layout.PhysicalPos(styleSet.MarginLeft().Value.AsLength(parentLogicalWidth).ToPx(fontSize)),
+		Top:    marginWidth(styleSet.MarginTop()),
+		Right:  marginWidth(styleSet.MarginRight()),
+		Bottom: marginWidth(styleSet.MarginBottom()),
+		Left:   marginWidth(styleSet.MarginLeft()),
+	}
+	// Border width is zero if border style is none or hidden.
+	// https://www.w3.org/TR/css-backgrounds-3/#border-width
+	borderWidth := func(width values.Length, style backgrounds.LineStyle) layout.PhysicalPos {
+		if style == backgrounds.NoLine || style == backgrounds.HiddenLine {
+			return 0
+		}
+		return layout.PhysicalPos(width.ToPx(fontSize))
+	}
+	border = layout.PhysicalEdges{
+		Top:    borderWidth(styleSet.BorderTopWidth(), styleSet.BorderTopStyle()),
+		Right:  borderWidth(styleSet.BorderRightWidth(), styleSet.BorderRightStyle()),
+		Bottom: borderWidth(styleSet.BorderBottomWidth(), styleSet.BorderBottomStyle()),
+		Left:   borderWidth(styleSet.BorderLeftWidth(), styleSet.BorderLeftStyle()),
 	}
 	padding = layout.PhysicalEdges{
 		Top:    layout.PhysicalPos(styleSet.PaddingTop().AsLength(parentLogicalWidth).ToPx(fontSize)),
@@ -230,7 +295,7 @@ func elementMarginAndPadding(elem dom.Element, boxParent layout.Box) (margin, pa
 		Bottom: layout.PhysicalPos(styleSet.PaddingBottom().AsLength(parentLogicalWidth).ToPx(fontSize)),
 		Left:   layout.PhysicalPos(styleSet.PaddingLeft().AsLength(parentLogicalWidth).ToPx(fontSize)),
 	}
-	return margin, padding
+	return margin, border, padding
 }
 func computeNextPosition(bfc *layout.BlockFormattingContext, ifc *layout.InlineFormattingContext, parentBcon *layout.BlockContainerBox, isInline bool) (logicalX, logicalY layout.LogicalPos) {
 	if isInline {
@@ -251,66 +316,85 @@ func computeNextPosition(bfc *layout.BlockFormattingContext, ifc *layout.InlineF
 		logicalX = baseLogicalX
 	}
 	logicalX += layout.LogicalPos(parentBcon.AccumulatedMarginLeft)
+	logicalX += layout.LogicalPos(parentBcon.AccumulatedBorderLeft)
 	logicalX += layout.LogicalPos(parentBcon.AccumulatedPaddingLeft)
 	return logicalX, logicalY
 }
+
+// computeBoxRect computes the margin rect of elem. Auto flags and size ranges
+// are along logical axes of the containing block.
 func computeBoxRect(
 	elem dom.Element, bfc *layout.BlockFormattingContext, ifc *layout.InlineFormattingContext,
 	boxParent layout.Box, parentBcon *layout.BlockContainerBox,
-	margin, padding layout.PhysicalEdges,
+	margin, border, padding layout.PhysicalEdges,
 	styleDisplay display.Display,
-) (boxRect layout.LogicalRect, physWidthAuto, physHeightAuto bool) {
+) (boxRect layout.LogicalRect, physWidthAuto, physHeightAuto bool, widthRange, heightRange sizeRange) {
 	styleSetSrc := cssom.ComputedStyleSetSourceOf(elem)
 	styleSet := styleSetSrc.ComputedStyleSet()
 	isFloat := styleSet.Float() != float.None
 	isInline := !isFloat && styleDisplay.Mode == display.OuterInnerMode && styleDisplay.OuterMode == display.Inline
-	isInlineFlowRoot := isInline && styleDisplay.InnerMode == display.FlowRoot
+	isInlineFlowRoot := isInline && isIndependentInnerMode(styleDisplay.InnerMode)
 
 	// Calculate left/top position
 	logicalX, logicalY := computeNextPosition(bfc, ifc, parentBcon, isInline)
 
-	var boxWidth, boxHeight sizing.Size
-	var boxWidthPhysical, boxHeightPhysical layout.PhysicalPos
+	var boxWidth, boxHeight layout.PhysicalPos
+	var sizes boxSizes
+	wm := boxParent.BoxContentRect().WritingMode
 	if !isInline || isInlineFlowRoot {
-		// Calculate width/height using `width` and `height` property
-		boxWidth = styleSet.Width()
-		boxHeight = styleSet.Height()
+		// Calculate width/height using `width`, `height`, and min/max properties
+		containerHeightAuto := boxParent.IsHeightAuto()
+		if wm.IsVertical() {
+			containerHeightAuto = boxParent.IsWidthAuto()
+		}
+		sizes = elementBoxSizes(elem, boxParent.BoxContentRect().ToPhysicalRect(), containerHeightAuto, border, padding)
+		if w, h, ok := replacedIntrinsicSize(elem); ok {
+			sizes = sizes.resolveReplacedSizes(w, h)
+		}
+		sizes = sizes.toLogical(wm)
 	} else {
-		// Inline elemenrs always have auto size
-		boxWidth = sizing.Size{Type: sizing.Auto}
-		boxHeight = sizing.Size{Type: sizing.Auto}
+		// Inline elemenrs always have auto size, and min/max properties don't apply.
+		sizes = boxSizes{
+			widthAuto:   true,
+			heightAuto:  true,
+			widthRange:  unlimitedSizeRange,
+			heightRange: unlimitedSizeRange,
+		}
 	}
 
 	// If width or height is auto, we start from 0 and expand it as we layout the children.
-	if boxWidth.Type != sizing.Auto {
-		containerSize := func() css.Num { return css.NumFromFloat(float64(boxParent.BoxContentRect().ToPhysicalRect().Width)) }
-		fontSize := func() css.Num { return css.NumFromFloat(fontSizeOf(styleSetSrc)) }
-		boxWidthPhysical = layout.PhysicalPos(boxWidth.ComputeUsedValue(containerSize).ToPx(fontSize))
+	if !sizes.widthAuto {
+		boxWidth = sizes.widthRange.clamp(sizes.width)
 	} else {
 		physWidthAuto = true
 	}
-	boxWidthPhysical += margin.HorizontalSum() + padding.HorizontalSum()
-	if boxHeight.Type != sizing.Auto {
-		parentSize := func() css.Num { return css.NumFromFloat(float64(boxParent.BoxContentRect().ToPhysicalRect().Height)) }
-		fontSize := func() css.Num { return css.NumFromFloat(fontSizeOf(styleSetSrc)) }
-		boxHeightPhysical = layout.PhysicalPos(boxHeight.ComputeUsedValue(parentSize).ToPx(fontSize))
+	boxWidth += edgesInlineSum(wm, margin, border, padding)
+	if !sizes.heightAuto {
+		boxHeight = sizes.heightRange.clamp(sizes.height)
 	} else {
 		physHeightAuto = true
 	}
-	boxHeightPhysical += margin.VerticalSum() + padding.VerticalSum()
-	boxWidthLogical, boxHeightLogical := layout.PhysicalSizeToLogical(boxWidthPhysical, boxHeightPhysical)
+	boxHeight += edgesBlockSum(wm, margin, border, padding)
 
-	return layout.LogicalRect{LogicalX: logicalX, LogicalY: logicalY, LogicalWidth: boxWidthLogical, LogicalHeight: boxHeightLogical},
-		physWidthAuto, physHeightAuto
+	rect := layout.LogicalRect{LogicalX: logicalX, LogicalY: logicalY, LogicalWidth: layout.LogicalPos(boxWidth), LogicalHeight: layout.LogicalPos(boxHeight), WritingMode: wm}
+	return rect, physWidthAuto, physHeightAuto, sizes.widthRange, sizes.heightRange
 }
 
 type treeBuilder struct {
-	font gfx.Font
+	textFonts            *fontChain // Fonts of the text being laid out
+	fonts                *fontState
+	gc                   *generatedContentState
+	pos                  *positioningState
+	bidi                 *bidiState
+	lines                *lineAlignState
+	principalWritingMode writingmodes.WritingMode
 }
 
 func (tb treeBuilder) newText(
 	txt string,
-	rect layout.PhysicalRect,
+	font gfx.Font,
+	rect layout.LogicalRect,
+	orientation gfx.TextOrientation,
 	color color.Color,
 	fontSize float64,
 	textDecors []gfx.TextDecorOptions,
@@ -318,7 +402,8 @@ func (tb treeBuilder) newText(
 	t := layout.Text{}
 	t.Text = txt
 	t.Rect = rect
-	t.Font = tb.font
+	t.Orientation = orientation
+	t.Font = font
 	t.Color = color
 	t.FontSize = fontSize
 	t.Decors = textDecors
@@ -328,7 +413,7 @@ func (tb treeBuilder) newInlineBox(
 	parentBcon *layout.BlockContainerBox,
 	elem dom.Element,
 	marginRect layout.LogicalRect,
-	margin, padding layout.PhysicalEdges,
+	margin, border, padding layout.PhysicalEdges,
 	physWidthAuto, physHeightAuto bool,
 	children []dom.Node, textDecors []gfx.TextDecorOptions,
 ) *layout.InlineBox {
@@ -337,11 +422,15 @@ func (tb treeBuilder) newInlineBox(
 	ibox.Elem = elem
 	ibox.MarginRect = marginRect
 	ibox.Margin = margin
+	ibox.Border = border
 	ibox.Padding = padding
 	ibox.PhysicalWidthAuto = physWidthAuto
 	ibox.PhysicalHeightAuto = physHeightAuto
 	ibox.ParentBcon = parentBcon
+	ibox.Background = backgroundOf(elem)
 
+	bidiBefore, bidiAfter := bidiControlsOfBox(parentBcon, elem)
+	tb.appendBidiText(parentBcon.Ifc, bidiBefore)
 	for _, childNode := range children {
 		nodes := tb.layoutNode(ibox.ParentBcon.Ifc, ibox.ParentBcon.Bfc, ibox.ParentBcon.Ifc, textDecors, ibox, childNode)
 		if len(nodes) == 0 {
@@ -357,6 +446,7 @@ func (tb treeBuilder) newInlineBox(
 			}
 		}
 	}
+	tb.appendBidiText(parentBcon.Ifc, bidiAfter)
 
 	return ibox
 }
@@ -367,13 +457,20 @@ func (tb treeBuilder) newBlockContainer(
 	parentBcon *layout.BlockContainerBox,
 	elem dom.Element,
 	marginRect layout.LogicalRect,
-	margin, padding layout.PhysicalEdges,
+	margin, border, padding layout.PhysicalEdges,
 	physWidthAuto, physHeightAuto bool,
 	isInlineFlowRoot bool,
 	children []dom.Node, textDecors []gfx.TextDecorOptions,
 ) *layout.BlockContainerBox {
 	bcon := &layout.BlockContainerBox{}
 
+	if isTable(elem) {
+		// Borders, paddings, and height belong to the table box, which is
+		// created inside of the table wrapper box(See layoutTable).
+		border, padding = layout.PhysicalEdges{}, layout.PhysicalEdges{}
+		physHeightAuto = true
+	}
+
 	// ICBs don't have any formatting context yet -- we have to create one.
 	if util.IsNil(parentFctx) {
 		bfc := &layout.BlockFormattingContext{}
@@ -385,7 +482,7 @@ func (tb treeBuilder) newBlockContainer(
 		ifc = &layout.InlineFormattingContext{}
 		ifc.OwnerBox = bcon
 		ifc.BlockContainer = bcon
-		ifc.InitialAvailableWidth = layout.LogicalPos(marginRect.ToPhysicalRect().Width)
+		ifc.InitialAvailableWidth = marginRect.LogicalWidth
 		ifc.InitialLogicalY = 0
 	}
 
@@ -394,32 +491,93 @@ func (tb treeBuilder) newBlockContainer(
 	bcon.Elem = elem
 	bcon.MarginRect = marginRect
 	bcon.Margin = margin
+	bcon.Border = border
 	bcon.Padding = padding
 	bcon.PhysicalWidthAuto = physWidthAuto
 	bcon.PhysicalHeightAuto = physHeightAuto
 	bcon.ParentFctx = parentFctx
 	bcon.Ifc = ifc
 	bcon.IsInlineFlowRoot = isInlineFlowRoot
+	bcon.IsAbsolutelyPositioned = isAbsolutelyPositioned(elem)
+	bcon.OverflowX, bcon.OverflowY = usedOverflowOf(elem)
+	bcon.Background = backgroundOf(elem)
 
 	if parentBcon != nil {
-		bcon.AccumulatedMarginLeft = parentBcon.AccumulatedMarginLeft + margin.Left
-		bcon.AccumulatedMarginRight = parentBcon.AccumulatedMarginRight + margin.Right
-		bcon.AccumulatedPaddingLeft = parentBcon.AccumulatedPaddingLeft + padding.Left
-		bcon.AccumulatedPaddingRight = parentBcon.AccumulatedPaddingRight + padding.Right
+		// Left and right are inline-start and inline-end sides in vertical writing modes.
+		wm := marginRect.WritingMode
+		logicalMargin, logicalBorder, logicalPadding := wm.LogicalEdges(margin), wm.LogicalEdges(border), wm.LogicalEdges(padding)
+		bcon.AccumulatedMarginLeft = parentBcon.AccumulatedMarginLeft + logicalMargin.InlineStart
+		bcon.AccumulatedMarginRight = parentBcon.AccumulatedMarginRight + logicalMargin.InlineEnd
+		bcon.AccumulatedBorderLeft = parentBcon.AccumulatedBorderLeft + logicalBorder.InlineStart
+		bcon.AccumulatedBorderRight = parentBcon.AccumulatedBorderRight + logicalBorder.InlineEnd
+		bcon.AccumulatedPaddingLeft = parentBcon.AccumulatedPaddingLeft + logicalPadding.InlineStart
+		bcon.AccumulatedPaddingRight = parentBcon.AccumulatedPaddingRight + logicalPadding.InlineEnd
 	}
-	if _, ok := parentFctx.(*layout.BlockFormattingContext); !ok || isInlineFlowRoot {
+	parentBfc, inParentBfc := parentFctx.(*layout.BlockFormattingContext)
+	if !inParentBfc || isInlineFlowRoot || establishesBfc(elem) {
 		bcon.Bfc = &layout.BlockFormattingContext{}
 		bcon.Bfc.OwnerBox = bcon
 		bcon.OwnsBfc = true
+		// Positions inside the new BFC are relative to the content box of bcon,
+		// which already includes edges of bcon and its ancestors.
+		bcon.AccumulatedMarginLeft, bcon.AccumulatedMarginRight = 0, 0
+		bcon.AccumulatedBorderLeft, bcon.AccumulatedBorderRight = 0, 0
+		bcon.AccumulatedPaddingLeft, bcon.AccumulatedPaddingRight = 0, 0
 	} else {
-		bcon.Bfc = parentFctx.(*layout.BlockFormattingContext)
+		bcon.Bfc = parentBfc
+	}
+	if isInBlockFlow(parentFctx, elem, isInlineFlowRoot) {
+		enterBlockFlow(parentBfc, bcon)
+	}
+	if mode, ok := tb.contentWritingModeOf(parentBox, elem); ok && mode != marginRect.WritingMode.WritingModeValue() {
+		if isFlexContainer(elem) || isGridContainer(elem) || isTable(elem) {
+			log.Printf("TODO: Support writing-mode %v on flex, grid, and table containers", mode)
+		} else {
+			tb.layoutInWritingMode(bcon, mode, children, textDecors)
+			return bcon
+		}
+	}
+	if isFlexContainer(elem) || isGridContainer(elem) {
+		// Inline contents are wrapped in flex or grid items, so the IFC is only
+		// used to find static positions of absolutely positioned children.
+		bcon.Ifc = &layout.InlineFormattingContext{}
+		bcon.Ifc.OwnerBox = bcon
+		bcon.Ifc.BlockContainer = bcon
+		bcon.Ifc.InitialAvailableWidth = bcon.BoxContentRect().LogicalWidth
+		if isFlexContainer(elem) {
+			bcon.Ffc = &layout.FlexFormattingContext{}
+			bcon.Ffc.OwnerBox = bcon
+			tb.layoutFlexContainer(bcon, children, textDecors)
+		} else {
+			bcon.Gfc = &layout.GridFormattingContext{}
+			bcon.Gfc.OwnerBox = bcon
+			tb.layoutGridContainer(bcon, children, textDecors)
+		}
+		return bcon
+	}
+	if isTable(elem) {
+		// Contents are laid out by the table box, so the IFC is only used to
+		// find static positions of absolutely positioned children.
+		bcon.Ifc = &layout.InlineFormattingContext{}
+		bcon.Ifc.OwnerBox = bcon
+		bcon.Ifc.BlockContainer = bcon
+		bcon.Ifc.InitialAvailableWidth = bcon.BoxContentRect().LogicalWidth
+		bcon.IsTableWrapper = true
+		tb.layoutTable(bcon, children, textDecors)
+		return bcon
 	}
 
 	// Check each children's display type.
 	hasInline, hasBlock := false, false
 	isInline := make([]bool, len(children))
 	for i, childNode := range children {
-		isBlockLevel := tb.isElementBlockLevel(bcon.ParentFctx, childNode)
+		if elem, ok := childNode.(dom.Element); ok && isAbsolutelyPositioned(elem) {
+			// Absolutely positioned boxes don't affect whether we need anonymous
+			// block containers, and they go along with surrounding inline contents.
+			isInline[i] = true
+			continue
+		}
+		isBlockLevel := tb.isElementBlockLevel(bcon.Bfc, childNode)
 		isInline[i] = false
 		if isBlockLevel {
 			hasBlock = true
@@ -453,30 +611,42 @@ func (tb treeBuilder) newBlockContainer(
 		if bcon.IsInlineFlowRoot && bcon.IsWidthAuto() {
 			bcon.Ifc.InitialAvailableWidth = currrentInitialAvailableWidth
 		} else {
-			bcon.Ifc.InitialAvailableWidth = bcon.MarginRect.LogicalWidth
+			bcon.Ifc.InitialAvailableWidth = bcon.BoxContentRect().LogicalWidth
 		}
+		setTextIndent(bcon, elem)
 		bcon.OwnsIfc = true
 		// Calculate common margin-top -----------------------------------------
 		commonMarginTop := layout.PhysicalPos(0.0)
 		commonMarginBottom := layout.PhysicalPos(0.0)
 		for _, child := range children {
 			var margin layout.PhysicalEdges
-			if elem, ok := child.(dom.Element); ok {
+			if elem, ok := child.(dom.Element); ok && !isAbsolutelyPositioned(elem) {
 				styleDisplay := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Display()
-				if styleDisplay.Mode == display.OuterInnerMode && (styleDisplay.OuterMode != display.Inline || styleDisplay.InnerMode == display.FlowRoot) {
-					margin, _ = elementMarginAndPadding(elem, bcon)
-					commonMarginTop = max(commonMarginTop, margin.Top)
-					commonMarginBottom = max(commonMarginBottom, margin.Bottom)
+				if styleDisplay.Mode == display.OuterInnerMode && (styleDisplay.OuterMode != display.Inline || isIndependentInnerMode(styleDisplay.InnerMode)) {
+					margin, _, _ = elementBoxEdges(elem, bcon)
+					logicalMargin := bcon.BoxContentRect().WritingMode.LogicalEdges(margin)
+					commonMarginTop = max(commonMarginTop, logicalMargin.BlockStart)
+					commonMarginBottom = max(commonMarginBottom, logicalMargin.BlockEnd)
 				}
 			}
 		}
 		// Create root inline box ----------------------------------------------
+		if hasInlineContent(children) {
+			// Line boxes separate margins before and after them.
+			bcon.Bfc.ApplyMargins()
+		}
+		baseLogicalY := bcon.Bfc.OwnerBox.BoxContentRect().LogicalY
 		bcon.Bfc.IncrementNaturalPos(layout.LogicalPos(commonMarginTop))
-		bcon.Ifc.InitialLogicalY = bcon.Bfc.OwnerBox.BoxContentRect().LogicalY + bcon.Bfc.CurrentNaturalPos
-		ibox := tb.newInlineBox(bcon, nil, bcon.BoxContentRect(), layout.PhysicalEdges{}, layout.PhysicalEdges{}, false, true, children, textDecors)
+		bcon.Ifc.InitialLogicalY = baseLogicalY + bcon.Bfc.CurrentNaturalPos
+		ibox := tb.newInlineBox(bcon, nil, bcon.BoxContentRect(), layout.PhysicalEdges{}, layout.PhysicalEdges{}, layout.PhysicalEdges{}, false, true, children, textDecors)
+		tb.finishLines(bcon, ibox)
+		if len(bcon.Ifc.LineBoxes) != 0 {
+			lb := bcon.Ifc.CurrentLineBox()
+			linesBottom := lb.InitialLogicalY + layout.LogicalPos(lb.CurrentLineHeight)
+			bcon.Bfc.IncrementNaturalPos(linesBottom - (baseLogicalY + bcon.Bfc.CurrentNaturalPos))
+		}
 		bcon.Bfc.IncrementNaturalPos(layout.LogicalPos(commonMarginBottom))
 		bcon.AddChildBox(ibox)
-		bcon.IncrementSize(0, layout.LogicalPos(commonMarginTop+commonMarginBottom))
 	} else {
 		//======================================================================
 		// We have either only block contents, or mix of inline and block contents.
@@ -492,17 +662,17 @@ func (tb treeBuilder) newBlockContainer(
 				if i == len(children)-1 || !isInline[i+1] {
 					// Create anonymous block container
 					logicalX, logicalY := computeNextPosition(bcon.Bfc, bcon.Ifc, bcon, true)
-					boxRect := layout.LogicalRect{LogicalX: logicalX, LogicalY: logicalY, LogicalWidth: bcon.MarginRect.LogicalWidth, LogicalHeight: 0}
-					anonBcon := tb.newBlockContainer(bcon.ParentFctx, bcon.Ifc, bcon, bcon, nil, boxRect, layout.PhysicalEdges{}, layout.PhysicalEdges{}, false, true, false, anonChildren, textDecors)
+					boxRect := layout.LogicalRect{LogicalX: logicalX, LogicalY: logicalY, LogicalWidth: bcon.MarginRect.LogicalWidth, LogicalHeight: 0, WritingMode: bcon.BoxContentRect().WritingMode}
+					anonBcon := tb.newBlockContainer(bcon.Bfc, bcon.Ifc, bcon, bcon, nil, boxRect, layout.PhysicalEdges{}, layout.PhysicalEdges{}, layout.PhysicalEdges{}, false, true, false, anonChildren, textDecors)
 					anonBcon.IsAnonymous = true
-					bcon.Bfc.IncrementNaturalPos(anonBcon.MarginRect.LogicalHeight)
+					exitBlockFlow(bcon.Bfc, anonBcon, unlimitedSizeRange)
 					anonChildren = []dom.Node{} // Clear children list
 					boxes = []any{anonBcon}
 				}
 
 			} else {
 				// Create layout node normally
-				boxes = tb.layoutNode(bcon.ParentFctx, bcon.Bfc, bcon.Ifc, textDecors, bcon, childNode)
+				boxes = tb.layoutNode(bcon.Bfc, bcon.Bfc, bcon.Ifc, textDecors, bcon, childNode)
 			}
 			if len(boxes) == 0 {
 				continue
@@ -514,6 +684,9 @@ func (tb treeBuilder) newBlockContainer(
 
 		}
 	}
+	if !util.IsNil(elem) && hasOutsideMarker(elem) {
+		tb.layoutOutsideMarker(bcon)
+	}
 
 	return bcon
 }
@@ -527,6 +700,9 @@ func (tb treeBuilder) isElementBlockLevel(parentFctx layout.FormattingContext, d
 		styleSetSrc := cssom.ComputedStyleSetSourceOf(elem)
 		styleSet := styleSetSrc.ComputedStyleSet()
 		styleDisplay := styleSet.Display()
+		if d, ok := orphanedTableDisplay(styleDisplay); ok {
+			styleDisplay = d
+		}
 		switch styleDisplay.Mode {
 		case display.DisplayNone:
 			return false
@@ -551,13 +727,19 @@ func (tb treeBuilder) isElementBlockLevel(parentFctx layout.FormattingContext, d
 					return false
 				}
 				return true
-			case display.FlowRoot:
+			case display.FlowRoot, display.Flex, display.Grid, display.Table:
 				//==================================================================
 				// "flow-root" mode (flow-root, inline-block display modes)
+				// "flex" mode (flex, inline-flex display modes)
+				// "grid" mode (grid, inline-grid display modes)
+				// "table" mode (table, inline-table display modes)
 				//==================================================================
 
 				// https://www.w3.org/TR/css-display-3/#valdef-display-flow-root
-				return false
+				// https://www.w3.org/TR/css-display-3/#valdef-display-flex
+				// https://www.w3.org/TR/css-display-3/#valdef-display-grid
+				// https://www.w3.org/TR/css-display-3/#valdef-display-table
+				return styleDisplay.OuterMode != display.Inline
 			default:
 				log.Panicf("TODO: Support display: %v", styleDisplay)
 			}
@@ -571,78 +753,125 @@ func (tb treeBuilder) isElementBlockLevel(parentFctx layout.FormattingContext, d
 }
 func (tb treeBuilder) layoutText(txt dom.Text, boxParent layout.Box, bfc *layout.BlockFormattingContext, ifc *layout.InlineFormattingContext, textDecors []gfx.TextDecorOptions) []any {
 	parentElem := closestDomElementForBox(boxParent)
+	if elem, ok := txt.Parent().(dom.Element); ok && isDisplayContents(elem) {
+		// The element doesn't have its own box, but the text still inherits
+		// its style.
+		parentElem = elem
+	}
 	parentBcon := closestParentBlockContainer(boxParent)
 	parentStyleSetSrc := cssom.ComputedStyleSetSourceOf(parentElem)
 	parentStyleSet := parentStyleSetSrc.ComputedStyleSet()
 
-	str := applyWhitespaceCollapsing(txt.Text(), ifc)
+	// Select fonts, and calculate the font size
+	// TODO: Take metrics of fallback fonts into account
+	tb.textFonts = tb.fontChainOf(parentStyleSetSrc)
+	fontSize := fontSizeOf(parentStyleSetSrc)
+	primaryFont := tb.textFonts.primary()
+	primaryFont.SetTextSize(int(fontSize)) // NOTE: Size we set here will only be used for measuring
+	metrics := primaryFont.Metrics()
+	lineHeight := usedLineHeight(parentStyleSet, metrics, fontSize)
+	wm := parentBcon.BoxContentRect().WritingMode
+	orientation := textOrientationOf(wm, parentStyleSet)
+
+	whiteSpace := parentStyleSet.WhiteSpaceCollapse()
+	isCollapsible := !whiteSpace.PreservesSpaces()
+	// Trailing spaces hang at the end of lines, unless these are preserved
+	// and can't be removed.
+	// Spec: https://www.w3.org/TR/css-text-3/#white-space-phase-2
+	spacesHang := whiteSpace != text.BreakSpaces
+	canWrap := parentStyleSet.TextWrapMode() == text.Wrap
+
+	str := applyWhitespaceCollapsing(txt.Text(), ifc, whiteSpace)
 	if str == "" {
 		return nil
 	}
+	if !isCollapsible {
+		x := layout.LogicalPos(0)
+		if len(ifc.LineBoxes) != 0 {
+			x = ifc.CurrentLineBox().CurrentNaturalPos
+		}
+		str = tb.expandTabs(str, x, tb.tabStopInterval(parentStyleSet, fontSize, orientation), orientation)
+	}
+	precedingText := ifc.WrittenText
 	ifc.WrittenText += str
 
 	// Apply text-transform
 	if v := parentStyleSet.TextTransform(); !util.IsNil(v) {
 		str = v.Apply(str)
 	}
+	bidiOffset := tb.appendBidiText(ifc, str)
 
-	// Calculate the font size
-	fontSize := fontSizeOf(parentStyleSetSrc)
-	tb.font.SetTextSize(int(fontSize)) // NOTE: Size we set here will only be used for measuring
-	metrics := tb.font.Metrics()
+	// Split text at line break opportunities.
+	segs, canBreakBefore := tb.textSegments(str, precedingText, lineBreakOptionsOf(parentStyleSet), spacesHang, orientation)
+	canBreakWords := canBreakWordsOnOverflow(parentStyleSet)
 
-	fragmentRemaining := str
 	textNodes := []any{}
+	start, segIdx := 0, 0
 
-	for 0 < len(fragmentRemaining) {
-		// https://www.w3.org/TR/css-text-3/#white-space-phase-2
-		// S1.
-		fragmentRemaining = strings.TrimLeft(fragmentRemaining, " ")
-		if fragmentRemaining == "" {
-			break
-		}
-
+	for start < len(str) {
 		// Create line box if needed
 		firstLineBoxCreated := false
 		if len(ifc.LineBoxes) == 0 {
-			ifc.AddLineBox(metrics.LineHeight)
+			ifc.AddLineBox(lineHeight)
 			firstLineBoxCreated = true
 		}
 		lineBox := ifc.CurrentLineBox()
+		breakLine := func(isForced bool) {
+			lineBox.HasForcedBreak = isForced
+			ifc.AddLineBox(lineHeight)
+			if boxParent.IsHeightAuto() {
+				boxParent.IncrementSize(0, layout.LogicalPos(lineHeight))
+			}
+		}
 
-		var rect layout.PhysicalRect
-		var logicalWidth layout.LogicalPos
-		strLen := len(fragmentRemaining)
-
-		// Figure out where we should end current fragment, so that we don't
-		// overflow the line box.
-		// TODO: We should not do this if we are not doing text wrapping(e.g. whitespace: nowrap).
-		for {
-			// FIXME: This is very brute-force way of fragmenting text.
-			//        We need smarter way to handle this.
-
-			// Calculate physWidth/height using dimensions of the text
-			physWidth, _ := gfx.MeasureText(tb.font, fragmentRemaining[:strLen])
-
-			rect = layout.PhysicalRect{Left: 0, Top: 0, Width: layout.PhysicalPos(physWidth), Height: layout.PhysicalPos(metrics.LineHeight)}
-
-			// Check if parent's size is auto and we have to grow its size.
-			logicalWidth = layout.LogicalPos(rect.Width)
-			// Check if we overflow beyond available size
-			if lineBox.CurrentNaturalPos+logicalWidth <= lineBox.AvailableWidth {
-				// If not, we don't have to fragment text further.
+		if lineBox.CurrentNaturalPos == 0 && isCollapsible {
+			// https://www.w3.org/TR/css-text-3/#white-space-phase-2
+			// S1.
+			start = len(str) - len(strings.TrimLeft(str[start:], " "))
+			if start == len(str) {
 				break
 			}
-			strLen-- // Decrement length and try again
 		}
-		if strLen == 0 {
-			// Display at least one chracter per line
-			strLen = 1
+		for segs[segIdx].end <= start {
+			segIdx++
 		}
-		fragment := fragmentRemaining[:strLen]
-		fragmentRemaining = fragmentRemaining[strLen:]
 
-		lineBox.CurrentLineHeight = max(lineBox.CurrentLineHeight, float64(rect.Height))
+		// Figure out where we should end current fragment, so that we don't
+		// overflow the line box. Without wrapping, only forced line breaks
+		// end the line.
+		availWidth := max(lineBox.AvailableWidth-lineBox.CurrentNaturalPos, 0)
+		if !canWrap {
+			availWidth = unlimitedLineWidth
+		}
+		end, logicalWidth, isMandatory := tb.fitTextSegments(str, segs, segIdx, start, availWidth, spacesHang, orientation)
+		if end == start {
+			if lineBox.CurrentNaturalPos != 0 && (start != 0 || canBreakBefore) {
+				// Nothing fits, but we can continue on the next line.
+				breakLine(false)
+				continue
+			}
+			// We have to overflow the line box, unless we can break the word.
+			seg := segs[segIdx]
+			word := strings.TrimSuffix(str[start:seg.end], "\n")
+			end, logicalWidth = len(word), 0
+			if canBreakWords {
+				end, logicalWidth = tb.breakTextAnywhere(word, availWidth, orientation)
+			} else {
+				logicalWidth = tb.measureText(word, orientation)
+			}
+			end += start
+			isMandatory = seg.isMandatory && end == start+len(word)
+			if isMandatory {
+				// Include the newline
+				end = seg.end
+			}
+		}
+		fragment := str[start:end]
+		fragmentStart := start
+		start = end
+
+		rect := layout.LogicalRect{LogicalWidth: logicalWidth, LogicalHeight: layout.LogicalPos(metrics.LineHeight), WritingMode: wm}
+		lineBox.CurrentLineHeight = max(lineBox.CurrentLineHeight, lineHeight)
 
 		// If we just created a line box, we may have to increase the height.
 		if firstLineBoxCreated && boxParent.IsHeightAuto() {
@@ -650,34 +879,70 @@ func (tb treeBuilder) layoutText(txt dom.Text, boxParent layout.Box, bfc *layout
 		}
 
 		// https://www.w3.org/TR/css-text-3/#white-space-phase-2
-		// S3.
-		fragment = strings.TrimRight(fragment, " ")
+		// S3. Preserved spaces are kept, unless these hang at the end of
+		// wrapped lines.
+		fragment = strings.TrimSuffix(fragment, "\n")
+		if spacesHang && (isCollapsible || canWrap) {
+			fragment = strings.TrimRight(fragment, " ")
+		}
+		// Overflowing text (and hanging spaces) can't move position beyond the line box.
+		advance := min(logicalWidth, availWidth)
 
 		if fragment == "" {
+			// Spaces between words in different elements, or an empty line
+			left, _ := computeNextPosition(bfc, ifc, parentBcon, true)
+			tb.addBidiFragment(ifc, boxParent, nil, bidiOffset+fragmentStart, bidiOffset+end, left, advance)
+			ifc.IncrementNaturalPos(advance)
+			if isMandatory {
+				breakLine(true)
+			}
 			continue
 		}
 
 		// Calculate left/top position -------------------------------------
 		left, top := computeNextPosition(bfc, ifc, parentBcon, true)
-		rect.Left = layout.PhysicalPos(left)
-		rect.Top = layout.PhysicalPos(top)
+		rect.LogicalX = left
+		rect.LogicalY = top
 
-		// Make text node --------------------------------------------------
+		// Make text nodes -------------------------------------------------
+		// The fragment is split where the font changes, so that each text
+		// node is drawn with single font.
 		color := parentStyleSet.Color().ToStdColor(parentStyleSetSrc.CurrentColor())
-		textNode := tb.newText(fragment, rect, color, fontSize, textDecors)
-
 		if boxParent.IsWidthAuto() {
-			boxParent.IncrementSize(layout.LogicalPos(rect.Width), 0)
+			boxParent.IncrementSize(rect.LogicalWidth, 0)
 		}
-
-		ifc.IncrementNaturalPos(logicalWidth)
-		textNodes = append(textNodes, textNode)
-		if len(fragmentRemaining) != 0 && strings.TrimLeft(fragmentRemaining, " ") != "" {
-			// Create next line --------------------------------------------
-			ifc.AddLineBox(metrics.LineHeight)
-			if boxParent.IsHeightAuto() {
-				boxParent.IncrementSize(0, layout.LogicalPos(metrics.LineHeight))
+		runs := tb.textFonts.runsOf(fragment)
+		runStart, runLeft := fragmentStart, rect.LogicalX
+		for i, run := range runs {
+			runRect := rect
+			runRect.LogicalX = runLeft
+			runEnd := runStart + len(run.text)
+			var runAdvance layout.LogicalPos
+			if len(runs) != 1 || len(fragment) != end-fragmentStart {
+				// Trailing spaces are not part of the text.
+				runRect.LogicalWidth = tb.textFonts.measure(run.text, orientation)
+			}
+			if i == len(runs)-1 {
+				// The last run also covers trailing spaces and the newline.
+				runEnd = end
+				runAdvance = max(advance-(runLeft-rect.LogicalX), 0)
+			} else {
+				runAdvance = runRect.LogicalWidth
 			}
+			textNode := tb.newText(run.text, run.font, runRect, orientation, color, fontSize, textDecors)
+			textNode.IsHidden = parentStyleSet.Visibility() != display.Visible
+			tb.addBidiFragment(ifc, boxParent, textNode, bidiOffset+runStart, bidiOffset+runEnd, runLeft, runAdvance)
+			textNodes = append(textNodes, textNode)
+			runStart, runLeft = runEnd, runLeft+runAdvance
+		}
+		ifc.IncrementNaturalPos(advance)
+		rest := str[start:]
+		if isCollapsible {
+			rest = strings.TrimLeft(rest, " ")
+		}
+		if isMandatory || rest != "" {
+			// Create next line --------------------------------------------
+			breakLine(isMandatory)
 		}
 	}
 
@@ -690,28 +955,52 @@ func (tb treeBuilder) layoutElement(elem dom.Element, boxParent layout.Box, pare
 	styleSet := styleSetSrc.ComputedStyleSet()
 
 	textDecors = elementTextDecoration(elem, textDecors)
-	margin, padding := elementMarginAndPadding(elem, boxParent)
+	margin, border, padding := elementBoxEdges(elem, boxParent)
 
 	styleDisplay := styleSet.Display()
 	styleFloat := styleSet.Float()
+	if d, ok := orphanedTableDisplay(styleDisplay); ok {
+		styleDisplay = d
+	}
+	replacedImage, isReplaced := tb.replacedImageOf(elem)
+	if isReplaced && styleDisplay.Mode == display.OuterInnerMode {
+		// Replaced elements are atomic, and their contents are not laid out
+		// by CSS.
+		styleDisplay.InnerMode = display.FlowRoot
+	}
 	switch styleDisplay.Mode {
 	case display.DisplayNone:
 		return nil
 	case display.OuterInnerMode:
+		if isAbsolutelyPositioned(elem) {
+			return tb.layoutAbsolutelyPositioned(elem, boxParent, bfc, ifc, margin, border, padding, textDecors)
+		}
 		if styleDisplay.OuterMode == display.Inline {
-			// Top and bottom margins are handled when creating inline box.
-			margin.Top = 0
-			margin.Bottom = 0
+			// Block-start and block-end margins are handled when creating inline box.
+			if boxParent.BoxContentRect().WritingMode.IsVertical() {
+				margin.Left = 0
+				margin.Right = 0
+			} else {
+				margin.Top = 0
+				margin.Bottom = 0
+			}
 		}
 
-		boxRect, physWidthAuto, physHeightAuto := computeBoxRect(elem, bfc, ifc, boxParent, parentBcon, margin, padding, styleDisplay)
+		boxRect, physWidthAuto, physHeightAuto, widthRange, heightRange := computeBoxRect(elem, bfc, ifc, boxParent, parentBcon, margin, border, padding, styleDisplay)
 		isFloat := styleFloat != float.None
+		if styleDisplay.InnerMode == display.Table {
+			// min-* and max-* properties apply to the table box inside of the
+			// table wrapper box(See layoutTable).
+			widthRange, heightRange = unlimitedSizeRange, unlimitedSizeRange
+		}
 
 		switch styleDisplay.OuterMode {
 		case display.Block:
 			// Check if we have auto size on a block element. If so, use parent's size and unset auto.
 			if physWidthAuto && !isFloat {
-				boxRect.LogicalWidth = boxParent.BoxContentRect().LogicalWidth
+				edges := edgesInlineSum(boxRect.WritingMode, margin, border, padding)
+				contentWidth := widthRange.clamp(layout.PhysicalPos(boxParent.BoxContentRect().LogicalWidth) - edges)
+				boxRect.LogicalWidth = layout.LogicalPos(contentWidth + edges)
 				physWidthAuto = false
 			}
 		case display.Inline:
@@ -723,18 +1012,17 @@ func (tb treeBuilder) layoutElement(elem dom.Element, boxParent layout.Box, pare
 		}
 
 		// Increment natural position(if it's auto)
-		// XXX: Should we increment width/height if the element uses absolute positioning?
 		switch styleDisplay.OuterMode {
 		case display.Block:
 			if boxParent.IsWidthAuto() {
-				boxParent.IncrementIfNeeded(layout.LogicalPos(boxRect.ToPhysicalRect().Width), 0)
+				boxParent.IncrementIfNeeded(boxRect.LogicalWidth, 0)
 			}
 			if boxParent.IsHeightAuto() {
-				boxParent.IncrementSize(0, layout.LogicalPos(boxRect.ToPhysicalRect().Height))
+				boxParent.IncrementSize(0, boxRect.LogicalHeight)
 			}
 		case display.Inline:
 			if boxParent.IsWidthAuto() {
-				boxParent.IncrementSize(layout.LogicalPos(boxRect.ToPhysicalRect().Width), 0)
+				boxParent.IncrementSize(boxRect.LogicalWidth, 0)
 			}
 			if boxParent.IsHeightAuto() {
 				// TODO
@@ -743,7 +1031,6 @@ func (tb treeBuilder) layoutElement(elem dom.Element, boxParent layout.Box, pare
 
 		var bx layout.Box
 		var oldLogicalX layout.LogicalPos
-		oldLogicalY := bfc.CurrentNaturalPos
 		if len(ifc.LineBoxes) != 0 {
 			oldLogicalX = ifc.CurrentLineBox().CurrentNaturalPos
 		}
@@ -765,26 +1052,41 @@ func (tb treeBuilder) layoutElement(elem dom.Element, boxParent layout.Box, pare
 				}
 			}
 			if shouldMakeInlineBox {
-				ibox := tb.newInlineBox(parentBcon, elem, boxRect, margin, padding, physWidthAuto, physHeightAuto, elem.Children(), textDecors)
+				ibox := tb.newInlineBox(parentBcon, elem, boxRect, margin, border, padding, physWidthAuto, physHeightAuto, tb.childNodesOf(elem), textDecors)
 				bx = ibox
 			} else {
-				bfc.IncrementNaturalPos(layout.LogicalPos(margin.Top + padding.Top)) // Consume top margin+padding first
 				bcon := tb.newBlockContainer(
-					parentFctx, ifc, boxParent, parentBcon, elem, boxRect, margin, padding, physWidthAuto, physHeightAuto, false, elem.Children(), textDecors)
-				bfc.IncrementNaturalPos(layout.LogicalPos(margin.Bottom + padding.Bottom)) // Consume bottom margin+padding
+					parentFctx, ifc, boxParent, parentBcon, elem, boxRect, margin, border, padding, physWidthAuto, physHeightAuto, false, tb.childNodesOf(elem), textDecors)
 				bx = bcon
 			}
-		case display.FlowRoot:
+		case display.FlowRoot, display.Flex, display.Grid, display.Table:
 			//==================================================================
 			// "flow-root" mode (flow-root, inline-block display modes)
+			// "flex" mode (flex, inline-flex display modes)
+			// "grid" mode (grid, inline-grid display modes)
+			// "table" mode (table, inline-table display modes)
 			//==================================================================
 			// https://www.w3.org/TR/css-display-3/#valdef-display-flow-root
-			bcon := tb.newBlockContainer(parentFctx, ifc, boxParent, parentBcon, elem, boxRect, margin, padding, physWidthAuto, physHeightAuto, true, elem.Children(), textDecors)
+			// https://www.w3.org/TR/css-display-3/#valdef-display-flex
+			// https://www.w3.org/TR/css-display-3/#valdef-display-grid
+			// https://www.w3.org/TR/css-display-3/#valdef-display-table
+			isInlineFlowRoot := styleDisplay.OuterMode == display.Inline
+			bcon := tb.newBlockContainer(parentFctx, ifc, boxParent, parentBcon, elem, boxRect, margin, border, padding, physWidthAuto, physHeightAuto, isInlineFlowRoot, tb.childNodesOf(elem), textDecors)
+			if isReplaced {
+				bcon.Image = replacedImage
+			}
 			bx = bcon
 		default:
 			log.Panicf("TODO: Support display: %v", styleDisplay)
 		}
-		newLogicalY := bfc.CurrentNaturalPos
+		if bcon, ok := bx.(*layout.BlockContainerBox); ok {
+			if isInBlockFlow(parentFctx, elem, bcon.IsInlineFlowRoot) {
+				exitBlockFlow(bfc, bcon, heightRange)
+			} else if bcon.OwnsBfc && bcon.IsHeightAuto() {
+				resolveBfcRootHeight(bcon, heightRange)
+			}
+			applySizeRanges(bcon, widthRange, heightRange)
+		}
 		var newLogicalX layout.LogicalPos
 		if len(ifc.LineBoxes) != 0 {
 			newLogicalX = ifc.CurrentLineBox().CurrentNaturalPos
@@ -794,11 +1096,8 @@ func (tb treeBuilder) layoutElement(elem dom.Element, boxParent layout.Box, pare
 		case float.None:
 			if bcon, ok := bx.(*layout.BlockContainerBox); ok {
 				// Increment natural position (but only the amount that hasn't been incremented)
+				// NOTE: Block-level boxes have already done this in exitBlockFlow().
 				switch styleDisplay.OuterMode {
-				case display.Block:
-					logicalHeight := bcon.BoxMarginRect().LogicalHeight
-					posDiff := newLogicalY - oldLogicalY
-					bfc.IncrementNaturalPos(logicalHeight - posDiff)
 				case display.Inline:
 					logicalWidth := bcon.BoxMarginRect().LogicalWidth
 					posDiff := newLogicalX - oldLogicalX
@@ -806,10 +1105,11 @@ func (tb treeBuilder) layoutElement(elem dom.Element, boxParent layout.Box, pare
 						ifc.AddLineBox(0)
 					}
 					ifc.IncrementNaturalPos(logicalWidth - posDiff)
+					tb.addAtomicInlineFragment(ifc, boxParent, bcon)
 
 					lb := ifc.CurrentLineBox()
-					heightDiff := float64(bcon.BoxMarginRect().ToPhysicalRect().Height) - lb.CurrentLineHeight
-					lb.CurrentLineHeight = max(lb.CurrentLineHeight, float64(bcon.BoxMarginRect().ToPhysicalRect().Height))
+					heightDiff := float64(bcon.BoxMarginRect().LogicalHeight) - lb.CurrentLineHeight
+					lb.CurrentLineHeight = max(lb.CurrentLineHeight, float64(bcon.BoxMarginRect().LogicalHeight))
 					if boxParent.IsHeightAuto() {
 						boxParent.IncrementSize(0, layout.LogicalPos(heightDiff))
 					}
@@ -821,6 +1121,7 @@ func (tb treeBuilder) layoutElement(elem dom.Element, boxParent layout.Box, pare
 		case float.Right:
 			bfc.RightFloatingBoxes = append(bfc.RightFloatingBoxes, bx)
 		}
+		tb.finishPositionedLayout(bx, elem, parentBcon)
 		return bx
 
 	default:
@@ -0,0 +1,234 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"slices"
+
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/display"
+	"github.com/inseo-oh/yw/css/lists"
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/css/selector"
+	"github.com/inseo-oh/yw/dom"
+)
+
+// listItemCounter is the name of the counter implicitly incremented by list items.
+// https://www.w3.org/TR/css-lists-3/#list-item-counter
+const listItemCounter = "list-item"
+
+// counterInstance is an instance of a CSS counter, created by counter-reset, or
+// implicitly by counter-increment and counter-set.
+//
+// https://www.w3.org/TR/css-lists-3/#creating-a-counter
+type counterInstance struct {
+	name  string
+	scope dom.Node // The counter is removed after children of this node are done.
+	value int      // Current value. If isRelative is set, it's relative to start.
+
+	// Reversed counters created without start value get their start values
+	// after all elements in the scope are visited. Until then, values are
+	// relative to start, and operations on the counter are recorded in ops.
+	isReversed bool
+	isRelative bool
+	start      int
+	ops        []counterOp
+}
+
+// counterOp is counter-increment and counter-set applied to a reversed counter
+// by an element or a pseudo-element.
+type counterOp struct {
+	ref       selector.PseudoElementRef
+	increment int
+	hasSet    bool
+	setValue  int
+}
+
+// counterValue is the value of a counter instance at some point.
+type counterValue struct {
+	inst       *counterInstance
+	value      int
+	isRelative bool
+}
+
+// resolve returns the actual value. This must be called after all counters
+// are resolved.
+func (v counterValue) resolve() int {
+	if v.isRelative {
+		return v.inst.start + v.value
+	}
+	return v.value
+}
+
+// counterResolver walks the DOM tree in the tree order, and records counters
+// of each element and pseudo-element.
+type counterResolver struct {
+	stack     []*counterInstance
+	snapshots map[selector.PseudoElementRef][]counterValue
+}
+
+// resolveCounters resolves values of all counters in the DOM tree starting
+// from root, and returns counters of each element and pseudo-element. Counters
+// of an element are keyed by PseudoElementRef with empty Name.
+//
+// Spec: https://www.w3.org/TR/css-lists-3/#auto-numbering
+func resolveCounters(root dom.Element) map[selector.PseudoElementRef][]counterValue {
+	cr := counterResolver{snapshots: map[selector.PseudoElementRef][]counterValue{}}
+	cr.visitElement(root)
+	for _, inst := range cr.stack {
+		inst.finish()
+	}
+	return cr.snapshots
+}
+
+func (cr *counterResolver) visitElement(elem dom.Element) {
+	styleSet := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet()
+	if styleSet.Display().Mode == display.DisplayNone {
+		return
+	}
+	cr.apply(selector.PseudoElementRef{Element: elem}, elem.Parent(), styleSet, isListItem(elem))
+	for _, name := range []string{"marker", "before"} {
+		if pseudoStyleSet := pseudoElementStyleSet(elem, name); pseudoStyleSet != nil {
+			cr.apply(selector.PseudoElementRef{Element: elem, Name: name}, elem, pseudoStyleSet, false)
+		}
+	}
+	for _, child := range elem.Children() {
+		if childElem, ok := child.(dom.Element); ok {
+			cr.visitElement(childElem)
+		}
+	}
+	if pseudoStyleSet := pseudoElementStyleSet(elem, "after"); pseudoStyleSet != nil {
+		cr.apply(selector.PseudoElementRef{Element: elem, Name: "after"}, elem, pseudoStyleSet, false)
+	}
+	// Counters created by children of elem are now out of scope.
+	for 0 < len(cr.stack) && cr.stack[len(cr.stack)-1].scope == elem {
+		cr.stack[len(cr.stack)-1].finish()
+		cr.stack = cr.stack[:len(cr.stack)-1]
+	}
+}
+
+// apply applies counter properties of ref, whose parent is parent, and
+// records resulting counters.
+//
+// Spec: https://www.w3.org/TR/css-lists-3/#counters-without-boxes
+func (cr *counterResolver) apply(ref selector.PseudoElementRef, parent dom.Node, styleSet *props.ComputedStyleSet, isListItem bool) {
+	// https://www.w3.org/TR/css-lists-3/#counter-reset
+	for _, c := range styleSet.CounterReset() {
+		// Counter created by previous sibling, or by ref itself is replaced.
+		if i := cr.innermostIndex(c.Name); i != -1 && cr.stack[i].scope == parent {
+			cr.stack[i].finish()
+			cr.stack = slices.Delete(cr.stack, i, i+1)
+		}
+		inst := &counterInstance{name: c.Name, scope: parent, value: c.Value, isReversed: c.IsReversed}
+		if c.IsReversed && !c.HasValue {
+			inst.value, inst.isRelative = 0, true
+		}
+		cr.stack = append(cr.stack, inst)
+	}
+
+	// https://www.w3.org/TR/css-lists-3/#increment-set
+	increments := styleSet.CounterIncrement()
+	if _, ok := increments.Find(listItemCounter); isListItem && !ok {
+		// List items increment list-item counter, unless it's explicitly
+		// incremented. Reversed counters are decremented instead.
+		// https://www.w3.org/TR/css-lists-3/#list-item-counter
+		c := lists.Counter{Name: listItemCounter, Value: 1}
+		if i := cr.innermostIndex(listItemCounter); i != -1 && cr.stack[i].isReversed {
+			c.Value = -1
+		}
+		increments = append(slices.Clone(increments), c)
+	}
+	for _, c := range increments {
+		inst := cr.counterFor(c.Name, parent)
+		inst.value += c.Value
+		inst.recordOp(ref).increment += c.Value
+	}
+	for _, c := range styleSet.CounterSet() {
+		inst := cr.counterFor(c.Name, parent)
+		op := inst.recordOp(ref)
+		op.hasSet, op.setValue = true, c.Value
+		inst.value, inst.isRelative = c.Value, false
+	}
+
+	snapshot := make([]counterValue, len(cr.stack))
+	for i, inst := range cr.stack {
+		snapshot[i] = counterValue{inst: inst, value: inst.value, isRelative: inst.isRelative}
+	}
+	cr.snapshots[ref] = snapshot
+}
+
+// innermostIndex returns index of the innermost counter named name in the
+// stack, or -1 if there's none.
+func (cr *counterResolver) innermostIndex(name string) int {
+	for i := len(cr.stack) - 1; 0 <= i; i-- {
+		if cr.stack[i].name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// counterFor returns the innermost counter named name. If there's no such
+// counter, new counter is instantiated with zero.
+//
+// Spec: https://www.w3.org/TR/css-lists-3/#increment-set
+func (cr *counterResolver) counterFor(name string, parent dom.Node) *counterInstance {
+	if i := cr.innermostIndex(name); i != -1 {
+		return cr.stack[i]
+	}
+	inst := &counterInstance{name: name, scope: parent}
+	cr.stack = append(cr.stack, inst)
+	return inst
+}
+
+// recordOp returns counterOp for ref, to record operations done by ref.
+// Returns a dummy counterOp if the counter doesn't need them.
+func (inst *counterInstance) recordOp(ref selector.PseudoElementRef) *counterOp {
+	if !inst.isRelative {
+		return &counterOp{}
+	}
+	if len(inst.ops) == 0 || inst.ops[len(inst.ops)-1].ref != ref {
+		inst.ops = append(inst.ops, counterOp{ref: ref})
+	}
+	return &inst.ops[len(inst.ops)-1]
+}
+
+// finish calculates start value of the counter, if it's a reversed counter
+// without start value. This must be called when the counter goes out of scope.
+//
+// Spec: https://www.w3.org/TR/css-lists-3/#instantiating-counters
+func (inst *counterInstance) finish() {
+	if len(inst.ops) == 0 {
+		return
+	}
+	num := 0
+	for i, op := range inst.ops {
+		incrementNegated := -op.increment
+		if i == 0 {
+			num += incrementNegated
+		}
+		if op.hasSet {
+			num += op.setValue
+			break
+		}
+		num += incrementNegated
+	}
+	inst.start = num
+	inst.ops = nil
+}
+
+// counterValueOf returns value of the innermost counter named name, of the
+// element or pseudo-element ref. If there's no such counter, zero is returned.
+//
+// Spec: https://www.w3.org/TR/css-lists-3/#counter-functions
+func (tb treeBuilder) counterValueOf(ref selector.PseudoElementRef, name string) int {
+	counters := tb.gc.counters[ref]
+	for i := len(counters) - 1; 0 <= i; i-- {
+		if counters[i].inst.name == name {
+			return counters[i].resolve()
+		}
+	}
+	return 0
+}
@@ -0,0 +1,386 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"math"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/align"
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/display"
+	"github.com/inseo-oh/yw/css/flexbox"
+	"github.com/inseo-oh/yw/css/sizing"
+	"github.com/inseo-oh/yw/css/values"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/layout"
+	"github.com/inseo-oh/yw/util"
+)
+
+// isFlexContainer reports whether elem generates a flex container.
+func isFlexContainer(elem dom.Element) bool {
+	if util.IsNil(elem) {
+		return false
+	}
+	styleDisplay := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Display()
+	return styleDisplay.Mode == display.OuterInnerMode && styleDisplay.InnerMode == display.Flex
+}
+
+// flexItem holds states of a flex item during flex layout.
+type flexItem struct {
+	layout.FlexItem
+	*containerItem
+}
+
+// flexAxes holds direction dependent states of a flex container.
+type flexAxes struct {
+	isRow                         bool
+	availableMain, availableCross layout.PhysicalPos // +Inf if it's indefinite
+	mainGap, crossGap             layout.PhysicalPos
+}
+
+// mainAndCross converts physical width and height into main and cross sizes.
+func (ax flexAxes) mainAndCross(width, height layout.PhysicalPos) (main, cross layout.PhysicalPos) {
+	if ax.isRow {
+		return width, height
+	}
+	return height, width
+}
+
+// layoutFlexContainer lays out children of bcon, which is a flex container.
+//
+// Spec: https://www.w3.org/TR/css-flexbox-1/#layout-algorithm
+func (tb treeBuilder) layoutFlexContainer(bcon *layout.BlockContainerBox, children []dom.Node, textDecors []gfx.TextDecorOptions) {
+	styleSetSrc := cssom.ComputedStyleSetSourceOf(bcon.Elem)
+	styleSet := styleSetSrc.ComputedStyleSet()
+	direction := styleSet.FlexDirection()
+	wrap := styleSet.FlexWrap()
+	isSingleLine := wrap == flexbox.NoWrap
+	contentRect := bcon.BoxContentRect().ToPhysicalRect()
+
+	//==========================================================================
+	// Determine the available space
+	// https://www.w3.org/TR/css-flexbox-1/#algo-available
+	//==========================================================================
+	availableWidth := contentRect.Width
+	if bcon.IsWidthAuto() {
+		// Inline-level flex containers with auto width fit into the containing block.
+		parentWidth := bcon.ParentBcon.BoxContentRect().ToPhysicalRect().Width
+		availableWidth = max(parentWidth-(bcon.Margin.HorizontalSum()+bcon.Border.HorizontalSum()+bcon.Padding.HorizontalSum()), 0)
+	}
+	availableHeight := layout.PhysicalPos(math.Inf(1))
+	if !bcon.IsHeightAuto() {
+		availableHeight = contentRect.Height
+	}
+	fontSize := func() css.Num { return css.NumFromFloat(fontSizeOf(styleSetSrc)) }
+	rowGap := containerGap(styleSet.RowGap(), availableHeight, fontSize)
+	columnGap := containerGap(styleSet.ColumnGap(), availableWidth, fontSize)
+	ax := flexAxes{isRow: direction.IsRow()}
+	if ax.isRow {
+		ax.availableMain, ax.availableCross = availableWidth, availableHeight
+		ax.mainGap, ax.crossGap = columnGap, rowGap
+	} else {
+		ax.availableMain, ax.availableCross = availableHeight, availableWidth
+		ax.mainGap, ax.crossGap = rowGap, columnGap
+	}
+	isCrossDefinite := !bcon.IsHeightAuto()
+	if !ax.isRow {
+		isCrossDefinite = !bcon.IsWidthAuto()
+	}
+
+	//==========================================================================
+	// Determine the flex base size and hypothetical main size of each item
+	// https://www.w3.org/TR/css-flexbox-1/#algo-main-item
+	//==========================================================================
+	items := []*flexItem{}
+	for _, ci := range tb.collectContainerItems(bcon, bcon.Ffc, children, textDecors) {
+		items = append(items, &flexItem{containerItem: ci})
+	}
+	flexItems := make([]*layout.FlexItem, len(items))
+	itemOf := map[*layout.FlexItem]*flexItem{}
+	for i, it := range items {
+		tb.initFlexItem(bcon, it, ax, textDecors)
+		flexItems[i] = &it.FlexItem
+		itemOf[&it.FlexItem] = it
+	}
+
+	//==========================================================================
+	// Collect flex items into flex lines, and resolve flexible lengths
+	// https://www.w3.org/TR/css-flexbox-1/#main-sizing
+	//==========================================================================
+	ffc := bcon.Ffc
+	ffc.CollectLines(flexItems, ax.availableMain, isSingleLine, ax.mainGap)
+	for i := range ffc.Lines {
+		ffc.Lines[i].ResolveFlexibleLengths(ax.availableMain, ax.mainGap)
+	}
+
+	//==========================================================================
+	// Determine the hypothetical cross size of each item, by laying it out with
+	// the used main size.
+	// https://www.w3.org/TR/css-flexbox-1/#cross-sizing
+	//==========================================================================
+	for _, it := range items {
+		if ax.isRow {
+			tb.layoutContainerItemBox(bcon, bcon.Ffc, it.containerItem, it.TargetMainSize, false, textDecors)
+		} else {
+			width, isShrinkToFit := it.crossWidth(ax.availableCross)
+			tb.layoutContainerItemBox(bcon, bcon.Ffc, it.containerItem, width, isShrinkToFit, textDecors)
+		}
+	}
+
+	// Calculate the cross size of each flex line.
+	// https://www.w3.org/TR/css-flexbox-1/#algo-cross-line
+	var sumLineCrossSizes layout.PhysicalPos
+	for i := range ffc.Lines {
+		line := &ffc.Lines[i]
+		if isSingleLine && isCrossDefinite {
+			line.CrossSize = ax.availableCross
+		} else {
+			for _, fi := range line.Items {
+				_, outerCross := ax.mainAndCross(itemOf[fi].bcon.BoxMarginRect().ToPhysicalRect().Width, itemOf[fi].bcon.BoxMarginRect().ToPhysicalRect().Height)
+				line.CrossSize = max(line.CrossSize, outerCross)
+			}
+		}
+		sumLineCrossSizes += line.CrossSize
+	}
+	sumLineCrossSizes += ax.crossGap * layout.PhysicalPos(max(len(ffc.Lines)-1, 0))
+	// align-content: normal behaves as stretch, so extra space is distributed to lines.
+	// https://www.w3.org/TR/css-flexbox-1/#algo-line-stretch
+	if !isSingleLine && isCrossDefinite && sumLineCrossSizes < ax.availableCross && len(ffc.Lines) != 0 {
+		extra := (ax.availableCross - sumLineCrossSizes) / layout.PhysicalPos(len(ffc.Lines))
+		for i := range ffc.Lines {
+			ffc.Lines[i].CrossSize += extra
+		}
+		sumLineCrossSizes = ax.availableCross
+	}
+
+	// Stretch items, and apply the used main size to items in column direction.
+	// https://www.w3.org/TR/css-flexbox-1/#algo-stretch
+	for _, line := range ffc.Lines {
+		for _, fi := range line.Items {
+			it := itemOf[fi]
+			if ax.isRow {
+				if it.alignSelf == align.ItemStretch && it.sizes.heightAuto {
+					height := it.sizes.heightRange.clamp(line.CrossSize - it.verticalEdges())
+					it.bcon.IncrementSize(0, layout.LogicalPos(height)-it.bcon.LogicalHeight())
+				}
+			} else {
+				if it.alignSelf == align.ItemStretch && it.sizes.widthAuto {
+					width := it.sizes.widthRange.clamp(line.CrossSize - it.horizontalEdges())
+					tb.layoutContainerItemBox(bcon, bcon.Ffc, it.containerItem, width, false, textDecors)
+				}
+				it.bcon.IncrementSize(0, layout.LogicalPos(it.TargetMainSize)-it.bcon.LogicalHeight())
+			}
+		}
+	}
+
+	//==========================================================================
+	// Determine the size of the flex container
+	//==========================================================================
+	containerMain, containerCross := ax.availableMain, ax.availableCross
+	if math.IsInf(float64(containerMain), 1) || (ax.isRow && bcon.IsWidthAuto()) {
+		containerMain = 0
+		for _, line := range ffc.Lines {
+			lineMain := ax.mainGap * layout.PhysicalPos(max(len(line.Items)-1, 0))
+			for _, fi := range line.Items {
+				lineMain += fi.OuterTargetMainSize()
+			}
+			containerMain = max(containerMain, lineMain)
+		}
+	}
+	if !isCrossDefinite {
+		containerCross = sumLineCrossSizes
+	}
+	containerWidth, containerHeight := containerMain, containerCross
+	if !ax.isRow {
+		containerWidth, containerHeight = containerCross, containerMain
+	}
+	if bcon.IsWidthAuto() {
+		bcon.IncrementSize(layout.LogicalPos(containerWidth)-bcon.LogicalWidth(), 0)
+	}
+	ffc.IncrementNaturalPos(layout.LogicalPos(containerHeight))
+
+	//==========================================================================
+	// Align items in the main and cross axis, and place them.
+	// https://www.w3.org/TR/css-flexbox-1/#main-alignment
+	// https://www.w3.org/TR/css-flexbox-1/#cross-alignment
+	//==========================================================================
+	justify := styleSet.JustifyContent()
+	if direction.IsReverse() {
+		// start and end are not flipped by reversed flex direction, so we flip
+		// them here, as we place items from the main-start.
+		switch justify {
+		case align.ContentStart:
+			justify = align.ContentEnd
+		case align.ContentEnd:
+			justify = align.ContentStart
+		}
+	}
+	var lineCrossPos layout.PhysicalPos
+	for _, line := range ffc.Lines {
+		freeSpace := containerMain - ax.mainGap*layout.PhysicalPos(max(len(line.Items)-1, 0))
+		for _, fi := range line.Items {
+			freeSpace -= fi.OuterTargetMainSize()
+		}
+		offset, between := justifyOffsets(justify, freeSpace, len(line.Items))
+
+		mainPos := offset
+		for _, fi := range line.Items {
+			it := itemOf[fi]
+			outerRect := it.bcon.BoxMarginRect().ToPhysicalRect()
+			outerMain, outerCross := ax.mainAndCross(outerRect.Width, outerRect.Height)
+
+			itemMainPos := mainPos
+			if direction.IsReverse() {
+				itemMainPos = containerMain - mainPos - outerMain
+			}
+			mainPos += outerMain + ax.mainGap + between
+
+			var itemCrossPos layout.PhysicalPos
+			switch it.alignSelf {
+			case align.ItemEnd, align.ItemFlexEnd:
+				itemCrossPos = line.CrossSize - outerCross
+			case align.ItemCenter:
+				itemCrossPos = (line.CrossSize - outerCross) / 2
+			default:
+				// TODO: Support baseline alignment
+			}
+			itemCrossPos += lineCrossPos
+			if wrap == flexbox.WrapReverse {
+				itemCrossPos = containerCross - itemCrossPos - outerCross
+			}
+
+			left, top := contentRect.Left+itemMainPos, contentRect.Top+itemCrossPos
+			if !ax.isRow {
+				left, top = contentRect.Left+itemCrossPos, contentRect.Top+itemMainPos
+			}
+			it.bcon.Translate(it.bcon.BoxMarginRect().WritingMode.PhysicalOffsetToLogical(left-outerRect.Left, top-outerRect.Top))
+		}
+		lineCrossPos += line.CrossSize + ax.crossGap
+	}
+
+	for _, it := range items {
+		bcon.AddChildBox(it.bcon)
+		if !util.IsNil(it.elem) {
+			tb.finishPositionedLayout(it.bcon, it.elem, bcon)
+		}
+	}
+}
+
+// initFlexItem computes sizes of it, and its flex base size.
+//
+// Spec: https://www.w3.org/TR/css-flexbox-1/#algo-main-item
+func (tb treeBuilder) initFlexItem(container *layout.BlockContainerBox, it *flexItem, ax flexAxes, textDecors []gfx.TextDecorOptions) {
+	initContainerItem(container, it.containerItem)
+	it.GrowFactor, it.ShrinkFactor = 0, 1
+	basis := sizing.Size{Type: sizing.Auto}
+	if !util.IsNil(it.elem) {
+		styleSetSrc := cssom.ComputedStyleSetSourceOf(it.elem)
+		styleSet := styleSetSrc.ComputedStyleSet()
+		it.GrowFactor = float64(styleSet.FlexGrow())
+		it.ShrinkFactor = float64(styleSet.FlexShrink())
+		basis = styleSet.FlexBasis()
+
+		// Resolve definite flex-basis.
+		// Percentages are resolved against the container's inner main size,
+		// and treated as content if it's indefinite.
+		_, isPercentage := basis.Size.(values.Percentage)
+		if basis.Type == sizing.ManualSize && (!isPercentage || !math.IsInf(float64(ax.availableMain), 1)) {
+			fontSize := func() css.Num { return css.NumFromFloat(fontSizeOf(styleSetSrc)) }
+			containerSize := func() css.Num { return css.NumFromFloat(float64(ax.availableMain)) }
+			size := layout.PhysicalPos(basis.ComputeUsedValue(containerSize).ToPx(fontSize))
+			if styleSet.BoxSizing() == sizing.BorderBox {
+				edges := it.border.HorizontalSum() + it.padding.HorizontalSum()
+				if !ax.isRow {
+					edges = it.border.VerticalSum() + it.padding.VerticalSum()
+				}
+				size -= edges
+			}
+			basis = sizing.Size{Type: sizing.ManualSize, Size: values.LengthFromPx(float64(max(size, 0)))}
+		} else if basis.Type == sizing.ManualSize {
+			basis = sizing.Size{Type: sizing.MaxContent}
+		}
+	}
+	// TODO: Automatic minimum size of flex items should be content-based.
+	// https://www.w3.org/TR/css-flexbox-1/#min-size-auto
+	mainSize, mainAuto, mainRange := it.sizes.width, it.sizes.widthAuto, it.sizes.widthRange
+	it.OuterEdges = it.horizontalEdges()
+	if !ax.isRow {
+		mainSize, mainAuto, mainRange = it.sizes.height, it.sizes.heightAuto, it.sizes.heightRange
+		it.OuterEdges = it.verticalEdges()
+	}
+	it.MinMainSize, it.MaxMainSize = mainRange.min, mainRange.max
+
+	switch {
+	case basis.Type == sizing.ManualSize:
+		it.FlexBaseSize = layout.PhysicalPos(basis.Size.(values.Length).ToPx(nil))
+	case basis.Type == sizing.Auto && !mainAuto:
+		// flex-basis: auto uses the main size property.
+		it.FlexBaseSize = mainSize
+	default:
+		// Otherwise, flex base size is the max-content size of the item.
+		if ax.isRow {
+			availableWidth := layout.PhysicalPos(math.Inf(1))
+			if !math.IsInf(float64(ax.availableMain), 1) {
+				availableWidth = max(ax.availableMain-it.OuterEdges, 0)
+			}
+			tb.layoutContainerItemBox(container, container.Ffc, it.containerItem, availableWidth, true, textDecors)
+			it.FlexBaseSize = layout.PhysicalPos(it.bcon.LogicalWidth())
+		} else {
+			width, isShrinkToFit := it.crossWidth(ax.availableCross)
+			tb.layoutContainerItemBox(container, container.Ffc, it.containerItem, width, isShrinkToFit, textDecors)
+			it.FlexBaseSize = layout.PhysicalPos(it.bcon.LogicalHeight())
+		}
+	}
+}
+
+// crossWidth returns content width of it, when flex container's main axis is
+// vertical and the available width is availableWidth. If the width depends on
+// its contents, isShrinkToFit is set.
+func (it *flexItem) crossWidth(availableWidth layout.PhysicalPos) (width layout.PhysicalPos, isShrinkToFit bool) {
+	if !it.sizes.widthAuto {
+		return it.sizes.widthRange.clamp(it.sizes.width), false
+	}
+	width = max(availableWidth-it.horizontalEdges(), 0)
+	if it.alignSelf == align.ItemStretch {
+		return it.sizes.widthRange.clamp(width), false
+	}
+	return width, true
+}
+
+// justifyOffsets returns the position of the first item, and extra space
+// between items, when justify-content is justify and the line has freeSpace.
+//
+// Spec: https://www.w3.org/TR/css-align-3/#distribution-values
+func justifyOffsets(justify align.Content, freeSpace layout.PhysicalPos, itemCount int) (offset, between layout.PhysicalPos) {
+	switch justify {
+	case align.ContentEnd, align.ContentFlexEnd:
+		return freeSpace, 0
+	case align.ContentCenter:
+		return freeSpace / 2, 0
+	case align.ContentSpaceBetween:
+		// If there's no space to distribute, it behaves as flex-start.
+		if freeSpace <= 0 || itemCount < 2 {
+			return 0, 0
+		}
+		return 0, freeSpace / layout.PhysicalPos(itemCount-1)
+	case align.ContentSpaceAround:
+		// If there's no space to distribute, it behaves as center.
+		if freeSpace <= 0 || itemCount < 1 {
+			return freeSpace / 2, 0
+		}
+		between = freeSpace / layout.PhysicalPos(itemCount)
+		return between / 2, between
+	case align.ContentSpaceEvenly:
+		if freeSpace <= 0 || itemCount < 1 {
+			return freeSpace / 2, 0
+		}
+		between = freeSpace / layout.PhysicalPos(itemCount+1)
+		return between, between
+	}
+	// normal, start, flex-start, stretch
+	return 0, 0
+}
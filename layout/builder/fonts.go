@@ -0,0 +1,179 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"log"
+	"unicode"
+
+	"github.com/inseo-oh/yw/css/fonts"
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/layout"
+	"github.com/inseo-oh/yw/platform"
+	"github.com/inseo-oh/yw/platform/fontdb"
+)
+
+// fontState holds fonts used by the layout.
+type fontState struct {
+	provider  platform.FontProvider
+	db        *fontdb.Database
+	opened    map[fontKey]gfx.Font     // Fonts opened so far, nil if it couldn't be opened
+	fallbacks map[string][]fontdb.Face // Results of fontdb.Database.Fallbacks, keyed by the query
+}
+
+// fontKey identifies a face within a font file.
+type fontKey struct {
+	path  string
+	index int
+}
+
+// open returns the font for the face, opening it if needed. ok is false if
+// the face couldn't be opened.
+func (fs *fontState) open(face fontdb.Face) (font gfx.Font, ok bool) {
+	key := fontKey{face.Path, face.Index}
+	font, ok = fs.opened[key]
+	if !ok {
+		var err error
+		font, err = fs.provider.OpenFont(face.Path, face.Index)
+		if err != nil {
+			log.Printf("Skipping font %s (index %d): %v", face.Path, face.Index, err)
+			font = nil
+		}
+		fs.opened[key] = font
+	}
+	return font, font != nil
+}
+
+// missingFont is used when none of fonts in the chain could be opened. It has
+// no glyphs, and draws nothing.
+type missingFont struct{}
+
+func (fnt missingFont) SetTextSize(size int)     {}
+func (fnt missingFont) Metrics() gfx.FontMetrics { return gfx.FontMetrics{} }
+func (fnt missingFont) HasGlyph(r rune) bool     { return false }
+func (fnt missingFont) DrawText(text string, dest *image.RGBA, offsetX, offsetY int, textColor color.Color) image.Rectangle {
+	return image.Rectangle{}
+}
+
+// fontChain is list of fonts used for a text, in order of preference.
+// Characters missing from the first font(the primary font) are drawn using
+// one of fonts after it. Faces that couldn't be opened are skipped.
+type fontChain struct {
+	fs    *fontState
+	faces []fontdb.Face
+	size  int // Text size to set before measuring
+}
+
+// primary returns the first font in the chain that could be opened.
+func (fc *fontChain) primary() gfx.Font {
+	for _, face := range fc.faces {
+		if font, ok := fc.fs.open(face); ok {
+			return font
+		}
+	}
+	return missingFont{}
+}
+
+// fontFor returns the first font in the chain that has a glyph for r. If no
+// fonts have it, the primary font is used.
+//
+// Faces whose coverage is known are only opened if they have r.
+func (fc *fontChain) fontFor(r rune) gfx.Font {
+	for _, face := range fc.faces {
+		if face.Coverage != nil && !face.Coverage.Has(r) {
+			continue
+		}
+		if font, ok := fc.fs.open(face); ok && font.HasGlyph(r) {
+			return font
+		}
+	}
+	return fc.primary()
+}
+
+// fontRun is part of a text drawn with single font.
+type fontRun struct {
+	text string
+	font gfx.Font
+}
+
+// runsOf splits str into runs, so that each character is drawn with the first
+// font in the chain that has a glyph for it.
+//
+// Combining marks and format characters(e.g. ZWJ) stay with the preceding
+// character, and so do spaces if the current font has them.
+//
+// Spec: https://www.w3.org/TR/css-fonts-3/#cluster-matching
+func (fc *fontChain) runsOf(str string) []fontRun {
+	res := []fontRun{}
+	var curr gfx.Font
+	runStart := 0
+	for i, r := range str {
+		var font gfx.Font
+		switch {
+		case curr != nil && unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+			font = curr
+		case curr != nil && unicode.IsSpace(r) && curr.HasGlyph(r):
+			font = curr
+		default:
+			font = fc.fontFor(r)
+		}
+		if curr != nil && font != curr {
+			res = append(res, fontRun{str[runStart:i], curr})
+			runStart = i
+		}
+		curr = font
+	}
+	if runStart < len(str) || len(res) == 0 {
+		if curr == nil {
+			curr = fc.primary()
+		}
+		res = append(res, fontRun{str[runStart:], curr})
+	}
+	return res
+}
+
+// measure returns the advance of str along the inline axis, when drawn in
+// given orientation.
+func (fc *fontChain) measure(str string, orientation gfx.TextOrientation) layout.LogicalPos {
+	res := layout.LogicalPos(0)
+	for _, run := range fc.runsOf(str) {
+		run.font.SetTextSize(fc.size)
+		res += layout.LogicalPos(gfx.MeasureOrientedText(run.font, run.text, orientation))
+	}
+	return res
+}
+
+// fontChainOf returns fonts used for texts with style from styleSetSrc.
+//
+// Spec: https://www.w3.org/TR/css-fonts-3/#font-matching-algorithm
+func (tb treeBuilder) fontChainOf(styleSetSrc props.ComputedStyleSetSource) *fontChain {
+	styleSet := styleSetSrc.ComputedStyleSet()
+	// Relative weights were resolved by the cascade, so the parent's weight
+	// isn't needed here.
+	q := fontdb.Query{
+		Families: styleSet.FontFamily(),
+		Weight:   styleSet.FontWeight().ResolveWeight(fonts.NormalWeight),
+		Style:    styleSet.FontStyle(),
+		Stretch:  styleSet.FontStretch(),
+	}
+	key := fmt.Sprint(q)
+	faces, ok := tb.fonts.fallbacks[key]
+	if !ok {
+		faces = tb.fonts.db.Fallbacks(q)
+		if len(faces) == 0 {
+			faces = []fontdb.Face{{Path: fontdb.DefaultFontPath}}
+		}
+		tb.fonts.fallbacks[key] = faces
+	}
+	return &fontChain{
+		fs:    tb.fonts,
+		faces: faces,
+		size:  int(fontSizeOf(styleSetSrc)),
+	}
+}
@@ -0,0 +1,217 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"log"
+	"strings"
+
+	"github.com/inseo-oh/yw/css/content"
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/display"
+	"github.com/inseo-oh/yw/css/lists"
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/css/selector"
+	"github.com/inseo-oh/yw/dom"
+)
+
+// defaultQuotes is list of quote pairs used by open-quote and close-quote,
+// from outermost to innermost level.
+//
+// TODO: Support the quotes property
+var defaultQuotes = [][2]string{
+	{"“", "”"},
+	{"‘", "’"},
+}
+
+// generatedContentState holds states needed to generate contents of pseudo-elements.
+// It is shared across the whole layout tree.
+type generatedContentState struct {
+	quoteDepth  int                                          // Current nesting level of quotes
+	pseudoElems map[dom.Element]selector.PseudoElementRef    // Pseudo-element nodes we made, and pseudo-elements they represent
+	counters    map[selector.PseudoElementRef][]counterValue // Counters of each element and pseudo-element (See resolveCounters)
+}
+
+// childNodesOf returns child nodes of elem to layout, including ::before and
+// ::after pseudo-elements, as well as inside ::marker pseudo-element.
+//
+// If elem is a pseudo-element node, its generated content is returned instead.
+// Because of this, this must be called when elem is about to be laid out,
+// so that quotes are nested in the right order.
+//
+// Elements with display: contents don't generate boxes, so their child nodes
+// are returned in place of them.
+//
+// TODO: Contents of display: contents elements are generated before preceding
+// siblings are laid out, so quotes inside them may not be nested correctly.
+func (tb treeBuilder) childNodesOf(elem dom.Element) []dom.Node {
+	if ref, ok := tb.gc.pseudoElems[elem]; ok {
+		return tb.generateContent(elem, ref)
+	}
+	if imgElem, ok := elem.(imageElement); ok {
+		return tb.imageFallbackNodes(elem, imgElem)
+	}
+	res := []dom.Node{}
+	if !hasOutsideMarker(elem) {
+		if marker := tb.makePseudoElement(elem, "marker"); marker != nil {
+			res = append(res, marker)
+		}
+	}
+	if before := tb.makePseudoElement(elem, "before"); before != nil {
+		res = append(res, before)
+	}
+	res = append(res, elem.Children()...)
+	if after := tb.makePseudoElement(elem, "after"); after != nil {
+		res = append(res, after)
+	}
+	return tb.spliceDisplayContents(res)
+}
+
+// spliceDisplayContents replaces elements with display: contents in nodes
+// with their child nodes.
+//
+// Spec: https://www.w3.org/TR/css-display-3/#valdef-display-contents
+func (tb treeBuilder) spliceDisplayContents(nodes []dom.Node) []dom.Node {
+	res := []dom.Node{}
+	for _, node := range nodes {
+		if elem, ok := node.(dom.Element); ok && isDisplayContents(elem) {
+			res = append(res, tb.childNodesOf(elem)...)
+			continue
+		}
+		res = append(res, node)
+	}
+	return res
+}
+
+// isDisplayContents reports whether elem has display: contents.
+func isDisplayContents(elem dom.Element) bool {
+	return cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Display().Mode == display.Contents
+}
+
+// pseudoElementStyleSet returns computed style of the pseudo-element named
+// name, originating from elem. Returns nil if the pseudo-element doesn't
+// generate a box.
+func pseudoElementStyleSet(elem dom.Element, name string) *props.ComputedStyleSet {
+	styleSet, ok := cssom.ElementDataOf(elem).PseudoElementStyleSets[name]
+	if name == "marker" {
+		// ::marker is only generated by list items, but it doesn't need
+		// matching style rules.
+		// https://www.w3.org/TR/css-lists-3/#marker-pseudo
+		if !isListItem(elem) {
+			return nil
+		}
+		if !ok {
+			styleSet = &props.ComputedStyleSet{}
+			styleSet.InheritPropertiesFromParent(cssom.ComputedStyleSetSourceOf(elem))
+		}
+		switch styleSet.Content().Type {
+		case content.None:
+			return nil
+		case content.Normal:
+			// https://www.w3.org/TR/css-lists-3/#content-property
+			if styleSet.ListStyleImage() == lists.NoImage && styleSet.ListStyleType().Type == lists.NoStyleType {
+				return nil
+			}
+		}
+		return styleSet
+	}
+	if !ok {
+		return nil
+	}
+	// ::before and ::after are only generated if content is a <content-list>.
+	// (normal computes to none for these)
+	// https://www.w3.org/TR/css-content-3/#valdef-content-normal
+	if styleSet.Content().Type != content.List {
+		return nil
+	}
+	return styleSet
+}
+
+// makePseudoElement makes a DOM element representing the pseudo-element named
+// name, originating from elem. Returns nil if the pseudo-element doesn't
+// generate a box.
+//
+// The element is not inserted into the DOM tree, but its parent is set to elem,
+// so that it can be laid out like any other child element of elem.
+func (tb treeBuilder) makePseudoElement(elem dom.Element, name string) dom.Element {
+	styleSet := pseudoElementStyleSet(elem, name)
+	if styleSet == nil {
+		return nil
+	}
+	pseudoElem := dom.NewElement(dom.ElementCreationCommonOptions{
+		NodeDocument: elem.NodeDocument(),
+		LocalName:    "::" + name,
+	})
+	pseudoElem.SetParent(elem)
+	pseudoElem.SetCssData(&cssom.ElementData{ComputedStyleSet: *styleSet})
+	tb.gc.pseudoElems[pseudoElem] = selector.PseudoElementRef{Element: elem, Name: name}
+	return pseudoElem
+}
+
+// generateContent makes text node containing generated content of pseudoElem,
+// which represents the pseudo-element ref.
+//
+// Spec: https://www.w3.org/TR/css-content-3/#content-property
+func (tb treeBuilder) generateContent(pseudoElem dom.Element, ref selector.PseudoElementRef) []dom.Node {
+	styleSet := cssom.ElementDataOf(pseudoElem).ComputedStyleSet
+	sb := strings.Builder{}
+	if ref.Name == "marker" && styleSet.Content().Type != content.List {
+		sb.WriteString(tb.markerText(ref, styleSet.ListStyleType()))
+	}
+	for _, item := range styleSet.Content().Items {
+		switch item.Type {
+		case content.StringItem:
+			sb.WriteString(item.Value)
+		case content.AttrItem:
+			// Missing attribute results in an empty string.
+			if v, ok := ref.Element.AttrWithoutNamespace(item.Value); ok {
+				sb.WriteString(v)
+			}
+		case content.CounterItem:
+			sb.WriteString(formatCounter(tb.counterValueOf(ref, item.Value), item.CounterStyle))
+		case content.OpenQuote:
+			// https://www.w3.org/TR/css-content-3/#quote-values
+			quotes := defaultQuotes[min(tb.gc.quoteDepth, len(defaultQuotes)-1)]
+			sb.WriteString(quotes[0])
+			tb.gc.quoteDepth++
+		case content.CloseQuote:
+			// close-quote at depth 0 doesn't generate anything.
+			if tb.gc.quoteDepth != 0 {
+				tb.gc.quoteDepth--
+				quotes := defaultQuotes[min(tb.gc.quoteDepth, len(defaultQuotes)-1)]
+				sb.WriteString(quotes[1])
+			}
+		case content.NoOpenQuote:
+			tb.gc.quoteDepth++
+		case content.NoCloseQuote:
+			if tb.gc.quoteDepth != 0 {
+				tb.gc.quoteDepth--
+			}
+		default:
+			log.Printf("BUG: bad content item type %d", item.Type)
+		}
+	}
+	if sb.Len() == 0 {
+		return nil
+	}
+	txt := dom.NewText(pseudoElem.NodeDocument(), sb.String())
+	txt.SetParent(pseudoElem)
+	return []dom.Node{txt}
+}
+
+// formatCounter returns representation of counter value using the counter
+// style named style.
+//
+// Spec: https://www.w3.org/TR/css-counter-styles-3/#generate-a-counter
+func formatCounter(value int, style string) string {
+	if style == "none" {
+		return ""
+	}
+	s, ok := lists.CounterStyleByName(style)
+	if !ok {
+		log.Printf("TODO: Support counter style %s", style)
+	}
+	return s.Representation(value)
+}
@@ -0,0 +1,245 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"math"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/align"
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/display"
+	"github.com/inseo-oh/yw/css/grid"
+	"github.com/inseo-oh/yw/css/values"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/layout"
+	"github.com/inseo-oh/yw/util"
+)
+
+// isGridContainer reports whether elem generates a grid container.
+func isGridContainer(elem dom.Element) bool {
+	if util.IsNil(elem) {
+		return false
+	}
+	styleDisplay := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Display()
+	return styleDisplay.Mode == display.OuterInnerMode && styleDisplay.InnerMode == display.Grid
+}
+
+// layoutGridContainer lays out children of bcon, which is a grid container.
+//
+// Spec: https://www.w3.org/TR/css-grid-1/#layout-algorithm
+func (tb treeBuilder) layoutGridContainer(bcon *layout.BlockContainerBox, children []dom.Node, textDecors []gfx.TextDecorOptions) {
+	styleSetSrc := cssom.ComputedStyleSetSourceOf(bcon.Elem)
+	styleSet := styleSetSrc.ComputedStyleSet()
+	gfc := bcon.Gfc
+	contentRect := bcon.BoxContentRect().ToPhysicalRect()
+	fontSize := func() css.Num { return css.NumFromFloat(fontSizeOf(styleSetSrc)) }
+
+	// Inline-level grid containers with auto width are sized by their contents,
+	// and contents are measured against the containing block.
+	availableWidth, measureWidth := contentRect.Width, contentRect.Width
+	if bcon.IsWidthAuto() {
+		parentWidth := bcon.ParentBcon.BoxContentRect().ToPhysicalRect().Width
+		measureWidth = max(parentWidth-(bcon.Margin.HorizontalSum()+bcon.Border.HorizontalSum()+bcon.Padding.HorizontalSum()), 0)
+		availableWidth = layout.PhysicalPos(math.Inf(1))
+	}
+	availableHeight := layout.PhysicalPos(math.Inf(1))
+	if !bcon.IsHeightAuto() {
+		availableHeight = contentRect.Height
+	}
+	rowGap := containerGap(styleSet.RowGap(), availableHeight, fontSize)
+	columnGap := containerGap(styleSet.ColumnGap(), availableWidth, fontSize)
+
+	//==========================================================================
+	// Define the explicit grid, and place grid items into the grid.
+	// https://www.w3.org/TR/css-grid-1/#grid-definition
+	// https://www.w3.org/TR/css-grid-1/#placement
+	//==========================================================================
+	columnList, rowList := styleSet.GridTemplateColumns(), styleSet.GridTemplateRows()
+	columnTemplate := columnList.Expand(autoRepeatCount(columnList, availableWidth, columnGap, fontSize))
+	rowTemplate := rowList.Expand(autoRepeatCount(rowList, availableHeight, rowGap, fontSize))
+
+	items := tb.collectContainerItems(bcon, gfc, children, textDecors)
+	placements := make([]*layout.GridItemPlacement, len(items))
+	for i, it := range items {
+		initContainerItem(bcon, it)
+		placements[i] = &layout.GridItemPlacement{}
+		if !util.IsNil(it.elem) {
+			itemStyleSet := cssom.ComputedStyleSetSourceOf(it.elem).ComputedStyleSet()
+			placements[i].RowStart, placements[i].RowEnd = itemStyleSet.GridRowStart(), itemStyleSet.GridRowEnd()
+			placements[i].ColumnStart, placements[i].ColumnEnd = itemStyleSet.GridColumnStart(), itemStyleSet.GridColumnEnd()
+		}
+	}
+	columnCount, rowCount := gfc.PlaceItems(placements, len(columnTemplate), len(rowTemplate), styleSet.GridAutoFlow())
+	gfc.Columns = gridTracks(columnTemplate, gfc.ImplicitColumnsBefore, columnCount, styleSet.GridAutoColumns(), availableWidth, fontSize)
+	gfc.Rows = gridTracks(rowTemplate, gfc.ImplicitRowsBefore, rowCount, styleSet.GridAutoRows(), availableHeight, fontSize)
+
+	//==========================================================================
+	// Size the columns, and then rows using heights of items laid out with
+	// the column sizes.
+	// https://www.w3.org/TR/css-grid-1/#algo-overview
+	//==========================================================================
+	contributions := make([]layout.GridContribution, len(items))
+	for i, it := range items {
+		area := placements[i].Area
+		contributions[i] = layout.GridContribution{Start: area.ColumnStart, End: area.ColumnEnd}
+		if !it.sizes.widthAuto {
+			contributions[i].Size = it.sizes.widthRange.clamp(it.sizes.width) + it.horizontalEdges()
+			continue
+		}
+		tb.layoutContainerItemBox(bcon, gfc, it, max(measureWidth-it.horizontalEdges(), 0), true, textDecors)
+		contributions[i].Size = it.bcon.BoxMarginRect().ToPhysicalRect().Width
+	}
+	layout.SizeGridTracks(gfc.Columns, contributions, availableWidth, columnGap)
+	columnPositions := gridTrackPositions(gfc.Columns, columnGap)
+
+	for i, it := range items {
+		area := placements[i].Area
+		areaWidth := columnPositions[area.ColumnEnd] - columnPositions[area.ColumnStart] - columnGap
+		// TODO: Support justify-self. For now, items with auto width are stretched.
+		width := it.sizes.widthRange.clamp(max(areaWidth-it.horizontalEdges(), 0))
+		if !it.sizes.widthAuto {
+			width = it.sizes.widthRange.clamp(it.sizes.width)
+		}
+		tb.layoutContainerItemBox(bcon, gfc, it, width, false, textDecors)
+		contributions[i] = layout.GridContribution{Start: area.RowStart, End: area.RowEnd, Size: it.bcon.BoxMarginRect().ToPhysicalRect().Height}
+	}
+	layout.SizeGridTracks(gfc.Rows, contributions, availableHeight, rowGap)
+	rowPositions := gridTrackPositions(gfc.Rows, rowGap)
+
+	//==========================================================================
+	// Determine the size of the grid container
+	//==========================================================================
+	gridWidth := max(columnPositions[len(columnPositions)-1]-columnGap, 0)
+	gridHeight := max(rowPositions[len(rowPositions)-1]-rowGap, 0)
+	if bcon.IsWidthAuto() {
+		bcon.IncrementSize(layout.LogicalPos(gridWidth)-bcon.LogicalWidth(), 0)
+	}
+	gfc.IncrementNaturalPos(layout.LogicalPos(gridHeight))
+
+	//==========================================================================
+	// Align items in their grid areas, and place them.
+	// https://www.w3.org/TR/css-grid-1/#alignment
+	//==========================================================================
+	for i, it := range items {
+		area := placements[i].Area
+		areaHeight := rowPositions[area.RowEnd] - rowPositions[area.RowStart] - rowGap
+		if it.alignSelf == align.ItemStretch && it.sizes.heightAuto {
+			height := it.sizes.heightRange.clamp(areaHeight - it.verticalEdges())
+			it.bcon.IncrementSize(0, layout.LogicalPos(height)-it.bcon.LogicalHeight())
+		}
+		outerRect := it.bcon.BoxMarginRect().ToPhysicalRect()
+		var offset layout.PhysicalPos
+		switch it.alignSelf {
+		case align.ItemEnd, align.ItemFlexEnd:
+			offset = areaHeight - outerRect.Height
+		case align.ItemCenter:
+			offset = (areaHeight - outerRect.Height) / 2
+		default:
+			// TODO: Support baseline alignment
+		}
+		left := contentRect.Left + columnPositions[area.ColumnStart]
+		top := contentRect.Top + rowPositions[area.RowStart] + offset
+		it.bcon.Translate(it.bcon.BoxMarginRect().WritingMode.PhysicalOffsetToLogical(left-outerRect.Left, top-outerRect.Top))
+	}
+	for _, it := range items {
+		bcon.AddChildBox(it.bcon)
+		if !util.IsNil(it.elem) {
+			tb.finishPositionedLayout(it.bcon, it.elem, bcon)
+		}
+	}
+}
+
+// gridSizingFunction returns used sizing function for b. containerSize is
+// the size of grid container in the same axis (+Inf if it's indefinite).
+//
+// Spec: https://www.w3.org/TR/css-grid-1/#typedef-track-breadth
+func gridSizingFunction(b grid.Breadth, containerSize layout.PhysicalPos, fontSize func() css.Num) layout.GridSizingFunction {
+	switch b.Type {
+	case grid.BreadthLength:
+		if _, ok := b.Length.(values.Percentage); ok && math.IsInf(float64(containerSize), 1) {
+			// Percentages against indefinite size are treated as auto.
+			return layout.GridSizingFunction{Type: layout.GridSizingAuto}
+		}
+		containerSizeFn := func() css.Num { return css.NumFromFloat(float64(containerSize)) }
+		return layout.GridSizingFunction{Type: layout.GridSizingFixed, Size: layout.PhysicalPos(b.Length.AsLength(containerSizeFn).ToPx(fontSize))}
+	case grid.BreadthFlex:
+		return layout.GridSizingFunction{Type: layout.GridSizingFlex, Flex: b.Flex}
+	case grid.BreadthMinContent:
+		return layout.GridSizingFunction{Type: layout.GridSizingMinContent}
+	case grid.BreadthMaxContent:
+		return layout.GridSizingFunction{Type: layout.GridSizingMaxContent}
+	}
+	return layout.GridSizingFunction{Type: layout.GridSizingAuto}
+}
+
+// gridTracks returns count tracks of the implicit grid, where template is the
+// explicit grid starting after implicitBefore tracks, and implicit tracks are
+// sized by autoSize.
+//
+// Spec: https://www.w3.org/TR/css-grid-1/#implicit-grids
+func gridTracks(template []grid.TrackSize, implicitBefore, count int, autoSize grid.TrackSize, containerSize layout.PhysicalPos, fontSize func() css.Num) []layout.GridTrack {
+	tracks := make([]layout.GridTrack, count)
+	for i := range tracks {
+		size := autoSize
+		if idx := i - implicitBefore; 0 <= idx && idx < len(template) {
+			size = template[idx]
+		}
+		tracks[i] = layout.GridTrack{
+			Min: gridSizingFunction(size.Min, containerSize, fontSize),
+			Max: gridSizingFunction(size.Max, containerSize, fontSize),
+		}
+	}
+	return tracks
+}
+
+// gridTrackPositions returns starting position of each track, followed by the
+// end of the last track plus gap.
+func gridTrackPositions(tracks []layout.GridTrack, gap layout.PhysicalPos) []layout.PhysicalPos {
+	res := make([]layout.PhysicalPos, len(tracks)+1)
+	for i, t := range tracks {
+		res[i+1] = res[i] + t.BaseSize + gap
+	}
+	return res
+}
+
+// autoRepeatCount returns how many times tracks in repeat() with auto-fill or
+// auto-fit should be repeated, when the grid container's size is availableSize.
+//
+// TODO: Collapse empty tracks for auto-fit
+//
+// Spec: https://www.w3.org/TR/css-grid-1/#auto-repeat
+func autoRepeatCount(list grid.TrackList, availableSize, gap layout.PhysicalPos, fontSize func() css.Num) int {
+	if list.AutoRepeat == nil {
+		return 0
+	}
+	if math.IsInf(float64(availableSize), 1) {
+		return 1
+	}
+	// Each track is treated as its max sizing function, or min sizing function
+	// if that's not fixed.
+	trackSize := func(t grid.TrackSize) layout.PhysicalPos {
+		f := gridSizingFunction(t.Max, availableSize, fontSize)
+		if f.Type != layout.GridSizingFixed {
+			f = gridSizingFunction(t.Min, availableSize, fontSize)
+		}
+		if f.Type != layout.GridSizingFixed {
+			return 0
+		}
+		return f.Size
+	}
+	var usedSize, repeatSize layout.PhysicalPos
+	for _, t := range list.Tracks {
+		usedSize += trackSize(t) + gap
+	}
+	for _, t := range list.AutoRepeat.Tracks {
+		repeatSize += trackSize(t) + gap
+	}
+	if repeatSize <= 0 {
+		return 1
+	}
+	return max(int((availableSize-usedSize+gap)/repeatSize), 1)
+}
@@ -0,0 +1,623 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"math"
+	"strings"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/inline"
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/css/text"
+	"github.com/inseo-oh/yw/css/writingmodes"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/layout"
+	"github.com/inseo-oh/yw/util"
+)
+
+// lineAlignState holds lines waiting for text-align to be applied. Lines are
+// aligned after the whole tree is laid out, because shrink-to-fit sizing
+// measures contents as placed from the line-left edge.
+type lineAlignState struct {
+	pending []pendingLines
+}
+
+// pendingLines is inline contents of a block container, waiting for text-align.
+type pendingLines struct {
+	bcon      *layout.BlockContainerBox
+	root      *layout.InlineBox // Root inline box
+	fragments []bidiFragment    // Fragments in visual order
+	lineStart layout.LogicalPos // Line-left edge, relative to the content box of bcon
+}
+
+// inlineMetrics holds font metrics and the used line-height of an inline box.
+type inlineMetrics struct {
+	fontSize        float64
+	ascent, descent float64
+	lineHeight      float64
+}
+
+// halfLeading returns space added above and below the content area of the box.
+//
+// Spec: https://www.w3.org/TR/CSS2/visudet.html#leading
+func (m inlineMetrics) halfLeading() float64 {
+	return (m.lineHeight - (m.ascent + m.descent)) / 2
+}
+
+// usedLineHeight returns used value of line-height in styleSet, for the font
+// with given metrics and size.
+//
+// TODO: Lengths in em and percentages should be resolved against the font size
+// of the element that specified it, not the one inheriting it.
+//
+// Spec: https://www.w3.org/TR/css-inline-3/#line-height-property
+func usedLineHeight(styleSet *props.ComputedStyleSet, metrics gfx.FontMetrics, fontSize float64) float64 {
+	lineHeight := styleSet.LineHeight()
+	fontSizeNum := func() css.Num { return css.NumFromFloat(fontSize) }
+	switch lineHeight.Type {
+	case inline.NumberLineHeight:
+		return lineHeight.Number.ToFloat() * fontSize
+	case inline.LengthLineHeight:
+		return lineHeight.Length.AsLength(fontSizeNum).ToPx(fontSizeNum)
+	}
+	return metrics.LineHeight
+}
+
+// inlineMetricsOf returns metrics of an inline box bx.
+func (tb treeBuilder) inlineMetricsOf(bx layout.Box) inlineMetrics {
+	elem := closestDomElementForBox(bx)
+	if util.IsNil(elem) {
+		return inlineMetrics{}
+	}
+	styleSetSrc := cssom.ComputedStyleSetSourceOf(elem)
+	fontSize := fontSizeOf(styleSetSrc)
+	font := tb.fontChainOf(styleSetSrc).primary()
+	font.SetTextSize(int(fontSize))
+	metrics := font.Metrics()
+	return inlineMetrics{
+		fontSize:   fontSize,
+		ascent:     metrics.Ascender,
+		descent:    metrics.Descender,
+		lineHeight: usedLineHeight(styleSetSrc.ComputedStyleSet(), metrics, fontSize),
+	}
+}
+
+// setTextIndent applies text-indent of elem to the inline formatting context
+// of bcon.
+//
+// TODO: Anonymous block containers starting contents of an element should be
+// indented as well.
+//
+// Spec: https://www.w3.org/TR/css-text-3/#text-indent-property
+func setTextIndent(bcon *layout.BlockContainerBox, elem dom.Element) {
+	if util.IsNil(elem) {
+		return
+	}
+	styleSetSrc := cssom.ComputedStyleSetSourceOf(elem)
+	indent := styleSetSrc.ComputedStyleSet().TextIndent()
+	containerWidth := func() css.Num { return css.NumFromFloat(float64(bcon.BoxContentRect().LogicalWidth)) }
+	fontSize := func() css.Num { return css.NumFromFloat(fontSizeOf(styleSetSrc)) }
+	bcon.Ifc.TextIndent = layout.LogicalPos(indent.Value.AsLength(containerWidth).ToPx(fontSize))
+	bcon.Ifc.IndentHanging = indent.Hanging
+	bcon.Ifc.IndentEachLine = indent.EachLine
+}
+
+// finishLines finishes lines of bcon, after all inline contents were placed.
+// root is the root inline box of bcon.
+//
+// Contents are reordered for bidirectional text, and aligned vertically within
+// each line. Horizontal alignment is done later(See alignPendingLines).
+func (tb treeBuilder) finishLines(bcon *layout.BlockContainerBox, root *layout.InlineBox) {
+	para, ok := tb.bidi.paragraphs[bcon.Ifc]
+	if !ok {
+		para = &bidiParagraph{}
+	}
+	delete(tb.bidi.paragraphs, bcon.Ifc)
+	tb.reorderBidiText(bcon, root, para)
+	tb.alignLinesVertically(bcon, root, para.fragments)
+
+	lineStart, _ := computeNextPosition(bcon.Bfc, &layout.InlineFormattingContext{}, bcon, true)
+	tb.lines.pending = append(tb.lines.pending, pendingLines{
+		bcon:      bcon,
+		root:      root,
+		fragments: para.fragments,
+		lineStart: lineStart - bcon.BoxContentRect().LogicalX,
+	})
+}
+
+// inlineParentsOf returns parent inline box of each inline box under root.
+// Note that parent of inline boxes is the block container, so it can't be used
+// here.
+func inlineParentsOf(root *layout.InlineBox) map[layout.Box]layout.Box {
+	parents := map[layout.Box]layout.Box{}
+	var visit func(bx layout.Box)
+	visit = func(bx layout.Box) {
+		for _, child := range bx.ChildBoxes() {
+			if _, ok := child.(*layout.InlineBox); ok {
+				parents[child] = bx
+				visit(child)
+			}
+		}
+	}
+	visit(root)
+	return parents
+}
+
+// boxShift is baseline shift of a box given by vertical-align.
+type boxShift struct {
+	raise float64    // Baseline shift towards the line-over side, from the baseline of the line or the group
+	group layout.Box // Box aligned with top or bottom of the line, or nil
+	isTop bool       // Is group aligned with top of the line?
+}
+
+// verticalAligner computes vertical-align of boxes in a block container.
+//
+// Spec: https://www.w3.org/TR/css-inline-3/#transverse-alignment
+type verticalAligner struct {
+	tb      treeBuilder
+	root    *layout.InlineBox
+	parents map[layout.Box]layout.Box
+	shifts  map[layout.Box]boxShift
+	metrics map[layout.Box]inlineMetrics
+}
+
+// metricsOf returns metrics of an inline box bx.
+func (va *verticalAligner) metricsOf(bx layout.Box) inlineMetrics {
+	m, ok := va.metrics[bx]
+	if !ok {
+		m = va.tb.inlineMetricsOf(bx)
+		va.metrics[bx] = m
+	}
+	return m
+}
+
+// shiftOfInlineBox returns baseline shift of an inline box bx.
+func (va *verticalAligner) shiftOfInlineBox(bx layout.Box) boxShift {
+	if _, ok := bx.(*layout.InlineBox); !ok || bx == layout.Box(va.root) || util.IsNil(bx.BoxElement()) {
+		return boxShift{}
+	}
+	if s, ok := va.shifts[bx]; ok {
+		return s
+	}
+	m := va.metricsOf(bx)
+	s := va.shiftOf(bx, va.parents[bx], m.ascent+m.halfLeading(), m.descent+m.halfLeading())
+	va.shifts[bx] = s
+	return s
+}
+
+// shiftOf returns baseline shift of bx, whose parent inline box is parent.
+// ascent and descent are the extent of the box above and below its baseline.
+func (va *verticalAligner) shiftOf(bx, parent layout.Box, ascent, descent float64) boxShift {
+	if util.IsNil(parent) {
+		parent = va.root
+	}
+	s := va.shiftOfInlineBox(parent)
+	pm := va.metricsOf(parent)
+	styleSetSrc := cssom.ComputedStyleSetSourceOf(bx.BoxElement())
+	v := styleSetSrc.ComputedStyleSet().VerticalAlign()
+	switch v.Type {
+	case inline.Sub:
+		// TODO: Use the subscript offset from the font
+		s.raise -= pm.fontSize / 5
+	case inline.Super:
+		// TODO: Use the superscript offset from the font
+		s.raise += pm.fontSize / 3
+	case inline.TextTop:
+		s.raise += pm.ascent - ascent
+	case inline.TextBottom:
+		s.raise += descent - pm.descent
+	case inline.Middle:
+		// TODO: Use x-height of the font
+		s.raise += pm.fontSize/4 - (ascent-descent)/2
+	case inline.Top, inline.Bottom:
+		s = boxShift{group: bx, isTop: v.Type == inline.Top}
+	case inline.LengthVerticalAlign:
+		fontSize := func() css.Num { return css.NumFromFloat(fontSizeOf(styleSetSrc)) }
+		lineHeight := func() css.Num { return css.NumFromFloat(va.metricsOf(bx).lineHeight) }
+		s.raise += v.Length.AsLength(lineHeight).ToPx(fontSize)
+	}
+	return s
+}
+
+// lineExtent is extent of boxes aligned on a line, or a group of boxes aligned
+// with top or bottom of the line.
+type lineExtent struct {
+	ascent, descent float64
+	isTop           bool
+}
+
+func (e *lineExtent) add(raise, ascent, descent float64) {
+	e.ascent = max(e.ascent, raise+ascent)
+	e.descent = max(e.descent, descent-raise)
+}
+
+// atomicInlineAscent returns distance from the top margin edge of an atomic
+// inline bx to its baseline.
+//
+// Spec: https://www.w3.org/TR/CSS2/visudet.html#propdef-vertical-align
+func atomicInlineAscent(bx layout.Box) float64 {
+	rect := bx.BoxMarginRect()
+	if baseline, ok := lastLineBaselineOf(bx); ok {
+		return float64(baseline - rect.LogicalY)
+	}
+	return float64(rect.LogicalHeight)
+}
+
+// lastLineBaselineOf returns baseline of the last line box in bx.
+//
+// TODO: Boxes with overflow other than visible should use the bottom margin
+// edge instead.
+func lastLineBaselineOf(bx layout.Box) (layout.LogicalPos, bool) {
+	bcon, ok := bx.(*layout.BlockContainerBox)
+	if !ok {
+		return 0, false
+	}
+	if bcon.OwnsIfc {
+		if len(bcon.Ifc.LineBoxes) == 0 {
+			return 0, false
+		}
+		lb := bcon.Ifc.CurrentLineBox()
+		return lb.InitialLogicalY + lb.Baseline, true
+	}
+	children := bcon.ChildBoxes()
+	for i := len(children) - 1; 0 <= i; i-- {
+		child := children[i]
+		if child.BoxMarginRect().WritingMode != bcon.MarginRect.WritingMode {
+			continue
+		}
+		if baseline, ok := lastLineBaselineOf(child); ok {
+			return baseline, true
+		}
+	}
+	return 0, false
+}
+
+// alignLinesVertically aligns fragments on each line of bcon according to
+// vertical-align, and sizes lines to fit them.
+//
+// Spec: https://www.w3.org/TR/CSS2/visudet.html#line-height
+func (tb treeBuilder) alignLinesVertically(bcon *layout.BlockContainerBox, root *layout.InlineBox, frags []bidiFragment) {
+	ifc := bcon.Ifc
+	if len(ifc.LineBoxes) == 0 {
+		return
+	}
+	va := verticalAligner{
+		tb:      tb,
+		root:    root,
+		parents: inlineParentsOf(root),
+		shifts:  map[layout.Box]boxShift{},
+		metrics: map[layout.Box]inlineMetrics{},
+	}
+	lastLine := ifc.CurrentLineBox()
+	oldBottom := lastLine.InitialLogicalY + layout.LogicalPos(lastLine.CurrentLineHeight)
+	if n := len(ifc.LineBoxes); 1 < n && ifc.LineBoxes[n-2].HasForcedBreak && !hasFragmentsOnLine(frags, n-1) {
+		// Preserved newline at the end doesn't start a new line.
+		ifc.LineBoxes = ifc.LineBoxes[:n-1]
+	}
+
+	fragsOnLine := make([][]bidiFragment, len(ifc.LineBoxes))
+	for _, frag := range frags {
+		fragsOnLine[frag.lineIdx] = append(fragsOnLine[frag.lineIdx], frag)
+	}
+	placedBoxes := map[layout.Box]bool{}
+	lineTop := ifc.LineBoxes[0].InitialLogicalY
+	for lineIdx := range ifc.LineBoxes {
+		lb := &ifc.LineBoxes[lineIdx]
+		lb.InitialLogicalY = lineTop
+		rootMetrics := va.metricsOf(root)
+		if len(fragsOnLine[lineIdx]) == 0 {
+			lb.Baseline = layout.LogicalPos(rootMetrics.ascent + rootMetrics.halfLeading())
+			lineTop += layout.LogicalPos(lb.CurrentLineHeight)
+			continue
+		}
+
+		// Compute extent of the line. Each line begins with the strut of the
+		// root inline box.
+		main := lineExtent{}
+		main.add(0, rootMetrics.ascent+rootMetrics.halfLeading(), rootMetrics.descent+rootMetrics.halfLeading())
+		groups := map[layout.Box]*lineExtent{}
+		addBox := func(s boxShift, ascent, descent float64) {
+			if s.group == nil {
+				main.add(s.raise, ascent, descent)
+				return
+			}
+			e, ok := groups[s.group]
+			if !ok {
+				e = &lineExtent{isTop: s.isTop}
+				groups[s.group] = e
+			}
+			e.add(s.raise, ascent, descent)
+		}
+		inlineBoxes := []layout.Box{}
+		seenInlineBoxes := map[layout.Box]bool{}
+		for _, frag := range fragsOnLine[lineIdx] {
+			for bx := frag.owner; !util.IsNil(bx) && bx != layout.Box(root) && !seenInlineBoxes[bx]; bx = va.parents[bx] {
+				seenInlineBoxes[bx] = true
+				inlineBoxes = append(inlineBoxes, bx)
+				m := va.metricsOf(bx)
+				addBox(va.shiftOfInlineBox(bx), m.ascent+m.halfLeading(), m.descent+m.halfLeading())
+			}
+			if frag.box != nil {
+				ascent := atomicInlineAscent(frag.box)
+				descent := float64(frag.box.BoxMarginRect().LogicalHeight) - ascent
+				s := va.shiftOf(frag.box, frag.owner, ascent, descent)
+				va.shifts[frag.box] = s
+				addBox(s, ascent, descent)
+			}
+		}
+		lineHeight := main.ascent + main.descent
+		for _, e := range groups {
+			lineHeight = max(lineHeight, e.ascent+e.descent)
+		}
+		baselineOf := func(s boxShift) float64 {
+			if s.group == nil {
+				return float64(lineTop) + main.ascent - s.raise
+			}
+			e := groups[s.group]
+			if e.isTop {
+				return float64(lineTop) + e.ascent - s.raise
+			}
+			return float64(lineTop) + lineHeight - e.descent - s.raise
+		}
+
+		// Place contents
+		for _, frag := range fragsOnLine[lineIdx] {
+			if frag.text != nil {
+				m := va.metricsOf(frag.owner)
+				frag.text.Rect.LogicalY = layout.LogicalPos(baselineOf(va.shiftOfInlineBox(frag.owner)) - m.ascent)
+			} else if frag.box != nil {
+				top := baselineOf(va.shifts[frag.box]) - atomicInlineAscent(frag.box)
+				frag.box.Translate(0, layout.LogicalPos(top)-frag.box.BoxMarginRect().LogicalY)
+			}
+		}
+		for _, bx := range inlineBoxes {
+			ibox, ok := bx.(*layout.InlineBox)
+			if !ok || placedBoxes[bx] {
+				continue
+			}
+			// TODO: Inline boxes spanning multiple lines should have a fragment
+			// on each line.
+			placedBoxes[bx] = true
+			m := va.metricsOf(bx)
+			edges := ibox.MarginRect.WritingMode.LogicalEdges(ibox.Border)
+			paddings := ibox.MarginRect.WritingMode.LogicalEdges(ibox.Padding)
+			contentTop := baselineOf(va.shiftOfInlineBox(bx)) - m.ascent
+			ibox.MarginRect.LogicalY = layout.LogicalPos(contentTop) - layout.LogicalPos(edges.BlockStart+paddings.BlockStart)
+			ibox.MarginRect.LogicalHeight = layout.LogicalPos(m.ascent+m.descent) + layout.LogicalPos(edges.BlockSum()+paddings.BlockSum())
+		}
+
+		lb.CurrentLineHeight = lineHeight
+		lb.Baseline = layout.LogicalPos(main.ascent)
+		lineTop += layout.LogicalPos(lineHeight)
+	}
+
+	if root.IsHeightAuto() {
+		root.IncrementSize(0, lineTop-oldBottom)
+	}
+}
+
+// hasFragmentsOnLine reports whether any of frags is on the line at lineIdx.
+func hasFragmentsOnLine(frags []bidiFragment, lineIdx int) bool {
+	for _, frag := range frags {
+		if frag.lineIdx == lineIdx {
+			return true
+		}
+	}
+	return false
+}
+
+// alignPendingLines applies text-align to all lines waiting for it.
+func (tb treeBuilder) alignPendingLines() {
+	for _, pl := range tb.lines.pending {
+		tb.alignLinesHorizontally(pl)
+		tb.applyTextOverflow(pl)
+	}
+	tb.lines.pending = nil
+}
+
+// resolvedTextAlign returns text-align of elem, with start, end, and
+// match-parent resolved to left or right.
+//
+// Spec: https://www.w3.org/TR/css-text-3/#text-align-property
+func resolvedTextAlign(elem dom.Element) text.Align {
+	if util.IsNil(elem) {
+		return text.LeftAlign
+	}
+	styleSet := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet()
+	align := styleSet.TextAlign()
+	if align == text.MatchParent {
+		parent, ok := elem.Parent().(dom.Element)
+		if !ok {
+			return text.LeftAlign
+		}
+		styleSet = cssom.ComputedStyleSetSourceOf(parent).ComputedStyleSet()
+		align = styleSet.TextAlign()
+		if align == text.MatchParent {
+			return resolvedTextAlign(parent)
+		}
+	}
+	isRtl := styleSet.Direction() == writingmodes.Rtl
+	switch align {
+	case text.StartAlign:
+		if isRtl {
+			return text.RightAlign
+		}
+		return text.LeftAlign
+	case text.EndAlign:
+		if isRtl {
+			return text.LeftAlign
+		}
+		return text.RightAlign
+	}
+	return align
+}
+
+// alignLinesHorizontally aligns each line of pl according to text-align.
+//
+// Spec: https://www.w3.org/TR/css-text-3/#alignment
+func (tb treeBuilder) alignLinesHorizontally(pl pendingLines) {
+	if len(pl.fragments) == 0 {
+		return
+	}
+	ifc := pl.bcon.Ifc
+	elem := closestDomElementForBox(pl.bcon)
+	align := resolvedTextAlign(elem)
+	isRtl := false
+	if !util.IsNil(elem) {
+		isRtl = cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Direction() == writingmodes.Rtl
+	}
+	contentRect := pl.bcon.BoxContentRect()
+	parents := inlineParentsOf(pl.root)
+	movedBoxes := map[layout.Box]bool{}
+
+	for first := 0; first < len(pl.fragments); {
+		lineIdx := pl.fragments[first].lineIdx
+		last := first
+		for last < len(pl.fragments) && pl.fragments[last].lineIdx == lineIdx {
+			last++
+		}
+		frags := pl.fragments[first:last]
+		isLastLine := last == len(pl.fragments)
+		first = last
+
+		lb := ifc.LineBoxes[lineIdx]
+		minLeft, maxRight := layout.LogicalPos(math.Inf(1)), layout.LogicalPos(math.Inf(-1))
+		for _, frag := range frags {
+			var left, width layout.LogicalPos
+			if frag.text != nil {
+				left, width = frag.text.Rect.LogicalX, frag.text.Rect.LogicalWidth
+			} else if frag.box != nil {
+				rect := frag.box.BoxMarginRect()
+				left, width = rect.LogicalX, rect.LogicalWidth
+			} else {
+				// Spaces at the end of line hang, and are not aligned.
+				continue
+			}
+			minLeft = min(minLeft, left)
+			maxRight = max(maxRight, left+width)
+		}
+		if math.IsInf(float64(minLeft), 1) {
+			continue
+		}
+
+		floatsWidth := ifc.InitialAvailableWidth - lb.AvailableWidth - lb.Indent
+		availWidth := contentRect.LogicalWidth - floatsWidth - lb.Indent
+		availLeft := contentRect.LogicalX + pl.lineStart + lb.LeftFloatWidth()
+		if !isRtl {
+			availLeft += lb.Indent
+		}
+		contentWidth := maxRight - minLeft
+
+		lineAlign := align
+		if lineAlign == text.JustifyAlign && (isLastLine || lb.HasForcedBreak) {
+			// Last line of justified text is aligned to the start.
+			// Spec: https://www.w3.org/TR/css-text-3/#text-align-last-property
+			lineAlign = text.LeftAlign
+			if isRtl {
+				lineAlign = text.RightAlign
+			}
+		}
+		target := availLeft
+		switch lineAlign {
+		case text.RightAlign:
+			target = availLeft + availWidth - contentWidth
+		case text.CenterAlign:
+			target = availLeft + (availWidth-contentWidth)/2
+		}
+		spacing := layout.LogicalPos(0)
+		if lineAlign == text.JustifyAlign || lineAlign == text.JustifyAll {
+			if n := justificationOpportunities(frags); n != 0 && contentWidth < availWidth {
+				spacing = (availWidth - contentWidth) / layout.LogicalPos(n)
+			}
+		}
+		shiftLine(frags, parents, movedBoxes, target-minLeft, spacing)
+	}
+}
+
+// justificationOpportunities returns number of places where spacing can be
+// added when justifying frags. Currently only word separators are used.
+//
+// Spec: https://www.w3.org/TR/css-text-3/#justification-opportunity
+func justificationOpportunities(frags []bidiFragment) int {
+	n := 0
+	hasContentBefore := false
+	pendingSpaces := 0
+	for _, frag := range frags {
+		switch {
+		case frag.text != nil:
+			n += strings.Count(frag.text.Text, " ")
+		case frag.box == nil:
+			if hasContentBefore {
+				pendingSpaces++
+			}
+			continue
+		}
+		hasContentBefore = true
+		n += pendingSpaces
+		pendingSpaces = 0
+	}
+	return n
+}
+
+// shiftLine moves frags on a line by offset, adding spacing after each
+// justification opportunity.
+func shiftLine(frags []bidiFragment, parents map[layout.Box]layout.Box, movedBoxes map[layout.Box]bool, offset, spacing layout.LogicalPos) {
+	pendingSpacing := layout.LogicalPos(0)
+	for _, frag := range frags {
+		if frag.text == nil && frag.box == nil {
+			pendingSpacing += spacing
+			continue
+		}
+		offset += pendingSpacing
+		pendingSpacing = 0
+
+		// Inline boxes move along with the first content inside.
+		for bx := frag.owner; !util.IsNil(bx) && !movedBoxes[bx]; bx = parents[bx] {
+			movedBoxes[bx] = true
+			if ibox, ok := bx.(*layout.InlineBox); ok && !util.IsNil(ibox.Elem) {
+				ibox.MarginRect.LogicalX += offset
+			}
+		}
+		if frag.box != nil {
+			frag.box.Translate(offset, 0)
+			continue
+		}
+		txt := frag.text
+		if spacing == 0 || !strings.Contains(txt.Text, " ") {
+			txt.Rect.LogicalX += offset
+			continue
+		}
+		// Split the text into words, so that spacing can be added between them.
+		txt.Font.SetTextSize(int(txt.FontSize))
+		measure := func(s string) layout.LogicalPos {
+			return layout.LogicalPos(gfx.MeasureOrientedText(txt.Font, s, txt.Orientation))
+		}
+		spaceWidth := measure(" ")
+		words := strings.Split(txt.Text, " ")
+		left := txt.Rect.LogicalX + offset
+		for i, word := range words {
+			if i != 0 {
+				left += spaceWidth + spacing
+				offset += spacing
+			}
+			wordTxt := txt
+			if i != 0 {
+				if word == "" {
+					continue
+				}
+				newTxt := *txt
+				wordTxt = &newTxt
+				frag.owner.AddChildText(wordTxt)
+			}
+			wordTxt.Text = word
+			wordTxt.Rect.LogicalX = left
+			wordTxt.Rect.LogicalWidth = measure(word)
+			left += wordTxt.Rect.LogicalWidth
+		}
+	}
+}
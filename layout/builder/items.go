@@ -0,0 +1,175 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"math"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/align"
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/display"
+	"github.com/inseo-oh/yw/css/values"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/layout"
+	"github.com/inseo-oh/yw/util"
+)
+
+// containerItem is a child of flex or grid container (i.e. flex item or grid
+// item). These are always blockified, and establish independent formatting
+// contexts for their contents.
+//
+// https://www.w3.org/TR/css-flexbox-1/#flex-items
+// https://www.w3.org/TR/css-grid-1/#grid-items
+type containerItem struct {
+	elem      dom.Element // nil for anonymous items
+	children  []dom.Node  // Contents of anonymous items
+	bcon      *layout.BlockContainerBox
+	alignSelf align.Item // Used value of align-self
+
+	quoteDepth int // Nesting level of quotes before the item was first laid out
+
+	margin, border, padding layout.PhysicalEdges
+	sizes                   boxSizes
+}
+
+func (it *containerItem) horizontalEdges() layout.PhysicalPos {
+	return it.margin.HorizontalSum() + it.border.HorizontalSum() + it.padding.HorizontalSum()
+}
+func (it *containerItem) verticalEdges() layout.PhysicalPos {
+	return it.margin.VerticalSum() + it.border.VerticalSum() + it.padding.VerticalSum()
+}
+
+// collectContainerItems returns items for children of bcon, a flex or grid
+// container establishing fctx. Absolutely positioned children are laid out and
+// added to bcon here, since they don't participate in the container's layout.
+//
+// https://www.w3.org/TR/css-flexbox-1/#abspos-items
+// https://www.w3.org/TR/css-grid-1/#abspos-items
+func (tb treeBuilder) collectContainerItems(bcon *layout.BlockContainerBox, fctx layout.FormattingContext, children []dom.Node, textDecors []gfx.TextDecorOptions) []*containerItem {
+	items := []*containerItem{}
+
+	// Each contiguous sequence of texts is wrapped in an anonymous item.
+	// (But not if it only contains white spaces)
+	anonChildren := []dom.Node{}
+	flushAnonChildren := func() {
+		if hasInlineContent(anonChildren) {
+			items = append(items, &containerItem{children: anonChildren})
+		}
+		anonChildren = []dom.Node{}
+	}
+	for _, child := range children {
+		elem, ok := child.(dom.Element)
+		if !ok {
+			anonChildren = append(anonChildren, child)
+			continue
+		}
+		if cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Display().Mode == display.DisplayNone {
+			continue
+		}
+		if isAbsolutelyPositioned(elem) {
+			for _, node := range tb.layoutNode(fctx, bcon.Bfc, bcon.Ifc, textDecors, bcon, elem) {
+				bcon.AddChildBox(node.(layout.Box))
+			}
+			continue
+		}
+		flushAnonChildren()
+		items = append(items, &containerItem{elem: elem})
+	}
+	flushAnonChildren()
+	return items
+}
+
+// initContainerItem computes edges, sizes and alignment of it, which is an
+// item of container.
+func initContainerItem(container *layout.BlockContainerBox, it *containerItem) {
+	it.sizes = boxSizes{widthAuto: true, heightAuto: true, widthRange: unlimitedSizeRange, heightRange: unlimitedSizeRange}
+	it.alignSelf = align.ItemAuto
+	if !util.IsNil(it.elem) {
+		styleSet := cssom.ComputedStyleSetSourceOf(it.elem).ComputedStyleSet()
+		it.margin, it.border, it.padding = elementBoxEdges(it.elem, container)
+		it.sizes = elementBoxSizes(it.elem, container.BoxContentRect().ToPhysicalRect(), container.IsHeightAuto(), it.border, it.padding)
+		it.alignSelf = styleSet.AlignSelf()
+	}
+	if it.alignSelf == align.ItemAuto {
+		it.alignSelf = cssom.ComputedStyleSetSourceOf(container.Elem).ComputedStyleSet().AlignItems()
+	}
+	if it.alignSelf == align.ItemNormal {
+		// normal behaves as stretch for flex and grid items.
+		// https://www.w3.org/TR/css-align-3/#align-flex
+		// https://www.w3.org/TR/css-align-3/#align-grid
+		it.alignSelf = align.ItemStretch
+	}
+}
+
+// layoutContainerItemBox lays out it inside container using given content
+// width, and stores the box to it.bcon. fctx is the formatting context
+// established by the container. If isShrinkToFit is set, the box is shrunk to
+// fit its contents afterwards.
+//
+// Since items establish independent formatting contexts, layout of the box
+// doesn't depend on its position. So it's placed at the content box of container,
+// and moved later. If it was already laid out with the same width, the box is reused.
+func (tb treeBuilder) layoutContainerItemBox(container *layout.BlockContainerBox, fctx layout.FormattingContext, it *containerItem, width layout.PhysicalPos, isShrinkToFit bool, textDecors []gfx.TextDecorOptions) {
+	if it.bcon != nil {
+		if !isShrinkToFit && layout.PhysicalPos(it.bcon.LogicalWidth()) == width {
+			return
+		}
+		// Positioned descendants of the old box will never be placed.
+		delete(tb.pos.pending, it.bcon)
+
+		// Quotes inside the item were already counted by the first layout, so
+		// the item sees the same nesting level again, and leaves it untouched.
+		depth := tb.gc.quoteDepth
+		tb.gc.quoteDepth = it.quoteDepth
+		defer func() { tb.gc.quoteDepth = depth }()
+	} else {
+		it.quoteDepth = tb.gc.quoteDepth
+	}
+	if math.IsInf(float64(width), 1) {
+		// TODO: Calculate max-content size properly, instead of using the size of the container.
+		width = layout.PhysicalPos(container.LogicalWidth())
+	}
+
+	var height layout.PhysicalPos
+	if !it.sizes.heightAuto {
+		height = it.sizes.heightRange.clamp(it.sizes.height)
+	}
+	contentRect := container.BoxContentRect()
+	boxWidth, boxHeight := contentRect.WritingMode.PhysicalSizeToLogical(width+it.horizontalEdges(), height+it.verticalEdges())
+	boxRect := layout.LogicalRect{LogicalX: contentRect.LogicalX, LogicalY: contentRect.LogicalY, LogicalWidth: boxWidth, LogicalHeight: boxHeight, WritingMode: contentRect.WritingMode}
+	children := it.children
+	if !util.IsNil(it.elem) {
+		children = tb.childNodesOf(it.elem)
+		textDecors = elementTextDecoration(it.elem, textDecors)
+	}
+
+	bcon := tb.newBlockContainer(fctx, nil, container, container, it.elem, boxRect, it.margin, it.border, it.padding, false, it.sizes.heightAuto, false, children, textDecors)
+	bcon.IsAnonymous = util.IsNil(it.elem)
+	if bcon.IsHeightAuto() {
+		resolveBfcRootHeight(bcon, it.sizes.heightRange)
+	}
+	if isShrinkToFit {
+		shrinkToFit(bcon, it.sizes.widthRange)
+	}
+	it.bcon = bcon
+}
+
+// containerGap returns used value of gap, where containerSize is size of the
+// container in the same axis. Percentages are treated as zero if it's indefinite.
+//
+// Spec: https://www.w3.org/TR/css-align-3/#column-row-gap
+func containerGap(gap align.Gap, containerSize layout.PhysicalPos, fontSize func() css.Num) layout.PhysicalPos {
+	// normal is 0 for flex and grid containers.
+	if gap.IsNormal() {
+		return 0
+	}
+	if _, ok := gap.Value.(values.Percentage); ok && math.IsInf(float64(containerSize), 1) {
+		return 0
+	}
+	containerSizeFn := func() css.Num { return css.NumFromFloat(float64(containerSize)) }
+	return layout.PhysicalPos(gap.Value.AsLength(containerSizeFn).ToPx(fontSize))
+}
@@ -0,0 +1,163 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/css/text"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/layout"
+	"github.com/inseo-oh/yw/text/linebreak"
+)
+
+// lineBreakContextLen is number of characters before the text that are used to
+// find line break opportunity at the start of the text.
+const lineBreakContextLen = 8
+
+// lineBreakOptionsOf returns options for finding line break opportunities,
+// using text properties in styleSet.
+//
+// Spec: https://www.w3.org/TR/css-text-3/#line-breaking
+func lineBreakOptionsOf(styleSet *props.ComputedStyleSet) linebreak.Options {
+	opts := linebreak.Options{}
+	switch styleSet.LineBreak() {
+	case text.LooseLineBreak:
+		opts.Strictness = linebreak.Loose
+	case text.StrictLineBreak:
+		opts.Strictness = linebreak.Strict
+	case text.AnywhereLineBreak:
+		opts.Strictness = linebreak.Anywhere
+	}
+	switch styleSet.WordBreak() {
+	case text.BreakAll:
+		opts.BreakAll = true
+	case text.KeepAll:
+		opts.KeepAll = true
+	}
+	return opts
+}
+
+// canBreakWordsOnOverflow reports whether words can be broken at arbitrary
+// points, if there are no other line break opportunities to avoid overflow.
+//
+// Spec: https://www.w3.org/TR/css-text-3/#overflow-wrap-property
+func canBreakWordsOnOverflow(styleSet *props.ComputedStyleSet) bool {
+	switch styleSet.OverflowWrap() {
+	case text.AnywhereOverflowWrap, text.BreakWordOverflowWrap:
+		return true
+	}
+	return styleSet.WordBreak() == text.BreakWord
+}
+
+// textSegment is a part of text between two line break opportunities.
+type textSegment struct {
+	start, end   int               // Byte offsets in the text
+	width        layout.LogicalPos // Width of the segment
+	trimmedWidth layout.LogicalPos // Width without trailing spaces, which hang at the end of lines
+	isMandatory  bool              // Line must be broken after the segment
+}
+
+// textSegments splits str into segments at line break opportunities. context
+// is text preceding str in the same inline formatting context, and
+// canBreakBefore reports whether there's line break opportunity before str.
+// spacesHang reports whether trailing spaces of segments hang at the end of
+// lines.
+func (tb treeBuilder) textSegments(str, context string, opts linebreak.Options, spacesHang bool, orientation gfx.TextOrientation) (segs []textSegment, canBreakBefore bool) {
+	context = lastRunesOf(context, lineBreakContextLen)
+	start := 0
+	for _, o := range linebreak.Opportunities(context+str, opts) {
+		offset := o.Offset - len(context)
+		if offset < 0 {
+			continue
+		} else if offset == 0 {
+			canBreakBefore = true
+			continue
+		}
+		segs = append(segs, tb.newTextSegment(str, start, offset, o.IsMandatory, spacesHang, orientation))
+		start = offset
+	}
+	if start != len(str) {
+		segs = append(segs, tb.newTextSegment(str, start, len(str), false, spacesHang, orientation))
+	}
+	return segs, canBreakBefore
+}
+
+// newTextSegment measures str[start:end], and returns the segment. Preserved
+// newline ending the segment has no width.
+func (tb treeBuilder) newTextSegment(str string, start, end int, isMandatory, spacesHang bool, orientation gfx.TextOrientation) textSegment {
+	s := strings.TrimSuffix(str[start:end], "\n")
+	width := tb.measureText(s, orientation)
+	trimmedWidth := width
+	if trimmed := strings.TrimRight(s, " "); spacesHang && len(trimmed) != len(s) {
+		trimmedWidth = tb.measureText(trimmed, orientation)
+	}
+	return textSegment{start, end, width, trimmedWidth, isMandatory}
+}
+
+// fitTextSegments returns where line should end, if text starting at start
+// were placed on the line having availWidth. first is index of the segment
+// containing start. If even the first segment doesn't fit, end is same as start.
+func (tb treeBuilder) fitTextSegments(str string, segs []textSegment, first, start int, availWidth layout.LogicalPos, spacesHang bool, orientation gfx.TextOrientation) (end int, width layout.LogicalPos, isMandatory bool) {
+	end = start
+	for i := first; i < len(segs); i++ {
+		seg := segs[i]
+		if i == first && seg.start != start {
+			// Segment was partially placed on the previous line, or its leading
+			// spaces were removed.
+			seg = tb.newTextSegment(str, start, seg.end, seg.isMandatory, spacesHang, orientation)
+		}
+		if availWidth < width+seg.trimmedWidth {
+			break
+		}
+		end, width = seg.end, width+seg.width
+		if seg.isMandatory {
+			return end, width, true
+		}
+	}
+	return end, width, false
+}
+
+// breakTextAnywhere returns the longest part of str that fits in availWidth,
+// by breaking text between characters. At least one character is returned even
+// if it doesn't fit.
+//
+// Spec: https://www.w3.org/TR/css-text-3/#overflow-wrap-property
+func (tb treeBuilder) breakTextAnywhere(str string, availWidth layout.LogicalPos, orientation gfx.TextOrientation) (end int, width layout.LogicalPos) {
+	for i, r := range str {
+		if i == 0 || linebreak.IsGraphemeExtender(r) {
+			continue
+		}
+		w := tb.measureText(str[:i], orientation)
+		if availWidth < w {
+			break
+		}
+		end, width = i, w
+	}
+	if end == 0 {
+		// Not even a single character fits.
+		end = len(str)
+		for i, r := range str {
+			if i != 0 && !linebreak.IsGraphemeExtender(r) {
+				end = i
+				break
+			}
+		}
+		width = tb.measureText(str[:end], orientation)
+	}
+	return end, width
+}
+
+// lastRunesOf returns last n characters of s.
+func lastRunesOf(s string, n int) string {
+	i := len(s)
+	for ; 0 < i && 0 < n; n-- {
+		_, size := utf8.DecodeLastRuneInString(s[:i])
+		i -= size
+	}
+	return s[i:]
+}
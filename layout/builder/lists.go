@@ -0,0 +1,84 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"log"
+
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/display"
+	"github.com/inseo-oh/yw/css/lists"
+	"github.com/inseo-oh/yw/css/selector"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/layout"
+)
+
+// isListItem reports whether elem is a list item, which generates ::marker.
+//
+// Spec: https://www.w3.org/TR/css-display-3/#list-items
+func isListItem(elem dom.Element) bool {
+	styleDisplay := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Display()
+	return styleDisplay.Mode == display.OuterInnerMode && styleDisplay.IsListItem
+}
+
+// hasOutsideMarker reports whether ::marker of elem is placed outside of
+// the principal box. Inline list items always have inside markers.
+//
+// Spec: https://www.w3.org/TR/css-lists-3/#list-style-position-property
+func hasOutsideMarker(elem dom.Element) bool {
+	styleSet := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet()
+	return isListItem(elem) && styleSet.ListStylePosition() == lists.Outside && styleSet.Display().OuterMode != display.Inline
+}
+
+// markerText returns contents of ::marker ref, using its list-style-type,
+// styleType.
+//
+// TODO: Support list-style-image
+//
+// Spec: https://www.w3.org/TR/css-lists-3/#content-property
+func (tb treeBuilder) markerText(ref selector.PseudoElementRef, styleType lists.StyleType) string {
+	switch styleType.Type {
+	case lists.StringStyleType:
+		return styleType.Value
+	case lists.CounterStyleType:
+		s, ok := lists.CounterStyleByName(styleType.Value)
+		if !ok {
+			log.Printf("TODO: Support counter style %s", styleType.Value)
+		}
+		return s.MarkerRepresentation(tb.counterValueOf(ref, listItemCounter))
+	}
+	return ""
+}
+
+// layoutOutsideMarker lays out outside ::marker of the list item bcon, and
+// places it before the start edge of bcon's border box.
+//
+// Spec: https://www.w3.org/TR/css-lists-3/#list-style-position-outside
+func (tb treeBuilder) layoutOutsideMarker(bcon *layout.BlockContainerBox) {
+	marker := tb.makePseudoElement(bcon.Elem, "marker")
+	if marker == nil {
+		return
+	}
+	// Outside markers don't affect layout of the list item, so these are laid
+	// out in their own formatting contexts.
+	margin, border, padding := elementBoxEdges(marker, bcon)
+	contentRect := bcon.BoxContentRect()
+	boxRect := layout.LogicalRect{LogicalX: contentRect.LogicalX, LogicalY: contentRect.LogicalY, LogicalWidth: contentRect.LogicalWidth, WritingMode: contentRect.WritingMode}
+	markerBox := tb.newBlockContainer(
+		nil, nil, nil, nil, marker, boxRect, margin, border, padding,
+		false, true, false, tb.childNodesOf(marker), elementTextDecoration(marker, []gfx.TextDecorOptions{}),
+	)
+	resolveBfcRootHeight(markerBox, unlimitedSizeRange)
+	shrinkToFit(markerBox, unlimitedSizeRange)
+
+	// TODO: Align baseline of the marker with the first line of the list item.
+	markerRect := markerBox.BoxMarginRect()
+	markerBox.Translate(bcon.BoxBorderRect().LogicalX-(markerRect.LogicalX+markerRect.LogicalWidth), 0)
+	markerBox.Parent = bcon
+	markerBox.ParentBcon = bcon
+	markerBox.IsOutsideMarker = true
+	bcon.AddChildBox(markerBox)
+}
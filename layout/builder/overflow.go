@@ -0,0 +1,123 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/overflow"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/layout"
+	"github.com/inseo-oh/yw/util"
+)
+
+// ellipsis is the character used for text-overflow: ellipsis.
+const ellipsis = "…"
+
+// usedOverflowOf returns used values of overflow-x and overflow-y of the box
+// generated by elem.
+//
+// TODO: Values of the root element and the body should be propagated to the
+// viewport, instead of being ignored.
+//
+// Spec: https://www.w3.org/TR/css-overflow-3/#overflow-properties
+func usedOverflowOf(elem dom.Element) (x, y overflow.Overflow) {
+	if util.IsNil(elem) {
+		return overflow.Visible, overflow.Visible
+	}
+	if _, isRoot := elem.Parent().(dom.Document); isRoot {
+		return overflow.Visible, overflow.Visible
+	}
+	styleSet := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet()
+	x, y = styleSet.OverflowX(), styleSet.OverflowY()
+	// If only one of these is scrollable, the other one becomes scrollable
+	// as well.
+	if x.IsScrollable() != y.IsScrollable() {
+		x, y = scrollableOverflow(x), scrollableOverflow(y)
+	}
+	return x, y
+}
+
+// scrollableOverflow returns o, with visible and clip turned into auto and
+// hidden respectively.
+func scrollableOverflow(o overflow.Overflow) overflow.Overflow {
+	switch o {
+	case overflow.Visible:
+		return overflow.Auto
+	case overflow.Clip:
+		return overflow.Hidden
+	}
+	return o
+}
+
+// applyTextOverflow replaces the end of text overflowing lines of pl with an
+// ellipsis, if text-overflow: ellipsis applies to the block container.
+// Texts past the ellipsis are removed.
+//
+// TODO: Atomic inlines overflowing the line should be hidden as well.
+// TODO: Support direction: rtl, where the line overflows to the left.
+//
+// Spec: https://www.w3.org/TR/css-overflow-3/#text-overflow
+func (tb treeBuilder) applyTextOverflow(pl pendingLines) {
+	elem := closestDomElementForBox(pl.bcon)
+	if util.IsNil(elem) || !pl.bcon.OverflowX.ClipsContents() {
+		return
+	}
+	if cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().TextOverflow() != overflow.EllipsisText {
+		return
+	}
+	ifc := pl.bcon.Ifc
+	contentRect := pl.bcon.BoxContentRect()
+	for first := 0; first < len(pl.fragments); {
+		lineIdx := pl.fragments[first].lineIdx
+		last := first
+		for last < len(pl.fragments) && pl.fragments[last].lineIdx == lineIdx {
+			last++
+		}
+		frags := pl.fragments[first:last]
+		first = last
+
+		lb := ifc.LineBoxes[lineIdx]
+		floatsWidth := ifc.InitialAvailableWidth - lb.AvailableWidth - lb.Indent
+		lineRight := contentRect.LogicalX + pl.lineStart + lb.LeftFloatWidth() + contentRect.LogicalWidth - floatsWidth
+		isOverflowing := false
+		for _, frag := range frags {
+			if frag.text != nil && lineRight < frag.text.Rect.LogicalX+frag.text.Rect.LogicalWidth {
+				isOverflowing = true
+			}
+		}
+		if !isOverflowing {
+			continue
+		}
+		isEllipsisPlaced := false
+		for _, frag := range frags {
+			txt := frag.text
+			if txt == nil {
+				continue
+			}
+			if isEllipsisPlaced {
+				txt.Text, txt.Rect.LogicalWidth = "", 0
+				continue
+			}
+			txt.Font.SetTextSize(int(txt.FontSize))
+			measure := func(s string) layout.LogicalPos {
+				return layout.LogicalPos(gfx.MeasureOrientedText(txt.Font, s, txt.Orientation))
+			}
+			availWidth := lineRight - txt.Rect.LogicalX - measure(ellipsis)
+			if txt.Rect.LogicalWidth <= availWidth {
+				continue
+			}
+			// Keep as many characters as possible before the ellipsis.
+			runes := []rune(txt.Text)
+			n := len(runes)
+			for 0 < n && availWidth < measure(string(runes[:n])) {
+				n--
+			}
+			txt.Text = string(runes[:n]) + ellipsis
+			txt.Rect.LogicalWidth = measure(txt.Text)
+			isEllipsisPlaced = true
+		}
+	}
+}
@@ -0,0 +1,416 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"math"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/display"
+	"github.com/inseo-oh/yw/css/float"
+	"github.com/inseo-oh/yw/css/position"
+	"github.com/inseo-oh/yw/css/sizing"
+	"github.com/inseo-oh/yw/css/values"
+	"github.com/inseo-oh/yw/css/writingmodes"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/layout"
+	"github.com/inseo-oh/yw/util"
+)
+
+// positioningState holds positioned boxes waiting for their containing blocks
+// to be laid out, since their positions depend on the final size of it.
+type positioningState struct {
+	pending  map[layout.Box][]pendingPositionedBox // Keyed by the containing block
+	viewport layout.PhysicalRect
+}
+
+// pendingPositionedBox is an absolutely positioned or sticky positioned box,
+// which is placed after layout of its containing block is done.
+type pendingPositionedBox struct {
+	bx          layout.Box
+	pos         position.Position
+	heightRange sizeRange
+}
+
+// isAbsolutelyPositioned reports whether elem is an absolutely positioned box.
+func isAbsolutelyPositioned(elem dom.Element) bool {
+	if util.IsNil(elem) {
+		return false
+	}
+	return cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Position().IsAbsolutelyPositioned()
+}
+
+// insets holds used values of inset properties.
+type insets struct {
+	top, right, bottom, left                 layout.PhysicalPos
+	topAuto, rightAuto, bottomAuto, leftAuto bool
+}
+
+// elementInsets computes used values of inset properties of elem, whose
+// containing block is containerRect. If the height of the containing block
+// depends on its contents, containerHeightAuto should be set.
+//
+// Spec: https://www.w3.org/TR/css-position-3/#insets
+func elementInsets(elem dom.Element, containerRect layout.PhysicalRect, containerHeightAuto bool) (res insets) {
+	styleSetSrc := cssom.ComputedStyleSetSourceOf(elem)
+	styleSet := styleSetSrc.ComputedStyleSet()
+	fontSize := func() css.Num { return css.NumFromFloat(fontSizeOf(styleSetSrc)) }
+	containerWidth := func() css.Num { return css.NumFromFloat(float64(containerRect.Width)) }
+	containerHeight := func() css.Num { return css.NumFromFloat(float64(containerRect.Height)) }
+
+	resolve := func(inset position.Inset, containerSize func() css.Num, isVertical bool) (layout.PhysicalPos, bool) {
+		if inset.IsAuto() {
+			return 0, true
+		}
+		// Percentages can't be resolved against height that depends on contents,
+		// so these are treated as auto.
+		if _, ok := inset.Value.(values.Percentage); ok && isVertical && containerHeightAuto {
+			return 0, true
+		}
+		return layout.PhysicalPos(inset.Value.AsLength(containerSize).ToPx(fontSize)), false
+	}
+	res.top, res.topAuto = resolve(styleSet.Top(), containerHeight, true)
+	res.right, res.rightAuto = resolve(styleSet.Right(), containerWidth, false)
+	res.bottom, res.bottomAuto = resolve(styleSet.Bottom(), containerHeight, true)
+	res.left, res.leftAuto = resolve(styleSet.Left(), containerWidth, false)
+	return res
+}
+
+// absoluteContainingBlock returns the containing block of an absolutely
+// positioned box with given position, whose parent box is boxParent.
+//
+// Spec: https://www.w3.org/TR/css-position-3/#def-cb
+func absoluteContainingBlock(boxParent layout.Box, pos position.Position) layout.Box {
+	curr := boxParent
+	for !util.IsNil(curr.BoxParent()) {
+		if elem := curr.BoxElement(); pos == position.Absolute && !util.IsNil(elem) {
+			if cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Position().IsPositioned() {
+				return curr
+			}
+		}
+		curr = curr.BoxParent()
+	}
+	// We reached the initial containing block.
+	// TODO: Fixed boxes should use the viewport, once we support scrolling.
+	return curr
+}
+
+// containingBlockRect returns the rect of cb, the containing block of an
+// absolutely positioned box.
+//
+// Spec: https://www.w3.org/TR/css-position-3/#absolute-cb
+func (ps positioningState) containingBlockRect(cb layout.Box) layout.PhysicalRect {
+	if util.IsNil(cb.BoxParent()) {
+		// ICB grows along with its contents, but it has the size of the viewport.
+		return ps.viewport
+	}
+	return cb.BoxPaddingRect().ToPhysicalRect()
+}
+
+// layoutAbsolutelyPositioned lays out elem, which is an absolutely positioned
+// box. The box is laid out at its static position, and then moved to the final
+// position once layout of its containing block is done(See placePositionedBoxes).
+//
+// Spec: https://www.w3.org/TR/css-position-3/#abs-non-replaced-width
+// Spec: https://www.w3.org/TR/css-position-3/#abs-non-replaced-height
+func (tb treeBuilder) layoutAbsolutelyPositioned(
+	elem dom.Element, boxParent layout.Box,
+	bfc *layout.BlockFormattingContext, ifc *layout.InlineFormattingContext,
+	margin, border, padding layout.PhysicalEdges,
+	textDecors []gfx.TextDecorOptions,
+) layout.Box {
+	parentBcon := closestParentBlockContainer(boxParent)
+	styleSet := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet()
+	cb := absoluteContainingBlock(boxParent, styleSet.Position())
+	cbRect := tb.pos.containingBlockRect(cb)
+
+	sizes := elementBoxSizes(elem, cbRect, false, border, padding)
+	in := elementInsets(elem, cbRect, false)
+
+	// The static position is where the box would have been if it were in normal flow.
+	// https://www.w3.org/TR/css-position-3/#staticpos-rect
+	wasInline := styleSet.Display().OuterMode == display.Inline
+	staticX, staticY := computeNextPosition(bfc, ifc, parentBcon, wasInline)
+
+	horizontalEdges := margin.HorizontalSum() + border.HorizontalSum() + padding.HorizontalSum()
+	verticalEdges := margin.VerticalSum() + border.VerticalSum() + padding.VerticalSum()
+	isShrinkToFit := false
+	var width, height layout.PhysicalPos
+	switch {
+	case !sizes.widthAuto:
+		width = sizes.widthRange.clamp(sizes.width)
+	case !in.leftAuto && !in.rightAuto:
+		width = sizes.widthRange.clamp(cbRect.Width - in.left - in.right - horizontalEdges)
+	default:
+		// We lay out using available width first, and shrink it afterwards.
+		isShrinkToFit = true
+		left := layout.PhysicalPos(staticX) - cbRect.Left
+		if !in.leftAuto {
+			left = in.left
+		}
+		width = max(cbRect.Width-left-in.right-horizontalEdges, 0)
+	}
+	if !sizes.heightAuto {
+		height = sizes.heightRange.clamp(sizes.height)
+	}
+	wm := boxParent.BoxContentRect().WritingMode
+	boxWidth, boxHeight := wm.PhysicalSizeToLogical(width+horizontalEdges, height+verticalEdges)
+	boxRect := layout.LogicalRect{LogicalX: staticX, LogicalY: staticY, LogicalWidth: boxWidth, LogicalHeight: boxHeight, WritingMode: wm}
+
+	// Absolutely positioned boxes are always block-level.
+	// https://www.w3.org/TR/css-display-3/#transformations
+	bcon := tb.newBlockContainer(bfc, nil, boxParent, parentBcon, elem, boxRect, margin, border, padding, false, sizes.heightAuto, false, tb.childNodesOf(elem), textDecors)
+	if bcon.IsHeightAuto() {
+		resolveBfcRootHeight(bcon, sizes.heightRange)
+	}
+	if isShrinkToFit {
+		shrinkToFit(bcon, sizes.widthRange)
+	}
+
+	tb.placePositionedBoxes(bcon)
+	tb.pos.pending[cb] = append(tb.pos.pending[cb], pendingPositionedBox{bx: bcon, pos: styleSet.Position(), heightRange: sizes.heightRange})
+	return bcon
+}
+
+// finishPositionedLayout does positioning works for bx, generated by elem,
+// after its layout is done.
+func (tb treeBuilder) finishPositionedLayout(bx layout.Box, elem dom.Element, parentBcon *layout.BlockContainerBox) {
+	// bx may be the containing block of its absolutely positioned descendants.
+	tb.placePositionedBoxes(bx)
+
+	switch cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Position() {
+	case position.Relative:
+		// https://www.w3.org/TR/css-position-3/#relpos-insets
+		in := elementInsets(elem, parentBcon.BoxContentRect().ToPhysicalRect(), parentBcon.IsHeightAuto())
+		var offsetX, offsetY layout.PhysicalPos
+		if !in.leftAuto {
+			offsetX = in.left
+		} else if !in.rightAuto {
+			offsetX = -in.right
+		}
+		if !in.topAuto {
+			offsetY = in.top
+		} else if !in.bottomAuto {
+			offsetY = -in.bottom
+		}
+		bx.Translate(bx.BoxMarginRect().WritingMode.PhysicalOffsetToLogical(offsetX, offsetY))
+	case position.Sticky:
+		// Sticky boxes are constrained by their containing block, which is
+		// nearest block container.
+		cb := parentBcon
+		for cb.IsAnonymous && cb.ParentBcon != nil {
+			cb = cb.ParentBcon
+		}
+		tb.pos.pending[cb] = append(tb.pos.pending[cb], pendingPositionedBox{bx: bx, pos: position.Sticky})
+	}
+}
+
+// placePositionedBoxes places positioned boxes whose containing block is cb.
+// This must be called after layout of cb is done.
+func (tb treeBuilder) placePositionedBoxes(cb layout.Box) {
+	for _, pb := range tb.pos.pending[cb] {
+		if pb.pos == position.Sticky {
+			placeStickyBox(pb.bx, cb.BoxContentRect().ToPhysicalRect(), tb.pos.viewport)
+		} else {
+			placeAbsolutelyPositionedBox(pb.bx.(*layout.BlockContainerBox), tb.pos.containingBlockRect(cb), pb.heightRange)
+		}
+	}
+	delete(tb.pos.pending, cb)
+}
+
+// placeAbsolutelyPositionedBox moves bcon from its static position to the
+// position specified by inset properties, relative to its containing block,
+// whose rect is cbRect.
+//
+// Spec: https://www.w3.org/TR/css-position-3/#abs-non-replaced-width
+// Spec: https://www.w3.org/TR/css-position-3/#abs-non-replaced-height
+func placeAbsolutelyPositionedBox(bcon *layout.BlockContainerBox, cbRect layout.PhysicalRect, heightRange sizeRange) {
+	in := elementInsets(bcon.Elem, cbRect, false)
+
+	// Auto height is stretched if both top and bottom are specified.
+	if bcon.IsHeightAuto() && !in.topAuto && !in.bottomAuto {
+		verticalEdges := bcon.Margin.VerticalSum() + bcon.Border.VerticalSum() + bcon.Padding.VerticalSum()
+		height := heightRange.clamp(cbRect.Height - in.top - in.bottom - verticalEdges)
+		_, heightDiff := bcon.MarginRect.WritingMode.PhysicalSizeToLogical(0, height-layout.PhysicalPos(bcon.LogicalHeight()))
+		bcon.IncrementSize(0, heightDiff)
+	}
+	resolveAutoMargins(bcon, cbRect, in)
+
+	marginRect := bcon.BoxMarginRect().ToPhysicalRect()
+	left, top := marginRect.Left, marginRect.Top
+	if !in.leftAuto {
+		left = cbRect.Left + in.left
+	} else if !in.rightAuto {
+		left = cbRect.Left + cbRect.Width - in.right - marginRect.Width
+	}
+	if !in.topAuto {
+		top = cbRect.Top + in.top
+	} else if !in.bottomAuto {
+		top = cbRect.Top + cbRect.Height - in.bottom - marginRect.Height
+	}
+	bcon.Translate(bcon.BoxMarginRect().WritingMode.PhysicalOffsetToLogical(left-marginRect.Left, top-marginRect.Top))
+}
+
+// resolveAutoMargins gives the space left in the containing block, whose rect
+// is cbRect, to auto margins of bcon. Border box of bcon stays where it is, and
+// bcon is moved to the final position afterwards.
+//
+// Spec: https://www.w3.org/TR/CSS2/visudet.html#abs-non-replaced-width
+// Spec: https://www.w3.org/TR/CSS2/visudet.html#abs-non-replaced-height
+func resolveAutoMargins(bcon *layout.BlockContainerBox, cbRect layout.PhysicalRect, in insets) {
+	styleSet := cssom.ComputedStyleSetSourceOf(bcon.Elem).ComputedStyleSet()
+	marginRect := bcon.BoxMarginRect().ToPhysicalRect()
+
+	// Auto margins were laid out as 0, so we can just add the space left to them.
+	var extra layout.PhysicalEdges
+	if !in.leftAuto && !in.rightAuto {
+		space := cbRect.Width - in.left - in.right - marginRect.Width
+		leftAuto, rightAuto := styleSet.MarginLeft().IsAuto(), styleSet.MarginRight().IsAuto()
+		switch {
+		case leftAuto && rightAuto && space < 0:
+			// Margins can't be negative when centering, so the end side takes all.
+			if styleSet.Direction() == writingmodes.Rtl {
+				extra.Left = space
+			} else {
+				extra.Right = space
+			}
+		case leftAuto && rightAuto:
+			extra.Left = space / 2
+			extra.Right = space - extra.Left
+		case leftAuto:
+			extra.Left = space
+		case rightAuto:
+			extra.Right = space
+		}
+	}
+	if !in.topAuto && !in.bottomAuto {
+		space := cbRect.Height - in.top - in.bottom - marginRect.Height
+		topAuto, bottomAuto := styleSet.MarginTop().IsAuto(), styleSet.MarginBottom().IsAuto()
+		switch {
+		case topAuto && bottomAuto:
+			extra.Top = space / 2
+			extra.Bottom = space - extra.Top
+		case topAuto:
+			extra.Top = space
+		case bottomAuto:
+			extra.Bottom = space
+		}
+	}
+
+	bcon.Margin.Top += extra.Top
+	bcon.Margin.Right += extra.Right
+	bcon.Margin.Bottom += extra.Bottom
+	bcon.Margin.Left += extra.Left
+	e := bcon.MarginRect.WritingMode.LogicalEdges(extra)
+	bcon.MarginRect.LogicalX -= layout.LogicalPos(e.InlineStart)
+	bcon.MarginRect.LogicalY -= layout.LogicalPos(e.BlockStart)
+	bcon.MarginRect.LogicalWidth += layout.LogicalPos(e.InlineSum())
+	bcon.MarginRect.LogicalHeight += layout.LogicalPos(e.BlockSum())
+}
+
+// placeStickyBox moves bx, so that it stays inside of the viewport as specified
+// by inset properties, without leaving its containing block, whose content box
+// is cbRect.
+//
+// Spec: https://www.w3.org/TR/css-position-3/#stickypos-insets
+func placeStickyBox(bx layout.Box, cbRect, viewportRect layout.PhysicalRect) {
+	// TODO: Use the nearest scrollport, and its scroll position.
+	in := elementInsets(bx.BoxElement(), viewportRect, false)
+	rect := bx.BoxMarginRect().ToPhysicalRect()
+
+	// Returns offset needed to put [start, end) inside of [minStart, maxEnd),
+	// while keeping it inside of [limitStart, limitEnd).
+	offset := func(start, end, minStart, maxEnd, limitStart, limitEnd layout.PhysicalPos, minAuto, maxAuto bool) layout.PhysicalPos {
+		if !minAuto && start < minStart {
+			return max(min(minStart-start, limitEnd-end), 0)
+		}
+		if !maxAuto && maxEnd < end {
+			return min(max(maxEnd-end, limitStart-start), 0)
+		}
+		return 0
+	}
+	offsetX := offset(
+		rect.Left, rect.Left+rect.Width,
+		viewportRect.Left+in.left, viewportRect.Left+viewportRect.Width-in.right,
+		cbRect.Left, cbRect.Left+cbRect.Width,
+		in.leftAuto, in.rightAuto,
+	)
+	offsetY := offset(
+		rect.Top, rect.Top+rect.Height,
+		viewportRect.Top+in.top, viewportRect.Top+viewportRect.Height-in.bottom,
+		cbRect.Top, cbRect.Top+cbRect.Height,
+		in.topAuto, in.bottomAuto,
+	)
+	bx.Translate(bx.BoxMarginRect().WritingMode.PhysicalOffsetToLogical(offsetX, offsetY))
+}
+
+// shrinkToFit shrinks bcon, which was laid out using the available width, to
+// its preferred width. Descendants that were stretched to fill bcon are shrunk
+// by the same amount.
+//
+// Spec: https://www.w3.org/TR/CSS2/visudet.html#shrink-to-fit-float
+func shrinkToFit(bcon *layout.BlockContainerBox, widthRange sizeRange) {
+	slack, stretchedBoxes := contentSlack(bcon)
+	width := bcon.LogicalWidth()
+	if math.IsInf(float64(slack), 1) {
+		slack = width // There's no content at all
+	}
+	newWidth := layout.LogicalPos(widthRange.clamp(layout.PhysicalPos(max(width-max(slack, 0), 0))))
+	for _, bx := range stretchedBoxes {
+		bx.IncrementSize(newWidth-width, 0)
+	}
+}
+
+// contentSlack returns how much of the width of bcon is left unused by its
+// contents, along with bcon and its descendants that were stretched to fill it.
+// Slack is negative if contents overflow bcon, and +Inf if there's no content at all.
+func contentSlack(bcon *layout.BlockContainerBox) (slack layout.LogicalPos, stretchedBoxes []layout.Box) {
+	slack = layout.LogicalPos(math.Inf(1))
+	var visit func(bx layout.Box)
+	visit = func(bx layout.Box) {
+		stretchedBoxes = append(stretchedBoxes, bx)
+		contentRect := bx.BoxContentRect()
+		contentRight := contentRect.LogicalX + contentRect.LogicalWidth
+		for _, child := range bx.ChildBoxes() {
+			if isStretchedBox(child) {
+				visit(child)
+				continue
+			}
+			marginRect := child.BoxMarginRect()
+			slack = min(slack, contentRight-(marginRect.LogicalX+marginRect.LogicalWidth))
+		}
+		for _, txt := range bx.ChildTexts() {
+			slack = min(slack, contentRight-(txt.Rect.LogicalX+txt.Rect.LogicalWidth))
+		}
+	}
+	visit(bcon)
+	return slack, stretchedBoxes
+}
+
+// isStretchedBox reports whether bx is a block-level box in normal flow, whose
+// width was stretched to fill its parent.
+func isStretchedBox(bx layout.Box) bool {
+	switch bx := bx.(type) {
+	case *layout.BlockContainerBox:
+		if bx.IsInlineFlowRoot || bx.IsAbsolutelyPositioned || bx.IsOutsideMarker {
+			return false
+		}
+		if bx.IsTableWrapper || bx.Tfc != nil {
+			// Tables are sized by the table layout algorithm.
+			return false
+		}
+		if util.IsNil(bx.Elem) {
+			return true // Anonymous block container
+		}
+		styleSet := cssom.ComputedStyleSetSourceOf(bx.Elem).ComputedStyleSet()
+		return styleSet.Float() == float.None && styleSet.Width().Type == sizing.Auto
+	case *layout.InlineBox:
+		// Root inline box of block container takes the whole width.
+		return util.IsNil(bx.Elem)
+	}
+	return false
+}
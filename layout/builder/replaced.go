@@ -0,0 +1,124 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"image"
+
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/layout"
+)
+
+// imageElement is implemented by elements that represent an image (e.g. HTML
+// img elements).
+type imageElement interface {
+	Alt() string
+	UpdateImageData(viewportWidth float64)
+	CurrentImage() image.Image
+}
+
+// replacedImageOf returns image of elem, if it's laid out as a replaced element
+// showing the image. Elements whose images are not available are laid out as
+// non-replaced elements instead(See imageFallbackNodes).
+//
+// Spec: https://html.spec.whatwg.org/multipage/rendering.html#images-3
+func (tb treeBuilder) replacedImageOf(elem dom.Element) (image.Image, bool) {
+	imgElem, ok := elem.(imageElement)
+	if !ok {
+		return nil, false
+	}
+	imgElem.UpdateImageData(float64(tb.pos.viewport.Width))
+	img := imgElem.CurrentImage()
+	return img, img != nil
+}
+
+// imageFallbackNodes returns child nodes of imgElem to layout. If the image is
+// not available, the element is rendered as its alt text.
+//
+// TODO: Elements without alt text but with dimensions should be rendered as
+// an empty replaced element of that size.
+//
+// Spec: https://html.spec.whatwg.org/multipage/rendering.html#images-3
+func (tb treeBuilder) imageFallbackNodes(elem dom.Element, imgElem imageElement) []dom.Node {
+	if _, ok := tb.replacedImageOf(elem); ok {
+		return nil
+	}
+	if alt := imgElem.Alt(); alt != "" {
+		return []dom.Node{dom.NewText(elem.NodeDocument(), alt)}
+	}
+	return nil
+}
+
+// replacedIntrinsicSize returns intrinsic size of elem, if it's a replaced
+// element with an image.
+func replacedIntrinsicSize(elem dom.Element) (width, height layout.PhysicalPos, ok bool) {
+	if imgElem, ok := elem.(imageElement); !ok || imgElem.CurrentImage() == nil {
+		return 0, 0, false
+	}
+	w, h := elem.IntrinsicSize()
+	return layout.PhysicalPos(w), layout.PhysicalPos(h), true
+}
+
+// resolveReplacedSizes resolves auto width and height of a replaced element
+// with given intrinsic size, preserving its aspect ratio.
+//
+// Spec: https://www.w3.org/TR/CSS2/visudet.html#inline-replaced-width
+// Spec: https://www.w3.org/TR/CSS2/visudet.html#inline-replaced-height
+func (s boxSizes) resolveReplacedSizes(intrinsicWidth, intrinsicHeight layout.PhysicalPos) boxSizes {
+	switch {
+	case s.widthAuto && s.heightAuto:
+		s.width, s.height = s.constrainReplacedSize(intrinsicWidth, intrinsicHeight)
+	case s.widthAuto:
+		s.width = intrinsicWidth
+		if intrinsicHeight != 0 {
+			s.width = s.height * intrinsicWidth / intrinsicHeight
+		}
+	case s.heightAuto:
+		s.height = intrinsicHeight
+		if intrinsicWidth != 0 {
+			s.height = s.width * intrinsicHeight / intrinsicWidth
+		}
+	}
+	s.widthAuto, s.heightAuto = false, false
+	return s
+}
+
+// constrainReplacedSize applies min-* and max-* constraints to size of a
+// replaced element whose width and height are both auto, preserving its
+// aspect ratio where possible.
+//
+// Spec: https://www.w3.org/TR/CSS2/visudet.html#min-max-widths
+func (s boxSizes) constrainReplacedSize(w, h layout.PhysicalPos) (layout.PhysicalPos, layout.PhysicalPos) {
+	minW, maxW := s.widthRange.min, max(s.widthRange.min, s.widthRange.max)
+	minH, maxH := s.heightRange.min, max(s.heightRange.min, s.heightRange.max)
+	if w == 0 || h == 0 {
+		return s.widthRange.clamp(w), s.heightRange.clamp(h)
+	}
+	switch {
+	case maxW < w && maxH < h:
+		if maxW/w <= maxH/h {
+			return maxW, max(minH, maxW*h/w)
+		}
+		return max(minW, maxH*w/h), maxH
+	case w < minW && h < minH:
+		if minW/w <= minH/h {
+			return min(maxW, minH*w/h), minH
+		}
+		return minW, min(maxH, minW*h/w)
+	case w < minW && maxH < h:
+		return minW, maxH
+	case maxW < w && h < minH:
+		return maxW, minH
+	case maxW < w:
+		return maxW, max(maxW*h/w, minH)
+	case w < minW:
+		return minW, min(minW*h/w, maxH)
+	case maxH < h:
+		return max(maxH*w/h, minW), maxH
+	case h < minH:
+		return min(minH*w/h, maxW), minH
+	}
+	return w, h
+}
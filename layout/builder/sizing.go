@@ -0,0 +1,136 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"log"
+	"math"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/sizing"
+	"github.com/inseo-oh/yw/css/values"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/layout"
+)
+
+// sizeRange holds used values of min-* and max-* properties of a box in one axis,
+// in terms of the content box size.
+type sizeRange struct{ min, max layout.PhysicalPos }
+
+// unlimitedSizeRange is sizeRange that doesn't constrain anything.
+var unlimitedSizeRange = sizeRange{min: 0, max: layout.PhysicalPos(math.Inf(1))}
+
+// clamp applies the range to size.
+//
+// Note that min wins if min is greater than max.
+//
+// Spec: https://www.w3.org/TR/CSS2/visudet.html#min-max-widths
+func (r sizeRange) clamp(size layout.PhysicalPos) layout.PhysicalPos {
+	return max(min(size, r.max), r.min)
+}
+
+// boxSizes holds used values of sizing properties of an element.
+type boxSizes struct {
+	width, height           layout.PhysicalPos // Content box size. Only valid if it isn't auto.
+	widthAuto, heightAuto   bool               // Is width or height auto?
+	widthRange, heightRange sizeRange          // min-*, max-* constraints
+}
+
+// elementBoxSizes computes used values of width, height, min-*, and max-*
+// properties of elem, whose containing block is containerRect. If the height of
+// the containing block depends on its contents, containerHeightAuto should be set.
+//
+// All sizes are converted to content box size, according to the box-sizing
+// property, so that border and padding can be added on top of it.
+func elementBoxSizes(elem dom.Element, containerRect layout.PhysicalRect, containerHeightAuto bool, border, padding layout.PhysicalEdges) (res boxSizes) {
+	styleSetSrc := cssom.ComputedStyleSetSourceOf(elem)
+	styleSet := styleSetSrc.ComputedStyleSet()
+	fontSize := func() css.Num { return css.NumFromFloat(fontSizeOf(styleSetSrc)) }
+	containerWidth := func() css.Num { return css.NumFromFloat(float64(containerRect.Width)) }
+	containerHeight := func() css.Num { return css.NumFromFloat(float64(containerRect.Height)) }
+
+	// If the height of the containing block is not specified explicitly, percentage
+	// heights can't be resolved.
+	// https://www.w3.org/TR/CSS2/visudet.html#the-height-property
+	// Sizes from border-box sizing need border and padding to be removed.
+	// https://www.w3.org/TR/2021/WD-css-sizing-3-20211217/#box-sizing
+	var horizontalEdges, verticalEdges layout.PhysicalPos
+	if styleSet.BoxSizing() == sizing.BorderBox {
+		horizontalEdges = border.HorizontalSum() + padding.HorizontalSum()
+		verticalEdges = border.VerticalSum() + padding.VerticalSum()
+	}
+	resolve := func(size sizing.Size, containerSize func() css.Num, isHeight bool, edges layout.PhysicalPos) (layout.PhysicalPos, bool) {
+		switch size.Type {
+		case sizing.Auto, sizing.NoneSize:
+			return 0, false
+		case sizing.ManualSize:
+			if _, ok := size.Size.(values.Percentage); ok && isHeight && containerHeightAuto {
+				return 0, false
+			}
+		default:
+			log.Printf("TODO: Support %v size", size)
+			return 0, false
+		}
+		return max(layout.PhysicalPos(size.ComputeUsedValue(containerSize).ToPx(fontSize))-edges, 0), true
+	}
+
+	var ok bool
+	res.width, ok = resolve(styleSet.Width(), containerWidth, false, horizontalEdges)
+	res.widthAuto = !ok
+	res.height, ok = resolve(styleSet.Height(), containerHeight, true, verticalEdges)
+	res.heightAuto = !ok
+
+	// Unresolvable min-* is treated as 0, and unresolvable max-* is treated as none.
+	// https://www.w3.org/TR/CSS2/visudet.html#min-max-heights
+	res.widthRange = unlimitedSizeRange
+	res.heightRange = unlimitedSizeRange
+	if v, ok := resolve(styleSet.MinWidth(), containerWidth, false, horizontalEdges); ok {
+		res.widthRange.min = v
+	}
+	if v, ok := resolve(styleSet.MaxWidth(), containerWidth, false, horizontalEdges); ok {
+		res.widthRange.max = v
+	}
+	if v, ok := resolve(styleSet.MinHeight(), containerHeight, true, verticalEdges); ok {
+		res.heightRange.min = v
+	}
+	if v, ok := resolve(styleSet.MaxHeight(), containerHeight, true, verticalEdges); ok {
+		res.heightRange.max = v
+	}
+	return res
+}
+
+// toLogical maps sizes to logical axes of wm. In vertical writing modes,
+// width and height are swapped.
+func (s boxSizes) toLogical(wm *layout.WritingMode) boxSizes {
+	if wm.IsVertical() {
+		s.width, s.height = s.height, s.width
+		s.widthAuto, s.heightAuto = s.heightAuto, s.widthAuto
+		s.widthRange, s.heightRange = s.heightRange, s.widthRange
+	}
+	return s
+}
+
+// applySizeRanges applies min-* and max-* constraints to bx, after its children
+// were laid out. Only auto sizes are affected, since other sizes are already
+// constrained when the box was created. widthRange and heightRange are in
+// logical axes of bx(See computeBoxRect).
+//
+// Spec: https://www.w3.org/TR/CSS2/visudet.html#min-max-widths
+// Spec: https://www.w3.org/TR/CSS2/visudet.html#min-max-heights
+func applySizeRanges(bx layout.Box, widthRange, heightRange sizeRange) {
+	var widthDiff, heightDiff layout.PhysicalPos
+	if bx.IsWidthAuto() {
+		// TODO: max-width should limit available width when laying out the children,
+		//       so that texts are wrapped inside of it.
+		width := layout.PhysicalPos(bx.LogicalWidth())
+		widthDiff = widthRange.clamp(width) - width
+	}
+	if bx.IsHeightAuto() {
+		height := layout.PhysicalPos(bx.LogicalHeight())
+		heightDiff = heightRange.clamp(height) - height
+	}
+	bx.IncrementSize(layout.LogicalPos(widthDiff), layout.LogicalPos(heightDiff))
+}
@@ -0,0 +1,685 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/backgrounds"
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/display"
+	"github.com/inseo-oh/yw/css/sizing"
+	"github.com/inseo-oh/yw/css/tables"
+	"github.com/inseo-oh/yw/css/values"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/layout"
+	"github.com/inseo-oh/yw/util"
+)
+
+// isTable reports whether elem generates a table wrapper box.
+func isTable(elem dom.Element) bool {
+	if util.IsNil(elem) {
+		return false
+	}
+	styleDisplay := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Display()
+	return styleDisplay.Mode == display.OuterInnerMode && styleDisplay.InnerMode == display.Table
+}
+
+// orphanedTableDisplay returns display to use instead of d, if d is an internal
+// table display, and the element isn't inside of a table.
+//
+// TODO: Generate anonymous table boxes around these, instead.
+//
+// Spec: https://www.w3.org/TR/css-tables-3/#fixup-algorithm
+func orphanedTableDisplay(d display.Display) (display.Display, bool) {
+	switch d.Mode {
+	case display.TableColumnGroup, display.TableColumn:
+		return display.Display{Mode: display.DisplayNone}, true
+	case display.TableRowGroup, display.TableHeaderGroup, display.TableFooterGroup,
+		display.TableRow, display.TableCell, display.TableCaption:
+		return display.Display{Mode: display.OuterInnerMode, OuterMode: display.Block, InnerMode: display.Flow}, true
+	}
+	return d, false
+}
+
+// spanningCell is implemented by elements that may span multiple rows or
+// columns of the table (e.g. HTML td and th elements).
+type spanningCell interface {
+	ColSpan() int
+	RowSpan() int
+}
+
+// spanningColumn is implemented by elements that may represent multiple
+// columns (e.g. HTML col and colgroup elements).
+type spanningColumn interface {
+	Span() int
+}
+
+// tableRowGroup is a row group of the table. elem is nil for anonymous row
+// groups, which are generated for rows outside of row groups.
+type tableRowGroup struct {
+	elem dom.Element
+	rows []*tableRow
+	bcon *layout.BlockContainerBox
+}
+
+// tableRow is a row of the table. elem is nil for anonymous rows, which are
+// generated for cells outside of rows.
+type tableRow struct {
+	elem  dom.Element
+	cells []*tableCell
+	bcon  *layout.BlockContainerBox
+}
+
+// tableCell is a cell of the table. Cells establish independent formatting
+// contexts for their contents, and they are laid out the same way as flex or
+// grid items.
+type tableCell struct {
+	layout.TableCell
+	*containerItem
+	collapsedBorders *layout.CollapsedBorders // Only set in the collapsing border model
+}
+
+// tableParts holds boxes generated from children of the table element.
+//
+// https://www.w3.org/TR/css-tables-3/#table-structure
+type tableParts struct {
+	captions []*containerItem
+	columns  []layout.TableColumn
+	groups   []*tableRowGroup
+}
+
+// collectTableParts collects captions, columns, and row groups from children
+// of the table, generating anonymous row groups, rows, and cells for contents
+// that are not inside of them. Absolutely positioned children are laid out and
+// added to tableBox here, since they don't participate in the table layout.
+//
+// https://www.w3.org/TR/css-tables-3/#fixup-algorithm
+func (tb treeBuilder) collectTableParts(tableBox *layout.BlockContainerBox, children []dom.Node, textDecors []gfx.TextDecorOptions) (res tableParts) {
+	var header, footer *tableRowGroup
+	bodies := []*tableRowGroup{}
+	strayChildren := []dom.Node{}
+	flushStrayChildren := func() {
+		if rows := tb.collectTableRows(tableBox, strayChildren, textDecors); len(rows) != 0 {
+			bodies = append(bodies, &tableRowGroup{rows: rows})
+		}
+		strayChildren = []dom.Node{}
+	}
+	for _, child := range children {
+		elem, ok := child.(dom.Element)
+		if !ok {
+			strayChildren = append(strayChildren, child)
+			continue
+		}
+		if isAbsolutelyPositioned(elem) {
+			tb.layoutTableAbsolutelyPositioned(tableBox, elem, textDecors)
+			continue
+		}
+		styleDisplay := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Display()
+		switch styleDisplay.Mode {
+		case display.DisplayNone:
+		case display.TableCaption:
+			res.captions = append(res.captions, &containerItem{elem: elem})
+		case display.TableColumnGroup:
+			res.columns = append(res.columns, tb.collectTableColumns(tableBox, elem)...)
+		case display.TableColumn:
+			res.columns = append(res.columns, tableColumns(tableBox, elem, nil)...)
+		case display.TableRowGroup, display.TableHeaderGroup, display.TableFooterGroup:
+			flushStrayChildren()
+			group := &tableRowGroup{elem: elem, rows: tb.collectTableRows(tableBox, tb.childNodesOf(elem), textDecors)}
+			// Only the first header and footer groups are moved to the top and
+			// the bottom of the table. Others are treated as normal row groups.
+			// https://www.w3.org/TR/CSS2/tables.html#table-display
+			switch {
+			case styleDisplay.Mode == display.TableHeaderGroup && header == nil:
+				header = group
+			case styleDisplay.Mode == display.TableFooterGroup && footer == nil:
+				footer = group
+			default:
+				bodies = append(bodies, group)
+			}
+		default:
+			strayChildren = append(strayChildren, child)
+		}
+	}
+	flushStrayChildren()
+	if header != nil {
+		res.groups = append(res.groups, header)
+	}
+	res.groups = append(res.groups, bodies...)
+	if footer != nil {
+		res.groups = append(res.groups, footer)
+	}
+	return res
+}
+
+// collectTableRows returns rows from children of a row group. Contiguous
+// contents that are not rows are wrapped in an anonymous row.
+func (tb treeBuilder) collectTableRows(tableBox *layout.BlockContainerBox, children []dom.Node, textDecors []gfx.TextDecorOptions) []*tableRow {
+	rows := []*tableRow{}
+	strayChildren := []dom.Node{}
+	flushStrayChildren := func() {
+		if cells := tb.collectTableCells(tableBox, strayChildren, textDecors); len(cells) != 0 {
+			rows = append(rows, &tableRow{cells: cells})
+		}
+		strayChildren = []dom.Node{}
+	}
+	for _, child := range children {
+		elem, ok := child.(dom.Element)
+		if !ok {
+			strayChildren = append(strayChildren, child)
+			continue
+		}
+		if isAbsolutelyPositioned(elem) {
+			tb.layoutTableAbsolutelyPositioned(tableBox, elem, textDecors)
+			continue
+		}
+		switch cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Display().Mode {
+		case display.DisplayNone:
+		case display.TableRow:
+			flushStrayChildren()
+			rows = append(rows, &tableRow{elem: elem, cells: tb.collectTableCells(tableBox, tb.childNodesOf(elem), textDecors)})
+		default:
+			strayChildren = append(strayChildren, child)
+		}
+	}
+	flushStrayChildren()
+	return rows
+}
+
+// collectTableCells returns cells from children of a row. Contiguous contents
+// that are not cells are wrapped in an anonymous cell. (But not if these are
+// only white spaces)
+func (tb treeBuilder) collectTableCells(tableBox *layout.BlockContainerBox, children []dom.Node, textDecors []gfx.TextDecorOptions) []*tableCell {
+	cells := []*tableCell{}
+	strayChildren := []dom.Node{}
+	flushStrayChildren := func() {
+		if hasInlineContent(strayChildren) {
+			cells = append(cells, &tableCell{
+				TableCell:     layout.TableCell{RowSpan: 1, ColumnSpan: 1},
+				containerItem: &containerItem{children: strayChildren},
+			})
+		}
+		strayChildren = []dom.Node{}
+	}
+	for _, child := range children {
+		elem, ok := child.(dom.Element)
+		if !ok {
+			strayChildren = append(strayChildren, child)
+			continue
+		}
+		if isAbsolutelyPositioned(elem) {
+			tb.layoutTableAbsolutelyPositioned(tableBox, elem, textDecors)
+			continue
+		}
+		if cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Display().Mode != display.TableCell {
+			strayChildren = append(strayChildren, child)
+			continue
+		}
+		flushStrayChildren()
+		c := &tableCell{
+			TableCell:     layout.TableCell{RowSpan: 1, ColumnSpan: 1},
+			containerItem: &containerItem{elem: elem},
+		}
+		if spanning, ok := elem.(spanningCell); ok {
+			c.RowSpan, c.ColumnSpan = spanning.RowSpan(), spanning.ColSpan()
+		}
+		cells = append(cells, c)
+	}
+	flushStrayChildren()
+	return cells
+}
+
+// collectTableColumns returns columns represented by elem, which is a column
+// group. If it has column children, columns come from them. Otherwise the
+// group itself represents columns.
+func (tb treeBuilder) collectTableColumns(tableBox *layout.BlockContainerBox, elem dom.Element) []layout.TableColumn {
+	res := []layout.TableColumn{}
+	for _, child := range tb.childNodesOf(elem) {
+		childElem, ok := child.(dom.Element)
+		if !ok || cssom.ComputedStyleSetSourceOf(childElem).ComputedStyleSet().Display().Mode != display.TableColumn {
+			continue
+		}
+		res = append(res, tableColumns(tableBox, childElem, elem)...)
+	}
+	if len(res) == 0 {
+		res = tableColumns(tableBox, elem, nil)
+	}
+	return res
+}
+
+// tableColumns returns columns represented by elem, which is a column or a
+// column group without column children. group is the column group containing
+// elem, if any, and its width is used if elem doesn't have one.
+func tableColumns(tableBox *layout.BlockContainerBox, elem, group dom.Element) []layout.TableColumn {
+	col := layout.TableColumn{}
+	for _, e := range []dom.Element{elem, group} {
+		if util.IsNil(e) {
+			continue
+		}
+		if width, ok := tableSpecifiedWidth(tableBox, e, layout.PhysicalEdges{}, layout.PhysicalEdges{}); ok {
+			col.SpecifiedWidth, col.HasSpecifiedWidth = width, true
+			break
+		}
+	}
+	span := 1
+	if spanning, ok := elem.(spanningColumn); ok {
+		span = spanning.Span()
+	}
+	res := make([]layout.TableColumn, span)
+	for i := range res {
+		res[i] = col
+	}
+	return res
+}
+
+// tableSpecifiedWidth returns used value of width of elem, which is a part of
+// the table inside of tableBox, as a content box size.
+//
+// TODO: Support percentage widths. For now, these are treated as auto.
+func tableSpecifiedWidth(tableBox *layout.BlockContainerBox, elem dom.Element, border, padding layout.PhysicalEdges) (layout.PhysicalPos, bool) {
+	width := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Width()
+	if _, ok := width.Size.(values.Percentage); ok && width.Type == sizing.ManualSize {
+		return 0, false
+	}
+	sizes := elementBoxSizes(elem, tableBox.BoxContentRect().ToPhysicalRect(), true, border, padding)
+	if sizes.widthAuto {
+		return 0, false
+	}
+	return sizes.widthRange.clamp(sizes.width), true
+}
+
+// layoutTableAbsolutelyPositioned lays out elem, which is an absolutely
+// positioned child of a part of the table inside of tableBox.
+func (tb treeBuilder) layoutTableAbsolutelyPositioned(tableBox *layout.BlockContainerBox, elem dom.Element, textDecors []gfx.TextDecorOptions) {
+	for _, node := range tb.layoutNode(tableBox.Tfc, tableBox.Bfc, tableBox.Ifc, textDecors, tableBox, elem) {
+		tableBox.AddChildBox(node.(layout.Box))
+	}
+}
+
+// newTablePartBox returns an empty box for elem, placed at the content box of
+// parent. This is used for the table box itself, rows and row groups, which
+// only contain other table parts.
+func newTablePartBox(parent *layout.BlockContainerBox, elem dom.Element) *layout.BlockContainerBox {
+	bx := &layout.BlockContainerBox{}
+	bx.Parent = parent
+	bx.ParentBcon = parent
+	bx.Elem = elem
+	bx.IsAnonymous = util.IsNil(elem)
+	bx.Background = backgroundOf(elem)
+	contentRect := parent.BoxContentRect()
+	bx.MarginRect = layout.LogicalRect{LogicalX: contentRect.LogicalX, LogicalY: contentRect.LogicalY, WritingMode: contentRect.WritingMode}
+	bx.ParentFctx = parent.Bfc
+	if parent.Tfc != nil {
+		bx.ParentFctx = parent.Tfc
+	}
+	bx.Bfc = &layout.BlockFormattingContext{}
+	bx.Bfc.OwnerBox = bx
+	bx.OwnsBfc = true
+	bx.Ifc = &layout.InlineFormattingContext{}
+	bx.Ifc.OwnerBox = bx
+	bx.Ifc.BlockContainer = bx
+	return bx
+}
+
+// placeTablePartBox moves and resizes bx, so that its margin box is at given
+// physical position and size.
+func placeTablePartBox(bx layout.Box, left, top, width, height layout.PhysicalPos) {
+	rect := bx.BoxMarginRect().ToPhysicalRect()
+	bx.IncrementSize(bx.BoxMarginRect().WritingMode.PhysicalSizeToLogical(width-rect.Width, height-rect.Height))
+	bx.Translate(bx.BoxMarginRect().WritingMode.PhysicalOffsetToLogical(left-rect.Left, top-rect.Top))
+}
+
+// layoutTable lays out children of wrapper, which is a table wrapper box.
+// The wrapper contains the table box and its captions, and the table box
+// contains row groups, rows, and cells.
+//
+// Spec: https://www.w3.org/TR/CSS2/tables.html
+// Spec: https://www.w3.org/TR/css-tables-3/#table-layout-algorithm
+func (tb treeBuilder) layoutTable(wrapper *layout.BlockContainerBox, children []dom.Node, textDecors []gfx.TextDecorOptions) {
+	elem := wrapper.Elem
+	styleSetSrc := cssom.ComputedStyleSetSourceOf(elem)
+	styleSet := styleSetSrc.ComputedStyleSet()
+	fontSize := func() css.Num { return css.NumFromFloat(fontSizeOf(styleSetSrc)) }
+	isCollapsed := styleSet.BorderCollapse() == tables.Collapse
+
+	// Percentages of the table are resolved against the containing block of
+	// the wrapper. If width of the wrapper is auto (e.g. inline-table), the
+	// table fits inside of the containing block.
+	var cb layout.Box = wrapper
+	containerRect := wrapper.BoxContentRect().ToPhysicalRect()
+	containerHeightAuto := true
+	availableWidth := containerRect.Width
+	if wrapper.ParentBcon != nil {
+		cb = wrapper.ParentBcon
+		containerRect = wrapper.ParentBcon.BoxContentRect().ToPhysicalRect()
+		containerHeightAuto = wrapper.ParentBcon.IsHeightAuto()
+		if wrapper.IsWidthAuto() {
+			availableWidth = max(containerRect.Width-wrapper.Margin.HorizontalSum(), 0)
+		}
+	}
+
+	//==========================================================================
+	// Create the table box, and collect parts of the table.
+	// https://www.w3.org/TR/css-tables-3/#table-wrapper-box
+	//==========================================================================
+	tableBox := newTablePartBox(wrapper, elem)
+	_, tableBox.Border, tableBox.Padding = elementBoxEdges(elem, cb)
+	tableBox.IncrementSize(tableBox.BoxMarginRect().WritingMode.PhysicalSizeToLogical(
+		tableBox.Border.HorizontalSum()+tableBox.Padding.HorizontalSum(),
+		tableBox.Border.VerticalSum()+tableBox.Padding.VerticalSum(),
+	))
+	tfc := &layout.TableFormattingContext{}
+	tfc.OwnerBox = tableBox
+	tableBox.Tfc = tfc
+	if !isCollapsed {
+		spacing := styleSet.BorderSpacing()
+		tfc.HorizontalSpacing = layout.PhysicalPos(spacing.Horizontal.ToPx(fontSize))
+		tfc.VerticalSpacing = layout.PhysicalPos(spacing.Vertical.ToPx(fontSize))
+	}
+	parts := tb.collectTableParts(tableBox, children, textDecors)
+	tfc.Columns = parts.columns
+
+	//==========================================================================
+	// Place cells into the table grid.
+	// https://www.w3.org/TR/css-tables-3/#table-grid
+	//==========================================================================
+	rows := []*tableRow{}
+	rowGroups := []*tableRowGroup{} // Row group of each row
+	cells := []*tableCell{}
+	rowCells := [][]*layout.TableCell{}
+	for _, g := range parts.groups {
+		groupEnd := len(rows) + len(g.rows)
+		for _, r := range g.rows {
+			row := layout.TableRow{GroupEnd: groupEnd}
+			if !util.IsNil(r.elem) {
+				sizes := elementBoxSizes(r.elem, containerRect, true, layout.PhysicalEdges{}, layout.PhysicalEdges{})
+				if !sizes.heightAuto {
+					row.SpecifiedHeight = sizes.heightRange.clamp(sizes.height)
+				}
+			}
+			tfc.Rows = append(tfc.Rows, row)
+			rows = append(rows, r)
+			rowGroups = append(rowGroups, g)
+			layoutCells := []*layout.TableCell{}
+			for _, c := range r.cells {
+				cells = append(cells, c)
+				layoutCells = append(layoutCells, &c.TableCell)
+			}
+			rowCells = append(rowCells, layoutCells)
+		}
+	}
+	tfc.PlaceCells(rowCells)
+	if isCollapsed && len(cells) != 0 {
+		// Borders of the table are collapsed into cells at the edges.
+		tableBox.IncrementSize(tableBox.BoxMarginRect().WritingMode.PhysicalSizeToLogical(
+			-(tableBox.Border.HorizontalSum() + tableBox.Padding.HorizontalSum()),
+			-(tableBox.Border.VerticalSum() + tableBox.Padding.VerticalSum()),
+		))
+		tableBox.Border, tableBox.Padding = layout.PhysicalEdges{}, layout.PhysicalEdges{}
+	}
+	for _, c := range cells {
+		// Cells don't have margins.
+		// https://www.w3.org/TR/css-tables-3/#table-cell
+		c.sizes = boxSizes{widthAuto: true, heightAuto: true, widthRange: unlimitedSizeRange, heightRange: unlimitedSizeRange}
+		if !util.IsNil(c.elem) {
+			_, c.border, c.padding = elementBoxEdges(c.elem, tableBox)
+			c.sizes = elementBoxSizes(c.elem, tableBox.BoxContentRect().ToPhysicalRect(), true, c.border, c.padding)
+		}
+	}
+	if isCollapsed {
+		collapseTableBorders(elem, rows, rowGroups, cells, len(tfc.Columns))
+	}
+	for _, c := range cells {
+		if util.IsNil(c.elem) {
+			continue
+		}
+		if width, ok := tableSpecifiedWidth(tableBox, c.elem, c.border, c.padding); ok {
+			c.SpecifiedWidth, c.HasSpecifiedWidth = width+c.horizontalEdges(), true
+		}
+	}
+	tableEdges := tableBox.Border.HorizontalSum() + tableBox.Padding.HorizontalSum()
+	sizes := elementBoxSizes(elem, containerRect, containerHeightAuto, tableBox.Border, tableBox.Padding)
+
+	//==========================================================================
+	// Determine column widths, and the width of the table.
+	//==========================================================================
+	var width layout.PhysicalPos
+	if styleSet.TableLayout() == tables.FixedLayout && !sizes.widthAuto {
+		// https://www.w3.org/TR/CSS2/tables.html#fixed-table-layout
+		width = sizes.widthRange.clamp(sizes.width)
+		firstRowCells := []*layout.TableCell{}
+		if len(rowCells) != 0 {
+			firstRowCells = rowCells[0]
+		}
+		tfc.FixedColumnWidths(firstRowCells, width)
+	} else {
+		// https://www.w3.org/TR/CSS2/tables.html#auto-table-layout
+		layoutCells := []*layout.TableCell{}
+		for _, c := range cells {
+			// Min-content width is how much the cell overflows when it's laid
+			// out with zero width.
+			tb.layoutContainerItemBox(tableBox, tfc, c.containerItem, 0, false, textDecors)
+			slack, _ := contentSlack(c.bcon)
+			c.MinWidth = c.sizes.widthRange.clamp(max(-layout.PhysicalPos(slack), 0)) + c.horizontalEdges()
+			tb.layoutContainerItemBox(tableBox, tfc, c.containerItem, max(availableWidth-c.horizontalEdges(), 0), true, textDecors)
+			c.MaxWidth = max(c.bcon.BoxMarginRect().ToPhysicalRect().Width, c.MinWidth)
+			if c.HasSpecifiedWidth {
+				c.MinWidth = max(c.MinWidth, c.SpecifiedWidth)
+				c.MaxWidth = c.MinWidth
+			}
+			layoutCells = append(layoutCells, &c.TableCell)
+		}
+		tfc.MeasureColumns(layoutCells)
+		if sizes.widthAuto {
+			width = sizes.widthRange.clamp(min(max(availableWidth-tableEdges, 0), tfc.MaxContentWidth()))
+		} else {
+			width = sizes.widthRange.clamp(sizes.width)
+		}
+		width = max(width, tfc.MinContentWidth())
+		tfc.DistributeWidth(width)
+	}
+	columnPositions := tfc.ColumnPositions()
+	width = max(width, columnPositions[len(columnPositions)-1])
+
+	//==========================================================================
+	// Lay out cells with final column widths, and determine row heights.
+	// https://www.w3.org/TR/CSS2/tables.html#height-layout
+	//==========================================================================
+	for _, c := range cells {
+		spanWidth := columnPositions[c.ColumnEnd()] - columnPositions[c.Column] - tfc.HorizontalSpacing
+		tb.layoutContainerItemBox(tableBox, tfc, c.containerItem, max(spanWidth-c.horizontalEdges(), 0), false, textDecors)
+		c.Height = c.bcon.BoxMarginRect().ToPhysicalRect().Height
+	}
+	layoutCells := []*layout.TableCell{}
+	for _, c := range cells {
+		layoutCells = append(layoutCells, &c.TableCell)
+	}
+	tfc.SizeRows(layoutCells)
+	minHeight := sizes.heightRange.min
+	if !sizes.heightAuto {
+		minHeight = sizes.heightRange.clamp(sizes.height)
+	}
+	tfc.StretchRows(minHeight)
+	rowPositions := tfc.RowPositions()
+	height := max(rowPositions[len(rowPositions)-1], minHeight)
+	tableBox.IncrementSize(layout.LogicalPos(width)-tableBox.LogicalWidth(), layout.LogicalPos(height)-tableBox.LogicalHeight())
+	tfc.IncrementNaturalPos(layout.LogicalPos(height))
+
+	//==========================================================================
+	// Place row groups, rows, and cells.
+	//==========================================================================
+	contentRect := tableBox.BoxContentRect().ToPhysicalRect()
+	gridLeft := contentRect.Left + columnPositions[0]
+	gridWidth := max(columnPositions[len(columnPositions)-1]-columnPositions[0]-tfc.HorizontalSpacing, 0)
+	rowTop := func(idx int) layout.PhysicalPos { return contentRect.Top + rowPositions[idx] }
+	rowIdx := 0
+	for _, g := range parts.groups {
+		g.bcon = newTablePartBox(tableBox, g.elem)
+		groupStart := rowIdx
+		for _, r := range g.rows {
+			r.bcon = newTablePartBox(g.bcon, r.elem)
+			placeTablePartBox(r.bcon, gridLeft, rowTop(rowIdx), gridWidth, tfc.Rows[rowIdx].Height)
+			rowIdx++
+		}
+		if groupStart != rowIdx {
+			placeTablePartBox(g.bcon, gridLeft, rowTop(groupStart), gridWidth, rowTop(rowIdx)-rowTop(groupStart)-tfc.VerticalSpacing)
+		}
+	}
+	for _, c := range cells {
+		// TODO: Support vertical-align. For now, contents are at the top of cells.
+		areaHeight := rowPositions[c.RowEnd()] - rowPositions[c.Row] - tfc.VerticalSpacing
+		placeTablePartBox(c.bcon, contentRect.Left+columnPositions[c.Column], rowTop(c.Row), c.bcon.BoxMarginRect().ToPhysicalRect().Width, areaHeight)
+		c.bcon.CollapsedBorders = c.collapsedBorders
+	}
+	for _, g := range parts.groups {
+		tableBox.AddChildBox(g.bcon)
+		for _, r := range g.rows {
+			g.bcon.AddChildBox(r.bcon)
+			for _, c := range r.cells {
+				c.bcon.Parent, c.bcon.ParentBcon = r.bcon, r.bcon
+				r.bcon.AddChildBox(c.bcon)
+			}
+		}
+	}
+
+	//==========================================================================
+	// Place captions around the table box, and size the wrapper.
+	// https://www.w3.org/TR/css-tables-3/#bounding-box-assignment
+	//==========================================================================
+	tableRect := tableBox.BoxMarginRect().ToPhysicalRect()
+	var topHeight, bottomHeight layout.PhysicalPos
+	bottomCaptions := []*containerItem{}
+	for _, it := range parts.captions {
+		initContainerItem(wrapper, it)
+		captionWidth := max(tableRect.Width-it.horizontalEdges(), 0)
+		if !it.sizes.widthAuto {
+			captionWidth = it.sizes.widthRange.clamp(it.sizes.width)
+		}
+		tb.layoutContainerItemBox(wrapper, tfc, it, captionWidth, false, textDecors)
+		if cssom.ComputedStyleSetSourceOf(it.elem).ComputedStyleSet().CaptionSide() == tables.CaptionBottom {
+			bottomCaptions = append(bottomCaptions, it)
+			continue
+		}
+		it.bcon.Translate(it.bcon.BoxMarginRect().WritingMode.PhysicalOffsetToLogical(0, topHeight))
+		topHeight += it.bcon.BoxMarginRect().ToPhysicalRect().Height
+	}
+	tableBox.Translate(tableBox.BoxMarginRect().WritingMode.PhysicalOffsetToLogical(0, topHeight))
+	for _, it := range bottomCaptions {
+		it.bcon.Translate(it.bcon.BoxMarginRect().WritingMode.PhysicalOffsetToLogical(0, topHeight+tableRect.Height+bottomHeight))
+		bottomHeight += it.bcon.BoxMarginRect().ToPhysicalRect().Height
+	}
+	for _, it := range parts.captions {
+		wrapper.AddChildBox(it.bcon)
+	}
+	wrapper.AddChildBox(tableBox)
+	wrapper.IncrementSize(layout.LogicalPos(tableRect.Width)-wrapper.LogicalWidth(), 0)
+	wrapper.Bfc.IncrementNaturalPos(layout.LogicalPos(topHeight + tableRect.Height + bottomHeight))
+
+	tb.placePositionedBoxes(tableBox)
+	for _, c := range cells {
+		if !util.IsNil(c.elem) {
+			tb.finishPositionedLayout(c.bcon, c.elem, c.bcon.ParentBcon)
+		}
+	}
+	for _, it := range parts.captions {
+		tb.finishPositionedLayout(it.bcon, it.elem, wrapper)
+	}
+}
+
+// collapsedBordersOf returns borders of elem in the collapsing border model,
+// before resolving conflicts with others. elem may be nil for anonymous boxes.
+func collapsedBordersOf(elem dom.Element) layout.CollapsedBorders {
+	if util.IsNil(elem) {
+		none := layout.CollapsedBorderSide{Style: backgrounds.NoLine}
+		return layout.CollapsedBorders{Top: none, Right: none, Bottom: none, Left: none}
+	}
+	styleSetSrc := cssom.ComputedStyleSetSourceOf(elem)
+	styleSet := styleSetSrc.ComputedStyleSet()
+	fontSize := func() css.Num { return css.NumFromFloat(fontSizeOf(styleSetSrc)) }
+	currColor := styleSetSrc.CurrentColor()
+	side := func(width values.Length, style backgrounds.LineStyle) layout.CollapsedBorderSide {
+		return layout.CollapsedBorderSide{Width: layout.PhysicalPos(width.ToPx(fontSize)), Style: style}
+	}
+	res := layout.CollapsedBorders{
+		Top:    side(styleSet.BorderTopWidth(), styleSet.BorderTopStyle()),
+		Right:  side(styleSet.BorderRightWidth(), styleSet.BorderRightStyle()),
+		Bottom: side(styleSet.BorderBottomWidth(), styleSet.BorderBottomStyle()),
+		Left:   side(styleSet.BorderLeftWidth(), styleSet.BorderLeftStyle()),
+	}
+	res.Top.Color = styleSet.BorderTopColor().ToStdColor(currColor)
+	res.Right.Color = styleSet.BorderRightColor().ToStdColor(currColor)
+	res.Bottom.Color = styleSet.BorderBottomColor().ToStdColor(currColor)
+	res.Left.Color = styleSet.BorderLeftColor().ToStdColor(currColor)
+	return res
+}
+
+// collapseTableBorders resolves borders of cells in the collapsing border
+// model, where rows[i] belongs to rowGroups[i]. Borders of the table, row
+// groups, and rows are collapsed into cells next to them.
+//
+// Each cell owns its top and left borders, as well as right and bottom borders
+// at the edges of the table, so that borders between cells are only drawn once.
+//
+// TODO: Borders should be centered on grid lines, with half of the width taken
+// from cells on each side.
+//
+// Spec: https://www.w3.org/TR/CSS2/tables.html#collapsing-borders
+func collapseTableBorders(table dom.Element, rows []*tableRow, rowGroups []*tableRowGroup, cells []*tableCell, columnCount int) {
+	tableBorders := collapsedBordersOf(table)
+	rowBorders := make([]layout.CollapsedBorders, len(rows))
+	groupBorders := make([]layout.CollapsedBorders, len(rows))
+	slots := make([][]*tableCell, len(rows))
+	for i := range rows {
+		rowBorders[i] = collapsedBordersOf(rows[i].elem)
+		groupBorders[i] = collapsedBordersOf(rowGroups[i].elem)
+		slots[i] = make([]*tableCell, columnCount)
+	}
+	cellBorders := map[*tableCell]layout.CollapsedBorders{}
+	for _, c := range cells {
+		cellBorders[c] = collapsedBordersOf(c.elem)
+		for r := c.Row; r < c.RowEnd(); r++ {
+			for col := c.Column; col < c.ColumnEnd(); col++ {
+				slots[r][col] = c
+			}
+		}
+	}
+	none := layout.CollapsedBorderSide{Style: backgrounds.NoLine}
+	for _, c := range cells {
+		own := cellBorders[c]
+		res := layout.CollapsedBorders{Top: none, Right: none, Bottom: none, Left: none}
+
+		top := []layout.CollapsedBorderSide{own.Top}
+		if c.Row == 0 {
+			top = append(top, rowBorders[c.Row].Top, groupBorders[c.Row].Top, tableBorders.Top)
+		} else {
+			if above := slots[c.Row-1][c.Column]; above != nil {
+				top = append(top, cellBorders[above].Bottom)
+			}
+			top = append(top, rowBorders[c.Row].Top, rowBorders[c.Row-1].Bottom)
+			if rowGroups[c.Row] != rowGroups[c.Row-1] {
+				top = append(top, groupBorders[c.Row].Top, groupBorders[c.Row-1].Bottom)
+			}
+		}
+		res.Top = layout.ResolveCollapsedBorder(top...)
+
+		left := []layout.CollapsedBorderSide{own.Left}
+		if c.Column == 0 {
+			left = append(left, rowBorders[c.Row].Left, groupBorders[c.Row].Left, tableBorders.Left)
+		} else if prev := slots[c.Row][c.Column-1]; prev != nil {
+			left = append(left, cellBorders[prev].Right)
+		}
+		res.Left = layout.ResolveCollapsedBorder(left...)
+
+		if c.ColumnEnd() == columnCount {
+			res.Right = layout.ResolveCollapsedBorder(own.Right, rowBorders[c.Row].Right, groupBorders[c.Row].Right, tableBorders.Right)
+		}
+		if last := c.RowEnd() - 1; c.RowEnd() == len(rows) {
+			res.Bottom = layout.ResolveCollapsedBorder(own.Bottom, rowBorders[last].Bottom, groupBorders[last].Bottom, tableBorders.Bottom)
+		}
+		c.border = layout.PhysicalEdges{Top: res.Top.Width, Right: res.Right.Width, Bottom: res.Bottom.Width, Left: res.Left.Width}
+		c.collapsedBorders = &res
+	}
+}
@@ -0,0 +1,71 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"math"
+	"strings"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/css/text"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/layout"
+)
+
+// unlimitedLineWidth is used as available width of lines when text is not
+// wrapped.
+var unlimitedLineWidth = layout.LogicalPos(math.Inf(1))
+
+// tabStopInterval returns distance between tab stops, using tab-size from
+// styleSet. Current font of tb must be set to the font used by the text.
+//
+// Spec: https://www.w3.org/TR/css-text-3/#tab-size-property
+func (tb treeBuilder) tabStopInterval(styleSet *props.ComputedStyleSet, fontSize float64, orientation gfx.TextOrientation) layout.LogicalPos {
+	tabSize := styleSet.TabSize()
+	if tabSize.Type == text.LengthTabSize {
+		return layout.LogicalPos(tabSize.Length.ToPx(func() css.Num { return css.NumFromFloat(fontSize) }))
+	}
+	return tb.measureText(" ", orientation) * layout.LogicalPos(tabSize.Number.ToFloat())
+}
+
+// expandTabs replaces preserved tabs in str with spaces, so that text after
+// each tab starts at the next tab stop. x is where str starts on the line.
+// Current font of tb must be set to the font used by the text.
+//
+// If the next tab stop is closer than half of the width of a space, the one
+// after it is used instead.
+//
+// TODO: Tabs should be rendered as a single advance, rather than spaces that
+// only approximate tab stops when font doesn't have fixed width.
+// TODO: Tab stops are found as if text before the tab was on the same line,
+// which is not the case if the text is wrapped before it.
+//
+// Spec: https://www.w3.org/TR/css-text-3/#white-space-phase-2
+func (tb treeBuilder) expandTabs(str string, x, interval layout.LogicalPos, orientation gfx.TextOrientation) string {
+	spaceWidth := tb.measureText(" ", orientation)
+	if !strings.Contains(str, "\t") || interval <= 0 || spaceWidth <= 0 {
+		return strings.ReplaceAll(str, "\t", " ")
+	}
+	sb := strings.Builder{}
+	lineStart := 0
+	for _, r := range str {
+		switch r {
+		case '\n':
+			x, lineStart = 0, sb.Len()+1
+		case '\t':
+			x += tb.measureText(sb.String()[lineStart:], orientation)
+			stop := layout.LogicalPos(math.Floor(float64(x/interval))+1) * interval
+			if stop-x < spaceWidth/2 {
+				stop += interval
+			}
+			sb.WriteString(strings.Repeat(" ", max(int(math.Round(float64((stop-x)/spaceWidth))), 1)))
+			x, lineStart = stop, sb.Len()
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
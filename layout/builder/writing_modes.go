@@ -0,0 +1,134 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package builder
+
+import (
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/css/writingmodes"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/layout"
+	"github.com/inseo-oh/yw/util"
+)
+
+// principalWritingModeOf returns the principal writing mode of the document
+// whose root element is root. It's used by the root element and the initial
+// containing block.
+//
+// Spec: https://www.w3.org/TR/css-writing-modes-3/#principal-flow
+func principalWritingModeOf(root dom.Element) writingmodes.WritingMode {
+	// If the root element has a body child, its writing mode is used instead.
+	for _, child := range root.Children() {
+		if elem, ok := child.(dom.Element); ok && elem.IsHtmlElement("body") {
+			return cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().WritingMode()
+		}
+	}
+	return cssom.ComputedStyleSetSourceOf(root).ComputedStyleSet().WritingMode()
+}
+
+// contentWritingModeOf returns the writing mode of contents of the block
+// container for elem. ok is false for anonymous boxes, which always use the
+// writing mode of the parent.
+func (tb treeBuilder) contentWritingModeOf(parentBox layout.Box, elem dom.Element) (mode writingmodes.WritingMode, ok bool) {
+	if util.IsNil(elem) {
+		if util.IsNil(parentBox) {
+			// Initial containing block
+			return tb.principalWritingMode, true
+		}
+		return writingmodes.HorizontalTb, false
+	}
+	if _, isRoot := elem.Parent().(dom.Document); isRoot {
+		return tb.principalWritingMode, true
+	}
+	return cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().WritingMode(), true
+}
+
+// layoutInWritingMode lays out children of bcon, whose contents are in the
+// writing mode other than its containing block's. Children are placed inside
+// of an anonymous block container filling bcon, which starts the new logical
+// coordinate space.
+//
+// Spec: https://www.w3.org/TR/css-writing-modes-3/#orthogonal-flows
+func (tb treeBuilder) layoutInWritingMode(bcon *layout.BlockContainerBox, mode writingmodes.WritingMode, children []dom.Node, textDecors []gfx.TextDecorOptions) {
+	wm := &layout.WritingMode{Mode: mode, Container: bcon}
+	contentRect := bcon.BoxContentRect()
+	inlineSize, blockSize := contentRect.LogicalWidth, contentRect.LogicalHeight
+	inlineAuto, blockAuto := bcon.IsWidthAuto(), bcon.IsHeightAuto()
+	isOrthogonal := wm.IsOrthogonalTo(contentRect.WritingMode)
+	if isOrthogonal {
+		inlineSize, blockSize = blockSize, inlineSize
+		inlineAuto, blockAuto = blockAuto, inlineAuto
+	}
+	if inlineAuto {
+		// Auto inline size of orthogonal flows is resolved against the initial
+		// containing block.
+		// TODO: Use fit-content size of the contents
+		// Spec: https://www.w3.org/TR/css-writing-modes-3/#orthogonal-auto
+		inlineSize, _ = wm.PhysicalSizeToLogical(tb.pos.viewport.Width, tb.pos.viewport.Height)
+		if isOrthogonal {
+			bcon.IncrementSize(0, inlineSize-contentRect.LogicalHeight)
+		} else {
+			bcon.IncrementSize(inlineSize-contentRect.LogicalWidth, 0)
+		}
+	}
+	if blockAuto {
+		blockSize = 0
+	}
+
+	rect := layout.LogicalRect{LogicalWidth: inlineSize, LogicalHeight: blockSize, WritingMode: wm}
+	inner := tb.newBlockContainer(
+		nil, nil, bcon, nil, nil, rect, layout.PhysicalEdges{}, layout.PhysicalEdges{}, layout.PhysicalEdges{},
+		false, blockAuto, false, children, textDecors,
+	)
+	inner.IsAnonymous = true
+	bcon.AddChildBox(inner)
+	if inlineAuto {
+		shrinkToFit(inner, unlimitedSizeRange)
+	}
+
+	// Size of bcon is now determined by contents in the other writing mode.
+	bcon.PhysicalWidthAuto = false
+	bcon.PhysicalHeightAuto = false
+}
+
+// textOrientationOf returns how texts are drawn in wm, using text-orientation
+// from styleSet.
+//
+// Spec: https://www.w3.org/TR/css-writing-modes-3/#text-orientation
+func textOrientationOf(wm *layout.WritingMode, styleSet *props.ComputedStyleSet) gfx.TextOrientation {
+	if !wm.IsVertical() {
+		return gfx.HorizontalText
+	}
+	switch styleSet.TextOrientation() {
+	case writingmodes.Upright:
+		return gfx.VerticalUprightText
+	case writingmodes.Sideways:
+		return gfx.VerticalSidewaysText
+	}
+	return gfx.VerticalMixedText
+}
+
+// measureText returns the advance of str along the inline axis, when drawn in
+// given orientation.
+func (tb treeBuilder) measureText(str string, orientation gfx.TextOrientation) layout.LogicalPos {
+	return tb.textFonts.measure(str, orientation)
+}
+
+// edgesInlineSum returns total size of edges along the inline axis of wm.
+func edgesInlineSum(wm *layout.WritingMode, margin, border, padding layout.PhysicalEdges) layout.PhysicalPos {
+	if wm.IsVertical() {
+		return margin.VerticalSum() + border.VerticalSum() + padding.VerticalSum()
+	}
+	return margin.HorizontalSum() + border.HorizontalSum() + padding.HorizontalSum()
+}
+
+// edgesBlockSum returns total size of edges along the block axis of wm.
+func edgesBlockSum(wm *layout.WritingMode, margin, border, padding layout.PhysicalEdges) layout.PhysicalPos {
+	if wm.IsVertical() {
+		return margin.HorizontalSum() + border.HorizontalSum() + padding.HorizontalSum()
+	}
+	return margin.VerticalSum() + border.VerticalSum() + padding.VerticalSum()
+}
@@ -0,0 +1,95 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package layout
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/inseo-oh/yw/css"
+	"github.com/inseo-oh/yw/css/backgrounds"
+	"github.com/inseo-oh/yw/css/props"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/gfx/paint"
+)
+
+// borderShape returns the border box with rounded corners given by
+// border-*-radius properties.
+//
+// Spec: https://www.w3.org/TR/css-backgrounds-3/#border-radius
+func (bx boxCommon) borderShape(styleSet *props.ComputedStyleSet) gfx.RoundedRect {
+	rect := bx.BoxBorderRect().ToPhysicalRect()
+	if bx.CollapsedBorders != nil {
+		// border-radius doesn't apply to tables in the collapsing border model.
+		return gfx.RoundedRect{Rect: rect.toImageRect()}
+	}
+	fontSize := func() css.Num { return css.NumFromFloat(bx.Background.FontSize) }
+	width := func() css.Num { return css.NumFromFloat(float64(rect.Width)) }
+	height := func() css.Num { return css.NumFromFloat(float64(rect.Height)) }
+	radii := gfx.CornerRadii{}
+	for i, r := range []backgrounds.Radius{
+		styleSet.BorderTopLeftRadius(), styleSet.BorderTopRightRadius(),
+		styleSet.BorderBottomRightRadius(), styleSet.BorderBottomLeftRadius(),
+	} {
+		radii[i] = [2]float64{
+			r.Horizontal.AsLength(width).ToPx(fontSize),
+			r.Vertical.AsLength(height).ToPx(fontSize),
+		}
+	}
+	return gfx.NewRoundedRect(rect.toImageRect(), radii)
+}
+
+// areaShape returns shape of the box area given by background-clip, with
+// corners following curve of the border box.
+//
+// Spec: https://www.w3.org/TR/css-backgrounds-3/#corner-clipping
+func (bx boxCommon) areaShape(borderShape gfx.RoundedRect, area backgrounds.Box) gfx.RoundedRect {
+	edges := PhysicalEdges{}
+	switch area {
+	case backgrounds.PaddingBox:
+		edges = bx.Border
+	case backgrounds.ContentBox:
+		edges = PhysicalEdges{
+			Top:    bx.Border.Top + bx.Padding.Top,
+			Right:  bx.Border.Right + bx.Padding.Right,
+			Bottom: bx.Border.Bottom + bx.Padding.Bottom,
+			Left:   bx.Border.Left + bx.Padding.Left,
+		}
+	}
+	shape := borderShape.Inset(float64(edges.Top), float64(edges.Right), float64(edges.Bottom), float64(edges.Left))
+	// Rect is snapped the same way as one without rounded corners.
+	shape.Rect = bx.backgroundAreaRect(area).toImageRect()
+	return shape
+}
+
+// makeBoxShadowNodes paints outer shadows(or inner shadows, if inset is set)
+// of box-shadow, from the bottom-most one.
+//
+// Spec: https://www.w3.org/TR/css-backgrounds-3/#box-shadow
+func (bx boxCommon) makeBoxShadowNodes(styleSet *props.ComputedStyleSet, currentColor color.Color, borderShape gfx.RoundedRect, inset bool) []paint.Node {
+	fontSize := func() css.Num { return css.NumFromFloat(bx.Background.FontSize) }
+	shadows := styleSet.BoxShadow()
+	nodes := []paint.Node{}
+	for i := len(shadows) - 1; 0 <= i; i-- {
+		s := shadows[i]
+		if s.Inset != inset {
+			continue
+		}
+		node := paint.BoxShadowPaint{
+			Box:     borderShape,
+			Color:   s.Color.ToStdColor(currentColor),
+			OffsetX: int(math.Round(s.OffsetX.ToPx(fontSize))),
+			OffsetY: int(math.Round(s.OffsetY.ToPx(fontSize))),
+			Blur:    s.Blur.ToPx(fontSize),
+			Spread:  s.Spread.ToPx(fontSize),
+			Inset:   s.Inset,
+		}
+		if inset {
+			node.Box = bx.areaShape(borderShape, backgrounds.PaddingBox)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
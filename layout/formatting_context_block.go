@@ -4,7 +4,10 @@
 
 package layout
 
-import "log"
+import (
+	"log"
+	"slices"
+)
 
 // Block Formatting Contexts(BFC for short) are responsible for tracking Y-axis,
 // or more accurately, the opposite axis of writing mode.
@@ -16,17 +19,105 @@ type BlockFormattingContext struct {
 	CurrentNaturalPos  LogicalPos
 	LeftFloatingBoxes  []Box
 	RightFloatingBoxes []Box
+
+	pendingMargins adjoiningMargins     // Margins adjoining CurrentNaturalPos, that are not applied yet
+	pendingBoxes   []*BlockContainerBox // Boxes whose top border edge comes after pendingMargins
+}
+
+// adjoiningMargins holds set of adjoining margins, which collapse into a single margin.
+//
+// https://www.w3.org/TR/CSS2/box.html#collapsing-margins
+type adjoiningMargins struct {
+	positive LogicalPos // The largest positive margin
+	negative LogicalPos // The most negative margin
+}
+
+func (m *adjoiningMargins) add(margin LogicalPos) {
+	if 0 < margin {
+		m.positive = max(m.positive, margin)
+	} else {
+		m.negative = min(m.negative, margin)
+	}
+}
+
+// collapsed returns width of the collapsed margin.
+func (m adjoiningMargins) collapsed() LogicalPos {
+	return m.positive + m.negative
 }
 
 func (bfc BlockFormattingContext) NaturalPos() LogicalPos {
 	return bfc.CurrentNaturalPos
 }
+
+// IncrementNaturalPos applies pending margins, and then increments the natural
+// position by pos. If pos is zero, pending margins are kept, as nothing separates
+// them from margins that follow.
 func (bfc *BlockFormattingContext) IncrementNaturalPos(pos LogicalPos) {
+	if pos == 0 {
+		return
+	}
+	bfc.ApplyMargins()
 	bfc.CurrentNaturalPos += pos
 	if pos < 0 {
 		log.Printf("warning: attempted to increment natural position with negative value %g", pos)
 	}
 }
+
+// AddMargin adds margin to the set of margins adjoining current natural position.
+// These are collapsed into single margin, which is applied when something
+// separates them from margins that follow(See ApplyMargins).
+func (bfc *BlockFormattingContext) AddMargin(margin LogicalPos) {
+	bfc.pendingMargins.add(margin)
+}
+
+// AddPendingBox adds bcon, whose top margin has just been added with AddMargin.
+// Its vertical position is determined when the margins are applied.
+func (bfc *BlockFormattingContext) AddPendingBox(bcon *BlockContainerBox) {
+	bfc.pendingBoxes = append(bfc.pendingBoxes, bcon)
+}
+
+// IsPendingBox reports whether bcon's vertical position is still waiting for
+// adjoining margins to be applied.
+func (bfc *BlockFormattingContext) IsPendingBox(bcon *BlockContainerBox) bool {
+	return slices.Contains(bfc.pendingBoxes, bcon)
+}
+
+// ApplyMargins collapses the pending margins, and increments natural position
+// by the result. Pending boxes are placed so that their top border edges are
+// at the new natural position.
+//
+// https://www.w3.org/TR/CSS2/box.html#collapsing-margins
+func (bfc *BlockFormattingContext) ApplyMargins() {
+	bfc.CurrentNaturalPos += bfc.pendingMargins.collapsed()
+	bfc.placeBoxes(bfc.pendingBoxes, bfc.CurrentNaturalPos)
+	bfc.pendingMargins = adjoiningMargins{}
+	bfc.pendingBoxes = nil
+}
+
+// CollapseThrough places bcon, a pending box whose top and bottom margins are
+// adjoining (i.e. margins collapse through it). Margins are kept pending.
+//
+// If its top margin collapses with its parent's, it's placed along with the
+// parent. Otherwise, it's placed as if it had non-zero bottom border.
+//
+// https://www.w3.org/TR/CSS2/box.html#collapsing-margins
+func (bfc *BlockFormattingContext) CollapseThrough(bcon *BlockContainerBox) {
+	if idx := slices.Index(bfc.pendingBoxes, bcon); idx != 0 {
+		return
+	}
+	bfc.placeBoxes(bfc.pendingBoxes, bfc.CurrentNaturalPos+bfc.pendingMargins.collapsed())
+	bfc.pendingBoxes = nil
+}
+
+// placeBoxes places boxes so that their top border edges are at natural position pos.
+func (bfc *BlockFormattingContext) placeBoxes(boxes []*BlockContainerBox, pos LogicalPos) {
+	baseLogicalY := bfc.ContextOwnerBox().BoxContentRect().LogicalY
+	for _, bcon := range boxes {
+		// TODO: Support vertical writing mode
+		bcon.MarginRect.LogicalY = baseLogicalY + pos - LogicalPos(bcon.MarginRect.WritingMode.LogicalEdges(bcon.Margin).BlockStart)
+	}
+}
+
 func (bfc *BlockFormattingContext) leftFloatWidth(forLogicalY LogicalPos) LogicalPos {
 	sum := LogicalPos(0.0)
 	for _, bx := range bfc.LeftFloatingBoxes {
@@ -0,0 +1,238 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package layout
+
+import (
+	"log"
+	"math"
+)
+
+// Flex Formatting Contexts(FFC for short) are responsible for laying out flex
+// items of a flex container along its main axis, breaking them into flex lines
+// and distributing free space between them.
+//
+// Once all flex lines are laid out, natural position is advanced by the height
+// of the flex container.
+//
+// https://www.w3.org/TR/css-flexbox-1/#flex-formatting-context
+type FlexFormattingContext struct {
+	formattingContextCommon
+	CurrentNaturalPos LogicalPos
+	Lines             []FlexLine
+}
+
+func (ffc FlexFormattingContext) NaturalPos() LogicalPos {
+	return ffc.CurrentNaturalPos
+}
+func (ffc *FlexFormattingContext) IncrementNaturalPos(pos LogicalPos) {
+	if pos < 0 {
+		log.Printf("warning: attempted to increment natural position with negative value %g", pos)
+	}
+	ffc.CurrentNaturalPos += pos
+}
+
+// FlexItem holds sizes of a flex item in the main axis, used by the flex
+// layout algorithm. Sizes are in terms of the content box, unless noted otherwise.
+//
+// https://www.w3.org/TR/css-flexbox-1/#flex-items
+type FlexItem struct {
+	Box          Box         // Box generated by the flex item
+	FlexBaseSize PhysicalPos // https://www.w3.org/TR/css-flexbox-1/#flex-base-size
+	MinMainSize  PhysicalPos // Used value of min-width or min-height
+	MaxMainSize  PhysicalPos // Used value of max-width or max-height (+Inf if it's none)
+	OuterEdges   PhysicalPos // Sum of margins, borders and paddings in the main axis
+	GrowFactor   float64     // https://www.w3.org/TR/css-flexbox-1/#flex-grow-factor
+	ShrinkFactor float64     // https://www.w3.org/TR/css-flexbox-1/#flex-shrink-factor
+
+	// Final main size, set by [FlexLine.ResolveFlexibleLengths].
+	TargetMainSize PhysicalPos
+
+	frozen bool
+}
+
+func (it FlexItem) clampMainSize(size PhysicalPos) PhysicalPos {
+	// Note that min wins if min is greater than max.
+	return max(min(size, it.MaxMainSize), it.MinMainSize)
+}
+
+// HypotheticalMainSize returns the flex base size clamped by min/max main size.
+//
+// https://www.w3.org/TR/css-flexbox-1/#hypothetical-main-size
+func (it FlexItem) HypotheticalMainSize() PhysicalPos {
+	return it.clampMainSize(it.FlexBaseSize)
+}
+
+// OuterTargetMainSize returns TargetMainSize, including margins, borders and paddings.
+func (it FlexItem) OuterTargetMainSize() PhysicalPos {
+	return it.TargetMainSize + it.OuterEdges
+}
+
+// FlexLine is a line of flex items.
+//
+// https://www.w3.org/TR/css-flexbox-1/#flex-line
+type FlexLine struct {
+	Items     []*FlexItem
+	CrossSize PhysicalPos // Size of the line in the cross axis. This is set by the caller.
+}
+
+// CollectLines breaks items into flex lines, and stores them to ffc.Lines.
+// If isSingleLine is set, all items are put into a single line. gap is the
+// gutter between adjacent items in the main axis.
+//
+// https://www.w3.org/TR/css-flexbox-1/#algo-line-break
+func (ffc *FlexFormattingContext) CollectLines(items []*FlexItem, availableMainSize PhysicalPos, isSingleLine bool, gap PhysicalPos) {
+	ffc.Lines = nil
+	var line FlexLine
+	var lineMainSize PhysicalPos
+	for _, it := range items {
+		outerSize := it.HypotheticalMainSize() + it.OuterEdges
+		if len(line.Items) != 0 {
+			// Items are collected as long as the next one fits. The first item
+			// is always collected, even if it overflows.
+			if !isSingleLine && availableMainSize < lineMainSize+gap+outerSize {
+				ffc.Lines = append(ffc.Lines, line)
+				line = FlexLine{}
+				lineMainSize = 0
+			} else {
+				lineMainSize += gap
+			}
+		}
+		line.Items = append(line.Items, it)
+		lineMainSize += outerSize
+	}
+	if len(line.Items) != 0 {
+		ffc.Lines = append(ffc.Lines, line)
+	}
+}
+
+// ResolveFlexibleLengths determines TargetMainSize of items in the line, by
+// distributing free space of the line according to flex factors.
+// If availableMainSize is infinite, items keep their hypothetical main sizes.
+//
+// https://www.w3.org/TR/css-flexbox-1/#resolve-flexible-lengths
+func (line *FlexLine) ResolveFlexibleLengths(availableMainSize PhysicalPos, gap PhysicalPos) {
+	gaps := gap * PhysicalPos(max(len(line.Items)-1, 0))
+
+	// S1. Determine the used flex factor.
+	var sumOuterHypotheticalSizes PhysicalPos
+	for _, it := range line.Items {
+		sumOuterHypotheticalSizes += it.HypotheticalMainSize() + it.OuterEdges
+	}
+	isGrowing := sumOuterHypotheticalSizes+gaps < availableMainSize
+	if math.IsInf(float64(availableMainSize), 1) {
+		for _, it := range line.Items {
+			it.TargetMainSize = it.HypotheticalMainSize()
+		}
+		return
+	}
+
+	// S2. Size inflexible items.
+	for _, it := range line.Items {
+		it.frozen = false
+		it.TargetMainSize = it.HypotheticalMainSize()
+		if isGrowing && (it.GrowFactor == 0 || it.HypotheticalMainSize() < it.FlexBaseSize) {
+			it.frozen = true
+		} else if !isGrowing && (it.ShrinkFactor == 0 || it.FlexBaseSize < it.HypotheticalMainSize()) {
+			it.frozen = true
+		}
+	}
+
+	// S3. Calculate initial free space.
+	remainingFreeSpace := func() PhysicalPos {
+		res := availableMainSize - gaps
+		for _, it := range line.Items {
+			if it.frozen {
+				res -= it.TargetMainSize + it.OuterEdges
+			} else {
+				res -= it.FlexBaseSize + it.OuterEdges
+			}
+		}
+		return res
+	}
+	initialFreeSpace := remainingFreeSpace()
+
+	// S4. Loop
+	for {
+		// a. Check for flexible items.
+		unfrozenItems := []*FlexItem{}
+		for _, it := range line.Items {
+			if !it.frozen {
+				unfrozenItems = append(unfrozenItems, it)
+			}
+		}
+		if len(unfrozenItems) == 0 {
+			break
+		}
+
+		// b. Calculate the remaining free space.
+		freeSpace := remainingFreeSpace()
+		var sumFlexFactors float64
+		for _, it := range unfrozenItems {
+			if isGrowing {
+				sumFlexFactors += it.GrowFactor
+			} else {
+				sumFlexFactors += it.ShrinkFactor
+			}
+		}
+		if sumFlexFactors < 1 {
+			// Fractional flex factors only take some of the free space.
+			if v := initialFreeSpace * PhysicalPos(sumFlexFactors); math.Abs(float64(v)) < math.Abs(float64(freeSpace)) {
+				freeSpace = v
+			}
+		}
+
+		// c. Distribute free space proportional to the flex factors.
+		if freeSpace != 0 {
+			if isGrowing {
+				for _, it := range unfrozenItems {
+					it.TargetMainSize = it.FlexBaseSize + freeSpace*PhysicalPos(it.GrowFactor/sumFlexFactors)
+				}
+			} else {
+				var sumScaledShrinkFactors float64
+				for _, it := range unfrozenItems {
+					sumScaledShrinkFactors += it.ShrinkFactor * float64(it.FlexBaseSize)
+				}
+				for _, it := range unfrozenItems {
+					ratio := 0.0
+					if sumScaledShrinkFactors != 0 {
+						ratio = it.ShrinkFactor * float64(it.FlexBaseSize) / sumScaledShrinkFactors
+					}
+					it.TargetMainSize = it.FlexBaseSize - PhysicalPos(math.Abs(float64(freeSpace))*ratio)
+				}
+			}
+		} else {
+			for _, it := range unfrozenItems {
+				it.TargetMainSize = it.FlexBaseSize
+			}
+		}
+
+		// d. Fix min/max violations.
+		var totalViolation PhysicalPos
+		isMinViolation := map[*FlexItem]bool{}
+		isMaxViolation := map[*FlexItem]bool{}
+		for _, it := range unfrozenItems {
+			clamped := it.clampMainSize(max(it.TargetMainSize, 0))
+			if clamped < it.TargetMainSize {
+				isMaxViolation[it] = true
+			} else if it.TargetMainSize < clamped {
+				isMinViolation[it] = true
+			}
+			totalViolation += clamped - it.TargetMainSize
+			it.TargetMainSize = clamped
+		}
+
+		// e. Freeze over-flexed items.
+		for _, it := range unfrozenItems {
+			switch {
+			case totalViolation == 0:
+				it.frozen = true
+			case 0 < totalViolation && isMinViolation[it]:
+				it.frozen = true
+			case totalViolation < 0 && isMaxViolation[it]:
+				it.frozen = true
+			}
+		}
+	}
+}
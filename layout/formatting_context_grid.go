@@ -0,0 +1,495 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package layout
+
+import (
+	"log"
+	"math"
+	"slices"
+
+	"github.com/inseo-oh/yw/css/grid"
+)
+
+// Grid Formatting Contexts(GFC for short) are responsible for placing grid
+// items into the grid, and sizing its rows and columns.
+//
+// Once tracks are sized, natural position is advanced by the height of the
+// grid.
+//
+// https://www.w3.org/TR/css-grid-1/#grid-formatting-context
+type GridFormattingContext struct {
+	formattingContextCommon
+	CurrentNaturalPos LogicalPos
+	Columns, Rows     []GridTrack
+
+	// Number of implicit tracks before the explicit grid, set by [GridFormattingContext.PlaceItems].
+	ImplicitColumnsBefore, ImplicitRowsBefore int
+}
+
+func (gfc GridFormattingContext) NaturalPos() LogicalPos {
+	return gfc.CurrentNaturalPos
+}
+func (gfc *GridFormattingContext) IncrementNaturalPos(pos LogicalPos) {
+	if pos < 0 {
+		log.Printf("warning: attempted to increment natural position with negative value %g", pos)
+	}
+	gfc.CurrentNaturalPos += pos
+}
+
+//==============================================================================
+// Grid item placement
+//==============================================================================
+
+// GridArea is an area of the grid, in terms of track indices. Ends are exclusive.
+//
+// https://www.w3.org/TR/css-grid-1/#grid-area-concept
+type GridArea struct {
+	RowStart, RowEnd       int
+	ColumnStart, ColumnEnd int
+}
+
+// GridItemPlacement holds grid-placement properties of a grid item, and the
+// resulting area after placement.
+type GridItemPlacement struct {
+	RowStart, RowEnd       grid.Line
+	ColumnStart, ColumnEnd grid.Line
+	Area                   GridArea // Set by [GridFormattingContext.PlaceItems]
+}
+
+// gridSpan is position of an item in an axis.
+type gridSpan struct {
+	start, span int
+	isDefinite  bool // If not set, only span is valid
+}
+
+func (s gridSpan) end() int { return s.start + s.span }
+
+// resolveGridSpan resolves pair of grid-placement properties, where
+// explicitCount is number of tracks in the explicit grid. Line indices start
+// from 0, and may be negative if it's before the explicit grid.
+//
+// https://www.w3.org/TR/css-grid-1/#line-placement
+func resolveGridSpan(start, end grid.Line, explicitCount int) gridSpan {
+	lineIndex := func(l grid.Line) int {
+		if l.Value < 0 {
+			// Negative numbers count from the end of the explicit grid.
+			return explicitCount + 1 + l.Value
+		}
+		return l.Value - 1
+	}
+	switch {
+	case start.Type == grid.LineNumber && end.Type == grid.LineNumber:
+		s, e := lineIndex(start), lineIndex(end)
+		if e < s {
+			s, e = e, s
+		} else if e == s {
+			e = s + 1
+		}
+		return gridSpan{s, e - s, true}
+	case start.Type == grid.LineNumber && end.Type == grid.LineSpan:
+		return gridSpan{lineIndex(start), end.Value, true}
+	case start.Type == grid.LineNumber:
+		return gridSpan{lineIndex(start), 1, true}
+	case end.Type == grid.LineNumber && start.Type == grid.LineSpan:
+		return gridSpan{lineIndex(end) - start.Value, start.Value, true}
+	case end.Type == grid.LineNumber:
+		return gridSpan{lineIndex(end) - 1, 1, true}
+	case start.Type == grid.LineSpan:
+		// If both are spans, end is ignored.
+		return gridSpan{0, start.Value, false}
+	case end.Type == grid.LineSpan:
+		return gridSpan{0, end.Value, false}
+	}
+	return gridSpan{0, 1, false}
+}
+
+// PlaceItems runs grid item placement algorithm, and sets Area of each item.
+// explicitColumns and explicitRows are number of tracks in the explicit grid.
+// It returns size of the implicit grid, including tracks before the explicit
+// grid (See ImplicitColumnsBefore and ImplicitRowsBefore).
+//
+// Note that auto-placement cursor moves along columns in row flow, and along
+// rows in column flow. Below, the former is called minor axis, and the latter
+// is major axis.
+//
+// https://www.w3.org/TR/css-grid-1/#auto-placement-algo
+func (gfc *GridFormattingContext) PlaceItems(items []*GridItemPlacement, explicitColumns, explicitRows int, flow grid.AutoFlow) (columnCount, rowCount int) {
+	type itemState struct{ major, minor gridSpan }
+	states := make([]itemState, len(items))
+	explicitMajor, explicitMinor := explicitRows, explicitColumns
+	if flow.IsColumn {
+		explicitMajor, explicitMinor = explicitColumns, explicitRows
+	}
+
+	// Resolve definite positions, and make room for lines before the explicit grid.
+	majorBefore, minorBefore := 0, 0
+	for i, it := range items {
+		rows := resolveGridSpan(it.RowStart, it.RowEnd, explicitRows)
+		columns := resolveGridSpan(it.ColumnStart, it.ColumnEnd, explicitColumns)
+		if flow.IsColumn {
+			states[i] = itemState{major: columns, minor: rows}
+		} else {
+			states[i] = itemState{major: rows, minor: columns}
+		}
+		if states[i].major.isDefinite {
+			majorBefore = max(majorBefore, -states[i].major.start)
+		}
+		if states[i].minor.isDefinite {
+			minorBefore = max(minorBefore, -states[i].minor.start)
+		}
+	}
+	majorCount, minorCount := majorBefore+explicitMajor, minorBefore+explicitMinor
+	for i := range states {
+		st := &states[i]
+		if st.major.isDefinite {
+			st.major.start += majorBefore
+			majorCount = max(majorCount, st.major.end())
+		}
+		if st.minor.isDefinite {
+			st.minor.start += minorBefore
+			minorCount = max(minorCount, st.minor.end())
+		} else {
+			minorCount = max(minorCount, st.minor.span)
+		}
+	}
+
+	occupied := [][]bool{} // Indexed by major, then minor
+	isFree := func(st itemState) bool {
+		for major := st.major.start; major < min(st.major.end(), len(occupied)); major++ {
+			for minor := st.minor.start; minor < min(st.minor.end(), len(occupied[major])); minor++ {
+				if occupied[major][minor] {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	occupy := func(st itemState) {
+		for len(occupied) < st.major.end() {
+			occupied = append(occupied, []bool{})
+		}
+		for major := st.major.start; major < st.major.end(); major++ {
+			for len(occupied[major]) < st.minor.end() {
+				occupied[major] = append(occupied[major], false)
+			}
+			for minor := st.minor.start; minor < st.minor.end(); minor++ {
+				occupied[major][minor] = true
+			}
+		}
+		majorCount = max(majorCount, st.major.end())
+		minorCount = max(minorCount, st.minor.end())
+	}
+
+	// S1. Place items with definite positions.
+	for _, st := range states {
+		if st.major.isDefinite && st.minor.isDefinite {
+			occupy(st)
+		}
+	}
+
+	// S2. Process items locked to a given major track.
+	majorCursors := map[int]int{}
+	for i := range states {
+		st := &states[i]
+		if !st.major.isDefinite || st.minor.isDefinite {
+			continue
+		}
+		st.minor.start = 0
+		if !flow.IsDense {
+			st.minor.start = majorCursors[st.major.start]
+		}
+		for !isFree(*st) {
+			st.minor.start++
+		}
+		st.minor.isDefinite = true
+		occupy(*st)
+		majorCursors[st.major.start] = st.minor.end()
+	}
+
+	// S3. Number of minor tracks was already determined above.
+
+	// S4. Position remaining items.
+	cursorMajor, cursorMinor := 0, 0
+	for i := range states {
+		st := &states[i]
+		if st.major.isDefinite {
+			continue
+		}
+		if flow.IsDense {
+			cursorMajor, cursorMinor = 0, 0
+		}
+		if st.minor.isDefinite {
+			if st.minor.start < cursorMinor {
+				cursorMajor++
+			}
+			cursorMinor = st.minor.start
+			for {
+				st.major.start = cursorMajor
+				if isFree(*st) {
+					break
+				}
+				cursorMajor++
+			}
+		} else {
+			for {
+				if minorCount < cursorMinor+st.minor.span {
+					cursorMajor++
+					cursorMinor = 0
+					continue
+				}
+				st.major.start, st.minor.start = cursorMajor, cursorMinor
+				if isFree(*st) {
+					break
+				}
+				cursorMinor++
+			}
+		}
+		st.major.isDefinite, st.minor.isDefinite = true, true
+		occupy(*st)
+	}
+
+	for i, it := range items {
+		rows, columns := states[i].major, states[i].minor
+		if flow.IsColumn {
+			rows, columns = columns, rows
+		}
+		it.Area = GridArea{RowStart: rows.start, RowEnd: rows.end(), ColumnStart: columns.start, ColumnEnd: columns.end()}
+	}
+	if flow.IsColumn {
+		gfc.ImplicitColumnsBefore, gfc.ImplicitRowsBefore = majorBefore, minorBefore
+		return majorCount, minorCount
+	}
+	gfc.ImplicitColumnsBefore, gfc.ImplicitRowsBefore = minorBefore, majorBefore
+	return minorCount, majorCount
+}
+
+//==============================================================================
+// Grid track sizing
+//==============================================================================
+
+// GridSizingType represents type of [GridSizingFunction].
+type GridSizingType uint8
+
+const (
+	GridSizingFixed      GridSizingType = iota // Fixed size
+	GridSizingAuto                             // auto
+	GridSizingMinContent                       // min-content
+	GridSizingMaxContent                       // max-content
+	GridSizingFlex                             // Flexible size (e.g. 1fr)
+)
+
+// GridSizingFunction is a used track sizing function.
+//
+// https://www.w3.org/TR/css-grid-1/#track-sizing-function
+type GridSizingFunction struct {
+	Type GridSizingType
+	Size PhysicalPos // Only valid for GridSizingFixed
+	Flex float64     // Only valid for GridSizingFlex
+}
+
+func (f GridSizingFunction) isIntrinsic() bool {
+	return f.Type == GridSizingAuto || f.Type == GridSizingMinContent || f.Type == GridSizingMaxContent
+}
+
+// GridTrack is a row or a column of the grid.
+//
+// https://www.w3.org/TR/css-grid-1/#grid-track-concept
+type GridTrack struct {
+	Min, Max GridSizingFunction
+
+	BaseSize    PhysicalPos // Set by [SizeGridTracks]
+	GrowthLimit PhysicalPos // Set by [SizeGridTracks]
+}
+
+// GridContribution is the outer size of a grid item's contents, spanning from
+// Start to End (exclusive) tracks.
+type GridContribution struct {
+	Start, End int
+	Size       PhysicalPos
+}
+
+// SizeGridTracks runs the track sizing algorithm for tracks in an axis, and
+// sets BaseSize of each track to its final size. availableSize is +Inf if
+// it's indefinite. gap is the gutter between adjacent tracks.
+//
+// TODO: We don't distinguish min-content and max-content contributions yet.
+//
+// https://www.w3.org/TR/css-grid-1/#algo-track-sizing
+func SizeGridTracks(tracks []GridTrack, contributions []GridContribution, availableSize PhysicalPos, gap PhysicalPos) {
+	isDefinite := !math.IsInf(float64(availableSize), 1)
+	gaps := func(count int) PhysicalPos { return gap * PhysicalPos(max(count-1, 0)) }
+	spansFlexTrack := func(c GridContribution) bool {
+		for _, t := range tracks[c.Start:c.End] {
+			if t.Max.Type == GridSizingFlex {
+				return true
+			}
+		}
+		return false
+	}
+
+	// S1. Initialize track sizes
+	// https://www.w3.org/TR/css-grid-1/#algo-init
+	for i := range tracks {
+		t := &tracks[i]
+		t.BaseSize = 0
+		if t.Min.Type == GridSizingFixed {
+			t.BaseSize = t.Min.Size
+		}
+		t.GrowthLimit = PhysicalPos(math.Inf(1))
+		if t.Max.Type == GridSizingFixed {
+			t.GrowthLimit = max(t.Max.Size, t.BaseSize)
+		}
+	}
+
+	// S2. Resolve intrinsic track sizes
+	// https://www.w3.org/TR/css-grid-1/#algo-content
+	//
+	// Items are processed in order of their spans, and items spanning flexible
+	// tracks are handled in S4 instead.
+	sortedContributions := slices.Clone(contributions)
+	slices.SortStableFunc(sortedContributions, func(a, b GridContribution) int {
+		return (a.End - a.Start) - (b.End - b.Start)
+	})
+	for _, c := range sortedContributions {
+		if spansFlexTrack(c) {
+			continue
+		}
+		spanned := tracks[c.Start:c.End]
+		distribute := func(getSize func(t *GridTrack) PhysicalPos, setSize func(t *GridTrack, size PhysicalPos), isTarget func(t *GridTrack) bool) {
+			extra := c.Size - gaps(len(spanned))
+			targets := []*GridTrack{}
+			for i := range spanned {
+				extra -= getSize(&spanned[i])
+				if isTarget(&spanned[i]) {
+					targets = append(targets, &spanned[i])
+				}
+			}
+			if extra <= 0 || len(targets) == 0 {
+				return
+			}
+			for _, t := range targets {
+				setSize(t, getSize(t)+extra/PhysicalPos(len(targets)))
+			}
+		}
+		distribute(
+			func(t *GridTrack) PhysicalPos { return t.BaseSize },
+			func(t *GridTrack, size PhysicalPos) { t.BaseSize = size },
+			func(t *GridTrack) bool { return t.Min.isIntrinsic() },
+		)
+		distribute(
+			func(t *GridTrack) PhysicalPos {
+				if math.IsInf(float64(t.GrowthLimit), 1) {
+					return t.BaseSize
+				}
+				return t.GrowthLimit
+			},
+			func(t *GridTrack, size PhysicalPos) { t.GrowthLimit = size },
+			func(t *GridTrack) bool { return t.Max.isIntrinsic() },
+		)
+	}
+	for i := range tracks {
+		t := &tracks[i]
+		if math.IsInf(float64(t.GrowthLimit), 1) || t.GrowthLimit < t.BaseSize {
+			t.GrowthLimit = t.BaseSize
+		}
+	}
+
+	freeSpace := func() PhysicalPos {
+		res := availableSize - gaps(len(tracks))
+		for _, t := range tracks {
+			res -= t.BaseSize
+		}
+		return res
+	}
+
+	// S3. Maximize tracks
+	// https://www.w3.org/TR/css-grid-1/#algo-grow-tracks
+	if isDefinite {
+		for {
+			free := freeSpace()
+			targets := []*GridTrack{}
+			for i := range tracks {
+				if tracks[i].BaseSize < tracks[i].GrowthLimit {
+					targets = append(targets, &tracks[i])
+				}
+			}
+			if free <= 0 || len(targets) == 0 {
+				break
+			}
+			share := free / PhysicalPos(len(targets))
+			for _, t := range targets {
+				t.BaseSize = min(t.BaseSize+share, t.GrowthLimit)
+			}
+		}
+	}
+
+	// S4. Expand flexible tracks
+	// https://www.w3.org/TR/css-grid-1/#algo-flex-tracks
+	//
+	// findFrSize finds the size of 1fr, when flexible tracks in given range
+	// should fill spaceToFill.
+	findFrSize := func(start, end int, spaceToFill PhysicalPos) PhysicalPos {
+		isInflexible := make([]bool, len(tracks))
+		for {
+			leftover := spaceToFill - gaps(end-start)
+			var flexSum float64
+			for i := start; i < end; i++ {
+				t := tracks[i]
+				if t.Max.Type != GridSizingFlex || isInflexible[i] {
+					leftover -= t.BaseSize
+				} else {
+					flexSum += t.Max.Flex
+				}
+			}
+			frSize := leftover / PhysicalPos(max(flexSum, 1))
+			done := true
+			for i := start; i < end; i++ {
+				t := tracks[i]
+				if t.Max.Type == GridSizingFlex && !isInflexible[i] && frSize*PhysicalPos(t.Max.Flex) < t.BaseSize {
+					isInflexible[i] = true
+					done = false
+				}
+			}
+			if done {
+				return max(frSize, 0)
+			}
+		}
+	}
+	var frSize PhysicalPos
+	if isDefinite {
+		frSize = findFrSize(0, len(tracks), availableSize)
+	} else {
+		for _, t := range tracks {
+			if t.Max.Type == GridSizingFlex {
+				frSize = max(frSize, t.BaseSize/PhysicalPos(max(t.Max.Flex, 1)))
+			}
+		}
+		for _, c := range contributions {
+			if spansFlexTrack(c) {
+				frSize = max(frSize, findFrSize(c.Start, c.End, c.Size))
+			}
+		}
+	}
+	for i := range tracks {
+		t := &tracks[i]
+		if t.Max.Type == GridSizingFlex {
+			t.BaseSize = max(t.BaseSize, frSize*PhysicalPos(t.Max.Flex))
+		}
+	}
+
+	// S5. Stretch auto tracks
+	// https://www.w3.org/TR/css-grid-1/#algo-stretch
+	if free := freeSpace(); isDefinite && 0 < free {
+		targets := []*GridTrack{}
+		for i := range tracks {
+			if tracks[i].Max.Type == GridSizingAuto {
+				targets = append(targets, &tracks[i])
+			}
+		}
+		for _, t := range targets {
+			t.BaseSize += free / PhysicalPos(len(targets))
+		}
+	}
+}
@@ -20,6 +20,12 @@ type InlineFormattingContext struct {
 	InitialAvailableWidth LogicalPos
 	InitialLogicalY       LogicalPos
 	WrittenText           string
+
+	// Indentation of lines, along with text-indent flags.
+	// See https://www.w3.org/TR/css-text-3/#text-indent-property
+	TextIndent     LogicalPos
+	IndentHanging  bool
+	IndentEachLine bool
 }
 
 func (ifc *InlineFormattingContext) AddLineBox(lineHeight float64) {
@@ -31,25 +37,34 @@ func (ifc *InlineFormattingContext) AddLineBox(lineHeight float64) {
 	} else {
 		lb.InitialLogicalY = ifc.InitialLogicalY
 	}
-	lb.AvailableWidth = ifc.InitialAvailableWidth - ifc.BlockContainer.Bfc.floatWidth(lb.InitialLogicalY)
+	if ifc.isIndentedLine() {
+		lb.Indent = ifc.TextIndent
+	}
+	lb.AvailableWidth = ifc.InitialAvailableWidth - ifc.BlockContainer.Bfc.floatWidth(lb.InitialLogicalY) - lb.Indent
 	lb.leftOffset = PhysicalPos(ifc.BlockContainer.Bfc.leftFloatWidth(lb.InitialLogicalY))
 	ifc.LineBoxes = append(ifc.LineBoxes, lb)
 }
+
+// isIndentedLine reports whether the line box being added should be indented.
+func (ifc *InlineFormattingContext) isIndentedLine() bool {
+	isFirst := len(ifc.LineBoxes) == 0 || (ifc.IndentEachLine && ifc.CurrentLineBox().HasForcedBreak)
+	return isFirst != ifc.IndentHanging
+}
 func (ifc *InlineFormattingContext) CurrentLineBox() *lineBox {
 	return &ifc.LineBoxes[len(ifc.LineBoxes)-1]
 }
 func (ifc InlineFormattingContext) NaturalPos() LogicalPos {
-	return ifc.CurrentLineBox().CurrentNaturalPos + LogicalPos(ifc.CurrentLineBox().leftOffset)
+	lb := ifc.CurrentLineBox()
+	return lb.CurrentNaturalPos + LogicalPos(lb.leftOffset) + lb.Indent
 }
+
+// IncrementNaturalPos moves the next inline position by pos. Contents may
+// overflow the line box only when these can't be wrapped(e.g. white-space: nowrap).
 func (ifc *InlineFormattingContext) IncrementNaturalPos(pos LogicalPos) {
 	if len(ifc.LineBoxes) == 0 {
 		panic("attempted to increment natural position without creating lineBox")
 	}
-	lb := ifc.CurrentLineBox()
-	if lb.AvailableWidth < lb.CurrentNaturalPos+pos {
-		panic("content overflow")
-	}
-	lb.CurrentNaturalPos += pos
+	ifc.CurrentLineBox().CurrentNaturalPos += pos
 }
 
 // Line box holds state needed for placing inline contents, such as next inline
@@ -62,4 +77,12 @@ type lineBox struct {
 	CurrentNaturalPos LogicalPos
 	CurrentLineHeight float64
 	InitialLogicalY   LogicalPos
+	Indent            LogicalPos // Space before contents, given by text-indent
+	HasForcedBreak    bool       // Line ends with forced line break
+	Baseline          LogicalPos // Position of the baseline, from the top of the line
+}
+
+// LeftFloatWidth returns how much the line is shortened by left floats.
+func (lb lineBox) LeftFloatWidth() LogicalPos {
+	return LogicalPos(lb.leftOffset)
 }
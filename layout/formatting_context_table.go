@@ -0,0 +1,440 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package layout
+
+import (
+	"image/color"
+	"log"
+	"slices"
+
+	"github.com/inseo-oh/yw/css/backgrounds"
+)
+
+// Table Formatting Contexts(TFC for short) are responsible for placing table
+// cells into the table grid, and sizing its rows and columns.
+//
+// Once rows are sized, natural position is advanced by the height of the
+// table grid.
+//
+// https://www.w3.org/TR/CSS2/tables.html
+type TableFormattingContext struct {
+	formattingContextCommon
+	CurrentNaturalPos LogicalPos
+	Columns           []TableColumn
+	Rows              []TableRow
+
+	// Used values of border-spacing. These are zero in the collapsing border model.
+	HorizontalSpacing, VerticalSpacing PhysicalPos
+}
+
+func (tfc TableFormattingContext) NaturalPos() LogicalPos {
+	return tfc.CurrentNaturalPos
+}
+func (tfc *TableFormattingContext) IncrementNaturalPos(pos LogicalPos) {
+	if pos < 0 {
+		log.Printf("warning: attempted to increment natural position with negative value %g", pos)
+	}
+	tfc.CurrentNaturalPos += pos
+}
+
+// TableColumn is a column of the table grid.
+//
+// https://www.w3.org/TR/CSS2/tables.html#columns
+type TableColumn struct {
+	SpecifiedWidth    PhysicalPos // Used value of width of the column element. Only valid if HasSpecifiedWidth is set.
+	HasSpecifiedWidth bool
+
+	MinWidth, MaxWidth PhysicalPos // Set by [TableFormattingContext.MeasureColumns]
+	Width              PhysicalPos // Final width of the column
+}
+
+// TableRow is a row of the table grid.
+//
+// https://www.w3.org/TR/CSS2/tables.html#table-layout
+type TableRow struct {
+	SpecifiedHeight PhysicalPos // Used value of height of the row element, which is treated as minimum height.
+	GroupEnd        int         // Index after the last row of the row group this row belongs to
+	Height          PhysicalPos // Set by [TableFormattingContext.SizeRows]
+}
+
+// TableCell is a cell in the table grid. Sizes are outer sizes of the cell,
+// including borders and paddings.
+type TableCell struct {
+	Row, Column         int // Set by [TableFormattingContext.PlaceCells]
+	RowSpan, ColumnSpan int // RowSpan may be zero, which means the cell spans remaining rows of the row group.
+
+	SpecifiedWidth     PhysicalPos // Used value of width. Only valid if HasSpecifiedWidth is set.
+	HasSpecifiedWidth  bool
+	MinWidth, MaxWidth PhysicalPos // Min-content and max-content widths. Only used by the automatic table layout.
+	Height             PhysicalPos // Height after laying out the cell with final column widths.
+}
+
+// ColumnEnd returns index after the last column the cell spans.
+func (c TableCell) ColumnEnd() int { return c.Column + c.ColumnSpan }
+
+// RowEnd returns index after the last row the cell spans.
+func (c TableCell) RowEnd() int { return c.Row + c.RowSpan }
+
+//==============================================================================
+// Forming the table grid
+//==============================================================================
+
+// PlaceCells places cells of each row into slots of the table grid, skipping
+// slots that are taken by cells spanning multiple rows. Rows must be already
+// set, and columns are added if cells need more of them.
+//
+// https://html.spec.whatwg.org/multipage/tables.html#forming-a-table
+func (tfc *TableFormattingContext) PlaceCells(rows [][]*TableCell) {
+	occupied := make([][]bool, len(rows))
+	columnCount := len(tfc.Columns)
+	for rowIdx, cells := range rows {
+		column := 0
+		for _, c := range cells {
+			for column < len(occupied[rowIdx]) && occupied[rowIdx][column] {
+				column++
+			}
+			c.Row, c.Column = rowIdx, column
+			groupEnd := tfc.Rows[rowIdx].GroupEnd
+			if c.RowSpan == 0 || groupEnd < c.RowEnd() {
+				// Cells can't span beyond the row group.
+				c.RowSpan = groupEnd - rowIdx
+			}
+			for r := c.Row; r < c.RowEnd(); r++ {
+				for len(occupied[r]) < c.ColumnEnd() {
+					occupied[r] = append(occupied[r], false)
+				}
+				for col := c.Column; col < c.ColumnEnd(); col++ {
+					occupied[r][col] = true
+				}
+			}
+			column = c.ColumnEnd()
+			columnCount = max(columnCount, column)
+		}
+	}
+	for len(tfc.Columns) < columnCount {
+		tfc.Columns = append(tfc.Columns, TableColumn{})
+	}
+}
+
+//==============================================================================
+// Column widths
+//==============================================================================
+
+// spacings returns total amount of horizontal spacing around count columns.
+func (tfc TableFormattingContext) spacings(spacing PhysicalPos, count int) PhysicalPos {
+	if count == 0 {
+		return 0
+	}
+	return spacing * PhysicalPos(count+1)
+}
+
+// MeasureColumns calculates MinWidth and MaxWidth of each column from cells
+// spanning it. Cells spanning multiple columns are handled after others, and
+// their widths are distributed over columns they span.
+//
+// https://www.w3.org/TR/CSS2/tables.html#auto-table-layout
+func (tfc *TableFormattingContext) MeasureColumns(cells []*TableCell) {
+	for i := range tfc.Columns {
+		col := &tfc.Columns[i]
+		col.MinWidth, col.MaxWidth = 0, 0
+		if col.HasSpecifiedWidth {
+			col.MinWidth, col.MaxWidth = col.SpecifiedWidth, col.SpecifiedWidth
+		}
+	}
+	sortedCells := slices.Clone(cells)
+	slices.SortStableFunc(sortedCells, func(a, b *TableCell) int { return a.ColumnSpan - b.ColumnSpan })
+	for _, c := range sortedCells {
+		spanned := tfc.Columns[c.Column:c.ColumnEnd()]
+		if len(spanned) == 1 {
+			spanned[0].MinWidth = max(spanned[0].MinWidth, c.MinWidth)
+			spanned[0].MaxWidth = max(spanned[0].MaxWidth, c.MaxWidth)
+			continue
+		}
+		// Extra width is distributed in proportion to max widths of columns,
+		// or evenly if they don't have any.
+		innerSpacing := tfc.HorizontalSpacing * PhysicalPos(len(spanned)-1)
+		var minSum, maxSum PhysicalPos
+		for _, col := range spanned {
+			minSum += col.MinWidth
+			maxSum += col.MaxWidth
+		}
+		distribute := func(extra PhysicalPos, apply func(col *TableColumn, share PhysicalPos)) {
+			if extra <= 0 {
+				return
+			}
+			for i := range spanned {
+				share := extra / PhysicalPos(len(spanned))
+				if 0 < maxSum {
+					share = extra * spanned[i].MaxWidth / maxSum
+				}
+				apply(&spanned[i], share)
+			}
+		}
+		distribute(c.MinWidth-innerSpacing-minSum, func(col *TableColumn, share PhysicalPos) { col.MinWidth += share })
+		distribute(c.MaxWidth-innerSpacing-maxSum, func(col *TableColumn, share PhysicalPos) { col.MaxWidth += share })
+		for i := range spanned {
+			spanned[i].MaxWidth = max(spanned[i].MaxWidth, spanned[i].MinWidth)
+		}
+	}
+}
+
+// MinContentWidth returns the minimum width of the table grid, including spacings.
+// This is only valid after [TableFormattingContext.MeasureColumns].
+func (tfc TableFormattingContext) MinContentWidth() PhysicalPos {
+	res := tfc.spacings(tfc.HorizontalSpacing, len(tfc.Columns))
+	for _, col := range tfc.Columns {
+		res += col.MinWidth
+	}
+	return res
+}
+
+// MaxContentWidth returns the maximum width of the table grid, including spacings.
+// This is only valid after [TableFormattingContext.MeasureColumns].
+func (tfc TableFormattingContext) MaxContentWidth() PhysicalPos {
+	res := tfc.spacings(tfc.HorizontalSpacing, len(tfc.Columns))
+	for _, col := range tfc.Columns {
+		res += col.MaxWidth
+	}
+	return res
+}
+
+// DistributeWidth sets Width of each column, so that the table grid fills
+// width (including spacings). Columns never get narrower than their minimum
+// width, so the grid may overflow.
+//
+// https://www.w3.org/TR/CSS2/tables.html#auto-table-layout
+func (tfc *TableFormattingContext) DistributeWidth(width PhysicalPos) {
+	available := width - tfc.spacings(tfc.HorizontalSpacing, len(tfc.Columns))
+	var minSum, maxSum PhysicalPos
+	autoCount := 0
+	var autoMaxSum PhysicalPos
+	for _, col := range tfc.Columns {
+		minSum += col.MinWidth
+		maxSum += col.MaxWidth
+		if !col.HasSpecifiedWidth {
+			autoCount++
+			autoMaxSum += col.MaxWidth
+		}
+	}
+	for i := range tfc.Columns {
+		col := &tfc.Columns[i]
+		switch {
+		case available <= minSum:
+			col.Width = col.MinWidth
+		case available <= maxSum:
+			// Columns grow from minimum widths in proportion to how much they
+			// can grow until their maximum widths.
+			col.Width = col.MinWidth + (col.MaxWidth-col.MinWidth)*(available-minSum)/(maxSum-minSum)
+		default:
+			// Extra space goes to columns without specified widths, if any.
+			col.Width = col.MaxWidth
+			extra := available - maxSum
+			switch {
+			case autoCount == 0:
+				col.Width += extra / PhysicalPos(len(tfc.Columns))
+			case col.HasSpecifiedWidth:
+			case 0 < autoMaxSum:
+				col.Width += extra * col.MaxWidth / autoMaxSum
+			default:
+				col.Width += extra / PhysicalPos(autoCount)
+			}
+		}
+	}
+}
+
+// FixedColumnWidths sets Width of each column using the fixed table layout,
+// where firstRowCells are cells in the first row, and width is width of the
+// table grid (including spacings). Columns without widths from column elements
+// or cells share remaining space.
+//
+// https://www.w3.org/TR/CSS2/tables.html#fixed-table-layout
+func (tfc *TableFormattingContext) FixedColumnWidths(firstRowCells []*TableCell, width PhysicalPos) {
+	isSet := make([]bool, len(tfc.Columns))
+	for i := range tfc.Columns {
+		col := &tfc.Columns[i]
+		col.Width = 0
+		if col.HasSpecifiedWidth {
+			col.Width = col.SpecifiedWidth
+			isSet[i] = true
+		}
+	}
+	for _, c := range firstRowCells {
+		if !c.HasSpecifiedWidth || slices.Contains(isSet[c.Column:c.ColumnEnd()], true) {
+			continue
+		}
+		innerSpacing := tfc.HorizontalSpacing * PhysicalPos(c.ColumnSpan-1)
+		for i := c.Column; i < c.ColumnEnd(); i++ {
+			tfc.Columns[i].Width = max(c.SpecifiedWidth-innerSpacing, 0) / PhysicalPos(c.ColumnSpan)
+			isSet[i] = true
+		}
+	}
+	remaining := width - tfc.spacings(tfc.HorizontalSpacing, len(tfc.Columns))
+	unsetCount := 0
+	for i, col := range tfc.Columns {
+		remaining -= col.Width
+		if !isSet[i] {
+			unsetCount++
+		}
+	}
+	if remaining <= 0 || len(tfc.Columns) == 0 {
+		return
+	}
+	for i := range tfc.Columns {
+		switch {
+		case unsetCount == 0:
+			tfc.Columns[i].Width += remaining / PhysicalPos(len(tfc.Columns))
+		case !isSet[i]:
+			tfc.Columns[i].Width = remaining / PhysicalPos(unsetCount)
+		}
+	}
+}
+
+// ColumnPositions returns starting position of each column relative to the
+// content box of the table, followed by width of the table grid.
+func (tfc TableFormattingContext) ColumnPositions() []PhysicalPos {
+	res := make([]PhysicalPos, len(tfc.Columns)+1)
+	if len(tfc.Columns) == 0 {
+		return res
+	}
+	res[0] = tfc.HorizontalSpacing
+	for i, col := range tfc.Columns {
+		res[i+1] = res[i] + col.Width + tfc.HorizontalSpacing
+	}
+	return res
+}
+
+//==============================================================================
+// Row heights
+//==============================================================================
+
+// SizeRows sets Height of each row from heights of cells. Cells spanning
+// multiple rows are handled after others, and extra heights are distributed
+// evenly over rows they span.
+//
+// https://www.w3.org/TR/CSS2/tables.html#height-layout
+func (tfc *TableFormattingContext) SizeRows(cells []*TableCell) {
+	for i := range tfc.Rows {
+		tfc.Rows[i].Height = tfc.Rows[i].SpecifiedHeight
+	}
+	sortedCells := slices.Clone(cells)
+	slices.SortStableFunc(sortedCells, func(a, b *TableCell) int { return a.RowSpan - b.RowSpan })
+	for _, c := range sortedCells {
+		spanned := tfc.Rows[c.Row:c.RowEnd()]
+		extra := c.Height - tfc.VerticalSpacing*PhysicalPos(len(spanned)-1)
+		for _, row := range spanned {
+			extra -= row.Height
+		}
+		if extra <= 0 {
+			continue
+		}
+		for i := range spanned {
+			spanned[i].Height += extra / PhysicalPos(len(spanned))
+		}
+	}
+}
+
+// StretchRows grows rows so that the table grid (including spacings) is at
+// least height tall. Extra height is distributed in proportion to heights of
+// rows, or evenly if they don't have any.
+func (tfc *TableFormattingContext) StretchRows(height PhysicalPos) {
+	extra := height - tfc.spacings(tfc.VerticalSpacing, len(tfc.Rows))
+	var heightSum PhysicalPos
+	for _, row := range tfc.Rows {
+		extra -= row.Height
+		heightSum += row.Height
+	}
+	if extra <= 0 || len(tfc.Rows) == 0 {
+		return
+	}
+	for i := range tfc.Rows {
+		if 0 < heightSum {
+			tfc.Rows[i].Height += extra * tfc.Rows[i].Height / heightSum
+		} else {
+			tfc.Rows[i].Height += extra / PhysicalPos(len(tfc.Rows))
+		}
+	}
+}
+
+// RowPositions returns starting position of each row relative to the content
+// box of the table, followed by height of the table grid.
+func (tfc TableFormattingContext) RowPositions() []PhysicalPos {
+	res := make([]PhysicalPos, len(tfc.Rows)+1)
+	if len(tfc.Rows) == 0 {
+		return res
+	}
+	res[0] = tfc.VerticalSpacing
+	for i, row := range tfc.Rows {
+		res[i+1] = res[i] + row.Height + tfc.VerticalSpacing
+	}
+	return res
+}
+
+//==============================================================================
+// Collapsing border model
+//==============================================================================
+
+// CollapsedBorderSide is a border side in the collapsing border model.
+type CollapsedBorderSide struct {
+	Width PhysicalPos
+	Style backgrounds.LineStyle
+	Color color.Color
+}
+
+// CollapsedBorders holds borders of a box resolved by the collapsing border
+// model, which are painted instead of ones from the element.
+type CollapsedBorders struct {
+	Top, Right, Bottom, Left CollapsedBorderSide
+}
+
+// collapsedBorderStylePriority returns priority of border style s, when borders
+// with the same width conflict.
+func collapsedBorderStylePriority(s backgrounds.LineStyle) int {
+	switch s {
+	case backgrounds.DoubleLine:
+		return 8
+	case backgrounds.SolidLine:
+		return 7
+	case backgrounds.DashedLine:
+		return 6
+	case backgrounds.DottedLine:
+		return 5
+	case backgrounds.RidgeLine:
+		return 4
+	case backgrounds.OutsetLine:
+		return 3
+	case backgrounds.GrovveLine:
+		return 2
+	case backgrounds.InsetLine:
+		return 1
+	}
+	return 0
+}
+
+// ResolveCollapsedBorder returns the border that wins among candidates, which
+// are borders on the same edge. If candidates are identical except for the
+// color, earlier one wins, so these should be sorted by the precedence of
+// boxes they come from (e.g. cells before rows).
+//
+// https://www.w3.org/TR/CSS2/tables.html#border-conflict-resolution
+func ResolveCollapsedBorder(candidates ...CollapsedBorderSide) CollapsedBorderSide {
+	res := CollapsedBorderSide{Style: backgrounds.NoLine}
+	for _, c := range candidates {
+		switch {
+		case c.Style == backgrounds.HiddenLine:
+			// Hidden borders suppress all other borders.
+			return CollapsedBorderSide{Style: backgrounds.HiddenLine}
+		case c.Style == backgrounds.NoLine || c.Width == 0:
+			continue
+		case res.Style == backgrounds.NoLine, res.Width < c.Width:
+			res = c
+		case res.Width == c.Width && collapsedBorderStylePriority(res.Style) < collapsedBorderStylePriority(c.Style):
+			res = c
+		}
+	}
+	if res.Style == backgrounds.NoLine {
+		res.Width = 0
+	}
+	return res
+}
@@ -0,0 +1,116 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package layout
+
+import (
+	"image"
+	"math"
+
+	"github.com/inseo-oh/yw/css/backgrounds"
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/gfx/paint"
+	"github.com/inseo-oh/yw/util"
+)
+
+// clipsContents reports whether contents of the box are clipped to its
+// padding box in any axis.
+func (bx boxCommon) clipsContents() bool {
+	return bx.OverflowX.ClipsContents() || bx.OverflowY.ClipsContents()
+}
+
+// IsScrollContainer reports whether the box is a scroll container.
+//
+// Spec: https://www.w3.org/TR/css-overflow-3/#scroll-container
+func (bx boxCommon) IsScrollContainer() bool {
+	return bx.OverflowX.IsScrollable() || bx.OverflowY.IsScrollable()
+}
+
+// clipPaintNodes clips nodes, paint nodes of contents of the box, to the
+// overflow clip edge and scrolls these by the scroll position. nodes are
+// returned as-is if the box doesn't clip its contents.
+//
+// TODO: Support overflow-clip-margin
+//
+// Spec: https://www.w3.org/TR/css-overflow-3/#overflow-control
+func (bx boxCommon) clipPaintNodes(nodes []paint.Node) []paint.Node {
+	if !bx.clipsContents() || len(nodes) == 0 {
+		return nodes
+	}
+	paddingRect := bx.BoxPaddingRect().ToPhysicalRect()
+	// Axes that aren't clipped are left unbounded.
+	rect := image.Rect(math.MinInt32, math.MinInt32, math.MaxInt32, math.MaxInt32)
+	if bx.OverflowX.ClipsContents() {
+		rect.Min.X = int(paddingRect.Left)
+		rect.Max.X = int(paddingRect.Left + paddingRect.Width)
+	}
+	if bx.OverflowY.ClipsContents() {
+		rect.Min.Y = int(paddingRect.Top)
+		rect.Max.Y = int(paddingRect.Top + paddingRect.Height)
+	}
+	offset := image.Pt(int(bx.ScrollX), int(bx.ScrollY))
+	node := paint.Node(paint.ClipPaint{Items: nodes, Rect: rect, Offset: offset})
+	if bx.OverflowX.ClipsContents() && bx.OverflowY.ClipsContents() && !util.IsNil(bx.Elem) {
+		// Contents are clipped to the curve of the padding box as well.
+		styleSet := cssom.ComputedStyleSetSourceOf(bx.Elem).ComputedStyleSet()
+		if borderShape := bx.borderShape(styleSet); !borderShape.Radii.IsZero() {
+			node = paint.RoundedClipPaint{Items: []paint.Node{node}, Shape: bx.areaShape(borderShape, backgrounds.PaddingBox)}
+		}
+	}
+	return []paint.Node{node}
+}
+
+// ScrollTo scrolls contents of the scroll container, so that the point
+// (physicalX, physicalY) of its scrollable overflow area is at the top-left
+// corner of the padding box. The position is clamped to the area. It does
+// nothing if the box isn't a scroll container.
+//
+// TODO: Scrollable overflow area of boxes with direction: rtl or vertical-rl
+// writing mode extends to the left.
+//
+// Spec: https://www.w3.org/TR/css-overflow-3/#scroll-container
+func (bx *boxCommon) ScrollTo(physicalX, physicalY PhysicalPos) {
+	if !bx.IsScrollContainer() {
+		return
+	}
+	paddingRect := bx.BoxPaddingRect().ToPhysicalRect()
+	overflowRect := bx.scrollableOverflowRect()
+	maxX := max(overflowRect.Left+overflowRect.Width-(paddingRect.Left+paddingRect.Width), 0)
+	maxY := max(overflowRect.Top+overflowRect.Height-(paddingRect.Top+paddingRect.Height), 0)
+	bx.ScrollX = min(max(physicalX, 0), maxX)
+	bx.ScrollY = min(max(physicalY, 0), maxY)
+}
+
+// scrollableOverflowRect returns the rect containing the padding box, and
+// border boxes and texts of descendants of the box, which is the area that
+// can be scrolled into view.
+//
+// Spec: https://www.w3.org/TR/css-overflow-3/#scrollable
+func (bx boxCommon) scrollableOverflowRect() PhysicalRect {
+	res := bx.BoxPaddingRect().ToPhysicalRect()
+	extend := func(r PhysicalRect) {
+		if r.Width <= 0 && r.Height <= 0 {
+			return
+		}
+		right := max(res.Left+res.Width, r.Left+r.Width)
+		bottom := max(res.Top+res.Height, r.Top+r.Height)
+		res.Left, res.Top = min(res.Left, r.Left), min(res.Top, r.Top)
+		res.Width, res.Height = right-res.Left, bottom-res.Top
+	}
+	var visit func(texts []*Text, boxes []Box)
+	visit = func(texts []*Text, boxes []Box) {
+		for _, txt := range texts {
+			extend(txt.Rect.ToPhysicalRect())
+		}
+		for _, child := range boxes {
+			extend(child.BoxBorderRect().ToPhysicalRect())
+			// Contents of other scroll containers are scrolled by them.
+			if !child.IsScrollContainer() {
+				visit(child.ChildTexts(), child.ChildBoxes())
+			}
+		}
+	}
+	visit(bx.childTexts, bx.childBoxes)
+	return res
+}
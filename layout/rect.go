@@ -4,26 +4,56 @@
 
 package layout
 
+import (
+	"image"
+
+	"github.com/inseo-oh/yw/css/writingmodes"
+)
+
 type PhysicalEdges struct{ Top, Right, Bottom, Left PhysicalPos }
 
 func (e PhysicalEdges) VerticalSum() PhysicalPos   { return e.Top + e.Bottom }
 func (e PhysicalEdges) HorizontalSum() PhysicalPos { return e.Left + e.Right }
 
-type LogicalRect struct{ LogicalX, LogicalY, LogicalWidth, LogicalHeight LogicalPos }
+// LogicalRect is a rect in the logical coordinate space WritingMode.
+type LogicalRect struct {
+	LogicalX, LogicalY, LogicalWidth, LogicalHeight LogicalPos
+	WritingMode                                     *WritingMode
+}
 
 func (r LogicalRect) addPadding(edges PhysicalEdges) LogicalRect {
-	// TODO: Support vertical writing mode
-	r.LogicalY += LogicalPos(edges.Top)
-	r.LogicalX += LogicalPos(edges.Left)
-	r.LogicalWidth -= LogicalPos(edges.Left + edges.Right)
-	r.LogicalHeight -= LogicalPos(edges.Top + edges.Bottom)
+	e := r.WritingMode.LogicalEdges(edges)
+	r.LogicalY += LogicalPos(e.BlockStart)
+	r.LogicalX += LogicalPos(e.InlineStart)
+	r.LogicalWidth -= LogicalPos(e.InlineSum())
+	r.LogicalHeight -= LogicalPos(e.BlockSum())
 	return r
 }
+
+// ToPhysicalRect maps the rect to physical coordinates, according to its writing mode.
+//
+// Spec: https://www.w3.org/TR/css-writing-modes-3/#logical-to-physical
 func (r LogicalRect) ToPhysicalRect() PhysicalRect {
-	// TODO: Support vertical writing mode
+	container := r.WritingMode.containerRect()
+	switch r.WritingMode.WritingModeValue() {
+	case writingmodes.VerticalRl:
+		return PhysicalRect{
+			Left:   container.Left + container.Width - PhysicalPos(r.LogicalY+r.LogicalHeight),
+			Top:    container.Top + PhysicalPos(r.LogicalX),
+			Width:  PhysicalPos(r.LogicalHeight),
+			Height: PhysicalPos(r.LogicalWidth),
+		}
+	case writingmodes.VerticalLr:
+		return PhysicalRect{
+			Left:   container.Left + PhysicalPos(r.LogicalY),
+			Top:    container.Top + PhysicalPos(r.LogicalX),
+			Width:  PhysicalPos(r.LogicalHeight),
+			Height: PhysicalPos(r.LogicalWidth),
+		}
+	}
 	return PhysicalRect{
-		Left:   PhysicalPos(r.LogicalX),
-		Top:    PhysicalPos(r.LogicalY),
+		Left:   container.Left + PhysicalPos(r.LogicalX),
+		Top:    container.Top + PhysicalPos(r.LogicalY),
 		Width:  PhysicalPos(r.LogicalWidth),
 		Height: PhysicalPos(r.LogicalHeight),
 	}
@@ -33,7 +63,8 @@ type PhysicalRect struct{ Left, Top, Width, Height PhysicalPos }
 
 func (r PhysicalRect) right() PhysicalPos  { return r.Left + r.Width - 1 }
 func (r PhysicalRect) bottom() PhysicalPos { return r.Top + r.Height - 1 }
-func PhysicalSizeToLogical(physicalWidth, physicalHeight PhysicalPos) (logicalWidth, logicalHeight LogicalPos) {
-	// TODO: Support vertical writing mode
-	return LogicalPos(physicalWidth), LogicalPos(physicalHeight)
+
+// toImageRect returns the rect as [image.Rectangle] (Max is exclusive).
+func (r PhysicalRect) toImageRect() image.Rectangle {
+	return image.Rect(int(r.Left), int(r.Top), int(r.Left+r.Width), int(r.Top+r.Height))
 }
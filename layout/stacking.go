@@ -0,0 +1,139 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package layout
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/inseo-oh/yw/css/cssom"
+	"github.com/inseo-oh/yw/css/position"
+	"github.com/inseo-oh/yw/gfx/paint"
+	"github.com/inseo-oh/yw/util"
+)
+
+// stackingContext collects paint nodes of positioned descendants in a stacking
+// context, which are painted after (or before, for negative stack levels)
+// descendants in normal flow.
+//
+// https://www.w3.org/TR/CSS2/zindex.html
+type stackingContext struct {
+	negative   []stackedNode // Child stacking contexts with negative stack levels
+	zeroOrAuto []paint.Node  // Positioned descendants with stack level 0 or auto, in tree order
+	positive   []stackedNode // Child stacking contexts with positive stack levels
+
+	// clip clips paint nodes of positioned descendants, by boxes clipping
+	// their contents between the descendant and the stacking context.
+	// nil if there's no such box.
+	clip func(nodes []paint.Node) []paint.Node
+}
+
+type stackedNode struct {
+	zIndex int
+	node   paint.Node
+}
+
+// addBox paints bx, a descendant of the stacking context. If bx is painted in
+// normal flow, its paint node is returned. Otherwise, it's added to the stacking
+// context and nil is returned.
+func (sc *stackingContext) addBox(bx Box) paint.Node {
+	pos, zIndex := boxPosition(bx)
+	// Boxes with opacity less than 1 establish stacking context, and are
+	// painted as if they were positioned with z-index: 0.
+	// https://www.w3.org/TR/css-color-4/#transparency
+	isTranslucent := boxOpacity(bx) < 1
+	if !pos.IsPositioned() && !isTranslucent {
+		return bx.makePaintNode(sc)
+	}
+	// Positioned boxes with z-index: auto don't establish stacking context,
+	// unless they are fixed or sticky.
+	// https://www.w3.org/TR/css-position-3/#position-property
+	if zIndex.IsAuto && pos != position.Fixed && pos != position.Sticky && !isTranslucent {
+		// Painted as if it established a stacking context, but its positioned
+		// descendants belong to the current one. These come after the box.
+		idx := len(sc.zeroOrAuto)
+		sc.zeroOrAuto = append(sc.zeroOrAuto, nil)
+		node := sc.clipNode(bx.makePaintNode(sc), pos)
+		sc.zeroOrAuto[idx] = node
+		return nil
+	}
+	node := sc.clipNode(bx.MakePaintNode(), pos)
+	switch {
+	case zIndex.IsAuto || zIndex.Value == 0:
+		sc.zeroOrAuto = append(sc.zeroOrAuto, node)
+	case zIndex.Value < 0:
+		sc.negative = append(sc.negative, stackedNode{zIndex.Value, node})
+	default:
+		sc.positive = append(sc.positive, stackedNode{zIndex.Value, node})
+	}
+	return nil
+}
+
+// clipNode clips node, paint node of a positioned descendant with given
+// position, by boxes clipping their contents.
+//
+// TODO: Absolutely positioned boxes should not be clipped by boxes outside of
+// their containing blocks.
+func (sc stackingContext) clipNode(node paint.Node, pos position.Position) paint.Node {
+	if sc.clip == nil || pos == position.Fixed {
+		return node
+	}
+	return paint.BoxPaint{Items: sc.clip([]paint.Node{node})}
+}
+
+// negativeNodes returns child stacking contexts with negative stack levels,
+// from the bottom-most one.
+func (sc stackingContext) negativeNodes() []paint.Node { return sortedStackedNodes(sc.negative) }
+
+// zeroOrAutoNodes returns positioned descendants with stack level 0 or auto,
+// in tree order.
+func (sc stackingContext) zeroOrAutoNodes() []paint.Node { return sc.zeroOrAuto }
+
+// positiveNodes returns child stacking contexts with positive stack levels,
+// from the bottom-most one.
+func (sc stackingContext) positiveNodes() []paint.Node { return sortedStackedNodes(sc.positive) }
+
+func sortedStackedNodes(nodes []stackedNode) []paint.Node {
+	// Ones with the same stack level are painted in tree order.
+	nodes = slices.Clone(nodes)
+	slices.SortStableFunc(nodes, func(a, b stackedNode) int { return cmp.Compare(a.zIndex, b.zIndex) })
+	res := make([]paint.Node, len(nodes))
+	for i, n := range nodes {
+		res[i] = n.node
+	}
+	return res
+}
+
+// boxPosition returns used values of position and z-index properties of bx.
+func boxPosition(bx Box) (position.Position, position.ZIndex) {
+	elem := bx.BoxElement()
+	if util.IsNil(elem) {
+		return position.Static, position.ZIndex{IsAuto: true}
+	}
+	if parent, ok := bx.BoxParent().(*BlockContainerBox); ok && parent.IsTableWrapper && parent.Elem == elem {
+		// Table boxes are positioned along with table wrapper boxes.
+		return position.Static, position.ZIndex{IsAuto: true}
+	}
+	styleSet := cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet()
+	pos := styleSet.Position()
+	if !pos.IsPositioned() {
+		// z-index only applies to positioned boxes.
+		return pos, position.ZIndex{IsAuto: true}
+	}
+	return pos, styleSet.ZIndex()
+}
+
+// boxOpacity returns used value of opacity property of bx.
+func boxOpacity(bx Box) float64 {
+	elem := bx.BoxElement()
+	if util.IsNil(elem) {
+		return 1
+	}
+	if parent, ok := bx.BoxParent().(*BlockContainerBox); ok && parent.IsTableWrapper && parent.Elem == elem {
+		// Opacity of tables is applied to table wrapper boxes.
+		return 1
+	}
+	return float64(cssom.ComputedStyleSetSourceOf(elem).ComputedStyleSet().Opacity())
+}
@@ -14,26 +14,30 @@ import (
 )
 
 type Text struct {
-	Rect     PhysicalRect
-	Text     string
-	Font     gfx.Font
-	FontSize float64
-	Color    color.Color
-	Decors   []gfx.TextDecorOptions
+	Rect        LogicalRect
+	Text        string
+	Font        gfx.Font
+	FontSize    float64
+	Color       color.Color
+	Decors      []gfx.TextDecorOptions
+	Orientation gfx.TextOrientation
+	IsHidden    bool // Text takes up space but is not painted (visibility: hidden)
 }
 
 func (txt Text) String() string {
-	return fmt.Sprintf("text %s at [%v]", strconv.Quote(txt.Text), txt.Rect)
+	return fmt.Sprintf("text %s at [%v]", strconv.Quote(txt.Text), txt.Rect.ToPhysicalRect())
 }
 func (txt Text) MakePaintNode() paint.Node {
+	rect := txt.Rect.ToPhysicalRect()
 	return paint.TextPaint{
-		Left:   int(txt.Rect.Left),
-		Top:    int(txt.Rect.Top),
-		Text:   txt.Text,
-		Font:   txt.Font,
-		Size:   txt.FontSize,
-		Color:  txt.Color,
-		Decors: txt.Decors,
+		Left:        int(rect.Left),
+		Top:         int(rect.Top),
+		Text:        txt.Text,
+		Font:        txt.Font,
+		Size:        txt.FontSize,
+		Color:       txt.Color,
+		Decors:      txt.Decors,
+		Orientation: txt.Orientation,
 	}
 }
 func (txt Text) isBlockLevel() bool { return false }
@@ -0,0 +1,93 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package layout
+
+import "github.com/inseo-oh/yw/css/writingmodes"
+
+// WritingMode is a logical coordinate space, shared by boxes whose containing
+// blocks have the same writing mode.
+//
+// LogicalX grows along the inline axis, and LogicalY grows along the block
+// axis. (0, 0) is the block-start, inline-start corner of the content box of
+// Container, which is the box whose writing mode is different from its
+// containing block's.
+//
+// nil WritingMode is the horizontal-tb space of the initial containing block,
+// where logical coordinates are the same as physical ones.
+//
+// Spec: https://www.w3.org/TR/css-writing-modes-3/#abstract-box
+type WritingMode struct {
+	Mode      writingmodes.WritingMode
+	Container Box
+}
+
+// WritingModeValue returns the writing mode of the space.
+func (wm *WritingMode) WritingModeValue() writingmodes.WritingMode {
+	if wm == nil {
+		return writingmodes.HorizontalTb
+	}
+	return wm.Mode
+}
+
+// IsVertical reports whether inline axis of the space is vertical.
+func (wm *WritingMode) IsVertical() bool {
+	return wm.WritingModeValue().IsVertical()
+}
+
+// IsOrthogonalTo reports whether inline axis of wm is perpendicular to other's.
+//
+// Spec: https://www.w3.org/TR/css-writing-modes-3/#orthogonal-flows
+func (wm *WritingMode) IsOrthogonalTo(other *WritingMode) bool {
+	return wm.IsVertical() != other.IsVertical()
+}
+
+// containerRect returns the physical rect where the space starts.
+func (wm *WritingMode) containerRect() PhysicalRect {
+	if wm == nil {
+		return PhysicalRect{}
+	}
+	return wm.Container.BoxContentRect().ToPhysicalRect()
+}
+
+// PhysicalSizeToLogical converts physical size to logical size in the space.
+func (wm *WritingMode) PhysicalSizeToLogical(physicalWidth, physicalHeight PhysicalPos) (logicalWidth, logicalHeight LogicalPos) {
+	if wm.IsVertical() {
+		return LogicalPos(physicalHeight), LogicalPos(physicalWidth)
+	}
+	return LogicalPos(physicalWidth), LogicalPos(physicalHeight)
+}
+
+// PhysicalOffsetToLogical converts physical offset(e.g. distance to move a box)
+// to logical offset in the space.
+func (wm *WritingMode) PhysicalOffsetToLogical(physicalX, physicalY PhysicalPos) (logicalX, logicalY LogicalPos) {
+	switch wm.WritingModeValue() {
+	case writingmodes.VerticalRl:
+		return LogicalPos(physicalY), LogicalPos(-physicalX)
+	case writingmodes.VerticalLr:
+		return LogicalPos(physicalY), LogicalPos(physicalX)
+	}
+	return LogicalPos(physicalX), LogicalPos(physicalY)
+}
+
+// LogicalEdges holds edges in logical directions.
+//
+// Spec: https://www.w3.org/TR/css-writing-modes-3/#logical-directions
+type LogicalEdges struct{ BlockStart, InlineEnd, BlockEnd, InlineStart PhysicalPos }
+
+func (e LogicalEdges) BlockSum() PhysicalPos  { return e.BlockStart + e.BlockEnd }
+func (e LogicalEdges) InlineSum() PhysicalPos { return e.InlineStart + e.InlineEnd }
+
+// LogicalEdges maps physical edges e to logical directions of the space.
+//
+// TODO: Support direction: rtl, where inline-start is on the right or bottom side.
+func (wm *WritingMode) LogicalEdges(e PhysicalEdges) LogicalEdges {
+	switch wm.WritingModeValue() {
+	case writingmodes.VerticalRl:
+		return LogicalEdges{BlockStart: e.Right, InlineEnd: e.Bottom, BlockEnd: e.Left, InlineStart: e.Top}
+	case writingmodes.VerticalLr:
+		return LogicalEdges{BlockStart: e.Left, InlineEnd: e.Bottom, BlockEnd: e.Right, InlineStart: e.Top}
+	}
+	return LogicalEdges{BlockStart: e.Top, InlineEnd: e.Right, BlockEnd: e.Bottom, InlineStart: e.Left}
+}
@@ -9,6 +9,9 @@ import "github.com/inseo-oh/yw/gfx"
 
 // FontProvider is abstract interface used to provide access to platform's fonts.
 type FontProvider interface {
-	// OpenFont opens a font with given name.
-	OpenFont(name string) gfx.Font
+	// OpenFont opens the face at index of the font file at path. Index is
+	// only used for font collections, and it's 0 for other font files.
+	// An error is returned if the face can't be opened (e.g. it's not a font
+	// file the platform recognizes).
+	OpenFont(path string, index int) (gfx.Font, error)
 }
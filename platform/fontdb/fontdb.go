@@ -0,0 +1,162 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+// Package fontdb provides database of font faces found in font files, and
+// selects faces for texts using [CSS font matching algorithm].
+//
+// [CSS font matching algorithm]: https://www.w3.org/TR/css-fonts-3/#font-matching-algorithm
+package fontdb
+
+import (
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/inseo-oh/yw/css/fonts"
+)
+
+// DefaultFontPath is path of the font used when no faces were found.
+const DefaultFontPath = "res/font/static/NotoSansKR-Regular.ttf"
+
+// Face represents a single face in a font file.
+type Face struct {
+	Path  string // Path to the font file
+	Index int    // Index of the face, for font collections (.ttc files)
+
+	Family  string
+	Weight  fonts.Weight
+	Style   fonts.Style
+	Stretch fonts.Stretch
+
+	Coverage *Coverage // Characters the face has glyphs for, or nil if it's unknown
+}
+
+func (f Face) String() string {
+	return fmt.Sprintf("%q %v %v %v (%s#%d)", f.Family, f.Weight, f.Style, f.Stretch, f.Path, f.Index)
+}
+
+// Coverage is a set of characters, read from the character map of a face.
+type Coverage struct {
+	ranges [][2]rune // Sorted, non-overlapping ranges of characters (inclusive)
+}
+
+// NewCoverage returns a Coverage containing given ranges of characters. Each
+// range is given as the first and the last character, and ranges must be
+// sorted.
+func NewCoverage(ranges ...[2]rune) *Coverage {
+	c := &Coverage{}
+	for _, rng := range ranges {
+		c.add(rng[0], rng[1])
+	}
+	return c
+}
+
+// add adds characters from first to last. These must come after characters
+// added so far.
+func (c *Coverage) add(first, last rune) {
+	if n := len(c.ranges); n != 0 && c.ranges[n-1][1]+1 == first {
+		c.ranges[n-1][1] = last
+		return
+	}
+	c.ranges = append(c.ranges, [2]rune{first, last})
+}
+
+// Has reports whether r is in the coverage.
+func (c *Coverage) Has(r rune) bool {
+	_, found := slices.BinarySearchFunc(c.ranges, r, func(rng [2]rune, r rune) int {
+		if rng[1] < r {
+			return -1
+		} else if r < rng[0] {
+			return 1
+		}
+		return 0
+	})
+	return found
+}
+
+// Database holds faces found in font files.
+type Database struct {
+	faces    []Face
+	families map[string][]Face // Faces keyed by lowercased family name
+
+	// Generics maps generic families to names of actual families, in order
+	// of preference.
+	//
+	// Spec: https://www.w3.org/TR/css-fonts-3/#generic-font-families
+	Generics map[fonts.FamilyType][]string
+}
+
+// New returns an empty Database, with default generic family mappings.
+func New() *Database {
+	return &Database{
+		families: map[string][]Face{},
+		Generics: map[fonts.FamilyType][]string{
+			fonts.Serif:     {"Noto Serif KR", "Noto Serif", "DejaVu Serif", "Liberation Serif", "Times New Roman"},
+			fonts.SansSerif: {"Noto Sans KR", "Noto Sans", "DejaVu Sans", "Liberation Sans", "Arial"},
+			fonts.Cursive:   {"Comic Neue", "Comic Sans MS"},
+			fonts.Fantasy:   {"Impact"},
+			fonts.Monospace: {"Noto Sans Mono", "DejaVu Sans Mono", "Liberation Mono", "Courier New"},
+		},
+	}
+}
+
+// Faces returns all faces in the database.
+func (db *Database) Faces() []Face {
+	return db.faces
+}
+
+// AddFace adds a face to the database.
+func (db *Database) AddFace(face Face) {
+	db.faces = append(db.faces, face)
+	key := strings.ToLower(face.Family)
+	db.families[key] = append(db.families[key], face)
+}
+
+// AddFile adds faces found in the font file at path.
+func (db *Database) AddFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	faces, err := readFaces(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	for _, face := range faces {
+		face.Path = path
+		db.AddFace(face)
+	}
+	return nil
+}
+
+// AddDir adds faces found in font files under dir, including subdirectories.
+// Files that couldn't be read are skipped, and it's not an error if dir
+// doesn't exist.
+func (db *Database) AddDir(dir string) {
+	filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path != dir {
+				log.Printf("fontdb: %v", err)
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".ttf", ".otf", ".ttc", ".otc":
+		default:
+			return nil
+		}
+		if err := db.AddFile(path); err != nil {
+			log.Printf("fontdb: %v", err)
+		}
+		return nil
+	})
+}
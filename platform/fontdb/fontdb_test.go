@@ -0,0 +1,421 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package fontdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/inseo-oh/yw/css/fonts"
+)
+
+// makeFont returns a font file containing only name and OS/2 tables. os2 is
+// omitted if weight is 0.
+func makeFont(family, typoFamily, subfamily string, weight, width, fsSelection uint16) []byte {
+	be := binary.BigEndian
+	// name table
+	type nameRec struct {
+		id   uint16
+		text string
+	}
+	recs := []nameRec{{nameFamily, family}, {nameSubfamily, subfamily}}
+	if typoFamily != "" {
+		recs = append(recs, nameRec{nameTypographicFamily, typoFamily})
+	}
+	name := be.AppendUint16(nil, 0)
+	name = be.AppendUint16(name, uint16(len(recs)))
+	name = be.AppendUint16(name, uint16(6+len(recs)*12))
+	storage := []byte{}
+	for _, rec := range recs {
+		str := []byte{}
+		for _, u := range utf16.Encode([]rune(rec.text)) {
+			str = be.AppendUint16(str, u)
+		}
+		for _, v := range []uint16{3, 1, 0x409, rec.id, uint16(len(str)), uint16(len(storage))} {
+			name = be.AppendUint16(name, v)
+		}
+		storage = append(storage, str...)
+	}
+	name = append(name, storage...)
+	tables := map[string][]byte{"name": name}
+	if weight != 0 {
+		os2 := make([]byte, 96)
+		be.PutUint16(os2[4:], weight)
+		be.PutUint16(os2[6:], width)
+		be.PutUint16(os2[62:], fsSelection)
+		tables["OS/2"] = os2
+	}
+
+	res := be.AppendUint32(nil, 0x00010000)
+	res = be.AppendUint16(res, uint16(len(tables)))
+	res = append(res, make([]byte, 6)...)
+	offset := 12 + len(tables)*16
+	data := []byte{}
+	for _, tag := range []string{"OS/2", "name"} {
+		table, ok := tables[tag]
+		if !ok {
+			continue
+		}
+		res = append(res, tag...)
+		res = be.AppendUint32(res, 0)
+		res = be.AppendUint32(res, uint32(offset+len(data)))
+		res = be.AppendUint32(res, uint32(len(table)))
+		data = append(data, table...)
+	}
+	return append(res, data...)
+}
+
+func TestReadFaces(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     []byte
+		expected Face
+	}{
+		{"regular", makeFont("Test Sans", "", "Regular", 400, 5, 0),
+			Face{Family: "Test Sans", Weight: 400, Style: fonts.NormalStyle, Stretch: fonts.NormalStretch}},
+		{"typographic family", makeFont("Test Sans Medium", "Test Sans", "Regular", 500, 5, 0),
+			Face{Family: "Test Sans", Weight: 500, Style: fonts.NormalStyle, Stretch: fonts.NormalStretch}},
+		{"italic", makeFont("Test Sans", "", "Bold Italic", 700, 3, 1),
+			Face{Family: "Test Sans", Weight: 700, Style: fonts.Italic, Stretch: fonts.Condensed}},
+		{"oblique", makeFont("Test Sans", "", "Oblique", 400, 5, 1<<9|1),
+			Face{Family: "Test Sans", Weight: 400, Style: fonts.Oblique, Stretch: fonts.NormalStretch}},
+		{"without OS/2", makeFont("Test Sans", "", "Bold Italic", 0, 0, 0),
+			Face{Family: "Test Sans", Weight: 700, Style: fonts.Italic, Stretch: fonts.NormalStretch}},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name, func(t *testing.T) {
+			got, err := readFaces(bytes.NewReader(cs.data))
+			if err != nil {
+				t.Fatalf("failed to read: %v", err)
+			}
+			if len(got) != 1 || got[0] != cs.expected {
+				t.Errorf("expected %v, got %v", cs.expected, got)
+			}
+		})
+	}
+
+	// Font collection
+	regular := makeFont("Test Sans", "", "Regular", 400, 5, 0)
+	bold := makeFont("Test Sans", "", "Bold", 700, 5, 0)
+	be := binary.BigEndian
+	ttc := []byte("ttcf")
+	ttc = be.AppendUint32(ttc, 0x00010000)
+	ttc = be.AppendUint32(ttc, 2)
+	ttc = be.AppendUint32(ttc, 20)
+	ttc = be.AppendUint32(ttc, uint32(20+len(regular)))
+	// Table offsets are relative to the start of the file.
+	for _, face := range [][]byte{regular, bold} {
+		base := uint32(len(ttc))
+		face = bytes.Clone(face)
+		for i := range int(be.Uint16(face[4:])) {
+			rec := face[12+i*16:]
+			be.PutUint32(rec[8:], be.Uint32(rec[8:])+base)
+		}
+		ttc = append(ttc, face...)
+	}
+	got, err := readFaces(bytes.NewReader(ttc))
+	if err != nil {
+		t.Fatalf("collection: failed to read: %v", err)
+	}
+	if len(got) != 2 || got[0].Weight != 400 || got[1].Weight != 700 || got[1].Index != 1 {
+		t.Errorf("collection: expected regular and bold faces, got %v", got)
+	}
+
+	if _, err := readFaces(bytes.NewReader([]byte("not a font file"))); err == nil {
+		t.Errorf("expected error for invalid file")
+	}
+}
+
+// addTable returns font with table added to it.
+func addTable(font []byte, tag string, table []byte) []byte {
+	be := binary.BigEndian
+	count := int(be.Uint16(font[4:]))
+	res := bytes.Clone(font[:12])
+	be.PutUint16(res[4:], uint16(count+1))
+	// Existing tables move by the size of the new table record.
+	for i := range count {
+		rec := bytes.Clone(font[12+i*16 : 28+i*16])
+		be.PutUint32(rec[8:], be.Uint32(rec[8:])+16)
+		res = append(res, rec...)
+	}
+	res = append(res, tag...)
+	res = be.AppendUint32(res, 0)
+	res = be.AppendUint32(res, uint32(len(font)+16))
+	res = be.AppendUint32(res, uint32(len(table)))
+	res = append(res, font[12+count*16:]...)
+	return append(res, table...)
+}
+
+// makeCmap returns a cmap table with a single subtable for the platform and
+// encoding.
+func makeCmap(platformID, encodingID uint16, subtable []byte) []byte {
+	be := binary.BigEndian
+	res := be.AppendUint16(nil, 0)
+	res = be.AppendUint16(res, 1)
+	res = be.AppendUint16(res, platformID)
+	res = be.AppendUint16(res, encodingID)
+	res = be.AppendUint32(res, 12)
+	return append(res, subtable...)
+}
+
+func TestReadCoverage(t *testing.T) {
+	be := binary.BigEndian
+	// Format 4: "A".."C" are mapped using idDelta, and "a".."c" using
+	// glyphIdArray, where "b" is mapped to the missing glyph.
+	type segment struct{ start, end, idDelta, idRangeOffset uint16 }
+	segs := []segment{{'A', 'C', 0x10000 + 1 - 'A', 0}, {'a', 'c', 0, 4}, {0xffff, 0xffff, 1, 0}}
+	format4 := be.AppendUint16(nil, 4)
+	format4 = be.AppendUint16(format4, 0)
+	format4 = be.AppendUint16(format4, 0)
+	format4 = be.AppendUint16(format4, uint16(len(segs)*2))
+	format4 = append(format4, make([]byte, 6)...)
+	for _, seg := range segs {
+		format4 = be.AppendUint16(format4, seg.end)
+	}
+	format4 = be.AppendUint16(format4, 0)
+	for _, field := range []func(segment) uint16{
+		func(seg segment) uint16 { return seg.start },
+		func(seg segment) uint16 { return seg.idDelta },
+		func(seg segment) uint16 { return seg.idRangeOffset },
+	} {
+		for _, seg := range segs {
+			format4 = be.AppendUint16(format4, field(seg))
+		}
+	}
+	for _, glyph := range []uint16{4, 0, 6} {
+		format4 = be.AppendUint16(format4, glyph)
+	}
+
+	// Format 12: U+1F600..U+1F64F, and U+AC00..U+AC02 where U+AC00 is mapped
+	// to the missing glyph.
+	format12 := be.AppendUint16(nil, 12)
+	format12 = be.AppendUint16(format12, 0)
+	format12 = be.AppendUint32(format12, 0)
+	format12 = be.AppendUint32(format12, 0)
+	format12 = be.AppendUint32(format12, 2)
+	for _, group := range [][3]uint32{{0xac00, 0xac02, 0}, {0x1f600, 0x1f64f, 10}} {
+		for _, v := range group {
+			format12 = be.AppendUint32(format12, v)
+		}
+	}
+
+	cases := []struct {
+		name        string
+		cmap        []byte
+		covered     []rune
+		notCovered  []rune
+		hasCoverage bool
+	}{
+		{"format 4", makeCmap(3, 1, format4), []rune{'A', 'C', 'a', 'c'}, []rune{'@', 'D', 'b', 0xffff}, true},
+		{"format 12", makeCmap(3, 10, format12), []rune{0xac01, 0xac02, 0x1f600, 0x1f64f}, []rune{0xac00, 0x1f650, 'A'}, true},
+		// Subtables for other platforms are ignored.
+		{"symbol", makeCmap(3, 0, format4), nil, nil, false},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name, func(t *testing.T) {
+			font := addTable(makeFont("Test Sans", "", "Regular", 400, 5, 0), "cmap", cs.cmap)
+			got, err := readFaces(bytes.NewReader(font))
+			if err != nil {
+				t.Fatalf("failed to read: %v", err)
+			}
+			coverage := got[0].Coverage
+			if (coverage != nil) != cs.hasCoverage {
+				t.Fatalf("expected coverage to exist: %v, got %v", cs.hasCoverage, coverage)
+			}
+			for _, r := range cs.covered {
+				if !coverage.Has(r) {
+					t.Errorf("expected %U to be covered", r)
+				}
+			}
+			for _, r := range cs.notCovered {
+				if coverage.Has(r) {
+					t.Errorf("expected %U not to be covered", r)
+				}
+			}
+		})
+	}
+}
+
+func TestAddDir(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{
+		"regular.ttf":     makeFont("Test Sans", "", "Regular", 400, 5, 0),
+		"sub/bold.OTF":    makeFont("Test Sans", "", "Bold", 700, 5, 0),
+		"broken.ttf":      []byte("broken"),
+		"readme.txt":      []byte("not a font"),
+		"sub/another.ttf": makeFont("Test Serif", "", "Regular", 400, 5, 0),
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db := New()
+	db.AddDir(dir)
+	db.AddDir(filepath.Join(dir, "missing"))
+	if got := len(db.Faces()); got != 3 {
+		t.Errorf("expected 3 faces, got %d", got)
+	}
+	face, ok := db.Match(Query{Families: fonts.FamilyList{Families: []fonts.Family{{Type: fonts.NonGeneric, Name: "test sans"}}}, Weight: 700})
+	if !ok || face.Path != filepath.Join(dir, "sub/bold.OTF") {
+		t.Errorf("expected bold face, got %v", face)
+	}
+}
+
+func TestMatchWeight(t *testing.T) {
+	cases := []struct {
+		available []fonts.Weight
+		desired   fonts.Weight
+		expected  fonts.Weight
+	}{
+		{[]fonts.Weight{300, 400, 500, 700}, 400, 400},
+		// For 400, 500 is checked first, followed by lighter weights.
+		{[]fonts.Weight{300, 500, 700}, 400, 500},
+		{[]fonts.Weight{300, 700}, 400, 300},
+		// For 500, 400 is checked first.
+		{[]fonts.Weight{300, 400, 700}, 500, 400},
+		{[]fonts.Weight{300, 700}, 500, 300},
+		// Lighter weights are checked first below 400.
+		{[]fonts.Weight{100, 200, 400}, 300, 200},
+		{[]fonts.Weight{400, 700}, 300, 400},
+		// Heavier weights are checked first above 500.
+		{[]fonts.Weight{400, 700, 900}, 600, 700},
+		{[]fonts.Weight{100, 400}, 800, 400},
+	}
+	for _, cs := range cases {
+		db := New()
+		for _, w := range cs.available {
+			db.AddFace(Face{Family: "Test", Weight: w})
+		}
+		face, ok := db.Match(Query{Weight: cs.desired})
+		if !ok || face.Weight != cs.expected {
+			t.Errorf("%v: expected weight %v for %v, got %v", cs.available, cs.expected, cs.desired, face.Weight)
+		}
+	}
+}
+
+func TestMatchStretch(t *testing.T) {
+	cases := []struct {
+		available []fonts.Stretch
+		desired   fonts.Stretch
+		expected  fonts.Stretch
+	}{
+		{[]fonts.Stretch{fonts.Condensed, fonts.NormalStretch}, fonts.Condensed, fonts.Condensed},
+		// Narrower widths are checked first for normal and condensed values.
+		{[]fonts.Stretch{fonts.Condensed, fonts.Expanded}, fonts.NormalStretch, fonts.Condensed},
+		{[]fonts.Stretch{fonts.NormalStretch, fonts.Expanded}, fonts.Condensed, fonts.NormalStretch},
+		// Wider widths are checked first for expanded values.
+		{[]fonts.Stretch{fonts.NormalStretch, fonts.UltraExpanded}, fonts.Expanded, fonts.UltraExpanded},
+		{[]fonts.Stretch{fonts.Condensed, fonts.NormalStretch}, fonts.Expanded, fonts.NormalStretch},
+	}
+	for _, cs := range cases {
+		db := New()
+		for _, s := range cs.available {
+			db.AddFace(Face{Family: "Test", Weight: 400, Stretch: s})
+		}
+		face, ok := db.Match(Query{Weight: 400, Stretch: cs.desired})
+		if !ok || face.Stretch != cs.expected {
+			t.Errorf("%v: expected %v for %v, got %v", cs.available, cs.expected, cs.desired, face.Stretch)
+		}
+	}
+}
+
+func TestMatchFamily(t *testing.T) {
+	db := New()
+	db.Generics = map[fonts.FamilyType][]string{
+		fonts.SansSerif: {"Missing Sans", "Test Sans"},
+		fonts.Monospace: {"Test Mono"},
+	}
+	for _, face := range []Face{
+		{Path: "serif", Family: "Test Serif", Weight: 400},
+		{Path: "sans", Family: "Test Sans", Weight: 400},
+		{Path: "mono", Family: "Test Mono", Weight: 400},
+	} {
+		db.AddFace(face)
+	}
+	family := func(name string) fonts.Family { return fonts.Family{Type: fonts.NonGeneric, Name: name} }
+	cases := []struct {
+		families []fonts.Family
+		expected string
+	}{
+		{[]fonts.Family{family("Test Serif")}, "serif"},
+		{[]fonts.Family{family("TEST SERIF")}, "serif"},
+		{[]fonts.Family{family("Missing"), {Type: fonts.Monospace}}, "mono"},
+		{[]fonts.Family{{Type: fonts.SansSerif}}, "sans"},
+		// Unavailable families fall back to sans-serif.
+		{[]fonts.Family{family("Missing"), {Type: fonts.Cursive}}, "sans"},
+	}
+	for _, cs := range cases {
+		face, ok := db.Match(Query{Families: fonts.FamilyList{Families: cs.families}, Weight: 400})
+		if !ok || face.Path != cs.expected {
+			t.Errorf("%v: expected %q, got %q", cs.families, cs.expected, face.Path)
+		}
+	}
+	// Without sans-serif families, the first face is used.
+	db.Generics = nil
+	if face, _ := db.Match(Query{Weight: 400}); face.Path != "serif" {
+		t.Errorf("expected the first face to be used, got %q", face.Path)
+	}
+	if _, ok := New().Match(Query{Weight: 400}); ok {
+		t.Errorf("expected no match for empty database")
+	}
+}
+
+func TestFallbacks(t *testing.T) {
+	db := New()
+	db.Generics = map[fonts.FamilyType][]string{
+		fonts.SansSerif: {"Test Sans"},
+		fonts.Monospace: {"Test Mono"},
+	}
+	for _, face := range []Face{
+		{Path: "serif", Family: "Test Serif", Weight: 400},
+		{Path: "sans", Family: "Test Sans", Weight: 400},
+		{Path: "sans-bold", Family: "Test Sans", Weight: 700},
+		{Path: "mono", Family: "Test Mono", Weight: 400},
+		{Path: "cjk", Family: "Test CJK", Weight: 400},
+	} {
+		db.AddFace(face)
+	}
+	family := func(name string) fonts.Family { return fonts.Family{Type: fonts.NonGeneric, Name: name} }
+	cases := []struct {
+		families []fonts.Family
+		weight   fonts.Weight
+		expected []string
+	}{
+		{nil, 400, []string{"sans", "serif", "mono", "cjk"}},
+		{[]fonts.Family{family("Test CJK"), {Type: fonts.Monospace}}, 400, []string{"cjk", "mono", "sans", "serif"}},
+		// Each family appears once, with the best matching face.
+		{[]fonts.Family{family("Missing"), family("test sans"), {Type: fonts.SansSerif}}, 700, []string{"sans-bold", "serif", "mono", "cjk"}},
+	}
+	for _, cs := range cases {
+		q := Query{Families: fonts.FamilyList{Families: cs.families}, Weight: cs.weight}
+		got := []string{}
+		for _, face := range db.Fallbacks(q) {
+			got = append(got, face.Path)
+		}
+		if !slices.Equal(got, cs.expected) {
+			t.Errorf("%v: expected %v, got %v", cs.families, cs.expected, got)
+		}
+		if face, _ := db.Match(q); len(got) != 0 && face.Path != got[0] {
+			t.Errorf("%v: expected the first face to be %q, got %q", cs.families, face.Path, got[0])
+		}
+	}
+	if got := New().Fallbacks(Query{Weight: 400}); len(got) != 0 {
+		t.Errorf("expected no faces for empty database, got %v", got)
+	}
+}
@@ -0,0 +1,181 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package fontdb
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/inseo-oh/yw/css/fonts"
+)
+
+// Query holds font properties used to select a face.
+type Query struct {
+	Families fonts.FamilyList
+	Weight   fonts.Weight // Absolute weight (See [fonts.WeightValue.ResolveWeight])
+	Style    fonts.Style
+	Stretch  fonts.Stretch
+}
+
+// Match returns the face that best matches q. If none of families in q are
+// available, faces of the first available sans-serif family are used, and
+// then the first face in the database. ok is false if the database is empty.
+//
+// TODO: Synthesize bold and oblique faces if these are missing from the family
+//
+// Spec: https://www.w3.org/TR/css-fonts-3/#font-style-matching
+func (db *Database) Match(q Query) (face Face, ok bool) {
+	for _, family := range q.Families.Families {
+		for _, name := range db.namesOf(family) {
+			if face, ok := db.matchFamily(name, q); ok {
+				return face, true
+			}
+		}
+	}
+	// None of families are available, so we use system default instead.
+	for _, name := range db.Generics[fonts.SansSerif] {
+		if face, ok := db.matchFamily(name, q); ok {
+			return face, true
+		}
+	}
+	if len(db.faces) == 0 {
+		return Face{}, false
+	}
+	return db.matchFamily(db.faces[0].Family, q)
+}
+
+// Fallbacks returns faces to use for q, in order of preference. Characters
+// missing from a face should be drawn using one of faces after it.
+//
+// It contains the best matching face of each available family in q, followed
+// by faces of sans-serif families, and then all other families in the
+// database. The first face is the same as the one returned by [Database.Match].
+//
+// Spec: https://www.w3.org/TR/css-fonts-3/#cluster-matching
+func (db *Database) Fallbacks(q Query) []Face {
+	res := []Face{}
+	seen := map[string]bool{}
+	add := func(family string) {
+		key := strings.ToLower(family)
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		if face, ok := db.matchFamily(family, q); ok {
+			res = append(res, face)
+		}
+	}
+	for _, family := range q.Families.Families {
+		for _, name := range db.namesOf(family) {
+			add(name)
+		}
+	}
+	for _, name := range db.Generics[fonts.SansSerif] {
+		add(name)
+	}
+	for _, face := range db.faces {
+		add(face.Family)
+	}
+	return res
+}
+
+// namesOf returns names of actual families for the family.
+func (db *Database) namesOf(family fonts.Family) []string {
+	if family.Type != fonts.NonGeneric {
+		return db.Generics[family.Type]
+	}
+	return []string{family.Name}
+}
+
+// matchFamily selects a face from the family, by narrowing down faces using
+// font-stretch, font-style and font-weight in that order.
+//
+// Spec: https://www.w3.org/TR/css-fonts-3/#font-style-matching
+func (db *Database) matchFamily(family string, q Query) (Face, bool) {
+	// Family names are matched case-insensitively.
+	// Spec: https://www.w3.org/TR/css-fonts-3/#font-family-casing
+	faces := slices.Clone(db.families[strings.ToLower(family)])
+	if len(faces) == 0 {
+		return Face{}, false
+	}
+
+	// 4.a. font-stretch -------------------------------------------------------
+	// Narrower widths are checked first for normal and condensed values, and
+	// wider widths are checked first for expanded values.
+	stretches := []int{}
+	for _, face := range faces {
+		stretches = append(stretches, int(face.Stretch))
+	}
+	stretch := fonts.Stretch(closestValue(stretches, int(q.Stretch), q.Stretch <= fonts.NormalStretch))
+	faces = slices.DeleteFunc(faces, func(face Face) bool { return face.Stretch != stretch })
+
+	// 4.b. font-style ---------------------------------------------------------
+	var styleOrder []fonts.Style
+	switch q.Style {
+	case fonts.Italic:
+		styleOrder = []fonts.Style{fonts.Italic, fonts.Oblique, fonts.NormalStyle}
+	case fonts.Oblique:
+		styleOrder = []fonts.Style{fonts.Oblique, fonts.Italic, fonts.NormalStyle}
+	default:
+		styleOrder = []fonts.Style{fonts.NormalStyle, fonts.Oblique, fonts.Italic}
+	}
+	for _, style := range styleOrder {
+		hasStyle := slices.ContainsFunc(faces, func(face Face) bool { return face.Style == style })
+		if hasStyle {
+			faces = slices.DeleteFunc(faces, func(face Face) bool { return face.Style != style })
+			break
+		}
+	}
+
+	// 4.c. font-weight --------------------------------------------------------
+	weights := []int{}
+	for _, face := range faces {
+		weights = append(weights, int(face.Weight))
+	}
+	var weight fonts.Weight
+	switch {
+	case slices.Contains(weights, int(q.Weight)):
+		weight = q.Weight
+	case q.Weight == 400 && slices.Contains(weights, 500):
+		// For 400, 500 is checked first.
+		weight = 500
+	case q.Weight == 500 && slices.Contains(weights, 400):
+		// For 500, 400 is checked first.
+		weight = 400
+	default:
+		// Lighter weights are checked first for weights less than or equal
+		// to 500, and heavier weights are checked first otherwise.
+		weight = fonts.Weight(closestValue(weights, int(q.Weight), q.Weight <= 500))
+	}
+	for _, face := range faces {
+		if face.Weight == weight {
+			return face, true
+		}
+	}
+	panic("unreachable")
+}
+
+// closestValue returns value in values that is closest to desired. If there's
+// no exact match, it first looks for the closest value in lower values if
+// lowerFirst is true, or higher values otherwise, and then the other side.
+//
+// values must not be empty.
+func closestValue(values []int, desired int, lowerFirst bool) int {
+	lower, higher := -1, -1
+	for _, v := range values {
+		switch {
+		case v == desired:
+			return v
+		case v < desired && (lower == -1 || lower < v):
+			lower = v
+		case desired < v && (higher == -1 || v < higher):
+			higher = v
+		}
+	}
+	if (lowerFirst && lower != -1) || higher == -1 {
+		return lower
+	}
+	return higher
+}
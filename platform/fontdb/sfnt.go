@@ -0,0 +1,361 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package fontdb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"github.com/inseo-oh/yw/css/fonts"
+)
+
+// This file reads just enough of TrueType/OpenType font files to index them.
+//
+// Spec: https://learn.microsoft.com/en-us/typography/opentype/spec/otff
+
+// Maximum number of faces in a font collection. Anything more is likely to be
+// a broken file.
+const maxCollectionFaces = 256
+
+// readFaces reads attributes of each face in the font file.
+// Path of returned faces are left empty.
+func readFaces(r io.ReaderAt) ([]Face, error) {
+	header := make([]byte, 12)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return nil, err
+	}
+	if string(header[:4]) != "ttcf" {
+		face, err := readFace(r, 0)
+		if err != nil {
+			return nil, err
+		}
+		return []Face{face}, nil
+	}
+	// Font collection
+	// Spec: https://learn.microsoft.com/en-us/typography/opentype/spec/otff#ttc-header
+	count := binary.BigEndian.Uint32(header[8:])
+	if maxCollectionFaces < count {
+		return nil, fmt.Errorf("too many faces in the collection (%d)", count)
+	}
+	offsets := make([]byte, count*4)
+	if _, err := r.ReadAt(offsets, 12); err != nil {
+		return nil, err
+	}
+	res := []Face{}
+	for i := range int(count) {
+		face, err := readFace(r, int64(binary.BigEndian.Uint32(offsets[i*4:])))
+		if err != nil {
+			return nil, err
+		}
+		face.Index = i
+		res = append(res, face)
+	}
+	return res, nil
+}
+
+// readFace reads attributes of the face whose table directory is at offset.
+func readFace(r io.ReaderAt, offset int64) (Face, error) {
+	res := Face{Weight: fonts.NormalWeight, Style: fonts.NormalStyle, Stretch: fonts.NormalStretch}
+	header := make([]byte, 12)
+	if _, err := r.ReadAt(header, offset); err != nil {
+		return res, err
+	}
+	switch string(header[:4]) {
+	case "\x00\x01\x00\x00", "OTTO", "true":
+	default:
+		return res, errors.New("not a TrueType or OpenType font")
+	}
+	tables, err := readTableDirectory(r, offset+12, int(binary.BigEndian.Uint16(header[4:])))
+	if err != nil {
+		return res, err
+	}
+
+	nameTable, ok := tables["name"]
+	if !ok {
+		return res, errors.New("name table is missing")
+	}
+	names, err := readNames(r, nameTable)
+	if err != nil {
+		return res, err
+	}
+	// Typographic names are preferred, as legacy family names may include
+	// style names that don't fit into the four styles of legacy apps.
+	// (e.g. "Noto Sans KR Medium")
+	res.Family = names[nameTypographicFamily]
+	if res.Family == "" {
+		res.Family = names[nameFamily]
+	}
+	if res.Family == "" {
+		return res, errors.New("family name is missing")
+	}
+	subfamily := names[nameTypographicSubfamily]
+	if subfamily == "" {
+		subfamily = names[nameSubfamily]
+	}
+
+	if os2Table, ok := tables["OS/2"]; ok && 64 <= os2Table.length {
+		// Spec: https://learn.microsoft.com/en-us/typography/opentype/spec/os2
+		buf := make([]byte, 64)
+		if _, err := r.ReadAt(buf, os2Table.offset); err != nil {
+			return res, err
+		}
+		if weight := binary.BigEndian.Uint16(buf[4:]); 1 <= weight && weight <= 1000 {
+			res.Weight = fonts.Weight(weight)
+		}
+		if width := binary.BigEndian.Uint16(buf[6:]); 1 <= width && width <= 9 {
+			res.Stretch = fonts.Stretch(width - 1)
+		}
+		fsSelection := binary.BigEndian.Uint16(buf[62:])
+		switch {
+		case fsSelection&(1<<9) != 0:
+			res.Style = fonts.Oblique
+		case fsSelection&(1<<0) != 0:
+			res.Style = fonts.Italic
+		}
+	} else {
+		// Guess from the subfamily name instead.
+		subfamily = strings.ToLower(subfamily)
+		if strings.Contains(subfamily, "bold") {
+			res.Weight = fonts.Bold
+		}
+		switch {
+		case strings.Contains(subfamily, "italic"):
+			res.Style = fonts.Italic
+		case strings.Contains(subfamily, "oblique"):
+			res.Style = fonts.Oblique
+		}
+	}
+
+	if cmapTable, ok := tables["cmap"]; ok {
+		if res.Coverage, err = readCoverage(r, cmapTable); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+type tableRecord struct {
+	offset, length int64
+}
+
+// readTableDirectory reads count table records at offset.
+func readTableDirectory(r io.ReaderAt, offset int64, count int) (map[string]tableRecord, error) {
+	buf := make([]byte, count*16)
+	if _, err := r.ReadAt(buf, offset); err != nil {
+		return nil, err
+	}
+	res := map[string]tableRecord{}
+	for i := range count {
+		rec := buf[i*16:]
+		res[string(rec[:4])] = tableRecord{
+			offset: int64(binary.BigEndian.Uint32(rec[8:])),
+			length: int64(binary.BigEndian.Uint32(rec[12:])),
+		}
+	}
+	return res, nil
+}
+
+// readCoverage reads characters the face has glyphs for, from the cmap table.
+// Only Unicode subtables in format 4 and 12 are supported, and nil is returned
+// if there's no such subtable.
+//
+// Spec: https://learn.microsoft.com/en-us/typography/opentype/spec/cmap
+func readCoverage(r io.ReaderAt, table tableRecord) (*Coverage, error) {
+	buf := make([]byte, table.length)
+	if _, err := r.ReadAt(buf, table.offset); err != nil {
+		return nil, err
+	}
+	if len(buf) < 4 {
+		return nil, errors.New("cmap table is too short")
+	}
+	count := int(binary.BigEndian.Uint16(buf[2:]))
+	if len(buf) < 4+count*8 {
+		return nil, errors.New("cmap table is too short")
+	}
+	// Subtables for full Unicode range are preferred over ones for BMP only.
+	var subtable []byte
+	bestPriority := 0
+	for i := range count {
+		rec := buf[4+i*8:]
+		platformID := binary.BigEndian.Uint16(rec[0:])
+		encodingID := binary.BigEndian.Uint16(rec[2:])
+		offset := int(binary.BigEndian.Uint32(rec[4:]))
+		var priority int
+		switch {
+		case platformID == 3 && encodingID == 10, platformID == 0 && (encodingID == 4 || encodingID == 6):
+			priority = 2
+		case platformID == 3 && encodingID == 1, platformID == 0 && encodingID <= 3:
+			priority = 1
+		default:
+			continue
+		}
+		if bestPriority < priority && offset+2 <= len(buf) {
+			subtable, bestPriority = buf[offset:], priority
+		}
+	}
+	if subtable == nil {
+		return nil, nil
+	}
+	switch binary.BigEndian.Uint16(subtable) {
+	case 4:
+		return readCmapFormat4(subtable)
+	case 12:
+		return readCmapFormat12(subtable)
+	}
+	return nil, nil
+}
+
+// readCmapFormat4 reads a format 4 cmap subtable, which maps BMP characters
+// using segments.
+//
+// Spec: https://learn.microsoft.com/en-us/typography/opentype/spec/cmap#format-4-segment-mapping-to-delta-values
+func readCmapFormat4(buf []byte) (*Coverage, error) {
+	if len(buf) < 14 {
+		return nil, errors.New("cmap subtable is too short")
+	}
+	segCount := int(binary.BigEndian.Uint16(buf[6:])) / 2
+	endCodes := 14
+	startCodes := endCodes + segCount*2 + 2
+	idDeltas := startCodes + segCount*2
+	idRangeOffsets := idDeltas + segCount*2
+	if len(buf) < idRangeOffsets+segCount*2 {
+		return nil, errors.New("cmap subtable is too short")
+	}
+	u16At := func(offset int) int { return int(binary.BigEndian.Uint16(buf[offset:])) }
+	res := &Coverage{}
+	prevEnd := -1
+	for i := range segCount {
+		start, end := u16At(startCodes+i*2), u16At(endCodes+i*2)
+		idDelta, idRangeOffset := u16At(idDeltas+i*2), u16At(idRangeOffsets+i*2)
+		// Segments must be sorted, and shouldn't overlap.
+		if end < start || start <= prevEnd {
+			continue
+		}
+		prevEnd = end
+		for c := start; c <= end; c++ {
+			glyph := 0
+			if idRangeOffset == 0 {
+				glyph = (c + idDelta) & 0xffff
+			} else if offset := idRangeOffsets + i*2 + idRangeOffset + (c-start)*2; offset+2 <= len(buf) {
+				if glyph = u16At(offset); glyph != 0 {
+					glyph = (glyph + idDelta) & 0xffff
+				}
+			}
+			// Glyph 0 is the "missing glyph".
+			if glyph != 0 {
+				res.add(rune(c), rune(c))
+			}
+		}
+	}
+	return res, nil
+}
+
+// readCmapFormat12 reads a format 12 cmap subtable, which maps ranges of
+// characters to consecutive glyphs.
+//
+// Spec: https://learn.microsoft.com/en-us/typography/opentype/spec/cmap#format-12-segmented-coverage
+func readCmapFormat12(buf []byte) (*Coverage, error) {
+	if len(buf) < 16 {
+		return nil, errors.New("cmap subtable is too short")
+	}
+	count := int(binary.BigEndian.Uint32(buf[12:]))
+	if (len(buf)-16)/12 < count {
+		return nil, errors.New("cmap subtable is too short")
+	}
+	res := &Coverage{}
+	for i := range count {
+		group := buf[16+i*12:]
+		first := rune(binary.BigEndian.Uint32(group[0:]))
+		last := min(rune(binary.BigEndian.Uint32(group[4:])), unicode.MaxRune)
+		if binary.BigEndian.Uint32(group[8:]) == 0 {
+			// The first character is mapped to the "missing glyph".
+			first++
+		}
+		if first < 0 || last < first || (len(res.ranges) != 0 && first <= res.ranges[len(res.ranges)-1][1]) {
+			continue
+		}
+		res.add(first, last)
+	}
+	return res, nil
+}
+
+// Name IDs we are interested in
+// Spec: https://learn.microsoft.com/en-us/typography/opentype/spec/name#name-ids
+const (
+	nameFamily               = 1
+	nameSubfamily            = 2
+	nameTypographicFamily    = 16
+	nameTypographicSubfamily = 17
+)
+
+// readNames reads names from the name table, keyed by name ID.
+//
+// Names for Windows platform in US English are preferred, followed by other
+// languages of Windows platform, and then Macintosh platform.
+//
+// Spec: https://learn.microsoft.com/en-us/typography/opentype/spec/name
+func readNames(r io.ReaderAt, table tableRecord) (map[int]string, error) {
+	buf := make([]byte, table.length)
+	if _, err := r.ReadAt(buf, table.offset); err != nil {
+		return nil, err
+	}
+	if len(buf) < 6 {
+		return nil, errors.New("name table is too short")
+	}
+	count := int(binary.BigEndian.Uint16(buf[2:]))
+	storageOffset := int(binary.BigEndian.Uint16(buf[4:]))
+	if len(buf) < 6+count*12 {
+		return nil, errors.New("name table is too short")
+	}
+	res := map[int]string{}
+	priorities := map[int]int{} // Lower is better
+	for i := range count {
+		rec := buf[6+i*12:]
+		platformID := binary.BigEndian.Uint16(rec[0:])
+		encodingID := binary.BigEndian.Uint16(rec[2:])
+		languageID := binary.BigEndian.Uint16(rec[4:])
+		nameID := int(binary.BigEndian.Uint16(rec[6:]))
+		length := int(binary.BigEndian.Uint16(rec[8:]))
+		start := storageOffset + int(binary.BigEndian.Uint16(rec[10:]))
+		if len(buf) < start+length {
+			continue
+		}
+		data := buf[start : start+length]
+
+		var priority int
+		var name string
+		switch {
+		case platformID == 3 && (encodingID == 1 || encodingID == 10):
+			// Windows, UTF-16BE
+			priority = 1
+			if languageID == 0x409 {
+				priority = 0
+			}
+			units := make([]uint16, len(data)/2)
+			for j := range units {
+				units[j] = binary.BigEndian.Uint16(data[j*2:])
+			}
+			name = string(utf16.Decode(units))
+		case platformID == 1 && encodingID == 0:
+			// Macintosh, Roman
+			// TODO: Decode characters outside of ASCII range.
+			priority = 2
+			name = string(data)
+		default:
+			continue
+		}
+		if old, ok := priorities[nameID]; ok && old <= priority {
+			continue
+		}
+		priorities[nameID] = priority
+		res[nameID] = name
+	}
+	return res, nil
+}
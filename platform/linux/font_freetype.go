@@ -9,6 +9,7 @@ package linux
 // #include FT_FREETYPE_H
 import "C"
 import (
+	"fmt"
 	"image"
 	"image/color"
 	"log"
@@ -20,6 +21,13 @@ import (
 
 type freetypeFontProvider struct {
 	ftLib C.FT_Library
+	faces map[ftFaceKey]ftFont // Faces opened so far. They are kept open, and reused by later OpenFont calls.
+}
+
+// ftFaceKey identifies a face within a font file.
+type ftFaceKey struct {
+	path  string
+	index int
 }
 
 // Returns new [platform.FontProvider] implementing Freetype support.
@@ -31,18 +39,25 @@ func NewFreetypeFontProvider() platform.FontProvider {
 
 	return &freetypeFontProvider{
 		ftLib: ftLib,
+		faces: map[ftFaceKey]ftFont{},
 	}
 }
-func (prv freetypeFontProvider) OpenFont(name string) gfx.Font {
+func (prv *freetypeFontProvider) OpenFont(path string, index int) (gfx.Font, error) {
+	key := ftFaceKey{path, index}
+	if fnt, ok := prv.faces[key]; ok {
+		return fnt, nil
+	}
 	var face C.FT_Face
-	fontName := C.CString("res/font/static/NotoSansKR-Regular.ttf")
-	if res := C.FT_New_Face(prv.ftLib, fontName, 0, &face); res == C.FT_Err_Unknown_File_Format {
-		log.Fatalf("Unrecognized font (FT Error %d)", res)
+	fontPath := C.CString(path)
+	defer C.free(unsafe.Pointer(fontPath))
+	if res := C.FT_New_Face(prv.ftLib, fontPath, C.FT_Long(index), &face); res == C.FT_Err_Unknown_File_Format {
+		return nil, fmt.Errorf("unrecognized font %s (FT Error %d)", path, res)
 	} else if res != C.FT_Err_Ok {
-		log.Fatalf("Failed to open font %s (FT Error %d)", name, res)
+		return nil, fmt.Errorf("failed to open font %s (FT Error %d)", path, res)
 	}
-	C.free(unsafe.Pointer(fontName))
-	return ftFont{face: face}
+	fnt := ftFont{face: face}
+	prv.faces[key] = fnt
+	return fnt, nil
 }
 
 type ftFont struct {
@@ -59,7 +74,7 @@ func (fnt ftFont) Metrics() gfx.FontMetrics {
 	return gfx.FontMetrics{
 		// Below appear to be 26.6 fixed point values.
 		Ascender:   float64(rawMetrics.ascender) / 64.0,
-		Descender:  float64(rawMetrics.descender) / 64.0,
+		Descender:  -float64(rawMetrics.descender) / 64.0, // FreeType's descender is negative
 		LineHeight: float64(rawMetrics.height) / 64.0,
 		// Below appear to be 12.4 fixed point values? Idk, these seem to work fine for now.
 		UnderlinePosition:  float64(fnt.face.underline_position) / 16.0,
@@ -126,3 +141,8 @@ func (fnt ftFont) DrawText(text string, dest *image.RGBA, offsetX, offsetY int,
 	rect.Max.Y = rect.Min.Y + lineHeight
 	return rect
 }
+
+func (fnt ftFont) HasGlyph(r rune) bool {
+	// Glyph index 0 is the "missing glyph".
+	return C.FT_Get_Char_Index(fnt.face, C.FT_ULong(r)) != 0
+}
@@ -19,8 +19,8 @@ func NewNullFontProvider() platform.FontProvider {
 	return &nullFontProvider{}
 }
 
-func (prv nullFontProvider) OpenFont(name string) gfx.Font {
-	return nullFont{}
+func (prv nullFontProvider) OpenFont(path string, index int) (gfx.Font, error) {
+	return nullFont{}, nil
 }
 
 type nullFont struct{}
@@ -32,3 +32,6 @@ func (fnt nullFont) Metrics() gfx.FontMetrics {
 func (fnt nullFont) DrawText(text string, dest *image.RGBA, offsetX, offsetY int, textColor color.Color) image.Rectangle {
 	return image.Rectangle{}
 }
+func (fnt nullFont) HasGlyph(r rune) bool {
+	return true
+}
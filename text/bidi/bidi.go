@@ -0,0 +1,226 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+// Package bidi implements the [Unicode Bidirectional Algorithm] (UAX #9),
+// which resolves embedding levels of text containing both left-to-right and
+// right-to-left characters, and reorders it for display.
+//
+// [Unicode Bidirectional Algorithm]: https://www.unicode.org/reports/tr9/
+package bidi
+
+//go:generate go run ./gen
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Class is a bidirectional character type.
+//
+// https://www.unicode.org/reports/tr9/#Table_Bidirectional_Character_Types
+type Class uint8
+
+const (
+	L   Class = iota // Left-to-Right
+	R                // Right-to-Left
+	AL               // Right-to-Left Arabic
+	EN               // European Number
+	ES               // European Number Separator
+	ET               // European Number Terminator
+	AN               // Arabic Number
+	CS               // Common Number Separator
+	NSM              // Nonspacing Mark
+	BN               // Boundary Neutral
+	B                // Paragraph Separator
+	S                // Segment Separator
+	WS               // Whitespace
+	ON               // Other Neutrals
+	LRE              // Left-to-Right Embedding
+	LRO              // Left-to-Right Override
+	RLE              // Right-to-Left Embedding
+	RLO              // Right-to-Left Override
+	PDF              // Pop Directional Format
+	LRI              // Left-to-Right Isolate
+	RLI              // Right-to-Left Isolate
+	FSI              // First Strong Isolate
+	PDI              // Pop Directional Isolate
+)
+
+func (c Class) String() string {
+	names := [...]string{
+		"L", "R", "AL", "EN", "ES", "ET", "AN", "CS", "NSM", "BN", "B", "S",
+		"WS", "ON", "LRE", "LRO", "RLE", "RLO", "PDF", "LRI", "RLI", "FSI", "PDI",
+	}
+	if int(c) < len(names) {
+		return names[c]
+	}
+	return fmt.Sprintf("<bad Class %d>", c)
+}
+
+// Directional formatting characters
+const (
+	LreChar = '\u202a' // LEFT-TO-RIGHT EMBEDDING
+	RleChar = '\u202b' // RIGHT-TO-LEFT EMBEDDING
+	PdfChar = '\u202c' // POP DIRECTIONAL FORMATTING
+	LroChar = '\u202d' // LEFT-TO-RIGHT OVERRIDE
+	RloChar = '\u202e' // RIGHT-TO-LEFT OVERRIDE
+	LriChar = '\u2066' // LEFT-TO-RIGHT ISOLATE
+	RliChar = '\u2067' // RIGHT-TO-LEFT ISOLATE
+	FsiChar = '\u2068' // FIRST STRONG ISOLATE
+	PdiChar = '\u2069' // POP DIRECTIONAL ISOLATE
+)
+
+// classRange is an entry of classRanges table, which is generated from DerivedBidiClass.txt.
+type classRange struct {
+	first, last rune
+	class       Class
+}
+
+// ClassOf returns the bidirectional character type of r, as defined in
+// DerivedBidiClass.txt.
+func ClassOf(r rune) Class {
+	idx, found := slices.BinarySearchFunc(classRanges, r, func(e classRange, r rune) int {
+		if r < e.first {
+			return 1
+		} else if e.last < r {
+			return -1
+		}
+		return 0
+	})
+	if !found {
+		return L
+	}
+	return classRanges[idx].class
+}
+
+// BracketType is the type of paired bracket, as defined in BidiBrackets.txt.
+type BracketType uint8
+
+const (
+	NotBracket   BracketType = iota // Not a paired bracket
+	OpenBracket                     // Opening paired bracket
+	CloseBracket                    // Closing paired bracket
+)
+
+// pairedBracket is an entry of pairedBrackets table, which is generated from BidiBrackets.txt.
+type pairedBracket struct {
+	pair rune
+	typ  BracketType
+}
+
+// BracketOf returns the paired bracket type of r, and the bracket paired with it.
+func BracketOf(r rune) (pair rune, typ BracketType) {
+	b, ok := pairedBrackets[r]
+	if !ok {
+		return 0, NotBracket
+	}
+	return b.pair, b.typ
+}
+
+// MirrorOf returns the character whose glyph is the mirrored image of r's
+// glyph, as defined in BidiMirroring.txt. ok is false if there's no such character.
+func MirrorOf(r rune) (mirrored rune, ok bool) {
+	mirrored, ok = mirroredGlyphs[r]
+	return mirrored, ok
+}
+
+// ReverseText returns s in reversed order, as displayed in right-to-left run.
+// Characters with mirrored glyphs are replaced with mirrored ones, and
+// nonspacing marks are kept after their base characters.
+//
+// Spec: https://www.unicode.org/reports/tr9/#L3
+func ReverseText(s string) string {
+	clusters := []string{}
+	for i := 0; i < len(s); {
+		base, baseSize := utf8.DecodeRuneInString(s[i:])
+		end := i + baseSize
+		for end < len(s) {
+			r, size := utf8.DecodeRuneInString(s[end:])
+			if ClassOf(r) != NSM && r != '\u200d' {
+				break
+			}
+			end += size
+		}
+		if m, ok := MirrorOf(base); ok {
+			clusters = append(clusters, string(m)+s[i+baseSize:end])
+		} else {
+			clusters = append(clusters, s[i:end])
+		}
+		i = end
+	}
+	slices.Reverse(clusters)
+	return strings.Join(clusters, "")
+}
+
+// Level is an embedding level. Text at odd level is displayed right-to-left.
+//
+// https://www.unicode.org/reports/tr9/#BD2
+type Level uint8
+
+// MaxDepth is the maximum explicit embedding level.
+//
+// https://www.unicode.org/reports/tr9/#BD2
+const MaxDepth Level = 125
+
+// AutoLevel can be given as paragraph embedding level, to determine it from
+// text of the paragraph, using rules P2 and P3.
+const AutoLevel Level = 0xff
+
+// IsRtl reports whether text at level l is displayed right-to-left.
+func (l Level) IsRtl() bool {
+	return l%2 == 1
+}
+
+// FirstStrongLevel finds the first strong character in s, skipping characters
+// between isolate initiators and matching PDIs. It returns 1 if it's R or AL,
+// and 0 if it's L. ok is false if there's no such character.
+//
+// Spec: https://www.unicode.org/reports/tr9/#P2
+func FirstStrongLevel(s string) (level Level, ok bool) {
+	classes := []Class{}
+	for _, r := range s {
+		classes = append(classes, ClassOf(r))
+	}
+	return firstStrongLevel(classes)
+}
+
+// VisualOrder returns indices of items at given levels, in visual order
+// from left to right.
+//
+// Spec: https://www.unicode.org/reports/tr9/#L2
+func VisualOrder(levels []Level) []int {
+	order := make([]int, len(levels))
+	if len(levels) == 0 {
+		return order
+	}
+	for i := range order {
+		order[i] = i
+	}
+	highest, lowestOdd := Level(0), MaxDepth+2
+	for _, l := range levels {
+		highest = max(highest, l)
+		if l.IsRtl() {
+			lowestOdd = min(lowestOdd, l)
+		}
+	}
+	// From the highest level to the lowest odd level, reverse any contiguous
+	// sequence of items that are at that level or higher.
+	for l := highest; lowestOdd <= l && l != 0; l-- {
+		for i := 0; i < len(order); {
+			if levels[order[i]] < l {
+				i++
+				continue
+			}
+			end := i
+			for end < len(order) && l <= levels[order[end]] {
+				end++
+			}
+			slices.Reverse(order[i:end])
+			i = end
+		}
+	}
+	return order
+}
@@ -0,0 +1,268 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package bidi
+
+import (
+	"bufio"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestClassOf(t *testing.T) {
+	cases := []struct {
+		input    rune
+		expected Class
+	}{
+		{'a', L}, {'가', L}, {'א', R}, {'ع', AL}, {'1', EN}, {'+', ES}, {'$', ET},
+		{'٣', AN}, {',', CS}, {'\u0301', NSM}, {'\u200b', BN}, {'\n', B}, {'\t', S},
+		{' ', WS}, {'(', ON}, {'\u202b', RLE}, {'\u2069', PDI},
+		{0x05ff, R}, {0x10ffff, BN},
+	}
+	for _, cs := range cases {
+		if got := ClassOf(cs.input); got != cs.expected {
+			t.Errorf("%q: expected class %v, got %v", cs.input, cs.expected, got)
+		}
+	}
+}
+
+func TestFirstStrongLevel(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected Level
+		ok       bool
+	}{
+		{"abc", 0, true},
+		{"123 אב", 1, true},
+		{"(ع)", 1, true},
+		{"\u2066abc\u2069 אב", 1, true},
+		{"123 !", 0, false},
+	}
+	for _, cs := range cases {
+		got, ok := FirstStrongLevel(cs.input)
+		if got != cs.expected || ok != cs.ok {
+			t.Errorf("%q: expected (%d, %v), got (%d, %v)", cs.input, cs.expected, cs.ok, got, ok)
+		}
+	}
+}
+
+func TestVisualOrder(t *testing.T) {
+	cases := []struct {
+		input    []Level
+		expected []int
+	}{
+		{[]Level{0, 0, 0}, []int{0, 1, 2}},
+		{[]Level{1, 1, 1}, []int{2, 1, 0}},
+		{[]Level{0, 0, 1, 1, 2, 2, 1, 0}, []int{0, 1, 6, 4, 5, 3, 2, 7}},
+		{[]Level{1, 2, 2, 1}, []int{3, 1, 2, 0}},
+	}
+	for _, cs := range cases {
+		if got := VisualOrder(cs.input); !slices.Equal(got, cs.expected) {
+			t.Errorf("%v: expected %v, got %v", cs.input, cs.expected, got)
+		}
+	}
+}
+
+func TestReverseText(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{"abc", "cba"},
+		{"a(b)", "(b)a"},
+		{"e\u0301f", "fe\u0301"},
+		{"1<2", "2>1"},
+	}
+	for _, cs := range cases {
+		if got := ReverseText(cs.input); got != cs.expected {
+			t.Errorf("%q: expected %q, got %q", cs.input, cs.expected, got)
+		}
+	}
+}
+
+// displayOrder returns s as displayed on a single line, with formatting
+// characters removed.
+func displayOrder(s string, baseLevel Level) string {
+	p := NewParagraph(s, baseLevel)
+	levels := p.LineLevels(0, len(s))
+	runs := []string{}
+	runLevels := []Level{}
+	for i, r := range s {
+		if c := ClassOf(r); isRemovedByX9(c) || isIsolateInitiator(c) || c == PDI {
+			continue
+		}
+		if len(runs) != 0 && runLevels[len(runLevels)-1] == levels[i] {
+			runs[len(runs)-1] += string(r)
+			continue
+		}
+		runs = append(runs, string(r))
+		runLevels = append(runLevels, levels[i])
+	}
+	sb := strings.Builder{}
+	for _, idx := range VisualOrder(runLevels) {
+		if runLevels[idx].IsRtl() {
+			sb.WriteString(ReverseText(runs[idx]))
+		} else {
+			sb.WriteString(runs[idx])
+		}
+	}
+	return sb.String()
+}
+
+func TestParagraph(t *testing.T) {
+	cases := []struct {
+		desc      string
+		input     string
+		baseLevel Level
+		expected  string
+	}{
+		{"LTR only", "abc def", 0, "abc def"},
+		{"RTL only", "אבג דה", 0, "הד גבא"},
+		{"RTL in LTR", "abc אבג def", 0, "abc גבא def"},
+		{"LTR in RTL", "אבג abc", 1, "abc גבא"},
+		{"Numbers after R", "אבג 123", 0, "123 גבא"},
+		{"Numbers after AL", "عرب 12", 0, "12 برع"},
+		{"Numbers with separators", "אב 1,234.5", 1, "1,234.5 בא"},
+		{"Brackets in RTL context", "אב(ג)", 0, "(ג)בא"},
+		{"Brackets in LTR context", "a(b)אב", 0, "a(b)בא"},
+		{"Brackets with opposite direction", "אב (cd) גד", 1, "דג (cd) בא"},
+		{"Auto", "אב cd", AutoLevel, "cd בא"},
+		{"Auto with no strong characters", "123 !", AutoLevel, "123 !"},
+		{"Override", "ab\u202ecd\u202c", 0, "abdc"},
+		{"Embedding", "\u202bab אב\u202c", 0, "בא ab"},
+		{"Isolate", "אב \u2066cd\u2069 גד", 1, "דג cd בא"},
+		{"First strong isolate", "ab \u2068גד ef\u2069", 0, "ab ef דג"},
+		{"Unmatched PDI", "אב\u2069 cd", 0, "בא cd"},
+		{"Nonspacing mark", "אב\u05b0ג", 0, "גב\u05b0א"},
+		{"Multiple paragraphs", "אב\ncd", AutoLevel, "\nבאcd"},
+	}
+	for _, cs := range cases {
+		got := displayOrder(cs.input, cs.baseLevel)
+		if got != cs.expected {
+			t.Errorf("%s: %q: expected %q, got %q", cs.desc, cs.input, cs.expected, got)
+		}
+	}
+}
+
+func TestLineLevels(t *testing.T) {
+	s := "\u202bab  \u202c"
+	p := NewParagraph(s, 0)
+	levels := p.LineLevels(0, len(s))
+	expected := []Level{0, 2, 2, 0, 0, 0}
+	got := []Level{}
+	for i := range s {
+		got = append(got, levels[i])
+	}
+	if !slices.Equal(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if p.BaseLevel() != 0 || p.IsLtrOnly() {
+		t.Errorf("expected base level 0 with RTL content")
+	}
+}
+
+// parseLevels parses space-separated list of levels in BidiCharacterTest.txt.
+// Characters removed by rule X9 are given as "x", and these are returned as
+// -1.
+func parseLevels(s string) ([]int, error) {
+	levels := []int{}
+	for _, field := range strings.Fields(s) {
+		if field == "x" {
+			levels = append(levels, -1)
+			continue
+		}
+		v, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, err
+		}
+		levels = append(levels, v)
+	}
+	return levels, nil
+}
+
+// TestConformance runs test cases from BidiCharacterTest.txt in testdata.
+// Each line gives codepoints of a paragraph, paragraph direction (0: LTR,
+// 1: RTL, 2: auto), resolved paragraph level, resolved levels of each
+// character, and visual order of characters that aren't removed by rule X9.
+//
+// Spec: https://www.unicode.org/Public/14.0.0/ucd/BidiCharacterTest.txt
+func TestConformance(t *testing.T) {
+	f, err := os.Open("testdata/BidiCharacterTest.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) != 5 {
+			t.Fatalf("line %d: expected 5 fields, got %d", lineNum, len(fields))
+		}
+		sb := strings.Builder{}
+		offsets := []int{}
+		for _, field := range strings.Fields(fields[0]) {
+			cp, err := strconv.ParseUint(field, 16, 32)
+			if err != nil {
+				t.Fatalf("line %d: bad codepoint %q", lineNum, field)
+			}
+			offsets = append(offsets, sb.Len())
+			sb.WriteRune(rune(cp))
+		}
+		baseLevel := AutoLevel
+		switch fields[1] {
+		case "0":
+			baseLevel = 0
+		case "1":
+			baseLevel = 1
+		}
+		expectedBaseLevel, err1 := strconv.Atoi(fields[2])
+		expectedLevels, err2 := parseLevels(fields[3])
+		expectedOrder, err3 := parseLevels(fields[4])
+		if err1 != nil || err2 != nil || err3 != nil || len(expectedLevels) != len(offsets) {
+			t.Fatalf("line %d: bad test case", lineNum)
+		}
+
+		text := sb.String()
+		p := NewParagraph(text, baseLevel)
+		if got := p.BaseLevel(); int(got) != expectedBaseLevel {
+			t.Errorf("line %d: expected paragraph level %d, got %d", lineNum, expectedBaseLevel, got)
+			continue
+		}
+		lineLevels := p.LineLevels(0, len(text))
+		gotLevels := make([]int, len(offsets))
+		indices, levels := []int{}, []Level{}
+		for i, offset := range offsets {
+			if expectedLevels[i] == -1 {
+				gotLevels[i] = -1
+				continue
+			}
+			gotLevels[i] = int(lineLevels[offset])
+			indices = append(indices, i)
+			levels = append(levels, lineLevels[offset])
+		}
+		if !slices.Equal(expectedLevels, gotLevels) {
+			t.Errorf("line %d: %s: expected levels %v, got %v", lineNum, fields[0], expectedLevels, gotLevels)
+			continue
+		}
+		gotOrder := []int{}
+		for _, idx := range VisualOrder(levels) {
+			gotOrder = append(gotOrder, indices[idx])
+		}
+		if !slices.Equal(expectedOrder, gotOrder) {
+			t.Errorf("line %d: %s: expected order %v, got %v", lineNum, fields[0], expectedOrder, gotOrder)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,194 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var pkg = flag.String("pkg", "bidi", "Package name to use")
+var url = flag.String("url", "https://www.unicode.org/Public/14.0.0/ucd/", "The HTTP URL of the directory to download UCD files from")
+var outFile = flag.String("out", "tables.go", "Output file")
+
+const maxCodepoint = 0x10ffff
+
+// parseCodepoint parses a hexadecimal codepoint.
+func parseCodepoint(s string) (int, error) {
+	v, err := strconv.ParseInt(strings.TrimSpace(s), 16, 32)
+	if err != nil {
+		return 0, err
+	}
+	if v < 0 || maxCodepoint < v {
+		return 0, fmt.Errorf("codepoint %s is out of range", s)
+	}
+	return int(v), nil
+}
+
+// parseRange parses "XXXX" or "XXXX..YYYY" codepoint range.
+func parseRange(s string) (first, last int, err error) {
+	firstStr, lastStr, isRange := strings.Cut(strings.TrimSpace(s), "..")
+	if !isRange {
+		lastStr = firstStr
+	}
+	if first, err = parseCodepoint(firstStr); err != nil {
+		return 0, 0, err
+	}
+	if last, err = parseCodepoint(lastStr); err != nil {
+		return 0, 0, err
+	}
+	return first, last, nil
+}
+
+// readUcdFile downloads a UCD file named name, and calls fn with fields of
+// each entry. If withMissing is set, default values given by @missing lines
+// are also passed to fn.
+func readUcdFile(name string, withMissing bool, fn func(fields []string)) {
+	fileUrl := *url + name
+	log.Printf("Downloading from %s", fileUrl)
+	res, err := http.Get(fileUrl)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		log.Fatalf("Server returned %s", res.Status)
+	}
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if missing, ok := strings.CutPrefix(line, "# @missing:"); ok && withMissing {
+			line = missing
+		} else if idx := strings.IndexByte(line, '#'); idx != -1 {
+			line = line[:idx]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		fn(fields)
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	log.SetPrefix("[text/bidi/gen] ")
+
+	log.Println("Reading bidi classes")
+	classes := make([]string, maxCodepoint+1)
+	readUcdFile("extracted/DerivedBidiClass.txt", true, func(fields []string) {
+		if len(fields) < 2 {
+			log.Printf("Bad entry %v -- skipping the entry", fields)
+			return
+		}
+		first, last, err := parseRange(fields[0])
+		if err != nil || last < first {
+			log.Printf("Bad codepoint range %q -- skipping the entry", fields[0])
+			return
+		}
+		for cp := first; cp <= last; cp++ {
+			classes[cp] = fields[1]
+		}
+	})
+
+	log.Println("Reading paired brackets")
+	type bracket struct {
+		cp, pair int
+		typ      string
+	}
+	brackets := []bracket{}
+	readUcdFile("BidiBrackets.txt", false, func(fields []string) {
+		if len(fields) < 3 {
+			log.Printf("Bad entry %v -- skipping the entry", fields)
+			return
+		}
+		cp, err1 := parseCodepoint(fields[0])
+		pair, err2 := parseCodepoint(fields[1])
+		if err1 != nil || err2 != nil {
+			log.Printf("Bad entry %v -- skipping the entry", fields)
+			return
+		}
+		switch fields[2] {
+		case "o":
+			brackets = append(brackets, bracket{cp, pair, "OpenBracket"})
+		case "c":
+			brackets = append(brackets, bracket{cp, pair, "CloseBracket"})
+		default:
+			log.Printf("Bad bracket type %q -- skipping the entry", fields[2])
+		}
+	})
+
+	log.Println("Reading mirrored glyphs")
+	type mirror struct{ cp, glyph int }
+	mirrors := []mirror{}
+	readUcdFile("BidiMirroring.txt", false, func(fields []string) {
+		if len(fields) < 2 {
+			log.Printf("Bad entry %v -- skipping the entry", fields)
+			return
+		}
+		cp, err1 := parseCodepoint(fields[0])
+		glyph, err2 := parseCodepoint(fields[1])
+		if err1 != nil || err2 != nil {
+			log.Printf("Bad entry %v -- skipping the entry", fields)
+			return
+		}
+		mirrors = append(mirrors, mirror{cp, glyph})
+	})
+
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("// Auto-generated by text/bidi/gen(using the data files from %s)\n// DO NOT EDIT.\n\n", *url))
+	sb.WriteString(fmt.Sprintf("package %s\n\n", *pkg))
+	sb.WriteString("var classRanges = []classRange{\n")
+	for first := 0; first <= maxCodepoint; {
+		last := first
+		for last < maxCodepoint && classes[last+1] == classes[first] {
+			last++
+		}
+		// L is the default class, so these are not stored in the table.
+		if class := classes[first]; class != "" && class != "L" {
+			sb.WriteString(fmt.Sprintf("    {%#x, %#x, %s},\n", first, last, class))
+		}
+		first = last + 1
+	}
+	sb.WriteString("}\n\n")
+	sb.WriteString("var pairedBrackets = map[rune]pairedBracket{\n")
+	for _, b := range brackets {
+		sb.WriteString(fmt.Sprintf("    %#x: {%#x, %s},\n", b.cp, b.pair, b.typ))
+	}
+	sb.WriteString("}\n\n")
+	sb.WriteString("var mirroredGlyphs = map[rune]rune{\n")
+	for _, m := range mirrors {
+		sb.WriteString(fmt.Sprintf("    %#x: %#x,\n", m.cp, m.glyph))
+	}
+	sb.WriteString("}\n")
+
+	log.Printf("Formatting generated source")
+	unformatted := []byte(sb.String())
+	formatted, err := format.Source(unformatted)
+	if err != nil {
+		log.Println(err)
+		log.Println("Formatting error -- Writing unformatted source code instead")
+		formatted = unformatted
+	}
+	log.Printf("Writing output to %s", *outFile)
+	err = os.WriteFile(*outFile, formatted, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,668 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package bidi
+
+import "slices"
+
+// Paragraph holds embedding levels of text, resolved by the bidirectional
+// algorithm. If the text contains paragraph separators, each paragraph within
+// it is resolved separately.
+type Paragraph struct {
+	text       string
+	classes    []Class // Original bidi class of each byte
+	levels     []Level // Resolved level of each byte
+	baseLevels []Level // Paragraph embedding level of each byte
+}
+
+// NewParagraph resolves embedding levels of text, using baseLevel as the
+// paragraph embedding level. If baseLevel is [AutoLevel], it's determined from
+// the text.
+//
+// Spec: https://www.unicode.org/reports/tr9/#The_Paragraph_Level
+func NewParagraph(text string, baseLevel Level) *Paragraph {
+	runes := []rune{}
+	offsets := []int{}
+	for i, r := range text {
+		runes = append(runes, r)
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(text))
+	classes := make([]Class, len(runes))
+	for i, r := range runes {
+		classes[i] = ClassOf(r)
+	}
+	levels := make([]Level, len(runes))
+	baseLevels := make([]Level, len(runes))
+
+	// P1: Split the text into paragraphs. Paragraph separator is kept with
+	// the preceding paragraph.
+	for start := 0; start < len(runes); {
+		end := start
+		for end < len(runes) && classes[end] != B {
+			end++
+		}
+		end = min(end+1, len(runes))
+		res := newResolver(runes[start:end], classes[start:end], levels[start:end], baseLevel)
+		res.resolve()
+		for i := start; i < end; i++ {
+			baseLevels[i] = res.paraLevel
+		}
+		start = end
+	}
+
+	p := &Paragraph{text, make([]Class, len(text)), make([]Level, len(text)), make([]Level, len(text))}
+	for i := range runes {
+		for j := offsets[i]; j < offsets[i+1]; j++ {
+			p.classes[j] = classes[i]
+			p.levels[j] = levels[i]
+			p.baseLevels[j] = baseLevels[i]
+		}
+	}
+	return p
+}
+
+// Text returns the text of the paragraph.
+func (p *Paragraph) Text() string {
+	return p.text
+}
+
+// BaseLevel returns the paragraph embedding level. If the text contains
+// multiple paragraphs, the level of the first one is returned.
+func (p *Paragraph) BaseLevel() Level {
+	if len(p.baseLevels) == 0 {
+		return 0
+	}
+	return p.baseLevels[0]
+}
+
+// Levels returns resolved embedding level of each byte in the text.
+// Rules for the line (L1) are not applied to these.
+func (p *Paragraph) Levels() []Level {
+	return p.levels
+}
+
+// LineLevels returns embedding level of each byte in text[start:end], which
+// is a single line of the text.
+//
+// Spec: https://www.unicode.org/reports/tr9/#L1
+func (p *Paragraph) LineLevels(start, end int) []Level {
+	levels := slices.Clone(p.levels[start:end])
+	// Segment separators, paragraph separators, and any sequence of whitespace
+	// before them or at the end of the line are reset to the paragraph level.
+	isTrailing := true
+	for i := end - 1; start <= i; i-- {
+		switch c := p.classes[i]; {
+		case c == S || c == B:
+			levels[i-start] = p.baseLevels[i]
+			isTrailing = true
+		case isTrailing && isWhitespaceOrIsolate(c):
+			levels[i-start] = p.baseLevels[i]
+		default:
+			isTrailing = false
+		}
+	}
+	return levels
+}
+
+// IsLtrOnly reports whether the whole text is at level 0, which means it
+// doesn't need to be reordered.
+func (p *Paragraph) IsLtrOnly() bool {
+	return !slices.ContainsFunc(p.levels, func(l Level) bool { return l != 0 })
+}
+
+// isWhitespaceOrIsolate reports whether c is either whitespace, isolate
+// formatting character, or a character removed by rule X9, which are reset to
+// paragraph level by rule L1.
+func isWhitespaceOrIsolate(c Class) bool {
+	switch c {
+	case WS, FSI, LRI, RLI, PDI:
+		return true
+	}
+	return isRemovedByX9(c)
+}
+
+// isRemovedByX9 reports whether characters of class c are ignored after
+// explicit levels are resolved.
+//
+// Spec: https://www.unicode.org/reports/tr9/#X9
+func isRemovedByX9(c Class) bool {
+	switch c {
+	case RLE, LRE, RLO, LRO, PDF, BN:
+		return true
+	}
+	return false
+}
+
+// isIsolateInitiator reports whether c is one of LRI, RLI and FSI.
+func isIsolateInitiator(c Class) bool {
+	return c == LRI || c == RLI || c == FSI
+}
+
+// isNeutralOrIsolate reports whether c is NI, as used by rules N1 and N2.
+func isNeutralOrIsolate(c Class) bool {
+	switch c {
+	case B, S, WS, ON, BN, LRI, RLI, FSI, PDI:
+		return true
+	}
+	return false
+}
+
+// strongDirectionOf returns the strong direction (L or R) of c, treating
+// numbers as R. ON is returned if c isn't strong.
+func strongDirectionOf(c Class) Class {
+	switch c {
+	case L:
+		return L
+	case R, AL, EN, AN:
+		return R
+	}
+	return ON
+}
+
+// directionOfLevel returns the direction (L or R) of level l.
+func directionOfLevel(l Level) Class {
+	if l.IsRtl() {
+		return R
+	}
+	return L
+}
+
+// firstStrongLevel implements FirstStrongLevel, using bidi classes of the text.
+func firstStrongLevel(classes []Class) (level Level, ok bool) {
+	isolateDepth := 0
+	for _, c := range classes {
+		switch c {
+		case LRI, RLI, FSI:
+			isolateDepth++
+		case PDI:
+			if isolateDepth != 0 {
+				isolateDepth--
+			}
+		case L:
+			if isolateDepth == 0 {
+				return 0, true
+			}
+		case R, AL:
+			if isolateDepth == 0 {
+				return 1, true
+			}
+		case B:
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+// nextEmbeddingLevel returns the least odd(if isRtl is set) or even level
+// greater than l.
+func nextEmbeddingLevel(l Level, isRtl bool) Level {
+	if isRtl {
+		return (l + 1) | 1
+	}
+	return (l + 2) &^ 1
+}
+
+// resolver resolves levels of a single paragraph.
+type resolver struct {
+	runes       []rune
+	classes     []Class // Original bidi classes
+	types       []Class // Bidi classes being resolved
+	levels      []Level
+	paraLevel   Level
+	matchingPdi []int // Index of matching PDI for each isolate initiator, or -1
+}
+
+func newResolver(runes []rune, classes []Class, levels []Level, baseLevel Level) *resolver {
+	res := &resolver{
+		runes:       runes,
+		classes:     classes,
+		types:       slices.Clone(classes),
+		levels:      levels,
+		paraLevel:   baseLevel,
+		matchingPdi: make([]int, len(runes)),
+	}
+	if baseLevel == AutoLevel {
+		// P2, P3
+		res.paraLevel, _ = firstStrongLevel(classes)
+	}
+	return res
+}
+
+func (res *resolver) resolve() {
+	res.matchIsolates()
+	res.resolveExplicitLevels()
+	for _, seq := range res.isolatingRunSequences() {
+		res.resolveSequence(seq)
+	}
+	// Characters removed by X9 get the level of preceding character, so
+	// that these don't break up runs of text.
+	for i, c := range res.classes {
+		if !isRemovedByX9(c) {
+			continue
+		}
+		if i == 0 {
+			res.levels[i] = res.paraLevel
+		} else {
+			res.levels[i] = res.levels[i-1]
+		}
+	}
+}
+
+// matchIsolates finds matching PDI of each isolate initiator.
+//
+// Spec: https://www.unicode.org/reports/tr9/#BD9
+func (res *resolver) matchIsolates() {
+	for i := range res.runes {
+		res.matchingPdi[i] = -1
+	}
+	stack := []int{}
+	for i, c := range res.classes {
+		switch c {
+		case LRI, RLI, FSI:
+			stack = append(stack, i)
+		case PDI:
+			if len(stack) == 0 {
+				continue
+			}
+			initiator := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			res.matchingPdi[initiator] = i
+		}
+	}
+}
+
+// resolveExplicitLevels applies rules X1 to X8.
+//
+// Spec: https://www.unicode.org/reports/tr9/#Explicit_Levels_and_Directions
+func (res *resolver) resolveExplicitLevels() {
+	type statusEntry struct {
+		level    Level
+		override Class // L, R, or ON if there's no override
+		isolate  bool
+	}
+	stack := []statusEntry{{res.paraLevel, ON, false}}
+	overflowIsolates, overflowEmbeddings, validIsolates := 0, 0, 0
+
+	for i, c := range res.classes {
+		top := stack[len(stack)-1]
+		switch c {
+		case RLE, LRE, RLO, LRO:
+			// X2 - X5
+			res.levels[i] = top.level
+			newLevel := nextEmbeddingLevel(top.level, c == RLE || c == RLO)
+			if newLevel <= MaxDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				override := ON
+				if c == RLO {
+					override = R
+				} else if c == LRO {
+					override = L
+				}
+				stack = append(stack, statusEntry{newLevel, override, false})
+			} else if overflowIsolates == 0 {
+				overflowEmbeddings++
+			}
+		case RLI, LRI, FSI:
+			// X5a - X5c
+			res.levels[i] = top.level
+			if top.override != ON {
+				res.types[i] = top.override
+			}
+			isRtl := c == RLI
+			if c == FSI {
+				end := res.matchingPdi[i]
+				if end == -1 {
+					end = len(res.classes)
+				}
+				level, _ := firstStrongLevel(res.classes[i+1 : end])
+				isRtl = level.IsRtl()
+			}
+			newLevel := nextEmbeddingLevel(top.level, isRtl)
+			if newLevel <= MaxDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				validIsolates++
+				stack = append(stack, statusEntry{newLevel, ON, true})
+			} else {
+				overflowIsolates++
+			}
+		case PDI:
+			// X6a
+			if overflowIsolates != 0 {
+				overflowIsolates--
+			} else if validIsolates != 0 {
+				overflowEmbeddings = 0
+				for !stack[len(stack)-1].isolate {
+					stack = stack[:len(stack)-1]
+				}
+				stack = stack[:len(stack)-1]
+				validIsolates--
+			}
+			top = stack[len(stack)-1]
+			res.levels[i] = top.level
+			if top.override != ON {
+				res.types[i] = top.override
+			}
+		case PDF:
+			// X7
+			res.levels[i] = top.level
+			if overflowIsolates != 0 {
+				// Do nothing
+			} else if overflowEmbeddings != 0 {
+				overflowEmbeddings--
+			} else if !top.isolate && 2 <= len(stack) {
+				stack = stack[:len(stack)-1]
+			}
+		case B:
+			// X8
+			res.levels[i] = res.paraLevel
+		case BN:
+			// Ignored by X9
+			res.levels[i] = top.level
+		default:
+			// X6
+			res.levels[i] = top.level
+			if top.override != ON {
+				res.types[i] = top.override
+			}
+		}
+	}
+}
+
+// isolatingRunSequence is a sequence of level runs, which are resolved together
+// by rules W1 to I2.
+//
+// Spec: https://www.unicode.org/reports/tr9/#BD13
+type isolatingRunSequence struct {
+	indices  []int // Indices of characters in the sequence
+	sos, eos Class // Directions of start-of-sequence and end-of-sequence
+}
+
+// isolatingRunSequences returns isolating run sequences in the paragraph.
+//
+// Spec: https://www.unicode.org/reports/tr9/#X10
+func (res *resolver) isolatingRunSequences() []isolatingRunSequence {
+	// Split text into level runs, ignoring characters removed by X9.
+	runs := [][]int{}
+	runOf := make([]int, len(res.classes))
+	for i, c := range res.classes {
+		if isRemovedByX9(c) {
+			continue
+		}
+		if len(runs) == 0 {
+			runs = append(runs, []int{})
+		} else if lastRun := runs[len(runs)-1]; res.levels[lastRun[0]] != res.levels[i] {
+			runs = append(runs, []int{})
+		}
+		runs[len(runs)-1] = append(runs[len(runs)-1], i)
+		runOf[i] = len(runs) - 1
+	}
+
+	seqs := []isolatingRunSequence{}
+	isInSeq := make([]bool, len(runs))
+	for runIdx, run := range runs {
+		if isInSeq[runIdx] {
+			continue
+		}
+		indices := slices.Clone(run)
+		isInSeq[runIdx] = true
+		// Level runs ending with an isolate initiator continues at the level
+		// run starting with matching PDI.
+		for {
+			last := indices[len(indices)-1]
+			if !isIsolateInitiator(res.classes[last]) || res.matchingPdi[last] == -1 {
+				break
+			}
+			pdi := res.matchingPdi[last]
+			nextRunIdx := runOf[pdi]
+			if runs[nextRunIdx][0] != pdi || isInSeq[nextRunIdx] {
+				break
+			}
+			indices = append(indices, runs[nextRunIdx]...)
+			isInSeq[nextRunIdx] = true
+		}
+
+		first, last := indices[0], indices[len(indices)-1]
+		level := res.levels[first]
+		prevLevel, nextLevel := res.paraLevel, res.paraLevel
+		for i := first - 1; 0 <= i; i-- {
+			if !isRemovedByX9(res.classes[i]) {
+				prevLevel = res.levels[i]
+				break
+			}
+		}
+		if !isIsolateInitiator(res.classes[last]) {
+			for i := last + 1; i < len(res.classes); i++ {
+				if !isRemovedByX9(res.classes[i]) {
+					nextLevel = res.levels[i]
+					break
+				}
+			}
+		}
+		seqs = append(seqs, isolatingRunSequence{
+			indices: indices,
+			sos:     directionOfLevel(max(prevLevel, level)),
+			eos:     directionOfLevel(max(nextLevel, level)),
+		})
+	}
+	return seqs
+}
+
+// resolveSequence applies rules W1 to I2 to an isolating run sequence.
+func (res *resolver) resolveSequence(seq isolatingRunSequence) {
+	indices := seq.indices
+	types := make([]Class, len(indices))
+	for k, i := range indices {
+		types[k] = res.types[i]
+	}
+	level := res.levels[indices[0]]
+	embeddingDir := directionOfLevel(level)
+
+	// W1: NSM gets the type of the previous character.
+	for k, t := range types {
+		if t != NSM {
+			continue
+		}
+		if k == 0 {
+			types[k] = seq.sos
+		} else if prev := types[k-1]; isIsolateInitiator(prev) || prev == PDI {
+			types[k] = ON
+		} else {
+			types[k] = prev
+		}
+	}
+	// W2: EN after AL becomes AN.
+	lastStrong := seq.sos
+	for k, t := range types {
+		switch t {
+		case L, R, AL:
+			lastStrong = t
+		case EN:
+			if lastStrong == AL {
+				types[k] = AN
+			}
+		}
+	}
+	// W3: AL becomes R.
+	for k, t := range types {
+		if t == AL {
+			types[k] = R
+		}
+	}
+	// W4: A single separator between two numbers becomes a number.
+	for k := 1; k < len(types)-1; k++ {
+		prev, next := types[k-1], types[k+1]
+		if types[k] == ES && prev == EN && next == EN {
+			types[k] = EN
+		} else if types[k] == CS && prev == next && (prev == EN || prev == AN) {
+			types[k] = prev
+		}
+	}
+	// W5: Sequence of ET adjacent to EN becomes EN.
+	for k := 0; k < len(types); {
+		if types[k] != ET {
+			k++
+			continue
+		}
+		end := k
+		for end < len(types) && types[end] == ET {
+			end++
+		}
+		if (0 < k && types[k-1] == EN) || (end < len(types) && types[end] == EN) {
+			for j := k; j < end; j++ {
+				types[j] = EN
+			}
+		}
+		k = end
+	}
+	// W6: Remaining separators and terminators become ON.
+	for k, t := range types {
+		if t == ES || t == ET || t == CS {
+			types[k] = ON
+		}
+	}
+	// W7: EN after L becomes L.
+	lastStrong = seq.sos
+	for k, t := range types {
+		switch t {
+		case L, R:
+			lastStrong = t
+		case EN:
+			if lastStrong == L {
+				types[k] = L
+			}
+		}
+	}
+
+	// N0: Paired brackets
+	for _, pair := range res.bracketPairs(indices, types) {
+		open, close := pair[0], pair[1]
+		hasEmbeddingDir, hasOppositeDir := false, false
+		for k := open + 1; k < close; k++ {
+			if dir := strongDirectionOf(types[k]); dir == embeddingDir {
+				hasEmbeddingDir = true
+				break
+			} else if dir != ON {
+				hasOppositeDir = true
+			}
+		}
+		newType := ON
+		if hasEmbeddingDir {
+			newType = embeddingDir
+		} else if hasOppositeDir {
+			// Use the direction of preceding context, if it's also opposite
+			// direction.
+			contextDir := seq.sos
+			for k := open - 1; 0 <= k; k-- {
+				if dir := strongDirectionOf(types[k]); dir != ON {
+					contextDir = dir
+					break
+				}
+			}
+			newType = contextDir
+		}
+		if newType == ON {
+			continue
+		}
+		for _, k := range []int{open, close} {
+			types[k] = newType
+			// NSMs following the bracket get the same type.
+			for j := k + 1; j < len(types) && res.classes[indices[j]] == NSM; j++ {
+				types[j] = newType
+			}
+		}
+	}
+
+	// N1, N2: Sequence of NIs gets the direction of surrounding text if both
+	// sides have the same direction, and the embedding direction otherwise.
+	for k := 0; k < len(types); {
+		if !isNeutralOrIsolate(types[k]) {
+			k++
+			continue
+		}
+		end := k
+		for end < len(types) && isNeutralOrIsolate(types[end]) {
+			end++
+		}
+		before, after := seq.sos, seq.eos
+		if 0 < k {
+			before = strongDirectionOf(types[k-1])
+		}
+		if end < len(types) {
+			after = strongDirectionOf(types[end])
+		}
+		newType := embeddingDir
+		if before == after {
+			newType = before
+		}
+		for j := k; j < end; j++ {
+			types[j] = newType
+		}
+		k = end
+	}
+
+	// I1, I2: Implicit levels
+	for k, i := range indices {
+		res.types[i] = types[k]
+		switch t := types[k]; {
+		case !level.IsRtl() && t == R:
+			res.levels[i] = level + 1
+		case !level.IsRtl() && (t == AN || t == EN):
+			res.levels[i] = level + 2
+		case level.IsRtl() && (t == L || t == EN || t == AN):
+			res.levels[i] = level + 1
+		}
+	}
+}
+
+// maxBracketDepth is the size of bracket stack used by BD16.
+const maxBracketDepth = 63
+
+// bracketPairs finds bracket pairs in an isolating run sequence, and returns
+// positions of these in the sequence, sorted by positions of opening brackets.
+//
+// Spec: https://www.unicode.org/reports/tr9/#BD16
+func (res *resolver) bracketPairs(indices []int, types []Class) [][2]int {
+	type stackEntry struct {
+		closing rune
+		pos     int
+	}
+	stack := []stackEntry{}
+	pairs := [][2]int{}
+loop:
+	for k, i := range indices {
+		if types[k] != ON {
+			continue
+		}
+		pair, typ := BracketOf(res.runes[i])
+		switch typ {
+		case OpenBracket:
+			if len(stack) == maxBracketDepth {
+				break loop
+			}
+			stack = append(stack, stackEntry{canonicalBracket(pair), k})
+		case CloseBracket:
+			closing := canonicalBracket(res.runes[i])
+			for j := len(stack) - 1; 0 <= j; j-- {
+				if stack[j].closing == closing {
+					pairs = append(pairs, [2]int{stack[j].pos, k})
+					stack = stack[:j]
+					break
+				}
+			}
+		}
+	}
+	slices.SortFunc(pairs, func(a, b [2]int) int { return a[0] - b[0] })
+	return pairs
+}
+
+// canonicalBracket maps brackets having canonical decompositions to
+// decomposed ones, so that they can be matched with each other.
+func canonicalBracket(r rune) rune {
+	switch r {
+	case '\u2329':
+		return '\u3008'
+	case '\u232a':
+		return '\u3009'
+	}
+	return r
+}
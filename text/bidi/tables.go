@@ -0,0 +1,1281 @@
+// Auto-generated by text/bidi/gen(using the data files from https://www.unicode.org/Public/14.0.0/ucd/)
+// DO NOT EDIT.
+
+package bidi
+
+var classRanges = []classRange{
+	{0x0, 0x8, BN},
+	{0x9, 0x9, S},
+	{0xa, 0xa, B},
+	{0xb, 0xb, S},
+	{0xc, 0xc, WS},
+	{0xd, 0xd, B},
+	{0xe, 0x1b, BN},
+	{0x1c, 0x1e, B},
+	{0x1f, 0x1f, S},
+	{0x20, 0x20, WS},
+	{0x21, 0x22, ON},
+	{0x23, 0x25, ET},
+	{0x26, 0x2a, ON},
+	{0x2b, 0x2b, ES},
+	{0x2c, 0x2c, CS},
+	{0x2d, 0x2d, ES},
+	{0x2e, 0x2f, CS},
+	{0x30, 0x39, EN},
+	{0x3a, 0x3a, CS},
+	{0x3b, 0x40, ON},
+	{0x5b, 0x60, ON},
+	{0x7b, 0x7e, ON},
+	{0x7f, 0x84, BN},
+	{0x85, 0x85, B},
+	{0x86, 0x9f, BN},
+	{0xa0, 0xa0, CS},
+	{0xa1, 0xa1, ON},
+	{0xa2, 0xa5, ET},
+	{0xa6, 0xa9, ON},
+	{0xab, 0xac, ON},
+	{0xad, 0xad, BN},
+	{0xae, 0xaf, ON},
+	{0xb0, 0xb1, ET},
+	{0xb2, 0xb3, EN},
+	{0xb4, 0xb4, ON},
+	{0xb6, 0xb8, ON},
+	{0xb9, 0xb9, EN},
+	{0xbb, 0xbf, ON},
+	{0xd7, 0xd7, ON},
+	{0xf7, 0xf7, ON},
+	{0x2b9, 0x2ba, ON},
+	{0x2c2, 0x2cf, ON},
+	{0x2d2, 0x2df, ON},
+	{0x2e5, 0x2ed, ON},
+	{0x2ef, 0x2ff, ON},
+	{0x300, 0x36f, NSM},
+	{0x374, 0x375, ON},
+	{0x37e, 0x37e, ON},
+	{0x384, 0x385, ON},
+	{0x387, 0x387, ON},
+	{0x3f6, 0x3f6, ON},
+	{0x483, 0x489, NSM},
+	{0x58a, 0x58a, ON},
+	{0x58d, 0x58e, ON},
+	{0x58f, 0x58f, ET},
+	{0x590, 0x590, R},
+	{0x591, 0x5bd, NSM},
+	{0x5be, 0x5be, R},
+	{0x5bf, 0x5bf, NSM},
+	{0x5c0, 0x5c0, R},
+	{0x5c1, 0x5c2, NSM},
+	{0x5c3, 0x5c3, R},
+	{0x5c4, 0x5c5, NSM},
+	{0x5c6, 0x5c6, R},
+	{0x5c7, 0x5c7, NSM},
+	{0x5c8, 0x5ff, R},
+	{0x600, 0x605, AN},
+	{0x606, 0x607, ON},
+	{0x608, 0x608, AL},
+	{0x609, 0x60a, ET},
+	{0x60b, 0x60b, AL},
+	{0x60c, 0x60c, CS},
+	{0x60d, 0x60d, AL},
+	{0x60e, 0x60f, ON},
+	{0x610, 0x61a, NSM},
+	{0x61b, 0x64a, AL},
+	{0x64b, 0x65f, NSM},
+	{0x660, 0x669, AN},
+	{0x66a, 0x66a, ET},
+	{0x66b, 0x66c, AN},
+	{0x66d, 0x66f, AL},
+	{0x670, 0x670, NSM},
+	{0x671, 0x6d5, AL},
+	{0x6d6, 0x6dc, NSM},
+	{0x6dd, 0x6dd, AN},
+	{0x6de, 0x6de, ON},
+	{0x6df, 0x6e4, NSM},
+	{0x6e5, 0x6e6, AL},
+	{0x6e7, 0x6e8, NSM},
+	{0x6e9, 0x6e9, ON},
+	{0x6ea, 0x6ed, NSM},
+	{0x6ee, 0x6ef, AL},
+	{0x6f0, 0x6f9, EN},
+	{0x6fa, 0x710, AL},
+	{0x711, 0x711, NSM},
+	{0x712, 0x72f, AL},
+	{0x730, 0x74a, NSM},
+	{0x74b, 0x7a5, AL},
+	{0x7a6, 0x7b0, NSM},
+	{0x7b1, 0x7bf, AL},
+	{0x7c0, 0x7ea, R},
+	{0x7eb, 0x7f3, NSM},
+	{0x7f4, 0x7f5, R},
+	{0x7f6, 0x7f9, ON},
+	{0x7fa, 0x7fc, R},
+	{0x7fd, 0x7fd, NSM},
+	{0x7fe, 0x815, R},
+	{0x816, 0x819, NSM},
+	{0x81a, 0x81a, R},
+	{0x81b, 0x823, NSM},
+	{0x824, 0x824, R},
+	{0x825, 0x827, NSM},
+	{0x828, 0x828, R},
+	{0x829, 0x82d, NSM},
+	{0x82e, 0x858, R},
+	{0x859, 0x85b, NSM},
+	{0x85c, 0x85f, R},
+	{0x860, 0x88f, AL},
+	{0x890, 0x891, AN},
+	{0x892, 0x897, AL},
+	{0x898, 0x89f, NSM},
+	{0x8a0, 0x8c9, AL},
+	{0x8ca, 0x8e1, NSM},
+	{0x8e2, 0x8e2, AN},
+	{0x8e3, 0x902, NSM},
+	{0x93a, 0x93a, NSM},
+	{0x93c, 0x93c, NSM},
+	{0x941, 0x948, NSM},
+	{0x94d, 0x94d, NSM},
+	{0x951, 0x957, NSM},
+	{0x962, 0x963, NSM},
+	{0x981, 0x981, NSM},
+	{0x9bc, 0x9bc, NSM},
+	{0x9c1, 0x9c4, NSM},
+	{0x9cd, 0x9cd, NSM},
+	{0x9e2, 0x9e3, NSM},
+	{0x9f2, 0x9f3, ET},
+	{0x9fb, 0x9fb, ET},
+	{0x9fe, 0x9fe, NSM},
+	{0xa01, 0xa02, NSM},
+	{0xa3c, 0xa3c, NSM},
+	{0xa41, 0xa42, NSM},
+	{0xa47, 0xa48, NSM},
+	{0xa4b, 0xa4d, NSM},
+	{0xa51, 0xa51, NSM},
+	{0xa70, 0xa71, NSM},
+	{0xa75, 0xa75, NSM},
+	{0xa81, 0xa82, NSM},
+	{0xabc, 0xabc, NSM},
+	{0xac1, 0xac5, NSM},
+	{0xac7, 0xac8, NSM},
+	{0xacd, 0xacd, NSM},
+	{0xae2, 0xae3, NSM},
+	{0xaf1, 0xaf1, ET},
+	{0xafa, 0xaff, NSM},
+	{0xb01, 0xb01, NSM},
+	{0xb3c, 0xb3c, NSM},
+	{0xb3f, 0xb3f, NSM},
+	{0xb41, 0xb44, NSM},
+	{0xb4d, 0xb4d, NSM},
+	{0xb55, 0xb56, NSM},
+	{0xb62, 0xb63, NSM},
+	{0xb82, 0xb82, NSM},
+	{0xbc0, 0xbc0, NSM},
+	{0xbcd, 0xbcd, NSM},
+	{0xbf3, 0xbf8, ON},
+	{0xbf9, 0xbf9, ET},
+	{0xbfa, 0xbfa, ON},
+	{0xc00, 0xc00, NSM},
+	{0xc04, 0xc04, NSM},
+	{0xc3c, 0xc3c, NSM},
+	{0xc3e, 0xc40, NSM},
+	{0xc46, 0xc48, NSM},
+	{0xc4a, 0xc4d, NSM},
+	{0xc55, 0xc56, NSM},
+	{0xc62, 0xc63, NSM},
+	{0xc78, 0xc7e, ON},
+	{0xc81, 0xc81, NSM},
+	{0xcbc, 0xcbc, NSM},
+	{0xccc, 0xccd, NSM},
+	{0xce2, 0xce3, NSM},
+	{0xd00, 0xd01, NSM},
+	{0xd3b, 0xd3c, NSM},
+	{0xd41, 0xd44, NSM},
+	{0xd4d, 0xd4d, NSM},
+	{0xd62, 0xd63, NSM},
+	{0xd81, 0xd81, NSM},
+	{0xdca, 0xdca, NSM},
+	{0xdd2, 0xdd4, NSM},
+	{0xdd6, 0xdd6, NSM},
+	{0xe31, 0xe31, NSM},
+	{0xe34, 0xe3a, NSM},
+	{0xe3f, 0xe3f, ET},
+	{0xe47, 0xe4e, NSM},
+	{0xeb1, 0xeb1, NSM},
+	{0xeb4, 0xebc, NSM},
+	{0xec8, 0xecd, NSM},
+	{0xf18, 0xf19, NSM},
+	{0xf35, 0xf35, NSM},
+	{0xf37, 0xf37, NSM},
+	{0xf39, 0xf39, NSM},
+	{0xf3a, 0xf3d, ON},
+	{0xf71, 0xf7e, NSM},
+	{0xf80, 0xf84, NSM},
+	{0xf86, 0xf87, NSM},
+	{0xf8d, 0xf97, NSM},
+	{0xf99, 0xfbc, NSM},
+	{0xfc6, 0xfc6, NSM},
+	{0x102d, 0x1030, NSM},
+	{0x1032, 0x1037, NSM},
+	{0x1039, 0x103a, NSM},
+	{0x103d, 0x103e, NSM},
+	{0x1058, 0x1059, NSM},
+	{0x105e, 0x1060, NSM},
+	{0x1071, 0x1074, NSM},
+	{0x1082, 0x1082, NSM},
+	{0x1085, 0x1086, NSM},
+	{0x108d, 0x108d, NSM},
+	{0x109d, 0x109d, NSM},
+	{0x135d, 0x135f, NSM},
+	{0x1390, 0x1399, ON},
+	{0x1400, 0x1400, ON},
+	{0x1680, 0x1680, WS},
+	{0x169b, 0x169c, ON},
+	{0x1712, 0x1714, NSM},
+	{0x1732, 0x1733, NSM},
+	{0x1752, 0x1753, NSM},
+	{0x1772, 0x1773, NSM},
+	{0x17b4, 0x17b5, NSM},
+	{0x17b7, 0x17bd, NSM},
+	{0x17c6, 0x17c6, NSM},
+	{0x17c9, 0x17d3, NSM},
+	{0x17db, 0x17db, ET},
+	{0x17dd, 0x17dd, NSM},
+	{0x17f0, 0x17f9, ON},
+	{0x1800, 0x180a, ON},
+	{0x180b, 0x180d, NSM},
+	{0x180e, 0x180e, BN},
+	{0x180f, 0x180f, NSM},
+	{0x1885, 0x1886, NSM},
+	{0x18a9, 0x18a9, NSM},
+	{0x1920, 0x1922, NSM},
+	{0x1927, 0x1928, NSM},
+	{0x1932, 0x1932, NSM},
+	{0x1939, 0x193b, NSM},
+	{0x1940, 0x1940, ON},
+	{0x1944, 0x1945, ON},
+	{0x19de, 0x19ff, ON},
+	{0x1a17, 0x1a18, NSM},
+	{0x1a1b, 0x1a1b, NSM},
+	{0x1a56, 0x1a56, NSM},
+	{0x1a58, 0x1a5e, NSM},
+	{0x1a60, 0x1a60, NSM},
+	{0x1a62, 0x1a62, NSM},
+	{0x1a65, 0x1a6c, NSM},
+	{0x1a73, 0x1a7c, NSM},
+	{0x1a7f, 0x1a7f, NSM},
+	{0x1ab0, 0x1ace, NSM},
+	{0x1b00, 0x1b03, NSM},
+	{0x1b34, 0x1b34, NSM},
+	{0x1b36, 0x1b3a, NSM},
+	{0x1b3c, 0x1b3c, NSM},
+	{0x1b42, 0x1b42, NSM},
+	{0x1b6b, 0x1b73, NSM},
+	{0x1b80, 0x1b81, NSM},
+	{0x1ba2, 0x1ba5, NSM},
+	{0x1ba8, 0x1ba9, NSM},
+	{0x1bab, 0x1bad, NSM},
+	{0x1be6, 0x1be6, NSM},
+	{0x1be8, 0x1be9, NSM},
+	{0x1bed, 0x1bed, NSM},
+	{0x1bef, 0x1bf1, NSM},
+	{0x1c2c, 0x1c33, NSM},
+	{0x1c36, 0x1c37, NSM},
+	{0x1cd0, 0x1cd2, NSM},
+	{0x1cd4, 0x1ce0, NSM},
+	{0x1ce2, 0x1ce8, NSM},
+	{0x1ced, 0x1ced, NSM},
+	{0x1cf4, 0x1cf4, NSM},
+	{0x1cf8, 0x1cf9, NSM},
+	{0x1dc0, 0x1dff, NSM},
+	{0x1fbd, 0x1fbd, ON},
+	{0x1fbf, 0x1fc1, ON},
+	{0x1fcd, 0x1fcf, ON},
+	{0x1fdd, 0x1fdf, ON},
+	{0x1fed, 0x1fef, ON},
+	{0x1ffd, 0x1ffe, ON},
+	{0x2000, 0x200a, WS},
+	{0x200b, 0x200d, BN},
+	{0x200f, 0x200f, R},
+	{0x2010, 0x2027, ON},
+	{0x2028, 0x2028, WS},
+	{0x2029, 0x2029, B},
+	{0x202a, 0x202a, LRE},
+	{0x202b, 0x202b, RLE},
+	{0x202c, 0x202c, PDF},
+	{0x202d, 0x202d, LRO},
+	{0x202e, 0x202e, RLO},
+	{0x202f, 0x202f, CS},
+	{0x2030, 0x2034, ET},
+	{0x2035, 0x2043, ON},
+	{0x2044, 0x2044, CS},
+	{0x2045, 0x205e, ON},
+	{0x205f, 0x205f, WS},
+	{0x2060, 0x2065, BN},
+	{0x2066, 0x2066, LRI},
+	{0x2067, 0x2067, RLI},
+	{0x2068, 0x2068, FSI},
+	{0x2069, 0x2069, PDI},
+	{0x206a, 0x206f, BN},
+	{0x2070, 0x2070, EN},
+	{0x2074, 0x2079, EN},
+	{0x207a, 0x207b, ES},
+	{0x207c, 0x207e, ON},
+	{0x2080, 0x2089, EN},
+	{0x208a, 0x208b, ES},
+	{0x208c, 0x208e, ON},
+	{0x20a0, 0x20cf, ET},
+	{0x20d0, 0x20f0, NSM},
+	{0x2100, 0x2101, ON},
+	{0x2103, 0x2106, ON},
+	{0x2108, 0x2109, ON},
+	{0x2114, 0x2114, ON},
+	{0x2116, 0x2118, ON},
+	{0x211e, 0x2123, ON},
+	{0x2125, 0x2125, ON},
+	{0x2127, 0x2127, ON},
+	{0x2129, 0x2129, ON},
+	{0x212e, 0x212e, ET},
+	{0x213a, 0x213b, ON},
+	{0x2140, 0x2144, ON},
+	{0x214a, 0x214d, ON},
+	{0x2150, 0x215f, ON},
+	{0x2189, 0x218b, ON},
+	{0x2190, 0x2211, ON},
+	{0x2212, 0x2212, ES},
+	{0x2213, 0x2213, ET},
+	{0x2214, 0x2335, ON},
+	{0x237b, 0x2394, ON},
+	{0x2396, 0x2426, ON},
+	{0x2440, 0x244a, ON},
+	{0x2460, 0x2487, ON},
+	{0x2488, 0x249b, EN},
+	{0x24ea, 0x26ab, ON},
+	{0x26ad, 0x27ff, ON},
+	{0x2900, 0x2b73, ON},
+	{0x2b76, 0x2b95, ON},
+	{0x2b97, 0x2bff, ON},
+	{0x2ce5, 0x2cea, ON},
+	{0x2cef, 0x2cf1, NSM},
+	{0x2cf9, 0x2cff, ON},
+	{0x2d7f, 0x2d7f, NSM},
+	{0x2de0, 0x2dff, NSM},
+	{0x2e00, 0x2e5d, ON},
+	{0x2e80, 0x2e99, ON},
+	{0x2e9b, 0x2ef3, ON},
+	{0x2f00, 0x2fd5, ON},
+	{0x2ff0, 0x2ffb, ON},
+	{0x3000, 0x3000, WS},
+	{0x3001, 0x3004, ON},
+	{0x3008, 0x3020, ON},
+	{0x302a, 0x302d, NSM},
+	{0x3030, 0x3030, ON},
+	{0x3036, 0x3037, ON},
+	{0x303d, 0x303f, ON},
+	{0x3099, 0x309a, NSM},
+	{0x309b, 0x309c, ON},
+	{0x30a0, 0x30a0, ON},
+	{0x30fb, 0x30fb, ON},
+	{0x31c0, 0x31e3, ON},
+	{0x321d, 0x321e, ON},
+	{0x3250, 0x325f, ON},
+	{0x327c, 0x327e, ON},
+	{0x32b1, 0x32bf, ON},
+	{0x32cc, 0x32cf, ON},
+	{0x3377, 0x337a, ON},
+	{0x33de, 0x33df, ON},
+	{0x33ff, 0x33ff, ON},
+	{0x4dc0, 0x4dff, ON},
+	{0xa490, 0xa4c6, ON},
+	{0xa60d, 0xa60f, ON},
+	{0xa66f, 0xa672, NSM},
+	{0xa673, 0xa673, ON},
+	{0xa674, 0xa67d, NSM},
+	{0xa67e, 0xa67f, ON},
+	{0xa69e, 0xa69f, NSM},
+	{0xa6f0, 0xa6f1, NSM},
+	{0xa700, 0xa721, ON},
+	{0xa788, 0xa788, ON},
+	{0xa802, 0xa802, NSM},
+	{0xa806, 0xa806, NSM},
+	{0xa80b, 0xa80b, NSM},
+	{0xa825, 0xa826, NSM},
+	{0xa828, 0xa82b, ON},
+	{0xa82c, 0xa82c, NSM},
+	{0xa838, 0xa839, ET},
+	{0xa874, 0xa877, ON},
+	{0xa8c4, 0xa8c5, NSM},
+	{0xa8e0, 0xa8f1, NSM},
+	{0xa8ff, 0xa8ff, NSM},
+	{0xa926, 0xa92d, NSM},
+	{0xa947, 0xa951, NSM},
+	{0xa980, 0xa982, NSM},
+	{0xa9b3, 0xa9b3, NSM},
+	{0xa9b6, 0xa9b9, NSM},
+	{0xa9bc, 0xa9bd, NSM},
+	{0xa9e5, 0xa9e5, NSM},
+	{0xaa29, 0xaa2e, NSM},
+	{0xaa31, 0xaa32, NSM},
+	{0xaa35, 0xaa36, NSM},
+	{0xaa43, 0xaa43, NSM},
+	{0xaa4c, 0xaa4c, NSM},
+	{0xaa7c, 0xaa7c, NSM},
+	{0xaab0, 0xaab0, NSM},
+	{0xaab2, 0xaab4, NSM},
+	{0xaab7, 0xaab8, NSM},
+	{0xaabe, 0xaabf, NSM},
+	{0xaac1, 0xaac1, NSM},
+	{0xaaec, 0xaaed, NSM},
+	{0xaaf6, 0xaaf6, NSM},
+	{0xab6a, 0xab6b, ON},
+	{0xabe5, 0xabe5, NSM},
+	{0xabe8, 0xabe8, NSM},
+	{0xabed, 0xabed, NSM},
+	{0xfb1d, 0xfb1d, R},
+	{0xfb1e, 0xfb1e, NSM},
+	{0xfb1f, 0xfb28, R},
+	{0xfb29, 0xfb29, ES},
+	{0xfb2a, 0xfb4f, R},
+	{0xfb50, 0xfd3d, AL},
+	{0xfd3e, 0xfd4f, ON},
+	{0xfd50, 0xfdce, AL},
+	{0xfdcf, 0xfdcf, ON},
+	{0xfdd0, 0xfdef, BN},
+	{0xfdf0, 0xfdfc, AL},
+	{0xfdfd, 0xfdff, ON},
+	{0xfe00, 0xfe0f, NSM},
+	{0xfe10, 0xfe19, ON},
+	{0xfe20, 0xfe2f, NSM},
+	{0xfe30, 0xfe4f, ON},
+	{0xfe50, 0xfe50, CS},
+	{0xfe51, 0xfe51, ON},
+	{0xfe52, 0xfe52, CS},
+	{0xfe54, 0xfe54, ON},
+	{0xfe55, 0xfe55, CS},
+	{0xfe56, 0xfe5e, ON},
+	{0xfe5f, 0xfe5f, ET},
+	{0xfe60, 0xfe61, ON},
+	{0xfe62, 0xfe63, ES},
+	{0xfe64, 0xfe66, ON},
+	{0xfe68, 0xfe68, ON},
+	{0xfe69, 0xfe6a, ET},
+	{0xfe6b, 0xfe6b, ON},
+	{0xfe70, 0xfefe, AL},
+	{0xfeff, 0xfeff, BN},
+	{0xff01, 0xff02, ON},
+	{0xff03, 0xff05, ET},
+	{0xff06, 0xff0a, ON},
+	{0xff0b, 0xff0b, ES},
+	{0xff0c, 0xff0c, CS},
+	{0xff0d, 0xff0d, ES},
+	{0xff0e, 0xff0f, CS},
+	{0xff10, 0xff19, EN},
+	{0xff1a, 0xff1a, CS},
+	{0xff1b, 0xff20, ON},
+	{0xff3b, 0xff40, ON},
+	{0xff5b, 0xff65, ON},
+	{0xffe0, 0xffe1, ET},
+	{0xffe2, 0xffe4, ON},
+	{0xffe5, 0xffe6, ET},
+	{0xffe8, 0xffee, ON},
+	{0xfff0, 0xfff8, BN},
+	{0xfff9, 0xfffd, ON},
+	{0xfffe, 0xffff, BN},
+	{0x10101, 0x10101, ON},
+	{0x10140, 0x1018c, ON},
+	{0x10190, 0x1019c, ON},
+	{0x101a0, 0x101a0, ON},
+	{0x101fd, 0x101fd, NSM},
+	{0x102e0, 0x102e0, NSM},
+	{0x102e1, 0x102fb, EN},
+	{0x10376, 0x1037a, NSM},
+	{0x10800, 0x1091e, R},
+	{0x1091f, 0x1091f, ON},
+	{0x10920, 0x10a00, R},
+	{0x10a01, 0x10a03, NSM},
+	{0x10a04, 0x10a04, R},
+	{0x10a05, 0x10a06, NSM},
+	{0x10a07, 0x10a0b, R},
+	{0x10a0c, 0x10a0f, NSM},
+	{0x10a10, 0x10a37, R},
+	{0x10a38, 0x10a3a, NSM},
+	{0x10a3b, 0x10a3e, R},
+	{0x10a3f, 0x10a3f, NSM},
+	{0x10a40, 0x10ae4, R},
+	{0x10ae5, 0x10ae6, NSM},
+	{0x10ae7, 0x10b38, R},
+	{0x10b39, 0x10b3f, ON},
+	{0x10b40, 0x10cff, R},
+	{0x10d00, 0x10d23, AL},
+	{0x10d24, 0x10d27, NSM},
+	{0x10d28, 0x10d2f, AL},
+	{0x10d30, 0x10d39, AN},
+	{0x10d3a, 0x10d3f, AL},
+	{0x10d40, 0x10e5f, R},
+	{0x10e60, 0x10e7e, AN},
+	{0x10e7f, 0x10eaa, R},
+	{0x10eab, 0x10eac, NSM},
+	{0x10ead, 0x10f2f, R},
+	{0x10f30, 0x10f45, AL},
+	{0x10f46, 0x10f50, NSM},
+	{0x10f51, 0x10f6f, AL},
+	{0x10f70, 0x10f81, R},
+	{0x10f82, 0x10f85, NSM},
+	{0x10f86, 0x10fff, R},
+	{0x11001, 0x11001, NSM},
+	{0x11038, 0x11046, NSM},
+	{0x11052, 0x11065, ON},
+	{0x11070, 0x11070, NSM},
+	{0x11073, 0x11074, NSM},
+	{0x1107f, 0x11081, NSM},
+	{0x110b3, 0x110b6, NSM},
+	{0x110b9, 0x110ba, NSM},
+	{0x110c2, 0x110c2, NSM},
+	{0x11100, 0x11102, NSM},
+	{0x11127, 0x1112b, NSM},
+	{0x1112d, 0x11134, NSM},
+	{0x11173, 0x11173, NSM},
+	{0x11180, 0x11181, NSM},
+	{0x111b6, 0x111be, NSM},
+	{0x111c9, 0x111cc, NSM},
+	{0x111cf, 0x111cf, NSM},
+	{0x1122f, 0x11231, NSM},
+	{0x11234, 0x11234, NSM},
+	{0x11236, 0x11237, NSM},
+	{0x1123e, 0x1123e, NSM},
+	{0x112df, 0x112df, NSM},
+	{0x112e3, 0x112ea, NSM},
+	{0x11300, 0x11301, NSM},
+	{0x1133b, 0x1133c, NSM},
+	{0x11340, 0x11340, NSM},
+	{0x11366, 0x1136c, NSM},
+	{0x11370, 0x11374, NSM},
+	{0x11438, 0x1143f, NSM},
+	{0x11442, 0x11444, NSM},
+	{0x11446, 0x11446, NSM},
+	{0x1145e, 0x1145e, NSM},
+	{0x114b3, 0x114b8, NSM},
+	{0x114ba, 0x114ba, NSM},
+	{0x114bf, 0x114c0, NSM},
+	{0x114c2, 0x114c3, NSM},
+	{0x115b2, 0x115b5, NSM},
+	{0x115bc, 0x115bd, NSM},
+	{0x115bf, 0x115c0, NSM},
+	{0x115dc, 0x115dd, NSM},
+	{0x11633, 0x1163a, NSM},
+	{0x1163d, 0x1163d, NSM},
+	{0x1163f, 0x11640, NSM},
+	{0x11660, 0x1166c, ON},
+	{0x116ab, 0x116ab, NSM},
+	{0x116ad, 0x116ad, NSM},
+	{0x116b0, 0x116b5, NSM},
+	{0x116b7, 0x116b7, NSM},
+	{0x1171d, 0x1171f, NSM},
+	{0x11722, 0x11725, NSM},
+	{0x11727, 0x1172b, NSM},
+	{0x1182f, 0x11837, NSM},
+	{0x11839, 0x1183a, NSM},
+	{0x1193b, 0x1193c, NSM},
+	{0x1193e, 0x1193e, NSM},
+	{0x11943, 0x11943, NSM},
+	{0x119d4, 0x119d7, NSM},
+	{0x119da, 0x119db, NSM},
+	{0x119e0, 0x119e0, NSM},
+	{0x11a01, 0x11a06, NSM},
+	{0x11a09, 0x11a0a, NSM},
+	{0x11a33, 0x11a38, NSM},
+	{0x11a3b, 0x11a3e, NSM},
+	{0x11a47, 0x11a47, NSM},
+	{0x11a51, 0x11a56, NSM},
+	{0x11a59, 0x11a5b, NSM},
+	{0x11a8a, 0x11a96, NSM},
+	{0x11a98, 0x11a99, NSM},
+	{0x11c30, 0x11c36, NSM},
+	{0x11c38, 0x11c3d, NSM},
+	{0x11c92, 0x11ca7, NSM},
+	{0x11caa, 0x11cb0, NSM},
+	{0x11cb2, 0x11cb3, NSM},
+	{0x11cb5, 0x11cb6, NSM},
+	{0x11d31, 0x11d36, NSM},
+	{0x11d3a, 0x11d3a, NSM},
+	{0x11d3c, 0x11d3d, NSM},
+	{0x11d3f, 0x11d45, NSM},
+	{0x11d47, 0x11d47, NSM},
+	{0x11d90, 0x11d91, NSM},
+	{0x11d95, 0x11d95, NSM},
+	{0x11d97, 0x11d97, NSM},
+	{0x11ef3, 0x11ef4, NSM},
+	{0x11fd5, 0x11fdc, ON},
+	{0x11fdd, 0x11fe0, ET},
+	{0x11fe1, 0x11ff1, ON},
+	{0x16af0, 0x16af4, NSM},
+	{0x16b30, 0x16b36, NSM},
+	{0x16f4f, 0x16f4f, NSM},
+	{0x16f8f, 0x16f92, NSM},
+	{0x16fe2, 0x16fe2, ON},
+	{0x16fe4, 0x16fe4, NSM},
+	{0x1bc9d, 0x1bc9e, NSM},
+	{0x1bca0, 0x1bca3, BN},
+	{0x1cf00, 0x1cf2d, NSM},
+	{0x1cf30, 0x1cf46, NSM},
+	{0x1d167, 0x1d169, NSM},
+	{0x1d173, 0x1d17a, BN},
+	{0x1d17b, 0x1d182, NSM},
+	{0x1d185, 0x1d18b, NSM},
+	{0x1d1aa, 0x1d1ad, NSM},
+	{0x1d1e9, 0x1d1ea, ON},
+	{0x1d200, 0x1d241, ON},
+	{0x1d242, 0x1d244, NSM},
+	{0x1d245, 0x1d245, ON},
+	{0x1d300, 0x1d356, ON},
+	{0x1d6db, 0x1d6db, ON},
+	{0x1d715, 0x1d715, ON},
+	{0x1d74f, 0x1d74f, ON},
+	{0x1d789, 0x1d789, ON},
+	{0x1d7c3, 0x1d7c3, ON},
+	{0x1d7ce, 0x1d7ff, EN},
+	{0x1da00, 0x1da36, NSM},
+	{0x1da3b, 0x1da6c, NSM},
+	{0x1da75, 0x1da75, NSM},
+	{0x1da84, 0x1da84, NSM},
+	{0x1da9b, 0x1da9f, NSM},
+	{0x1daa1, 0x1daaf, NSM},
+	{0x1e000, 0x1e006, NSM},
+	{0x1e008, 0x1e018, NSM},
+	{0x1e01b, 0x1e021, NSM},
+	{0x1e023, 0x1e024, NSM},
+	{0x1e026, 0x1e02a, NSM},
+	{0x1e130, 0x1e136, NSM},
+	{0x1e2ae, 0x1e2ae, NSM},
+	{0x1e2ec, 0x1e2ef, NSM},
+	{0x1e2ff, 0x1e2ff, ET},
+	{0x1e800, 0x1e8cf, R},
+	{0x1e8d0, 0x1e8d6, NSM},
+	{0x1e8d7, 0x1e943, R},
+	{0x1e944, 0x1e94a, NSM},
+	{0x1e94b, 0x1ec6f, R},
+	{0x1ec70, 0x1ecbf, AL},
+	{0x1ecc0, 0x1ecff, R},
+	{0x1ed00, 0x1ed4f, AL},
+	{0x1ed50, 0x1edff, R},
+	{0x1ee00, 0x1eeef, AL},
+	{0x1eef0, 0x1eef1, ON},
+	{0x1eef2, 0x1eeff, AL},
+	{0x1ef00, 0x1efff, R},
+	{0x1f000, 0x1f02b, ON},
+	{0x1f030, 0x1f093, ON},
+	{0x1f0a0, 0x1f0ae, ON},
+	{0x1f0b1, 0x1f0bf, ON},
+	{0x1f0c1, 0x1f0cf, ON},
+	{0x1f0d1, 0x1f0f5, ON},
+	{0x1f100, 0x1f10a, EN},
+	{0x1f10b, 0x1f10f, ON},
+	{0x1f12f, 0x1f12f, ON},
+	{0x1f16a, 0x1f16f, ON},
+	{0x1f1ad, 0x1f1ad, ON},
+	{0x1f260, 0x1f265, ON},
+	{0x1f300, 0x1f6d7, ON},
+	{0x1f6dd, 0x1f6ec, ON},
+	{0x1f6f0, 0x1f6fc, ON},
+	{0x1f700, 0x1f773, ON},
+	{0x1f780, 0x1f7d8, ON},
+	{0x1f7e0, 0x1f7eb, ON},
+	{0x1f7f0, 0x1f7f0, ON},
+	{0x1f800, 0x1f80b, ON},
+	{0x1f810, 0x1f847, ON},
+	{0x1f850, 0x1f859, ON},
+	{0x1f860, 0x1f887, ON},
+	{0x1f890, 0x1f8ad, ON},
+	{0x1f8b0, 0x1f8b1, ON},
+	{0x1f900, 0x1fa53, ON},
+	{0x1fa60, 0x1fa6d, ON},
+	{0x1fa70, 0x1fa74, ON},
+	{0x1fa78, 0x1fa7c, ON},
+	{0x1fa80, 0x1fa86, ON},
+	{0x1fa90, 0x1faac, ON},
+	{0x1fab0, 0x1faba, ON},
+	{0x1fac0, 0x1fac5, ON},
+	{0x1fad0, 0x1fad9, ON},
+	{0x1fae0, 0x1fae7, ON},
+	{0x1faf0, 0x1faf6, ON},
+	{0x1fb00, 0x1fb92, ON},
+	{0x1fb94, 0x1fbca, ON},
+	{0x1fbf0, 0x1fbf9, EN},
+	{0x1fffe, 0x1ffff, BN},
+	{0x2fffe, 0x2ffff, BN},
+	{0x3fffe, 0x3ffff, BN},
+	{0x4fffe, 0x4ffff, BN},
+	{0x5fffe, 0x5ffff, BN},
+	{0x6fffe, 0x6ffff, BN},
+	{0x7fffe, 0x7ffff, BN},
+	{0x8fffe, 0x8ffff, BN},
+	{0x9fffe, 0x9ffff, BN},
+	{0xafffe, 0xaffff, BN},
+	{0xbfffe, 0xbffff, BN},
+	{0xcfffe, 0xcffff, BN},
+	{0xdfffe, 0xe00ff, BN},
+	{0xe0100, 0xe01ef, NSM},
+	{0xe01f0, 0xe0fff, BN},
+	{0xefffe, 0xeffff, BN},
+	{0xffffe, 0xfffff, BN},
+	{0x10fffe, 0x10ffff, BN},
+}
+
+var pairedBrackets = map[rune]pairedBracket{
+	0x28:   {0x29, OpenBracket},
+	0x29:   {0x28, CloseBracket},
+	0x5b:   {0x5d, OpenBracket},
+	0x5d:   {0x5b, CloseBracket},
+	0x7b:   {0x7d, OpenBracket},
+	0x7d:   {0x7b, CloseBracket},
+	0xf3a:  {0xf3b, OpenBracket},
+	0xf3b:  {0xf3a, CloseBracket},
+	0xf3c:  {0xf3d, OpenBracket},
+	0xf3d:  {0xf3c, CloseBracket},
+	0x169b: {0x169c, OpenBracket},
+	0x169c: {0x169b, CloseBracket},
+	0x2045: {0x2046, OpenBracket},
+	0x2046: {0x2045, CloseBracket},
+	0x207d: {0x207e, OpenBracket},
+	0x207e: {0x207d, CloseBracket},
+	0x208d: {0x208e, OpenBracket},
+	0x208e: {0x208d, CloseBracket},
+	0x2308: {0x2309, OpenBracket},
+	0x2309: {0x2308, CloseBracket},
+	0x230a: {0x230b, OpenBracket},
+	0x230b: {0x230a, CloseBracket},
+	0x2329: {0x232a, OpenBracket},
+	0x232a: {0x2329, CloseBracket},
+	0x2768: {0x2769, OpenBracket},
+	0x2769: {0x2768, CloseBracket},
+	0x276a: {0x276b, OpenBracket},
+	0x276b: {0x276a, CloseBracket},
+	0x276c: {0x276d, OpenBracket},
+	0x276d: {0x276c, CloseBracket},
+	0x276e: {0x276f, OpenBracket},
+	0x276f: {0x276e, CloseBracket},
+	0x2770: {0x2771, OpenBracket},
+	0x2771: {0x2770, CloseBracket},
+	0x2772: {0x2773, OpenBracket},
+	0x2773: {0x2772, CloseBracket},
+	0x2774: {0x2775, OpenBracket},
+	0x2775: {0x2774, CloseBracket},
+	0x27c5: {0x27c6, OpenBracket},
+	0x27c6: {0x27c5, CloseBracket},
+	0x27e6: {0x27e7, OpenBracket},
+	0x27e7: {0x27e6, CloseBracket},
+	0x27e8: {0x27e9, OpenBracket},
+	0x27e9: {0x27e8, CloseBracket},
+	0x27ea: {0x27eb, OpenBracket},
+	0x27eb: {0x27ea, CloseBracket},
+	0x27ec: {0x27ed, OpenBracket},
+	0x27ed: {0x27ec, CloseBracket},
+	0x27ee: {0x27ef, OpenBracket},
+	0x27ef: {0x27ee, CloseBracket},
+	0x2983: {0x2984, OpenBracket},
+	0x2984: {0x2983, CloseBracket},
+	0x2985: {0x2986, OpenBracket},
+	0x2986: {0x2985, CloseBracket},
+	0x2987: {0x2988, OpenBracket},
+	0x2988: {0x2987, CloseBracket},
+	0x2989: {0x298a, OpenBracket},
+	0x298a: {0x2989, CloseBracket},
+	0x298b: {0x298c, OpenBracket},
+	0x298c: {0x298b, CloseBracket},
+	0x298d: {0x2990, OpenBracket},
+	0x298e: {0x298f, CloseBracket},
+	0x298f: {0x298e, OpenBracket},
+	0x2990: {0x298d, CloseBracket},
+	0x2991: {0x2992, OpenBracket},
+	0x2992: {0x2991, CloseBracket},
+	0x2993: {0x2994, OpenBracket},
+	0x2994: {0x2993, CloseBracket},
+	0x2995: {0x2996, OpenBracket},
+	0x2996: {0x2995, CloseBracket},
+	0x2997: {0x2998, OpenBracket},
+	0x2998: {0x2997, CloseBracket},
+	0x29d8: {0x29d9, OpenBracket},
+	0x29d9: {0x29d8, CloseBracket},
+	0x29da: {0x29db, OpenBracket},
+	0x29db: {0x29da, CloseBracket},
+	0x29fc: {0x29fd, OpenBracket},
+	0x29fd: {0x29fc, CloseBracket},
+	0x2e22: {0x2e23, OpenBracket},
+	0x2e23: {0x2e22, CloseBracket},
+	0x2e24: {0x2e25, OpenBracket},
+	0x2e25: {0x2e24, CloseBracket},
+	0x2e26: {0x2e27, OpenBracket},
+	0x2e27: {0x2e26, CloseBracket},
+	0x2e28: {0x2e29, OpenBracket},
+	0x2e29: {0x2e28, CloseBracket},
+	0x2e55: {0x2e56, OpenBracket},
+	0x2e56: {0x2e55, CloseBracket},
+	0x2e57: {0x2e58, OpenBracket},
+	0x2e58: {0x2e57, CloseBracket},
+	0x2e59: {0x2e5a, OpenBracket},
+	0x2e5a: {0x2e59, CloseBracket},
+	0x2e5b: {0x2e5c, OpenBracket},
+	0x2e5c: {0x2e5b, CloseBracket},
+	0x3008: {0x3009, OpenBracket},
+	0x3009: {0x3008, CloseBracket},
+	0x300a: {0x300b, OpenBracket},
+	0x300b: {0x300a, CloseBracket},
+	0x300c: {0x300d, OpenBracket},
+	0x300d: {0x300c, CloseBracket},
+	0x300e: {0x300f, OpenBracket},
+	0x300f: {0x300e, CloseBracket},
+	0x3010: {0x3011, OpenBracket},
+	0x3011: {0x3010, CloseBracket},
+	0x3014: {0x3015, OpenBracket},
+	0x3015: {0x3014, CloseBracket},
+	0x3016: {0x3017, OpenBracket},
+	0x3017: {0x3016, CloseBracket},
+	0x3018: {0x3019, OpenBracket},
+	0x3019: {0x3018, CloseBracket},
+	0x301a: {0x301b, OpenBracket},
+	0x301b: {0x301a, CloseBracket},
+	0xfe59: {0xfe5a, OpenBracket},
+	0xfe5a: {0xfe59, CloseBracket},
+	0xfe5b: {0xfe5c, OpenBracket},
+	0xfe5c: {0xfe5b, CloseBracket},
+	0xfe5d: {0xfe5e, OpenBracket},
+	0xfe5e: {0xfe5d, CloseBracket},
+	0xff08: {0xff09, OpenBracket},
+	0xff09: {0xff08, CloseBracket},
+	0xff3b: {0xff3d, OpenBracket},
+	0xff3d: {0xff3b, CloseBracket},
+	0xff5b: {0xff5d, OpenBracket},
+	0xff5d: {0xff5b, CloseBracket},
+	0xff5f: {0xff60, OpenBracket},
+	0xff60: {0xff5f, CloseBracket},
+	0xff62: {0xff63, OpenBracket},
+	0xff63: {0xff62, CloseBracket},
+}
+
+var mirroredGlyphs = map[rune]rune{
+	0x28:   0x29,
+	0x29:   0x28,
+	0x3c:   0x3e,
+	0x3e:   0x3c,
+	0x5b:   0x5d,
+	0x5d:   0x5b,
+	0x7b:   0x7d,
+	0x7d:   0x7b,
+	0xab:   0xbb,
+	0xbb:   0xab,
+	0xf3a:  0xf3b,
+	0xf3b:  0xf3a,
+	0xf3c:  0xf3d,
+	0xf3d:  0xf3c,
+	0x169b: 0x169c,
+	0x169c: 0x169b,
+	0x2039: 0x203a,
+	0x203a: 0x2039,
+	0x2045: 0x2046,
+	0x2046: 0x2045,
+	0x207d: 0x207e,
+	0x207e: 0x207d,
+	0x208d: 0x208e,
+	0x208e: 0x208d,
+	0x2208: 0x220b,
+	0x2209: 0x220c,
+	0x220a: 0x220d,
+	0x220b: 0x2208,
+	0x220c: 0x2209,
+	0x220d: 0x220a,
+	0x2215: 0x29f5,
+	0x221f: 0x2bfe,
+	0x2220: 0x29a3,
+	0x2221: 0x299b,
+	0x2222: 0x29a0,
+	0x2224: 0x2aee,
+	0x223c: 0x223d,
+	0x223d: 0x223c,
+	0x2243: 0x22cd,
+	0x2245: 0x224c,
+	0x224c: 0x2245,
+	0x2252: 0x2253,
+	0x2253: 0x2252,
+	0x2254: 0x2255,
+	0x2255: 0x2254,
+	0x2264: 0x2265,
+	0x2265: 0x2264,
+	0x2266: 0x2267,
+	0x2267: 0x2266,
+	0x2268: 0x2269,
+	0x2269: 0x2268,
+	0x226a: 0x226b,
+	0x226b: 0x226a,
+	0x226e: 0x226f,
+	0x226f: 0x226e,
+	0x2270: 0x2271,
+	0x2271: 0x2270,
+	0x2272: 0x2273,
+	0x2273: 0x2272,
+	0x2274: 0x2275,
+	0x2275: 0x2274,
+	0x2276: 0x2277,
+	0x2277: 0x2276,
+	0x2278: 0x2279,
+	0x2279: 0x2278,
+	0x227a: 0x227b,
+	0x227b: 0x227a,
+	0x227c: 0x227d,
+	0x227d: 0x227c,
+	0x227e: 0x227f,
+	0x227f: 0x227e,
+	0x2280: 0x2281,
+	0x2281: 0x2280,
+	0x2282: 0x2283,
+	0x2283: 0x2282,
+	0x2284: 0x2285,
+	0x2285: 0x2284,
+	0x2286: 0x2287,
+	0x2287: 0x2286,
+	0x2288: 0x2289,
+	0x2289: 0x2288,
+	0x228a: 0x228b,
+	0x228b: 0x228a,
+	0x228f: 0x2290,
+	0x2290: 0x228f,
+	0x2291: 0x2292,
+	0x2292: 0x2291,
+	0x2298: 0x29b8,
+	0x22a2: 0x22a3,
+	0x22a3: 0x22a2,
+	0x22a6: 0x2ade,
+	0x22a8: 0x2ae4,
+	0x22a9: 0x2ae3,
+	0x22ab: 0x2ae5,
+	0x22b0: 0x22b1,
+	0x22b1: 0x22b0,
+	0x22b2: 0x22b3,
+	0x22b3: 0x22b2,
+	0x22b4: 0x22b5,
+	0x22b5: 0x22b4,
+	0x22b6: 0x22b7,
+	0x22b7: 0x22b6,
+	0x22b8: 0x27dc,
+	0x22c9: 0x22ca,
+	0x22ca: 0x22c9,
+	0x22cb: 0x22cc,
+	0x22cc: 0x22cb,
+	0x22cd: 0x2243,
+	0x22d0: 0x22d1,
+	0x22d1: 0x22d0,
+	0x22d6: 0x22d7,
+	0x22d7: 0x22d6,
+	0x22d8: 0x22d9,
+	0x22d9: 0x22d8,
+	0x22da: 0x22db,
+	0x22db: 0x22da,
+	0x22dc: 0x22dd,
+	0x22dd: 0x22dc,
+	0x22de: 0x22df,
+	0x22df: 0x22de,
+	0x22e0: 0x22e1,
+	0x22e1: 0x22e0,
+	0x22e2: 0x22e3,
+	0x22e3: 0x22e2,
+	0x22e4: 0x22e5,
+	0x22e5: 0x22e4,
+	0x22e6: 0x22e7,
+	0x22e7: 0x22e6,
+	0x22e8: 0x22e9,
+	0x22e9: 0x22e8,
+	0x22ea: 0x22eb,
+	0x22eb: 0x22ea,
+	0x22ec: 0x22ed,
+	0x22ed: 0x22ec,
+	0x22f0: 0x22f1,
+	0x22f1: 0x22f0,
+	0x22f2: 0x22fa,
+	0x22f3: 0x22fb,
+	0x22f4: 0x22fc,
+	0x22f6: 0x22fd,
+	0x22f7: 0x22fe,
+	0x22fa: 0x22f2,
+	0x22fb: 0x22f3,
+	0x22fc: 0x22f4,
+	0x22fd: 0x22f6,
+	0x22fe: 0x22f7,
+	0x2308: 0x2309,
+	0x2309: 0x2308,
+	0x230a: 0x230b,
+	0x230b: 0x230a,
+	0x2329: 0x232a,
+	0x232a: 0x2329,
+	0x2768: 0x2769,
+	0x2769: 0x2768,
+	0x276a: 0x276b,
+	0x276b: 0x276a,
+	0x276c: 0x276d,
+	0x276d: 0x276c,
+	0x276e: 0x276f,
+	0x276f: 0x276e,
+	0x2770: 0x2771,
+	0x2771: 0x2770,
+	0x2772: 0x2773,
+	0x2773: 0x2772,
+	0x2774: 0x2775,
+	0x2775: 0x2774,
+	0x27c3: 0x27c4,
+	0x27c4: 0x27c3,
+	0x27c5: 0x27c6,
+	0x27c6: 0x27c5,
+	0x27c8: 0x27c9,
+	0x27c9: 0x27c8,
+	0x27cb: 0x27cd,
+	0x27cd: 0x27cb,
+	0x27d5: 0x27d6,
+	0x27d6: 0x27d5,
+	0x27dc: 0x22b8,
+	0x27dd: 0x27de,
+	0x27de: 0x27dd,
+	0x27e2: 0x27e3,
+	0x27e3: 0x27e2,
+	0x27e4: 0x27e5,
+	0x27e5: 0x27e4,
+	0x27e6: 0x27e7,
+	0x27e7: 0x27e6,
+	0x27e8: 0x27e9,
+	0x27e9: 0x27e8,
+	0x27ea: 0x27eb,
+	0x27eb: 0x27ea,
+	0x27ec: 0x27ed,
+	0x27ed: 0x27ec,
+	0x27ee: 0x27ef,
+	0x27ef: 0x27ee,
+	0x2983: 0x2984,
+	0x2984: 0x2983,
+	0x2985: 0x2986,
+	0x2986: 0x2985,
+	0x2987: 0x2988,
+	0x2988: 0x2987,
+	0x2989: 0x298a,
+	0x298a: 0x2989,
+	0x298b: 0x298c,
+	0x298c: 0x298b,
+	0x298d: 0x2990,
+	0x298e: 0x298f,
+	0x298f: 0x298e,
+	0x2990: 0x298d,
+	0x2991: 0x2992,
+	0x2992: 0x2991,
+	0x2993: 0x2994,
+	0x2994: 0x2993,
+	0x2995: 0x2996,
+	0x2996: 0x2995,
+	0x2997: 0x2998,
+	0x2998: 0x2997,
+	0x299b: 0x2221,
+	0x29a0: 0x2222,
+	0x29a3: 0x2220,
+	0x29a4: 0x29a5,
+	0x29a5: 0x29a4,
+	0x29a8: 0x29a9,
+	0x29a9: 0x29a8,
+	0x29aa: 0x29ab,
+	0x29ab: 0x29aa,
+	0x29ac: 0x29ad,
+	0x29ad: 0x29ac,
+	0x29ae: 0x29af,
+	0x29af: 0x29ae,
+	0x29b8: 0x2298,
+	0x29c0: 0x29c1,
+	0x29c1: 0x29c0,
+	0x29c4: 0x29c5,
+	0x29c5: 0x29c4,
+	0x29cf: 0x29d0,
+	0x29d0: 0x29cf,
+	0x29d1: 0x29d2,
+	0x29d2: 0x29d1,
+	0x29d4: 0x29d5,
+	0x29d5: 0x29d4,
+	0x29d8: 0x29d9,
+	0x29d9: 0x29d8,
+	0x29da: 0x29db,
+	0x29db: 0x29da,
+	0x29e8: 0x29e9,
+	0x29e9: 0x29e8,
+	0x29f5: 0x2215,
+	0x29f8: 0x29f9,
+	0x29f9: 0x29f8,
+	0x29fc: 0x29fd,
+	0x29fd: 0x29fc,
+	0x2a2b: 0x2a2c,
+	0x2a2c: 0x2a2b,
+	0x2a2d: 0x2a2e,
+	0x2a2e: 0x2a2d,
+	0x2a34: 0x2a35,
+	0x2a35: 0x2a34,
+	0x2a3c: 0x2a3d,
+	0x2a3d: 0x2a3c,
+	0x2a64: 0x2a65,
+	0x2a65: 0x2a64,
+	0x2a79: 0x2a7a,
+	0x2a7a: 0x2a79,
+	0x2a7b: 0x2a7c,
+	0x2a7c: 0x2a7b,
+	0x2a7d: 0x2a7e,
+	0x2a7e: 0x2a7d,
+	0x2a7f: 0x2a80,
+	0x2a80: 0x2a7f,
+	0x2a81: 0x2a82,
+	0x2a82: 0x2a81,
+	0x2a83: 0x2a84,
+	0x2a84: 0x2a83,
+	0x2a85: 0x2a86,
+	0x2a86: 0x2a85,
+	0x2a87: 0x2a88,
+	0x2a88: 0x2a87,
+	0x2a89: 0x2a8a,
+	0x2a8a: 0x2a89,
+	0x2a8b: 0x2a8c,
+	0x2a8c: 0x2a8b,
+	0x2a8d: 0x2a8e,
+	0x2a8e: 0x2a8d,
+	0x2a8f: 0x2a90,
+	0x2a90: 0x2a8f,
+	0x2a91: 0x2a92,
+	0x2a92: 0x2a91,
+	0x2a93: 0x2a94,
+	0x2a94: 0x2a93,
+	0x2a95: 0x2a96,
+	0x2a96: 0x2a95,
+	0x2a97: 0x2a98,
+	0x2a98: 0x2a97,
+	0x2a99: 0x2a9a,
+	0x2a9a: 0x2a99,
+	0x2a9b: 0x2a9c,
+	0x2a9c: 0x2a9b,
+	0x2a9d: 0x2a9e,
+	0x2a9e: 0x2a9d,
+	0x2a9f: 0x2aa0,
+	0x2aa0: 0x2a9f,
+	0x2aa1: 0x2aa2,
+	0x2aa2: 0x2aa1,
+	0x2aa6: 0x2aa7,
+	0x2aa7: 0x2aa6,
+	0x2aa8: 0x2aa9,
+	0x2aa9: 0x2aa8,
+	0x2aaa: 0x2aab,
+	0x2aab: 0x2aaa,
+	0x2aac: 0x2aad,
+	0x2aad: 0x2aac,
+	0x2aaf: 0x2ab0,
+	0x2ab0: 0x2aaf,
+	0x2ab1: 0x2ab2,
+	0x2ab2: 0x2ab1,
+	0x2ab3: 0x2ab4,
+	0x2ab4: 0x2ab3,
+	0x2ab5: 0x2ab6,
+	0x2ab6: 0x2ab5,
+	0x2ab7: 0x2ab8,
+	0x2ab8: 0x2ab7,
+	0x2ab9: 0x2aba,
+	0x2aba: 0x2ab9,
+	0x2abb: 0x2abc,
+	0x2abc: 0x2abb,
+	0x2abd: 0x2abe,
+	0x2abe: 0x2abd,
+	0x2abf: 0x2ac0,
+	0x2ac0: 0x2abf,
+	0x2ac1: 0x2ac2,
+	0x2ac2: 0x2ac1,
+	0x2ac3: 0x2ac4,
+	0x2ac4: 0x2ac3,
+	0x2ac5: 0x2ac6,
+	0x2ac6: 0x2ac5,
+	0x2ac7: 0x2ac8,
+	0x2ac8: 0x2ac7,
+	0x2ac9: 0x2aca,
+	0x2aca: 0x2ac9,
+	0x2acb: 0x2acc,
+	0x2acc: 0x2acb,
+	0x2acd: 0x2ace,
+	0x2ace: 0x2acd,
+	0x2acf: 0x2ad0,
+	0x2ad0: 0x2acf,
+	0x2ad1: 0x2ad2,
+	0x2ad2: 0x2ad1,
+	0x2ad3: 0x2ad4,
+	0x2ad4: 0x2ad3,
+	0x2ad5: 0x2ad6,
+	0x2ad6: 0x2ad5,
+	0x2ade: 0x22a6,
+	0x2ae3: 0x22a9,
+	0x2ae4: 0x22a8,
+	0x2ae5: 0x22ab,
+	0x2aec: 0x2aed,
+	0x2aed: 0x2aec,
+	0x2aee: 0x2224,
+	0x2af7: 0x2af8,
+	0x2af8: 0x2af7,
+	0x2af9: 0x2afa,
+	0x2afa: 0x2af9,
+	0x2bfe: 0x221f,
+	0x2e02: 0x2e03,
+	0x2e03: 0x2e02,
+	0x2e04: 0x2e05,
+	0x2e05: 0x2e04,
+	0x2e09: 0x2e0a,
+	0x2e0a: 0x2e09,
+	0x2e0c: 0x2e0d,
+	0x2e0d: 0x2e0c,
+	0x2e1c: 0x2e1d,
+	0x2e1d: 0x2e1c,
+	0x2e20: 0x2e21,
+	0x2e21: 0x2e20,
+	0x2e22: 0x2e23,
+	0x2e23: 0x2e22,
+	0x2e24: 0x2e25,
+	0x2e25: 0x2e24,
+	0x2e26: 0x2e27,
+	0x2e27: 0x2e26,
+	0x2e28: 0x2e29,
+	0x2e29: 0x2e28,
+	0x2e55: 0x2e56,
+	0x2e56: 0x2e55,
+	0x2e57: 0x2e58,
+	0x2e58: 0x2e57,
+	0x2e59: 0x2e5a,
+	0x2e5a: 0x2e59,
+	0x2e5b: 0x2e5c,
+	0x2e5c: 0x2e5b,
+	0x3008: 0x3009,
+	0x3009: 0x3008,
+	0x300a: 0x300b,
+	0x300b: 0x300a,
+	0x300c: 0x300d,
+	0x300d: 0x300c,
+	0x300e: 0x300f,
+	0x300f: 0x300e,
+	0x3010: 0x3011,
+	0x3011: 0x3010,
+	0x3014: 0x3015,
+	0x3015: 0x3014,
+	0x3016: 0x3017,
+	0x3017: 0x3016,
+	0x3018: 0x3019,
+	0x3019: 0x3018,
+	0x301a: 0x301b,
+	0x301b: 0x301a,
+	0xfe59: 0xfe5a,
+	0xfe5a: 0xfe59,
+	0xfe5b: 0xfe5c,
+	0xfe5c: 0xfe5b,
+	0xfe5d: 0xfe5e,
+	0xfe5e: 0xfe5d,
+	0xfe64: 0xfe65,
+	0xfe65: 0xfe64,
+	0xff08: 0xff09,
+	0xff09: 0xff08,
+	0xff1c: 0xff1e,
+	0xff1e: 0xff1c,
+	0xff3b: 0xff3d,
+	0xff3d: 0xff3b,
+	0xff5b: 0xff5d,
+	0xff5d: 0xff5b,
+	0xff5f: 0xff60,
+	0xff60: 0xff5f,
+	0xff62: 0xff63,
+	0xff63: 0xff62,
+}
@@ -0,0 +1,194 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var pkg = flag.String("pkg", "linebreak", "Package name to use")
+var url = flag.String("url", "https://www.unicode.org/Public/14.0.0/ucd/", "The HTTP URL of the directory to download UCD files from")
+var outFile = flag.String("out", "tables.go", "Output file")
+
+const maxCodepoint = 0x10ffff
+
+// Hangul syllables are classified by ClassOf without using the table.
+const hangulSyllableFirst, hangulSyllableLast = 0xac00, 0xd7a3
+
+// parseRange parses "XXXX" or "XXXX..YYYY" codepoint range.
+func parseRange(s string) (first, last int, err error) {
+	firstStr, lastStr, isRange := strings.Cut(strings.TrimSpace(s), "..")
+	if !isRange {
+		lastStr = firstStr
+	}
+	first64, err := strconv.ParseInt(firstStr, 16, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	last64, err := strconv.ParseInt(lastStr, 16, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(first64), int(last64), nil
+}
+
+// readUcdFile downloads a UCD file named name, and calls fn with codepoint
+// range and the value of each entry. Default values given by @missing lines
+// are also passed to fn.
+func readUcdFile(name string, fn func(first, last int, value string)) {
+	fileUrl := *url + name
+	log.Printf("Downloading from %s", fileUrl)
+	res, err := http.Get(fileUrl)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		log.Fatalf("Server returned %s", res.Status)
+	}
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		// Default values for unlisted codepoints are given as comments:
+		// # @missing: 3400..4DBF; ID
+		if missing, ok := strings.CutPrefix(line, "# @missing:"); ok {
+			line = missing
+		} else if idx := strings.IndexByte(line, '#'); idx != -1 {
+			line = line[:idx]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		rangeStr, value, ok := strings.Cut(line, ";")
+		if !ok {
+			log.Printf("Bad line %q -- skipping the entry", line)
+			continue
+		}
+		first, last, err := parseRange(rangeStr)
+		if err != nil || last < first || maxCodepoint < last {
+			log.Printf("Bad codepoint range %q -- skipping the entry", rangeStr)
+			continue
+		}
+		fn(first, last, strings.TrimSpace(value))
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// writeRanges writes ranges of codepoints where pred is true, as a runeRange
+// table named name.
+func writeRanges(sb *strings.Builder, name string, pred func(cp int) bool) {
+	sb.WriteString(fmt.Sprintf("var %s = []runeRange{\n", name))
+	for first := 0; first <= maxCodepoint; first++ {
+		if !pred(first) {
+			continue
+		}
+		last := first
+		for last < maxCodepoint && pred(last+1) {
+			last++
+		}
+		sb.WriteString(fmt.Sprintf("    {%#x, %#x},\n", first, last))
+		first = last
+	}
+	sb.WriteString("}\n")
+}
+
+func main() {
+	flag.Parse()
+
+	log.SetPrefix("[text/linebreak/gen] ")
+
+	log.Println("Reading line breaking classes")
+	classes := make([]string, maxCodepoint+1)
+	for i := range classes {
+		classes[i] = "XX"
+	}
+	readUcdFile("LineBreak.txt", func(first, last int, value string) {
+		for cp := first; cp <= last; cp++ {
+			classes[cp] = value
+		}
+	})
+
+	// LB30 doesn't apply to OP and CP characters whose East Asian Width is
+	// F, W or H.
+	log.Println("Reading East Asian widths")
+	isWide := make([]bool, maxCodepoint+1)
+	readUcdFile("EastAsianWidth.txt", func(first, last int, value string) {
+		for cp := first; cp <= last; cp++ {
+			isWide[cp] = value == "F" || value == "W" || value == "H"
+		}
+	})
+
+	// LB30b also applies to unassigned codepoints that are reserved for
+	// Extended_Pictographic characters.
+	log.Println("Reading Extended_Pictographic characters")
+	isPictographic := make([]bool, maxCodepoint+1)
+	readUcdFile("emoji/emoji-data.txt", func(first, last int, value string) {
+		if value != "Extended_Pictographic" {
+			return
+		}
+		for cp := first; cp <= last; cp++ {
+			isPictographic[cp] = true
+		}
+	})
+	log.Println("Reading unassigned codepoints")
+	isUnassigned := make([]bool, maxCodepoint+1)
+	readUcdFile("extracted/DerivedGeneralCategory.txt", func(first, last int, value string) {
+		for cp := first; cp <= last; cp++ {
+			isUnassigned[cp] = value == "Cn"
+		}
+	})
+
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("// Auto-generated by text/linebreak/gen(using the data files from %s)\n// DO NOT EDIT.\n\n", *url))
+	sb.WriteString(fmt.Sprintf("package %s\n\n", *pkg))
+	sb.WriteString("var classRanges = []classRange{\n")
+	count := 0
+	for first := 0; first <= maxCodepoint; {
+		last := first
+		for last < maxCodepoint && classes[last+1] == classes[first] {
+			last++
+		}
+		class := classes[first]
+		isHangulSyllable := hangulSyllableFirst <= first && last <= hangulSyllableLast && (class == "H2" || class == "H3")
+		if class != "XX" && !isHangulSyllable {
+			sb.WriteString(fmt.Sprintf("    {%#x, %#x, %s},\n", first, last, class))
+			count++
+		}
+		first = last + 1
+	}
+	sb.WriteString("}\n\n")
+	log.Printf("Generated %d ranges", count)
+	writeRanges(&sb, "eastAsianParenRanges", func(cp int) bool {
+		return isWide[cp] && (classes[cp] == "OP" || classes[cp] == "CP")
+	})
+	sb.WriteString("\n")
+	writeRanges(&sb, "reservedPictographicRanges", func(cp int) bool {
+		return isPictographic[cp] && isUnassigned[cp]
+	})
+
+	log.Printf("Formatting generated source")
+	unformatted := []byte(sb.String())
+	formatted, err := format.Source(unformatted)
+	if err != nil {
+		log.Println(err)
+		log.Println("Formatting error -- Writing unformatted source code instead")
+		formatted = unformatted
+	}
+	log.Printf("Writing output to %s", *outFile)
+	err = os.WriteFile(*outFile, formatted, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,417 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+// Package linebreak implements the [Unicode Line Breaking Algorithm] (UAX #14),
+// which finds where lines can be broken when wrapping text.
+//
+// Tailorings required by [CSS Text Module Level 3] (line-break and word-break)
+// are supported through [Options].
+//
+// [Unicode Line Breaking Algorithm]: https://www.unicode.org/reports/tr14/
+// [CSS Text Module Level 3]: https://www.w3.org/TR/css-text-3/#line-breaking
+package linebreak
+
+//go:generate go run ./gen
+
+import (
+	"slices"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Class is a line breaking class of a character.
+//
+// https://www.unicode.org/reports/tr14/#Table1
+type Class uint8
+
+const (
+	XX  Class = iota // Unknown
+	BK               // Mandatory Break
+	CR               // Carriage Return
+	LF               // Line Feed
+	CM               // Combining Mark
+	NL               // Next Line
+	SG               // Surrogate
+	WJ               // Word Joiner
+	ZW               // Zero Width Space
+	GL               // Non-breaking ("Glue")
+	SP               // Space
+	ZWJ              // Zero Width Joiner
+	B2               // Break Opportunity Before and After
+	BA               // Break After
+	BB               // Break Before
+	HY               // Hyphen
+	CB               // Contingent Break Opportunity
+	CL               // Close Punctuation
+	CP               // Close Parenthesis
+	EX               // Exclamation/Interrogation
+	IN               // Inseparable
+	NS               // Nonstarter
+	OP               // Open Punctuation
+	QU               // Quotation
+	IS               // Infix Numeric Separator
+	NU               // Numeric
+	PO               // Postfix Numeric
+	PR               // Prefix Numeric
+	SY               // Symbols Allowing Break After
+	AI               // Ambiguous (Alphabetic or Ideographic)
+	AL               // Alphabetic
+	CJ               // Conditional Japanese Starter
+	EB               // Emoji Base
+	EM               // Emoji Modifier
+	H2               // Hangul LV Syllable
+	H3               // Hangul LVT Syllable
+	HL               // Hebrew Letter
+	ID               // Ideographic
+	JL               // Hangul L Jamo
+	JV               // Hangul V Jamo
+	JT               // Hangul T Jamo
+	RI               // Regional Indicator
+	SA               // Complex Context Dependent (South East Asian)
+)
+
+// noClass is used in place of the class of the character before the start of text.
+const noClass Class = 0xff
+
+// classRange is an entry of classRanges table, which is generated from LineBreak.txt.
+type classRange struct {
+	first, last rune
+	class       Class
+}
+
+// runeRange is an entry of tables listing characters with a property, which
+// are generated from UCD files.
+type runeRange struct {
+	first, last rune
+}
+
+// inRanges reports whether r is in one of sorted ranges.
+func inRanges(ranges []runeRange, r rune) bool {
+	_, found := slices.BinarySearchFunc(ranges, r, func(e runeRange, r rune) int {
+		if e.last < r {
+			return -1
+		} else if r < e.first {
+			return 1
+		}
+		return 0
+	})
+	return found
+}
+
+// Hangul syllables are either LV(H2) or LVT(H3), and every 28th syllable is
+// LV syllable. So these are not stored in the table.
+const (
+	hangulSyllableFirst = 0xac00
+	hangulSyllableLast  = 0xd7a3
+	hangulTCount        = 28
+)
+
+// ClassOf returns the line breaking class of r, as defined in LineBreak.txt.
+func ClassOf(r rune) Class {
+	if hangulSyllableFirst <= r && r <= hangulSyllableLast {
+		if (r-hangulSyllableFirst)%hangulTCount == 0 {
+			return H2
+		}
+		return H3
+	}
+	i, found := slices.BinarySearchFunc(classRanges, r, func(e classRange, r rune) int {
+		if e.last < r {
+			return -1
+		} else if r < e.first {
+			return 1
+		}
+		return 0
+	})
+	if !found {
+		return XX
+	}
+	return classRanges[i].class
+}
+
+// Strictness is strictness of line breaking rules, as defined by
+// [CSS line-break] property.
+//
+// [CSS line-break]: https://www.w3.org/TR/css-text-3/#line-break-property
+type Strictness uint8
+
+const (
+	Normal   Strictness = iota // Most common line breaking rules
+	Strict                     // Most stringent line breaking rules
+	Loose                      // Least restrictive line breaking rules
+	Anywhere                   // Break around every typographic character unit
+)
+
+// Options are tailorings applied to line breaking rules.
+type Options struct {
+	Strictness Strictness
+	BreakAll   bool // Allow breaks between letters (word-break: break-all)
+	KeepAll    bool // Disallow breaks between letters, including CJK ones (word-break: keep-all)
+}
+
+// Opportunity is a line break opportunity.
+type Opportunity struct {
+	Offset      int  // Byte offset where the next line starts
+	IsMandatory bool // Whether line must be broken here (e.g. after line feed)
+}
+
+// resolveClass returns class of r after resolving classes that aren't used
+// by pair rules, and applying tailorings in opts.
+//
+// Spec: https://www.unicode.org/reports/tr14/#LB1
+func resolveClass(r rune, opts Options) Class {
+	c := ClassOf(r)
+	switch c {
+	case AI, SG, XX:
+		c = AL
+	case SA:
+		if unicode.In(r, unicode.Mn, unicode.Mc) {
+			c = CM
+		} else {
+			c = AL
+		}
+	case CJ:
+		if opts.Strictness == Strict {
+			c = NS
+		} else {
+			c = ID
+		}
+	}
+	// https://www.w3.org/TR/css-text-3/#line-break-property
+	// TODO: Loose rules should only apply to specific characters in CJK text.
+	if opts.Strictness == Loose && c == NS {
+		c = ID
+	}
+	// https://www.w3.org/TR/css-text-3/#word-break-property
+	if opts.BreakAll {
+		switch c {
+		case AL, HL, NU:
+			c = ID
+		}
+	}
+	if opts.KeepAll {
+		switch c {
+		case ID, H2, H3, JL, JV, JT:
+			c = AL
+		}
+	}
+	return c
+}
+
+// breakContext is the state of the text around the current position.
+type breakContext struct {
+	prev        Class // Class of the previous character, after applying LB9 and LB10
+	prevPrev    Class // Class of the character before prev
+	prevRaw     Class // Class of the previous character, before applying LB9 and LB10
+	prevRune    rune  // The previous character, after applying LB9
+	base        Class // Class of the last character that isn't SP
+	next        Class // Class of the next character that isn't CM or ZWJ. Only set when the current one is OP or HY.
+	riCount     int   // Number of consecutive RI characters before the current position
+	inNumber    bool  // Whether the text before the current position ends with NU (NU | SY | IS)*
+	afterNumber bool  // Whether the text before the current position ends with NU (NU | SY | IS)* (CL | CP)
+}
+
+// classIn reports whether c is one of classes.
+func classIn(c Class, classes ...Class) bool {
+	return slices.Contains(classes, c)
+}
+
+// pairRule decides whether line can be broken before r, whose resolved class
+// is cur, in the given context.
+//
+// Spec: https://www.unicode.org/reports/tr14/#Algorithm
+func pairRule(ctx breakContext, r rune, cur Class) (isBreak, isMandatory bool) {
+	prev := ctx.prev
+	// LB4, LB5
+	if prev == CR && cur == LF {
+		return false, false
+	} else if classIn(prev, BK, CR, LF, NL) {
+		return true, true
+	}
+	// LB6, LB7
+	if classIn(cur, BK, CR, LF, NL, SP, ZW) {
+		return false, false
+	}
+	// LB8
+	if ctx.base == ZW {
+		return true, false
+	}
+	// LB8a
+	if ctx.prevRaw == ZWJ {
+		return false, false
+	}
+	// LB9, LB10
+	if classIn(cur, CM, ZWJ) {
+		if !classIn(prev, BK, CR, LF, NL, SP, ZW) {
+			return false, false
+		}
+		cur = AL
+	}
+	// LB11, LB12, LB12a
+	if cur == WJ || prev == WJ || prev == GL {
+		return false, false
+	} else if cur == GL && !classIn(prev, SP, BA, HY) {
+		return false, false
+	}
+	// LB13
+	if classIn(cur, CL, CP, EX, IS, SY) {
+		return false, false
+	}
+	// LB14 ~ LB17
+	switch {
+	case ctx.base == OP,
+		ctx.base == QU && cur == OP,
+		classIn(ctx.base, CL, CP) && cur == NS,
+		ctx.base == B2 && cur == B2:
+		return false, false
+	}
+	// LB18
+	if prev == SP {
+		return true, false
+	}
+	// LB19, LB20
+	if cur == QU || prev == QU {
+		return false, false
+	} else if cur == CB || prev == CB {
+		return true, false
+	}
+	// LB21, LB21a, LB21b, LB22
+	switch {
+	case classIn(cur, BA, HY, NS), prev == BB,
+		ctx.prevPrev == HL && classIn(prev, HY, BA),
+		prev == SY && cur == HL,
+		cur == IN:
+		return false, false
+	}
+	// LB23, LB23a, LB24
+	switch {
+	case classIn(prev, AL, HL) && cur == NU, prev == NU && classIn(cur, AL, HL),
+		prev == PR && classIn(cur, ID, EB, EM), classIn(prev, ID, EB, EM) && cur == PO,
+		classIn(prev, PR, PO) && classIn(cur, AL, HL), classIn(prev, AL, HL) && classIn(cur, PR, PO):
+		return false, false
+	}
+	// LB25
+	// Numbers are matched with the regular expression from the spec, instead
+	// of the pair rules, so that "a.2" or "(s)" isn't kept together.
+	// https://www.unicode.org/reports/tr14/#Examples (Example 7)
+	switch {
+	case classIn(prev, PR, PO) && classIn(cur, OP, HY) && ctx.next == NU,
+		classIn(prev, PR, PO, OP, HY) && cur == NU,
+		ctx.inNumber && classIn(cur, NU, SY, IS, CL, CP),
+		(ctx.inNumber || ctx.afterNumber) && classIn(cur, PR, PO):
+		return false, false
+	}
+	// LB26, LB27
+	switch {
+	case prev == JL && classIn(cur, JL, JV, H2, H3),
+		classIn(prev, JV, H2) && classIn(cur, JV, JT),
+		classIn(prev, JT, H3) && cur == JT,
+		classIn(prev, JL, JV, JT, H2, H3) && cur == PO,
+		prev == PR && classIn(cur, JL, JV, JT, H2, H3):
+		return false, false
+	}
+	// LB28, LB29
+	if classIn(prev, AL, HL) && classIn(cur, AL, HL) {
+		return false, false
+	} else if prev == IS && classIn(cur, AL, HL) {
+		return false, false
+	}
+	// LB30
+	if classIn(prev, AL, HL, NU) && cur == OP && !inRanges(eastAsianParenRanges, r) {
+		return false, false
+	} else if prev == CP && classIn(cur, AL, HL, NU) && !inRanges(eastAsianParenRanges, ctx.prevRune) {
+		return false, false
+	}
+	// LB30a, LB30b
+	if prev == RI && cur == RI && ctx.riCount%2 == 1 {
+		return false, false
+	} else if cur == EM && (prev == EB || inRanges(reservedPictographicRanges, ctx.prevRune)) {
+		return false, false
+	}
+	// LB31
+	return true, false
+}
+
+// anywhereRule decides whether line can be broken before a character of class
+// cur, when line-break is anywhere. Line can be broken between any typographic
+// character units, except for line breaks.
+//
+// Spec: https://www.w3.org/TR/css-text-3/#valdef-line-break-anywhere
+func anywhereRule(ctx breakContext, cur Class) (isBreak, isMandatory bool) {
+	if ctx.prevRaw == CR && cur == LF {
+		return false, false
+	} else if classIn(ctx.prevRaw, BK, CR, LF, NL) {
+		return true, true
+	}
+	// TODO: Use extended grapheme cluster boundaries instead.
+	if classIn(cur, BK, CR, LF, NL, CM, ZWJ) || ctx.prevRaw == ZWJ {
+		return false, false
+	}
+	return true, false
+}
+
+// classAfter returns the class of the first character in s that isn't CM or
+// ZWJ, or noClass if there's no such character.
+func classAfter(s string, opts Options) Class {
+	for _, r := range s {
+		if c := resolveClass(r, opts); !classIn(c, CM, ZWJ) {
+			return c
+		}
+	}
+	return noClass
+}
+
+// Opportunities returns line break opportunities in s, in ascending order.
+// The start and the end of the text are not included.
+func Opportunities(s string, opts Options) []Opportunity {
+	res := []Opportunity{}
+	ctx := breakContext{prev: noClass, prevPrev: noClass, prevRaw: noClass, base: noClass}
+	for i, r := range s {
+		cur := resolveClass(r, opts)
+		if i != 0 {
+			var isBreak, isMandatory bool
+			if opts.Strictness == Anywhere {
+				isBreak, isMandatory = anywhereRule(ctx, cur)
+			} else {
+				ctx.next = noClass
+				if classIn(cur, OP, HY) {
+					_, size := utf8.DecodeRuneInString(s[i:])
+					ctx.next = classAfter(s[i+size:], opts)
+				}
+				isBreak, isMandatory = pairRule(ctx, r, cur)
+			}
+			if isBreak {
+				res = append(res, Opportunity{Offset: i, IsMandatory: isMandatory})
+			}
+		}
+		ctx.prevRaw = cur
+		// LB9: X (CM | ZWJ)* is treated as X.
+		if classIn(cur, CM, ZWJ) && ctx.prev != noClass && !classIn(ctx.prev, BK, CR, LF, NL, SP, ZW) {
+			continue
+		}
+		if classIn(cur, CM, ZWJ) {
+			cur = AL // LB10
+		}
+		if cur == RI {
+			ctx.riCount++
+		} else {
+			ctx.riCount = 0
+		}
+		if cur != SP {
+			ctx.base = cur
+		}
+		ctx.afterNumber = ctx.inNumber && classIn(cur, CL, CP)
+		ctx.inNumber = cur == NU || (ctx.inNumber && classIn(cur, NU, SY, IS))
+		ctx.prevPrev, ctx.prev, ctx.prevRune = ctx.prev, cur, r
+	}
+	return res
+}
+
+// IsGraphemeExtender reports whether r is a character that should be kept
+// with the previous character, when text is broken at arbitrary position.
+//
+// TODO: Use extended grapheme cluster boundaries instead.
+func IsGraphemeExtender(r rune) bool {
+	c := ClassOf(r)
+	return c == CM || c == ZWJ || (c == SA && unicode.In(r, unicode.Mn, unicode.Mc))
+}
@@ -0,0 +1,133 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package linebreak
+
+import (
+	"bufio"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestClassOf(t *testing.T) {
+	cases := []struct {
+		input    rune
+		expected Class
+	}{
+		{'a', AL}, {' ', SP}, {'\n', LF}, {'0', NU}, {'(', OP}, {')', CP},
+		{'가', H2}, {'각', H3}, {'ᄀ', JL}, {'漢', ID}, {'ぁ', CJ}, {'\u0301', CM},
+		{'\u200b', ZW}, {'\u00a0', GL}, {0x10ffff, XX},
+	}
+	for _, cs := range cases {
+		if got := ClassOf(cs.input); got != cs.expected {
+			t.Errorf("%q: expected class %d, got %d", cs.input, cs.expected, got)
+		}
+	}
+}
+
+func TestOpportunities(t *testing.T) {
+	cases := []struct {
+		desc      string
+		input     string
+		opts      Options
+		expected  []int // Offsets of opportunities
+		mandatory []int // Offsets of mandatory breaks
+	}{
+		{"Spaces", "Hello, world!  foo", Options{}, []int{7, 15}, nil},
+		{"Hyphen", "foo-bar", Options{}, []int{4}, nil},
+		{"Numbers", "$100 (abc)", Options{}, []int{5}, nil},
+		{"Combining marks", "e\u0301 e\u0301", Options{}, []int{4}, nil},
+		{"Non-breaking space", "a\u00a0b c", Options{}, []int{5}, nil},
+		{"Zero width space", "ab\u200bcd", Options{}, []int{5}, nil},
+		{"Line feed", "a\nb\r\nc", Options{}, []int{2, 5}, []int{2, 5}},
+		{"Hangul", "안녕 세계", Options{}, []int{3, 7, 10}, nil},
+		{"Hangul with keep-all", "안녕 세계", Options{KeepAll: true}, []int{7}, nil},
+		{"Ideographs", "日本語。", Options{}, []int{3, 6}, nil},
+		{"Small kana", "キャット", Options{}, []int{3, 6, 9}, nil},
+		{"Iteration mark", "日々", Options{}, []int{}, nil},
+		{"Iteration mark with loose", "日々", Options{Strictness: Loose}, []int{3}, nil},
+		{"Small kana with strict", "キャット", Options{Strictness: Strict}, []int{9}, nil},
+		{"Letters with break-all", "ab cd", Options{BreakAll: true}, []int{1, 3, 4}, nil},
+		{"Anywhere", "ab, e\u0301", Options{Strictness: Anywhere}, []int{1, 2, 3, 4}, nil},
+		{"Regional indicators", "🇰🇷🇯🇵", Options{}, []int{8}, nil},
+	}
+	for _, cs := range cases {
+		t.Run(cs.desc, func(t *testing.T) {
+			got, gotMandatory := []int{}, []int{}
+			for _, o := range Opportunities(cs.input, cs.opts) {
+				got = append(got, o.Offset)
+				if o.IsMandatory {
+					gotMandatory = append(gotMandatory, o.Offset)
+				}
+			}
+			if !slices.Equal(cs.expected, got) {
+				t.Errorf("expected opportunities %v, got %v", cs.expected, got)
+			}
+			if cs.mandatory == nil {
+				cs.mandatory = []int{}
+			}
+			if !slices.Equal(cs.mandatory, gotMandatory) {
+				t.Errorf("expected mandatory breaks %v, got %v", cs.mandatory, gotMandatory)
+			}
+		})
+	}
+}
+
+// TestConformance runs test cases from LineBreakTest.txt in testdata. Each
+// line is a sequence of codepoints, with "÷" where line can be broken and "×"
+// where it can't.
+//
+// Strict rules are used, since these are closest to rules without tailorings.
+//
+// Spec: https://www.unicode.org/Public/14.0.0/ucd/auxiliary/LineBreakTest.txt
+func TestConformance(t *testing.T) {
+	f, err := os.Open("testdata/LineBreakTest.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		sb := strings.Builder{}
+		expected := []int{}
+		for _, field := range strings.Fields(line) {
+			switch field {
+			case "÷":
+				if sb.Len() != 0 {
+					expected = append(expected, sb.Len())
+				}
+			case "×":
+			default:
+				cp, err := strconv.ParseUint(field, 16, 32)
+				if err != nil {
+					t.Fatalf("line %d: bad codepoint %q", lineNum, field)
+				}
+				sb.WriteRune(rune(cp))
+			}
+		}
+		// Break at the end of text is not reported.
+		if len(expected) != 0 && expected[len(expected)-1] == sb.Len() {
+			expected = expected[:len(expected)-1]
+		}
+		got := []int{}
+		for _, o := range Opportunities(sb.String(), Options{Strictness: Strict}) {
+			got = append(got, o.Offset)
+		}
+		if !slices.Equal(expected, got) {
+			t.Errorf("line %d: %s: expected opportunities %v, got %v", lineNum, strings.TrimSpace(line), expected, got)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
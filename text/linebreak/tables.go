@@ -0,0 +1,2071 @@
+// Auto-generated by text/linebreak/gen(using the data files from https://www.unicode.org/Public/14.0.0/ucd/)
+// DO NOT EDIT.
+
+package linebreak
+
+var classRanges = []classRange{
+	{0x0, 0x8, CM},
+	{0x9, 0x9, BA},
+	{0xa, 0xa, LF},
+	{0xb, 0xc, BK},
+	{0xd, 0xd, CR},
+	{0xe, 0x1f, CM},
+	{0x20, 0x20, SP},
+	{0x21, 0x21, EX},
+	{0x22, 0x22, QU},
+	{0x23, 0x23, AL},
+	{0x24, 0x24, PR},
+	{0x25, 0x25, PO},
+	{0x26, 0x26, AL},
+	{0x27, 0x27, QU},
+	{0x28, 0x28, OP},
+	{0x29, 0x29, CP},
+	{0x2a, 0x2a, AL},
+	{0x2b, 0x2b, PR},
+	{0x2c, 0x2c, IS},
+	{0x2d, 0x2d, HY},
+	{0x2e, 0x2e, IS},
+	{0x2f, 0x2f, SY},
+	{0x30, 0x39, NU},
+	{0x3a, 0x3b, IS},
+	{0x3c, 0x3e, AL},
+	{0x3f, 0x3f, EX},
+	{0x40, 0x5a, AL},
+	{0x5b, 0x5b, OP},
+	{0x5c, 0x5c, PR},
+	{0x5d, 0x5d, CP},
+	{0x5e, 0x7a, AL},
+	{0x7b, 0x7b, OP},
+	{0x7c, 0x7c, BA},
+	{0x7d, 0x7d, CL},
+	{0x7e, 0x7e, AL},
+	{0x7f, 0x84, CM},
+	{0x85, 0x85, NL},
+	{0x86, 0x9f, CM},
+	{0xa0, 0xa0, GL},
+	{0xa1, 0xa1, OP},
+	{0xa2, 0xa2, PO},
+	{0xa3, 0xa5, PR},
+	{0xa6, 0xa6, AL},
+	{0xa7, 0xa8, AI},
+	{0xa9, 0xa9, AL},
+	{0xaa, 0xaa, AI},
+	{0xab, 0xab, QU},
+	{0xac, 0xac, AL},
+	{0xad, 0xad, BA},
+	{0xae, 0xaf, AL},
+	{0xb0, 0xb0, PO},
+	{0xb1, 0xb1, PR},
+	{0xb2, 0xb3, AI},
+	{0xb4, 0xb4, BB},
+	{0xb5, 0xb5, AL},
+	{0xb6, 0xba, AI},
+	{0xbb, 0xbb, QU},
+	{0xbc, 0xbe, AI},
+	{0xbf, 0xbf, OP},
+	{0xc0, 0xd6, AL},
+	{0xd7, 0xd7, AI},
+	{0xd8, 0xf6, AL},
+	{0xf7, 0xf7, AI},
+	{0xf8, 0x2c6, AL},
+	{0x2c7, 0x2c7, AI},
+	{0x2c8, 0x2c8, BB},
+	{0x2c9, 0x2cb, AI},
+	{0x2cc, 0x2cc, BB},
+	{0x2cd, 0x2cd, AI},
+	{0x2ce, 0x2cf, AL},
+	{0x2d0, 0x2d0, AI},
+	{0x2d1, 0x2d7, AL},
+	{0x2d8, 0x2db, AI},
+	{0x2dc, 0x2dc, AL},
+	{0x2dd, 0x2dd, AI},
+	{0x2de, 0x2de, AL},
+	{0x2df, 0x2df, BB},
+	{0x2e0, 0x2ff, AL},
+	{0x300, 0x34e, CM},
+	{0x34f, 0x34f, GL},
+	{0x350, 0x35b, CM},
+	{0x35c, 0x362, GL},
+	{0x363, 0x36f, CM},
+	{0x370, 0x377, AL},
+	{0x37a, 0x37d, AL},
+	{0x37e, 0x37e, IS},
+	{0x37f, 0x37f, AL},
+	{0x384, 0x38a, AL},
+	{0x38c, 0x38c, AL},
+	{0x38e, 0x3a1, AL},
+	{0x3a3, 0x482, AL},
+	{0x483, 0x489, CM},
+	{0x48a, 0x52f, AL},
+	{0x531, 0x556, AL},
+	{0x559, 0x588, AL},
+	{0x589, 0x589, IS},
+	{0x58a, 0x58a, BA},
+	{0x58d, 0x58e, AL},
+	{0x58f, 0x58f, PR},
+	{0x591, 0x5bd, CM},
+	{0x5be, 0x5be, BA},
+	{0x5bf, 0x5bf, CM},
+	{0x5c0, 0x5c0, AL},
+	{0x5c1, 0x5c2, CM},
+	{0x5c3, 0x5c3, AL},
+	{0x5c4, 0x5c5, CM},
+	{0x5c6, 0x5c6, EX},
+	{0x5c7, 0x5c7, CM},
+	{0x5d0, 0x5ea, HL},
+	{0x5ef, 0x5f2, HL},
+	{0x5f3, 0x5f4, AL},
+	{0x600, 0x608, AL},
+	{0x609, 0x60b, PO},
+	{0x60c, 0x60d, IS},
+	{0x60e, 0x60f, AL},
+	{0x610, 0x61a, CM},
+	{0x61b, 0x61b, EX},
+	{0x61c, 0x61c, CM},
+	{0x61d, 0x61f, EX},
+	{0x620, 0x64a, AL},
+	{0x64b, 0x65f, CM},
+	{0x660, 0x669, NU},
+	{0x66a, 0x66a, PO},
+	{0x66b, 0x66c, NU},
+	{0x66d, 0x66f, AL},
+	{0x670, 0x670, CM},
+	{0x671, 0x6d3, AL},
+	{0x6d4, 0x6d4, EX},
+	{0x6d5, 0x6d5, AL},
+	{0x6d6, 0x6dc, CM},
+	{0x6dd, 0x6de, AL},
+	{0x6df, 0x6e4, CM},
+	{0x6e5, 0x6e6, AL},
+	{0x6e7, 0x6e8, CM},
+	{0x6e9, 0x6e9, AL},
+	{0x6ea, 0x6ed, CM},
+	{0x6ee, 0x6ef, AL},
+	{0x6f0, 0x6f9, NU},
+	{0x6fa, 0x70d, AL},
+	{0x70f, 0x710, AL},
+	{0x711, 0x711, CM},
+	{0x712, 0x72f, AL},
+	{0x730, 0x74a, CM},
+	{0x74d, 0x7a5, AL},
+	{0x7a6, 0x7b0, CM},
+	{0x7b1, 0x7b1, AL},
+	{0x7c0, 0x7c9, NU},
+	{0x7ca, 0x7ea, AL},
+	{0x7eb, 0x7f3, CM},
+	{0x7f4, 0x7f7, AL},
+	{0x7f8, 0x7f8, IS},
+	{0x7f9, 0x7f9, EX},
+	{0x7fa, 0x7fa, AL},
+	{0x7fd, 0x7fd, CM},
+	{0x7fe, 0x7ff, PR},
+	{0x800, 0x815, AL},
+	{0x816, 0x819, CM},
+	{0x81a, 0x81a, AL},
+	{0x81b, 0x823, CM},
+	{0x824, 0x824, AL},
+	{0x825, 0x827, CM},
+	{0x828, 0x828, AL},
+	{0x829, 0x82d, CM},
+	{0x830, 0x83e, AL},
+	{0x840, 0x858, AL},
+	{0x859, 0x85b, CM},
+	{0x85e, 0x85e, AL},
+	{0x860, 0x86a, AL},
+	{0x870, 0x88e, AL},
+	{0x890, 0x891, AL},
+	{0x898, 0x89f, CM},
+	{0x8a0, 0x8c9, AL},
+	{0x8ca, 0x8e1, CM},
+	{0x8e2, 0x8e2, AL},
+	{0x8e3, 0x903, CM},
+	{0x904, 0x939, AL},
+	{0x93a, 0x93c, CM},
+	{0x93d, 0x93d, AL},
+	{0x93e, 0x94f, CM},
+	{0x950, 0x950, AL},
+	{0x951, 0x957, CM},
+	{0x958, 0x961, AL},
+	{0x962, 0x963, CM},
+	{0x964, 0x965, BA},
+	{0x966, 0x96f, NU},
+	{0x970, 0x980, AL},
+	{0x981, 0x983, CM},
+	{0x985, 0x98c, AL},
+	{0x98f, 0x990, AL},
+	{0x993, 0x9a8, AL},
+	{0x9aa, 0x9b0, AL},
+	{0x9b2, 0x9b2, AL},
+	{0x9b6, 0x9b9, AL},
+	{0x9bc, 0x9bc, CM},
+	{0x9bd, 0x9bd, AL},
+	{0x9be, 0x9c4, CM},
+	{0x9c7, 0x9c8, CM},
+	{0x9cb, 0x9cd, CM},
+	{0x9ce, 0x9ce, AL},
+	{0x9d7, 0x9d7, CM},
+	{0x9dc, 0x9dd, AL},
+	{0x9df, 0x9e1, AL},
+	{0x9e2, 0x9e3, CM},
+	{0x9e6, 0x9ef, NU},
+	{0x9f0, 0x9f1, AL},
+	{0x9f2, 0x9f3, PO},
+	{0x9f4, 0x9f8, AL},
+	{0x9f9, 0x9f9, PO},
+	{0x9fa, 0x9fa, AL},
+	{0x9fb, 0x9fb, PR},
+	{0x9fc, 0x9fd, AL},
+	{0x9fe, 0x9fe, CM},
+	{0xa01, 0xa03, CM},
+	{0xa05, 0xa0a, AL},
+	{0xa0f, 0xa10, AL},
+	{0xa13, 0xa28, AL},
+	{0xa2a, 0xa30, AL},
+	{0xa32, 0xa33, AL},
+	{0xa35, 0xa36, AL},
+	{0xa38, 0xa39, AL},
+	{0xa3c, 0xa3c, CM},
+	{0xa3e, 0xa42, CM},
+	{0xa47, 0xa48, CM},
+	{0xa4b, 0xa4d, CM},
+	{0xa51, 0xa51, CM},
+	{0xa59, 0xa5c, AL},
+	{0xa5e, 0xa5e, AL},
+	{0xa66, 0xa6f, NU},
+	{0xa70, 0xa71, CM},
+	{0xa72, 0xa74, AL},
+	{0xa75, 0xa75, CM},
+	{0xa76, 0xa76, AL},
+	{0xa81, 0xa83, CM},
+	{0xa85, 0xa8d, AL},
+	{0xa8f, 0xa91, AL},
+	{0xa93, 0xaa8, AL},
+	{0xaaa, 0xab0, AL},
+	{0xab2, 0xab3, AL},
+	{0xab5, 0xab9, AL},
+	{0xabc, 0xabc, CM},
+	{0xabd, 0xabd, AL},
+	{0xabe, 0xac5, CM},
+	{0xac7, 0xac9, CM},
+	{0xacb, 0xacd, CM},
+	{0xad0, 0xad0, AL},
+	{0xae0, 0xae1, AL},
+	{0xae2, 0xae3, CM},
+	{0xae6, 0xaef, NU},
+	{0xaf0, 0xaf0, AL},
+	{0xaf1, 0xaf1, PR},
+	{0xaf9, 0xaf9, AL},
+	{0xafa, 0xaff, CM},
+	{0xb01, 0xb03, CM},
+	{0xb05, 0xb0c, AL},
+	{0xb0f, 0xb10, AL},
+	{0xb13, 0xb28, AL},
+	{0xb2a, 0xb30, AL},
+	{0xb32, 0xb33, AL},
+	{0xb35, 0xb39, AL},
+	{0xb3c, 0xb3c, CM},
+	{0xb3d, 0xb3d, AL},
+	{0xb3e, 0xb44, CM},
+	{0xb47, 0xb48, CM},
+	{0xb4b, 0xb4d, CM},
+	{0xb55, 0xb57, CM},
+	{0xb5c, 0xb5d, AL},
+	{0xb5f, 0xb61, AL},
+	{0xb62, 0xb63, CM},
+	{0xb66, 0xb6f, NU},
+	{0xb70, 0xb77, AL},
+	{0xb82, 0xb82, CM},
+	{0xb83, 0xb83, AL},
+	{0xb85, 0xb8a, AL},
+	{0xb8e, 0xb90, AL},
+	{0xb92, 0xb95, AL},
+	{0xb99, 0xb9a, AL},
+	{0xb9c, 0xb9c, AL},
+	{0xb9e, 0xb9f, AL},
+	{0xba3, 0xba4, AL},
+	{0xba8, 0xbaa, AL},
+	{0xbae, 0xbb9, AL},
+	{0xbbe, 0xbc2, CM},
+	{0xbc6, 0xbc8, CM},
+	{0xbca, 0xbcd, CM},
+	{0xbd0, 0xbd0, AL},
+	{0xbd7, 0xbd7, CM},
+	{0xbe6, 0xbef, NU},
+	{0xbf0, 0xbf8, AL},
+	{0xbf9, 0xbf9, PR},
+	{0xbfa, 0xbfa, AL},
+	{0xc00, 0xc04, CM},
+	{0xc05, 0xc0c, AL},
+	{0xc0e, 0xc10, AL},
+	{0xc12, 0xc28, AL},
+	{0xc2a, 0xc39, AL},
+	{0xc3c, 0xc3c, CM},
+	{0xc3d, 0xc3d, AL},
+	{0xc3e, 0xc44, CM},
+	{0xc46, 0xc48, CM},
+	{0xc4a, 0xc4d, CM},
+	{0xc55, 0xc56, CM},
+	{0xc58, 0xc5a, AL},
+	{0xc5d, 0xc5d, AL},
+	{0xc60, 0xc61, AL},
+	{0xc62, 0xc63, CM},
+	{0xc66, 0xc6f, NU},
+	{0xc77, 0xc77, BB},
+	{0xc78, 0xc80, AL},
+	{0xc81, 0xc83, CM},
+	{0xc84, 0xc84, BB},
+	{0xc85, 0xc8c, AL},
+	{0xc8e, 0xc90, AL},
+	{0xc92, 0xca8, AL},
+	{0xcaa, 0xcb3, AL},
+	{0xcb5, 0xcb9, AL},
+	{0xcbc, 0xcbc, CM},
+	{0xcbd, 0xcbd, AL},
+	{0xcbe, 0xcc4, CM},
+	{0xcc6, 0xcc8, CM},
+	{0xcca, 0xccd, CM},
+	{0xcd5, 0xcd6, CM},
+	{0xcdd, 0xcde, AL},
+	{0xce0, 0xce1, AL},
+	{0xce2, 0xce3, CM},
+	{0xce6, 0xcef, NU},
+	{0xcf1, 0xcf2, AL},
+	{0xd00, 0xd03, CM},
+	{0xd04, 0xd0c, AL},
+	{0xd0e, 0xd10, AL},
+	{0xd12, 0xd3a, AL},
+	{0xd3b, 0xd3c, CM},
+	{0xd3d, 0xd3d, AL},
+	{0xd3e, 0xd44, CM},
+	{0xd46, 0xd48, CM},
+	{0xd4a, 0xd4d, CM},
+	{0xd4e, 0xd4f, AL},
+	{0xd54, 0xd56, AL},
+	{0xd57, 0xd57, CM},
+	{0xd58, 0xd61, AL},
+	{0xd62, 0xd63, CM},
+	{0xd66, 0xd6f, NU},
+	{0xd70, 0xd78, AL},
+	{0xd79, 0xd79, PO},
+	{0xd7a, 0xd7f, AL},
+	{0xd81, 0xd83, CM},
+	{0xd85, 0xd96, AL},
+	{0xd9a, 0xdb1, AL},
+	{0xdb3, 0xdbb, AL},
+	{0xdbd, 0xdbd, AL},
+	{0xdc0, 0xdc6, AL},
+	{0xdca, 0xdca, CM},
+	{0xdcf, 0xdd4, CM},
+	{0xdd6, 0xdd6, CM},
+	{0xdd8, 0xddf, CM},
+	{0xde6, 0xdef, NU},
+	{0xdf2, 0xdf3, CM},
+	{0xdf4, 0xdf4, AL},
+	{0xe01, 0xe3a, SA},
+	{0xe3f, 0xe3f, PR},
+	{0xe40, 0xe4e, SA},
+	{0xe4f, 0xe4f, AL},
+	{0xe50, 0xe59, NU},
+	{0xe5a, 0xe5b, BA},
+	{0xe81, 0xe82, SA},
+	{0xe84, 0xe84, SA},
+	{0xe86, 0xe8a, SA},
+	{0xe8c, 0xea3, SA},
+	{0xea5, 0xea5, SA},
+	{0xea7, 0xebd, SA},
+	{0xec0, 0xec4, SA},
+	{0xec6, 0xec6, SA},
+	{0xec8, 0xecd, SA},
+	{0xed0, 0xed9, NU},
+	{0xedc, 0xedf, SA},
+	{0xf00, 0xf00, AL},
+	{0xf01, 0xf04, BB},
+	{0xf05, 0xf05, AL},
+	{0xf06, 0xf07, BB},
+	{0xf08, 0xf08, GL},
+	{0xf09, 0xf0a, BB},
+	{0xf0b, 0xf0b, BA},
+	{0xf0c, 0xf0c, GL},
+	{0xf0d, 0xf11, EX},
+	{0xf12, 0xf12, GL},
+	{0xf13, 0xf13, AL},
+	{0xf14, 0xf14, EX},
+	{0xf15, 0xf17, AL},
+	{0xf18, 0xf19, CM},
+	{0xf1a, 0xf1f, AL},
+	{0xf20, 0xf29, NU},
+	{0xf2a, 0xf33, AL},
+	{0xf34, 0xf34, BA},
+	{0xf35, 0xf35, CM},
+	{0xf36, 0xf36, AL},
+	{0xf37, 0xf37, CM},
+	{0xf38, 0xf38, AL},
+	{0xf39, 0xf39, CM},
+	{0xf3a, 0xf3a, OP},
+	{0xf3b, 0xf3b, CL},
+	{0xf3c, 0xf3c, OP},
+	{0xf3d, 0xf3d, CL},
+	{0xf3e, 0xf3f, CM},
+	{0xf40, 0xf47, AL},
+	{0xf49, 0xf6c, AL},
+	{0xf71, 0xf7e, CM},
+	{0xf7f, 0xf7f, BA},
+	{0xf80, 0xf84, CM},
+	{0xf85, 0xf85, BA},
+	{0xf86, 0xf87, CM},
+	{0xf88, 0xf8c, AL},
+	{0xf8d, 0xf97, CM},
+	{0xf99, 0xfbc, CM},
+	{0xfbe, 0xfbf, BA},
+	{0xfc0, 0xfc5, AL},
+	{0xfc6, 0xfc6, CM},
+	{0xfc7, 0xfcc, AL},
+	{0xfce, 0xfcf, AL},
+	{0xfd0, 0xfd1, BB},
+	{0xfd2, 0xfd2, BA},
+	{0xfd3, 0xfd3, BB},
+	{0xfd4, 0xfd8, AL},
+	{0xfd9, 0xfda, GL},
+	{0x1000, 0x103f, SA},
+	{0x1040, 0x1049, NU},
+	{0x104a, 0x104b, BA},
+	{0x104c, 0x104f, AL},
+	{0x1050, 0x108f, SA},
+	{0x1090, 0x1099, NU},
+	{0x109a, 0x109f, SA},
+	{0x10a0, 0x10c5, AL},
+	{0x10c7, 0x10c7, AL},
+	{0x10cd, 0x10cd, AL},
+	{0x10d0, 0x10ff, AL},
+	{0x1100, 0x115f, JL},
+	{0x1160, 0x11a7, JV},
+	{0x11a8, 0x11ff, JT},
+	{0x1200, 0x1248, AL},
+	{0x124a, 0x124d, AL},
+	{0x1250, 0x1256, AL},
+	{0x1258, 0x1258, AL},
+	{0x125a, 0x125d, AL},
+	{0x1260, 0x1288, AL},
+	{0x128a, 0x128d, AL},
+	{0x1290, 0x12b0, AL},
+	{0x12b2, 0x12b5, AL},
+	{0x12b8, 0x12be, AL},
+	{0x12c0, 0x12c0, AL},
+	{0x12c2, 0x12c5, AL},
+	{0x12c8, 0x12d6, AL},
+	{0x12d8, 0x1310, AL},
+	{0x1312, 0x1315, AL},
+	{0x1318, 0x135a, AL},
+	{0x135d, 0x135f, CM},
+	{0x1360, 0x1360, AL},
+	{0x1361, 0x1361, BA},
+	{0x1362, 0x137c, AL},
+	{0x1380, 0x1399, AL},
+	{0x13a0, 0x13f5, AL},
+	{0x13f8, 0x13fd, AL},
+	{0x1400, 0x1400, BA},
+	{0x1401, 0x167f, AL},
+	{0x1680, 0x1680, BA},
+	{0x1681, 0x169a, AL},
+	{0x169b, 0x169b, OP},
+	{0x169c, 0x169c, CL},
+	{0x16a0, 0x16ea, AL},
+	{0x16eb, 0x16ed, BA},
+	{0x16ee, 0x16f8, AL},
+	{0x1700, 0x1711, AL},
+	{0x1712, 0x1715, CM},
+	{0x171f, 0x1731, AL},
+	{0x1732, 0x1734, CM},
+	{0x1735, 0x1736, BA},
+	{0x1740, 0x1751, AL},
+	{0x1752, 0x1753, CM},
+	{0x1760, 0x176c, AL},
+	{0x176e, 0x1770, AL},
+	{0x1772, 0x1773, CM},
+	{0x1780, 0x17d3, SA},
+	{0x17d4, 0x17d5, BA},
+	{0x17d6, 0x17d6, NS},
+	{0x17d7, 0x17d7, SA},
+	{0x17d8, 0x17d8, BA},
+	{0x17d9, 0x17d9, AL},
+	{0x17da, 0x17da, BA},
+	{0x17db, 0x17db, PR},
+	{0x17dc, 0x17dd, SA},
+	{0x17e0, 0x17e9, NU},
+	{0x17f0, 0x17f9, AL},
+	{0x1800, 0x1801, AL},
+	{0x1802, 0x1803, EX},
+	{0x1804, 0x1805, BA},
+	{0x1806, 0x1806, BB},
+	{0x1807, 0x1807, AL},
+	{0x1808, 0x1809, EX},
+	{0x180a, 0x180a, AL},
+	{0x180b, 0x180d, CM},
+	{0x180e, 0x180e, GL},
+	{0x180f, 0x180f, CM},
+	{0x1810, 0x1819, NU},
+	{0x1820, 0x1878, AL},
+	{0x1880, 0x1884, AL},
+	{0x1885, 0x1886, CM},
+	{0x1887, 0x18a8, AL},
+	{0x18a9, 0x18a9, CM},
+	{0x18aa, 0x18aa, AL},
+	{0x18b0, 0x18f5, AL},
+	{0x1900, 0x191e, AL},
+	{0x1920, 0x192b, CM},
+	{0x1930, 0x193b, CM},
+	{0x1940, 0x1940, AL},
+	{0x1944, 0x1945, EX},
+	{0x1946, 0x194f, NU},
+	{0x1950, 0x196d, SA},
+	{0x1970, 0x1974, SA},
+	{0x1980, 0x19ab, SA},
+	{0x19b0, 0x19c9, SA},
+	{0x19d0, 0x19d9, NU},
+	{0x19da, 0x19da, SA},
+	{0x19de, 0x19df, SA},
+	{0x19e0, 0x1a16, AL},
+	{0x1a17, 0x1a1b, CM},
+	{0x1a1e, 0x1a1f, AL},
+	{0x1a20, 0x1a5e, SA},
+	{0x1a60, 0x1a7c, SA},
+	{0x1a7f, 0x1a7f, CM},
+	{0x1a80, 0x1a89, NU},
+	{0x1a90, 0x1a99, NU},
+	{0x1aa0, 0x1aad, SA},
+	{0x1ab0, 0x1ace, CM},
+	{0x1b00, 0x1b04, CM},
+	{0x1b05, 0x1b33, AL},
+	{0x1b34, 0x1b44, CM},
+	{0x1b45, 0x1b4c, AL},
+	{0x1b50, 0x1b59, NU},
+	{0x1b5a, 0x1b5b, BA},
+	{0x1b5c, 0x1b5c, AL},
+	{0x1b5d, 0x1b60, BA},
+	{0x1b61, 0x1b6a, AL},
+	{0x1b6b, 0x1b73, CM},
+	{0x1b74, 0x1b7c, AL},
+	{0x1b7d, 0x1b7e, BA},
+	{0x1b80, 0x1b82, CM},
+	{0x1b83, 0x1ba0, AL},
+	{0x1ba1, 0x1bad, CM},
+	{0x1bae, 0x1baf, AL},
+	{0x1bb0, 0x1bb9, NU},
+	{0x1bba, 0x1be5, AL},
+	{0x1be6, 0x1bf3, CM},
+	{0x1bfc, 0x1c23, AL},
+	{0x1c24, 0x1c37, CM},
+	{0x1c3b, 0x1c3f, BA},
+	{0x1c40, 0x1c49, NU},
+	{0x1c4d, 0x1c4f, AL},
+	{0x1c50, 0x1c59, NU},
+	{0x1c5a, 0x1c7d, AL},
+	{0x1c7e, 0x1c7f, BA},
+	{0x1c80, 0x1c88, AL},
+	{0x1c90, 0x1cba, AL},
+	{0x1cbd, 0x1cc7, AL},
+	{0x1cd0, 0x1cd2, CM},
+	{0x1cd3, 0x1cd3, AL},
+	{0x1cd4, 0x1ce8, CM},
+	{0x1ce9, 0x1cec, AL},
+	{0x1ced, 0x1ced, CM},
+	{0x1cee, 0x1cf3, AL},
+	{0x1cf4, 0x1cf4, CM},
+	{0x1cf5, 0x1cf6, AL},
+	{0x1cf7, 0x1cf9, CM},
+	{0x1cfa, 0x1cfa, AL},
+	{0x1d00, 0x1dbf, AL},
+	{0x1dc0, 0x1dff, CM},
+	{0x1e00, 0x1f15, AL},
+	{0x1f18, 0x1f1d, AL},
+	{0x1f20, 0x1f45, AL},
+	{0x1f48, 0x1f4d, AL},
+	{0x1f50, 0x1f57, AL},
+	{0x1f59, 0x1f59, AL},
+	{0x1f5b, 0x1f5b, AL},
+	{0x1f5d, 0x1f5d, AL},
+	{0x1f5f, 0x1f7d, AL},
+	{0x1f80, 0x1fb4, AL},
+	{0x1fb6, 0x1fc4, AL},
+	{0x1fc6, 0x1fd3, AL},
+	{0x1fd6, 0x1fdb, AL},
+	{0x1fdd, 0x1fef, AL},
+	{0x1ff2, 0x1ff4, AL},
+	{0x1ff6, 0x1ffc, AL},
+	{0x1ffd, 0x1ffd, BB},
+	{0x1ffe, 0x1ffe, AL},
+	{0x2000, 0x2006, BA},
+	{0x2007, 0x2007, GL},
+	{0x2008, 0x200a, BA},
+	{0x200b, 0x200b, ZW},
+	{0x200c, 0x200c, CM},
+	{0x200d, 0x200d, ZWJ},
+	{0x200e, 0x200f, CM},
+	{0x2010, 0x2010, BA},
+	{0x2011, 0x2011, GL},
+	{0x2012, 0x2013, BA},
+	{0x2014, 0x2014, B2},
+	{0x2015, 0x2016, AI},
+	{0x2017, 0x2017, AL},
+	{0x2018, 0x2019, QU},
+	{0x201a, 0x201a, OP},
+	{0x201b, 0x201d, QU},
+	{0x201e, 0x201e, OP},
+	{0x201f, 0x201f, QU},
+	{0x2020, 0x2021, AI},
+	{0x2022, 0x2023, AL},
+	{0x2024, 0x2026, IN},
+	{0x2027, 0x2027, BA},
+	{0x2028, 0x2029, BK},
+	{0x202a, 0x202e, CM},
+	{0x202f, 0x202f, GL},
+	{0x2030, 0x2037, PO},
+	{0x2038, 0x2038, AL},
+	{0x2039, 0x203a, QU},
+	{0x203b, 0x203b, AI},
+	{0x203c, 0x203d, NS},
+	{0x203e, 0x2043, AL},
+	{0x2044, 0x2044, IS},
+	{0x2045, 0x2045, OP},
+	{0x2046, 0x2046, CL},
+	{0x2047, 0x2049, NS},
+	{0x204a, 0x2055, AL},
+	{0x2056, 0x2056, BA},
+	{0x2057, 0x2057, AL},
+	{0x2058, 0x205b, BA},
+	{0x205c, 0x205c, AL},
+	{0x205d, 0x205f, BA},
+	{0x2060, 0x2060, WJ},
+	{0x2061, 0x2064, AL},
+	{0x2066, 0x206f, CM},
+	{0x2070, 0x2071, AL},
+	{0x2074, 0x2074, AI},
+	{0x2075, 0x207c, AL},
+	{0x207d, 0x207d, OP},
+	{0x207e, 0x207e, CL},
+	{0x207f, 0x207f, AI},
+	{0x2080, 0x2080, AL},
+	{0x2081, 0x2084, AI},
+	{0x2085, 0x208c, AL},
+	{0x208d, 0x208d, OP},
+	{0x208e, 0x208e, CL},
+	{0x2090, 0x209c, AL},
+	{0x20a0, 0x20a6, PR},
+	{0x20a7, 0x20a7, PO},
+	{0x20a8, 0x20b5, PR},
+	{0x20b6, 0x20b6, PO},
+	{0x20b7, 0x20ba, PR},
+	{0x20bb, 0x20bb, PO},
+	{0x20bc, 0x20bd, PR},
+	{0x20be, 0x20be, PO},
+	{0x20bf, 0x20bf, PR},
+	{0x20c0, 0x20c0, PO},
+	{0x20c1, 0x20cf, PR},
+	{0x20d0, 0x20f0, CM},
+	{0x2100, 0x2102, AL},
+	{0x2103, 0x2103, PO},
+	{0x2104, 0x2104, AL},
+	{0x2105, 0x2105, AI},
+	{0x2106, 0x2108, AL},
+	{0x2109, 0x2109, PO},
+	{0x210a, 0x2112, AL},
+	{0x2113, 0x2113, AI},
+	{0x2114, 0x2115, AL},
+	{0x2116, 0x2116, PR},
+	{0x2117, 0x2120, AL},
+	{0x2121, 0x2122, AI},
+	{0x2123, 0x212a, AL},
+	{0x212b, 0x212b, AI},
+	{0x212c, 0x2153, AL},
+	{0x2154, 0x2155, AI},
+	{0x2156, 0x215a, AL},
+	{0x215b, 0x215b, AI},
+	{0x215c, 0x215d, AL},
+	{0x215e, 0x215e, AI},
+	{0x215f, 0x215f, AL},
+	{0x2160, 0x216b, AI},
+	{0x216c, 0x216f, AL},
+	{0x2170, 0x2179, AI},
+	{0x217a, 0x2188, AL},
+	{0x2189, 0x2189, AI},
+	{0x218a, 0x218b, AL},
+	{0x2190, 0x2199, AI},
+	{0x219a, 0x21d1, AL},
+	{0x21d2, 0x21d2, AI},
+	{0x21d3, 0x21d3, AL},
+	{0x21d4, 0x21d4, AI},
+	{0x21d5, 0x21ff, AL},
+	{0x2200, 0x2200, AI},
+	{0x2201, 0x2201, AL},
+	{0x2202, 0x2203, AI},
+	{0x2204, 0x2206, AL},
+	{0x2207, 0x2208, AI},
+	{0x2209, 0x220a, AL},
+	{0x220b, 0x220b, AI},
+	{0x220c, 0x220e, AL},
+	{0x220f, 0x220f, AI},
+	{0x2210, 0x2210, AL},
+	{0x2211, 0x2211, AI},
+	{0x2212, 0x2213, PR},
+	{0x2214, 0x2214, AL},
+	{0x2215, 0x2215, AI},
+	{0x2216, 0x2219, AL},
+	{0x221a, 0x221a, AI},
+	{0x221b, 0x221c, AL},
+	{0x221d, 0x2220, AI},
+	{0x2221, 0x2222, AL},
+	{0x2223, 0x2223, AI},
+	{0x2224, 0x2224, AL},
+	{0x2225, 0x2225, AI},
+	{0x2226, 0x2226, AL},
+	{0x2227, 0x222c, AI},
+	{0x222d, 0x222d, AL},
+	{0x222e, 0x222e, AI},
+	{0x222f, 0x2233, AL},
+	{0x2234, 0x2237, AI},
+	{0x2238, 0x223b, AL},
+	{0x223c, 0x223d, AI},
+	{0x223e, 0x2247, AL},
+	{0x2248, 0x2248, AI},
+	{0x2249, 0x224b, AL},
+	{0x224c, 0x224c, AI},
+	{0x224d, 0x2251, AL},
+	{0x2252, 0x2252, AI},
+	{0x2253, 0x225f, AL},
+	{0x2260, 0x2261, AI},
+	{0x2262, 0x2263, AL},
+	{0x2264, 0x2267, AI},
+	{0x2268, 0x2269, AL},
+	{0x226a, 0x226b, AI},
+	{0x226c, 0x226d, AL},
+	{0x226e, 0x226f, AI},
+	{0x2270, 0x2281, AL},
+	{0x2282, 0x2283, AI},
+	{0x2284, 0x2285, AL},
+	{0x2286, 0x2287, AI},
+	{0x2288, 0x2294, AL},
+	{0x2295, 0x2295, AI},
+	{0x2296, 0x2298, AL},
+	{0x2299, 0x2299, AI},
+	{0x229a, 0x22a4, AL},
+	{0x22a5, 0x22a5, AI},
+	{0x22a6, 0x22be, AL},
+	{0x22bf, 0x22bf, AI},
+	{0x22c0, 0x22ee, AL},
+	{0x22ef, 0x22ef, IN},
+	{0x22f0, 0x2307, AL},
+	{0x2308, 0x2308, OP},
+	{0x2309, 0x2309, CL},
+	{0x230a, 0x230a, OP},
+	{0x230b, 0x230b, CL},
+	{0x230c, 0x2311, AL},
+	{0x2312, 0x2312, AI},
+	{0x2313, 0x2319, AL},
+	{0x231a, 0x231b, ID},
+	{0x231c, 0x2328, AL},
+	{0x2329, 0x2329, OP},
+	{0x232a, 0x232a, CL},
+	{0x232b, 0x23ef, AL},
+	{0x23f0, 0x23f3, ID},
+	{0x23f4, 0x2426, AL},
+	{0x2440, 0x244a, AL},
+	{0x2460, 0x24fe, AI},
+	{0x24ff, 0x24ff, AL},
+	{0x2500, 0x254b, AI},
+	{0x254c, 0x254f, AL},
+	{0x2550, 0x2574, AI},
+	{0x2575, 0x257f, AL},
+	{0x2580, 0x258f, AI},
+	{0x2590, 0x2591, AL},
+	{0x2592, 0x2595, AI},
+	{0x2596, 0x259f, AL},
+	{0x25a0, 0x25a1, AI},
+	{0x25a2, 0x25a2, AL},
+	{0x25a3, 0x25a9, AI},
+	{0x25aa, 0x25b1, AL},
+	{0x25b2, 0x25b3, AI},
+	{0x25b4, 0x25b5, AL},
+	{0x25b6, 0x25b7, AI},
+	{0x25b8, 0x25bb, AL},
+	{0x25bc, 0x25bd, AI},
+	{0x25be, 0x25bf, AL},
+	{0x25c0, 0x25c1, AI},
+	{0x25c2, 0x25c5, AL},
+	{0x25c6, 0x25c8, AI},
+	{0x25c9, 0x25ca, AL},
+	{0x25cb, 0x25cb, AI},
+	{0x25cc, 0x25cd, AL},
+	{0x25ce, 0x25d1, AI},
+	{0x25d2, 0x25e1, AL},
+	{0x25e2, 0x25e5, AI},
+	{0x25e6, 0x25ee, AL},
+	{0x25ef, 0x25ef, AI},
+	{0x25f0, 0x25ff, AL},
+	{0x2600, 0x2603, ID},
+	{0x2604, 0x2604, AL},
+	{0x2605, 0x2606, AI},
+	{0x2607, 0x2608, AL},
+	{0x2609, 0x2609, AI},
+	{0x260a, 0x260d, AL},
+	{0x260e, 0x260f, AI},
+	{0x2610, 0x2613, AL},
+	{0x2614, 0x2615, ID},
+	{0x2616, 0x2617, AI},
+	{0x2618, 0x2618, ID},
+	{0x2619, 0x2619, AL},
+	{0x261a, 0x261c, ID},
+	{0x261d, 0x261d, EB},
+	{0x261e, 0x261f, ID},
+	{0x2620, 0x2638, AL},
+	{0x2639, 0x263b, ID},
+	{0x263c, 0x263f, AL},
+	{0x2640, 0x2640, AI},
+	{0x2641, 0x2641, AL},
+	{0x2642, 0x2642, AI},
+	{0x2643, 0x265f, AL},
+	{0x2660, 0x2661, AI},
+	{0x2662, 0x2662, AL},
+	{0x2663, 0x2665, AI},
+	{0x2666, 0x2666, AL},
+	{0x2667, 0x2667, AI},
+	{0x2668, 0x2668, ID},
+	{0x2669, 0x266a, AI},
+	{0x266b, 0x266b, AL},
+	{0x266c, 0x266d, AI},
+	{0x266e, 0x266e, AL},
+	{0x266f, 0x266f, AI},
+	{0x2670, 0x267e, AL},
+	{0x267f, 0x267f, ID},
+	{0x2680, 0x269d, AL},
+	{0x269e, 0x269f, AI},
+	{0x26a0, 0x26bc, AL},
+	{0x26bd, 0x26c8, ID},
+	{0x26c9, 0x26cc, AI},
+	{0x26cd, 0x26cd, ID},
+	{0x26ce, 0x26ce, AL},
+	{0x26cf, 0x26d1, ID},
+	{0x26d2, 0x26d2, AI},
+	{0x26d3, 0x26d4, ID},
+	{0x26d5, 0x26d7, AI},
+	{0x26d8, 0x26d9, ID},
+	{0x26da, 0x26db, AI},
+	{0x26dc, 0x26dc, ID},
+	{0x26dd, 0x26de, AI},
+	{0x26df, 0x26e1, ID},
+	{0x26e2, 0x26e2, AL},
+	{0x26e3, 0x26e3, AI},
+	{0x26e4, 0x26e7, AL},
+	{0x26e8, 0x26e9, AI},
+	{0x26ea, 0x26ea, ID},
+	{0x26eb, 0x26f0, AI},
+	{0x26f1, 0x26f5, ID},
+	{0x26f6, 0x26f6, AI},
+	{0x26f7, 0x26f8, ID},
+	{0x26f9, 0x26f9, EB},
+	{0x26fa, 0x26fa, ID},
+	{0x26fb, 0x26fc, AI},
+	{0x26fd, 0x2704, ID},
+	{0x2705, 0x2707, AL},
+	{0x2708, 0x2709, ID},
+	{0x270a, 0x270d, EB},
+	{0x270e, 0x2756, AL},
+	{0x2757, 0x2757, AI},
+	{0x2758, 0x275a, AL},
+	{0x275b, 0x2760, QU},
+	{0x2761, 0x2761, AL},
+	{0x2762, 0x2763, EX},
+	{0x2764, 0x2764, ID},
+	{0x2765, 0x2767, AL},
+	{0x2768, 0x2768, OP},
+	{0x2769, 0x2769, CL},
+	{0x276a, 0x276a, OP},
+	{0x276b, 0x276b, CL},
+	{0x276c, 0x276c, OP},
+	{0x276d, 0x276d, CL},
+	{0x276e, 0x276e, OP},
+	{0x276f, 0x276f, CL},
+	{0x2770, 0x2770, OP},
+	{0x2771, 0x2771, CL},
+	{0x2772, 0x2772, OP},
+	{0x2773, 0x2773, CL},
+	{0x2774, 0x2774, OP},
+	{0x2775, 0x2775, CL},
+	{0x2776, 0x2793, AI},
+	{0x2794, 0x27c4, AL},
+	{0x27c5, 0x27c5, OP},
+	{0x27c6, 0x27c6, CL},
+	{0x27c7, 0x27e5, AL},
+	{0x27e6, 0x27e6, OP},
+	{0x27e7, 0x27e7, CL},
+	{0x27e8, 0x27e8, OP},
+	{0x27e9, 0x27e9, CL},
+	{0x27ea, 0x27ea, OP},
+	{0x27eb, 0x27eb, CL},
+	{0x27ec, 0x27ec, OP},
+	{0x27ed, 0x27ed, CL},
+	{0x27ee, 0x27ee, OP},
+	{0x27ef, 0x27ef, CL},
+	{0x27f0, 0x2982, AL},
+	{0x2983, 0x2983, OP},
+	{0x2984, 0x2984, CL},
+	{0x2985, 0x2985, OP},
+	{0x2986, 0x2986, CL},
+	{0x2987, 0x2987, OP},
+	{0x2988, 0x2988, CL},
+	{0x2989, 0x2989, OP},
+	{0x298a, 0x298a, CL},
+	{0x298b, 0x298b, OP},
+	{0x298c, 0x298c, CL},
+	{0x298d, 0x298d, OP},
+	{0x298e, 0x298e, CL},
+	{0x298f, 0x298f, OP},
+	{0x2990, 0x2990, CL},
+	{0x2991, 0x2991, OP},
+	{0x2992, 0x2992, CL},
+	{0x2993, 0x2993, OP},
+	{0x2994, 0x2994, CL},
+	{0x2995, 0x2995, OP},
+	{0x2996, 0x2996, CL},
+	{0x2997, 0x2997, OP},
+	{0x2998, 0x2998, CL},
+	{0x2999, 0x29d7, AL},
+	{0x29d8, 0x29d8, OP},
+	{0x29d9, 0x29d9, CL},
+	{0x29da, 0x29da, OP},
+	{0x29db, 0x29db, CL},
+	{0x29dc, 0x29fb, AL},
+	{0x29fc, 0x29fc, OP},
+	{0x29fd, 0x29fd, CL},
+	{0x29fe, 0x2b54, AL},
+	{0x2b55, 0x2b59, AI},
+	{0x2b5a, 0x2b73, AL},
+	{0x2b76, 0x2b95, AL},
+	{0x2b97, 0x2cee, AL},
+	{0x2cef, 0x2cf1, CM},
+	{0x2cf2, 0x2cf3, AL},
+	{0x2cf9, 0x2cf9, EX},
+	{0x2cfa, 0x2cfc, BA},
+	{0x2cfd, 0x2cfd, AL},
+	{0x2cfe, 0x2cfe, EX},
+	{0x2cff, 0x2cff, BA},
+	{0x2d00, 0x2d25, AL},
+	{0x2d27, 0x2d27, AL},
+	{0x2d2d, 0x2d2d, AL},
+	{0x2d30, 0x2d67, AL},
+	{0x2d6f, 0x2d6f, AL},
+	{0x2d70, 0x2d70, BA},
+	{0x2d7f, 0x2d7f, CM},
+	{0x2d80, 0x2d96, AL},
+	{0x2da0, 0x2da6, AL},
+	{0x2da8, 0x2dae, AL},
+	{0x2db0, 0x2db6, AL},
+	{0x2db8, 0x2dbe, AL},
+	{0x2dc0, 0x2dc6, AL},
+	{0x2dc8, 0x2dce, AL},
+	{0x2dd0, 0x2dd6, AL},
+	{0x2dd8, 0x2dde, AL},
+	{0x2de0, 0x2dff, CM},
+	{0x2e00, 0x2e0d, QU},
+	{0x2e0e, 0x2e15, BA},
+	{0x2e16, 0x2e16, AL},
+	{0x2e17, 0x2e17, BA},
+	{0x2e18, 0x2e18, OP},
+	{0x2e19, 0x2e19, BA},
+	{0x2e1a, 0x2e1b, AL},
+	{0x2e1c, 0x2e1d, QU},
+	{0x2e1e, 0x2e1f, AL},
+	{0x2e20, 0x2e21, QU},
+	{0x2e22, 0x2e22, OP},
+	{0x2e23, 0x2e23, CL},
+	{0x2e24, 0x2e24, OP},
+	{0x2e25, 0x2e25, CL},
+	{0x2e26, 0x2e26, OP},
+	{0x2e27, 0x2e27, CL},
+	{0x2e28, 0x2e28, OP},
+	{0x2e29, 0x2e29, CL},
+	{0x2e2a, 0x2e2d, BA},
+	{0x2e2e, 0x2e2e, EX},
+	{0x2e2f, 0x2e2f, AL},
+	{0x2e30, 0x2e31, BA},
+	{0x2e32, 0x2e32, AL},
+	{0x2e33, 0x2e34, BA},
+	{0x2e35, 0x2e39, AL},
+	{0x2e3a, 0x2e3b, B2},
+	{0x2e3c, 0x2e3e, BA},
+	{0x2e3f, 0x2e3f, AL},
+	{0x2e40, 0x2e41, BA},
+	{0x2e42, 0x2e42, OP},
+	{0x2e43, 0x2e4a, BA},
+	{0x2e4b, 0x2e4b, AL},
+	{0x2e4c, 0x2e4c, BA},
+	{0x2e4d, 0x2e4d, AL},
+	{0x2e4e, 0x2e4f, BA},
+	{0x2e50, 0x2e52, AL},
+	{0x2e53, 0x2e54, EX},
+	{0x2e55, 0x2e55, OP},
+	{0x2e56, 0x2e56, CL},
+	{0x2e57, 0x2e57, OP},
+	{0x2e58, 0x2e58, CL},
+	{0x2e59, 0x2e59, OP},
+	{0x2e5a, 0x2e5a, CL},
+	{0x2e5b, 0x2e5b, OP},
+	{0x2e5c, 0x2e5c, CL},
+	{0x2e5d, 0x2e5d, BA},
+	{0x2e80, 0x2e99, ID},
+	{0x2e9b, 0x2ef3, ID},
+	{0x2f00, 0x2fd5, ID},
+	{0x2ff0, 0x2ffb, ID},
+	{0x3000, 0x3000, BA},
+	{0x3001, 0x3002, CL},
+	{0x3003, 0x3004, ID},
+	{0x3005, 0x3005, NS},
+	{0x3006, 0x3007, ID},
+	{0x3008, 0x3008, OP},
+	{0x3009, 0x3009, CL},
+	{0x300a, 0x300a, OP},
+	{0x300b, 0x300b, CL},
+	{0x300c, 0x300c, OP},
+	{0x300d, 0x300d, CL},
+	{0x300e, 0x300e, OP},
+	{0x300f, 0x300f, CL},
+	{0x3010, 0x3010, OP},
+	{0x3011, 0x3011, CL},
+	{0x3012, 0x3013, ID},
+	{0x3014, 0x3014, OP},
+	{0x3015, 0x3015, CL},
+	{0x3016, 0x3016, OP},
+	{0x3017, 0x3017, CL},
+	{0x3018, 0x3018, OP},
+	{0x3019, 0x3019, CL},
+	{0x301a, 0x301a, OP},
+	{0x301b, 0x301b, CL},
+	{0x301c, 0x301c, NS},
+	{0x301d, 0x301d, OP},
+	{0x301e, 0x301f, CL},
+	{0x3020, 0x3029, ID},
+	{0x302a, 0x302f, CM},
+	{0x3030, 0x3034, ID},
+	{0x3035, 0x3035, CM},
+	{0x3036, 0x303a, ID},
+	{0x303b, 0x303c, NS},
+	{0x303d, 0x303f, ID},
+	{0x3041, 0x3041, CJ},
+	{0x3042, 0x3042, ID},
+	{0x3043, 0x3043, CJ},
+	{0x3044, 0x3044, ID},
+	{0x3045, 0x3045, CJ},
+	{0x3046, 0x3046, ID},
+	{0x3047, 0x3047, CJ},
+	{0x3048, 0x3048, ID},
+	{0x3049, 0x3049, CJ},
+	{0x304a, 0x3062, ID},
+	{0x3063, 0x3063, CJ},
+	{0x3064, 0x3082, ID},
+	{0x3083, 0x3083, CJ},
+	{0x3084, 0x3084, ID},
+	{0x3085, 0x3085, CJ},
+	{0x3086, 0x3086, ID},
+	{0x3087, 0x3087, CJ},
+	{0x3088, 0x308d, ID},
+	{0x308e, 0x308e, CJ},
+	{0x308f, 0x3094, ID},
+	{0x3095, 0x3096, CJ},
+	{0x3099, 0x309a, CM},
+	{0x309b, 0x309e, NS},
+	{0x309f, 0x309f, ID},
+	{0x30a0, 0x30a0, NS},
+	{0x30a1, 0x30a1, CJ},
+	{0x30a2, 0x30a2, ID},
+	{0x30a3, 0x30a3, CJ},
+	{0x30a4, 0x30a4, ID},
+	{0x30a5, 0x30a5, CJ},
+	{0x30a6, 0x30a6, ID},
+	{0x30a7, 0x30a7, CJ},
+	{0x30a8, 0x30a8, ID},
+	{0x30a9, 0x30a9, CJ},
+	{0x30aa, 0x30c2, ID},
+	{0x30c3, 0x30c3, CJ},
+	{0x30c4, 0x30e2, ID},
+	{0x30e3, 0x30e3, CJ},
+	{0x30e4, 0x30e4, ID},
+	{0x30e5, 0x30e5, CJ},
+	{0x30e6, 0x30e6, ID},
+	{0x30e7, 0x30e7, CJ},
+	{0x30e8, 0x30ed, ID},
+	{0x30ee, 0x30ee, CJ},
+	{0x30ef, 0x30f4, ID},
+	{0x30f5, 0x30f6, CJ},
+	{0x30f7, 0x30fa, ID},
+	{0x30fb, 0x30fb, NS},
+	{0x30fc, 0x30fc, CJ},
+	{0x30fd, 0x30fe, NS},
+	{0x30ff, 0x30ff, ID},
+	{0x3105, 0x312f, ID},
+	{0x3131, 0x318e, ID},
+	{0x3190, 0x31e3, ID},
+	{0x31f0, 0x31ff, CJ},
+	{0x3200, 0x321e, ID},
+	{0x3220, 0x3247, ID},
+	{0x3248, 0x324f, AI},
+	{0x3250, 0x4dbf, ID},
+	{0x4dc0, 0x4dff, AL},
+	{0x4e00, 0xa014, ID},
+	{0xa015, 0xa015, NS},
+	{0xa016, 0xa48c, ID},
+	{0xa490, 0xa4c6, ID},
+	{0xa4d0, 0xa4fd, AL},
+	{0xa4fe, 0xa4ff, BA},
+	{0xa500, 0xa60c, AL},
+	{0xa60d, 0xa60d, BA},
+	{0xa60e, 0xa60e, EX},
+	{0xa60f, 0xa60f, BA},
+	{0xa610, 0xa61f, AL},
+	{0xa620, 0xa629, NU},
+	{0xa62a, 0xa62b, AL},
+	{0xa640, 0xa66e, AL},
+	{0xa66f, 0xa672, CM},
+	{0xa673, 0xa673, AL},
+	{0xa674, 0xa67d, CM},
+	{0xa67e, 0xa69d, AL},
+	{0xa69e, 0xa69f, CM},
+	{0xa6a0, 0xa6ef, AL},
+	{0xa6f0, 0xa6f1, CM},
+	{0xa6f2, 0xa6f2, AL},
+	{0xa6f3, 0xa6f7, BA},
+	{0xa700, 0xa7ca, AL},
+	{0xa7d0, 0xa7d1, AL},
+	{0xa7d3, 0xa7d3, AL},
+	{0xa7d5, 0xa7d9, AL},
+	{0xa7f2, 0xa801, AL},
+	{0xa802, 0xa802, CM},
+	{0xa803, 0xa805, AL},
+	{0xa806, 0xa806, CM},
+	{0xa807, 0xa80a, AL},
+	{0xa80b, 0xa80b, CM},
+	{0xa80c, 0xa822, AL},
+	{0xa823, 0xa827, CM},
+	{0xa828, 0xa82b, AL},
+	{0xa82c, 0xa82c, CM},
+	{0xa830, 0xa837, AL},
+	{0xa838, 0xa838, PO},
+	{0xa839, 0xa839, AL},
+	{0xa840, 0xa873, AL},
+	{0xa874, 0xa875, BB},
+	{0xa876, 0xa877, EX},
+	{0xa880, 0xa881, CM},
+	{0xa882, 0xa8b3, AL},
+	{0xa8b4, 0xa8c5, CM},
+	{0xa8ce, 0xa8cf, BA},
+	{0xa8d0, 0xa8d9, NU},
+	{0xa8e0, 0xa8f1, CM},
+	{0xa8f2, 0xa8fb, AL},
+	{0xa8fc, 0xa8fc, BB},
+	{0xa8fd, 0xa8fe, AL},
+	{0xa8ff, 0xa8ff, CM},
+	{0xa900, 0xa909, NU},
+	{0xa90a, 0xa925, AL},
+	{0xa926, 0xa92d, CM},
+	{0xa92e, 0xa92f, BA},
+	{0xa930, 0xa946, AL},
+	{0xa947, 0xa953, CM},
+	{0xa95f, 0xa95f, AL},
+	{0xa960, 0xa97c, JL},
+	{0xa980, 0xa983, CM},
+	{0xa984, 0xa9b2, AL},
+	{0xa9b3, 0xa9c0, CM},
+	{0xa9c1, 0xa9c6, AL},
+	{0xa9c7, 0xa9c9, BA},
+	{0xa9ca, 0xa9cd, AL},
+	{0xa9cf, 0xa9cf, AL},
+	{0xa9d0, 0xa9d9, NU},
+	{0xa9de, 0xa9df, AL},
+	{0xa9e0, 0xa9ef, SA},
+	{0xa9f0, 0xa9f9, NU},
+	{0xa9fa, 0xa9fe, SA},
+	{0xaa00, 0xaa28, AL},
+	{0xaa29, 0xaa36, CM},
+	{0xaa40, 0xaa42, AL},
+	{0xaa43, 0xaa43, CM},
+	{0xaa44, 0xaa4b, AL},
+	{0xaa4c, 0xaa4d, CM},
+	{0xaa50, 0xaa59, NU},
+	{0xaa5c, 0xaa5c, AL},
+	{0xaa5d, 0xaa5f, BA},
+	{0xaa60, 0xaac2, SA},
+	{0xaadb, 0xaadf, SA},
+	{0xaae0, 0xaaea, AL},
+	{0xaaeb, 0xaaef, CM},
+	{0xaaf0, 0xaaf1, BA},
+	{0xaaf2, 0xaaf4, AL},
+	{0xaaf5, 0xaaf6, CM},
+	{0xab01, 0xab06, AL},
+	{0xab09, 0xab0e, AL},
+	{0xab11, 0xab16, AL},
+	{0xab20, 0xab26, AL},
+	{0xab28, 0xab2e, AL},
+	{0xab30, 0xab6b, AL},
+	{0xab70, 0xabe2, AL},
+	{0xabe3, 0xabea, CM},
+	{0xabeb, 0xabeb, BA},
+	{0xabec, 0xabed, CM},
+	{0xabf0, 0xabf9, NU},
+	{0xd7b0, 0xd7c6, JV},
+	{0xd7cb, 0xd7fb, JT},
+	{0xd800, 0xdfff, SG},
+	{0xf900, 0xfaff, ID},
+	{0xfb00, 0xfb06, AL},
+	{0xfb13, 0xfb17, AL},
+	{0xfb1d, 0xfb1d, HL},
+	{0xfb1e, 0xfb1e, CM},
+	{0xfb1f, 0xfb28, HL},
+	{0xfb29, 0xfb29, AL},
+	{0xfb2a, 0xfb36, HL},
+	{0xfb38, 0xfb3c, HL},
+	{0xfb3e, 0xfb3e, HL},
+	{0xfb40, 0xfb41, HL},
+	{0xfb43, 0xfb44, HL},
+	{0xfb46, 0xfb4f, HL},
+	{0xfb50, 0xfbc2, AL},
+	{0xfbd3, 0xfd3d, AL},
+	{0xfd3e, 0xfd3e, CL},
+	{0xfd3f, 0xfd3f, OP},
+	{0xfd40, 0xfd8f, AL},
+	{0xfd92, 0xfdc7, AL},
+	{0xfdcf, 0xfdcf, AL},
+	{0xfdf0, 0xfdfb, AL},
+	{0xfdfc, 0xfdfc, PO},
+	{0xfdfd, 0xfdff, AL},
+	{0xfe00, 0xfe0f, CM},
+	{0xfe10, 0xfe10, IS},
+	{0xfe11, 0xfe12, CL},
+	{0xfe13, 0xfe14, IS},
+	{0xfe15, 0xfe16, EX},
+	{0xfe17, 0xfe17, OP},
+	{0xfe18, 0xfe18, CL},
+	{0xfe19, 0xfe19, IN},
+	{0xfe20, 0xfe2f, CM},
+	{0xfe30, 0xfe34, ID},
+	{0xfe35, 0xfe35, OP},
+	{0xfe36, 0xfe36, CL},
+	{0xfe37, 0xfe37, OP},
+	{0xfe38, 0xfe38, CL},
+	{0xfe39, 0xfe39, OP},
+	{0xfe3a, 0xfe3a, CL},
+	{0xfe3b, 0xfe3b, OP},
+	{0xfe3c, 0xfe3c, CL},
+	{0xfe3d, 0xfe3d, OP},
+	{0xfe3e, 0xfe3e, CL},
+	{0xfe3f, 0xfe3f, OP},
+	{0xfe40, 0xfe40, CL},
+	{0xfe41, 0xfe41, OP},
+	{0xfe42, 0xfe42, CL},
+	{0xfe43, 0xfe43, OP},
+	{0xfe44, 0xfe44, CL},
+	{0xfe45, 0xfe46, ID},
+	{0xfe47, 0xfe47, OP},
+	{0xfe48, 0xfe48, CL},
+	{0xfe49, 0xfe4f, ID},
+	{0xfe50, 0xfe50, CL},
+	{0xfe51, 0xfe51, ID},
+	{0xfe52, 0xfe52, CL},
+	{0xfe54, 0xfe55, NS},
+	{0xfe56, 0xfe57, EX},
+	{0xfe58, 0xfe58, ID},
+	{0xfe59, 0xfe59, OP},
+	{0xfe5a, 0xfe5a, CL},
+	{0xfe5b, 0xfe5b, OP},
+	{0xfe5c, 0xfe5c, CL},
+	{0xfe5d, 0xfe5d, OP},
+	{0xfe5e, 0xfe5e, CL},
+	{0xfe5f, 0xfe66, ID},
+	{0xfe68, 0xfe68, ID},
+	{0xfe69, 0xfe69, PR},
+	{0xfe6a, 0xfe6a, PO},
+	{0xfe6b, 0xfe6b, ID},
+	{0xfe70, 0xfe74, AL},
+	{0xfe76, 0xfefc, AL},
+	{0xfeff, 0xfeff, WJ},
+	{0xff01, 0xff01, EX},
+	{0xff02, 0xff03, ID},
+	{0xff04, 0xff04, PR},
+	{0xff05, 0xff05, PO},
+	{0xff06, 0xff07, ID},
+	{0xff08, 0xff08, OP},
+	{0xff09, 0xff09, CL},
+	{0xff0a, 0xff0b, ID},
+	{0xff0c, 0xff0c, CL},
+	{0xff0d, 0xff0d, ID},
+	{0xff0e, 0xff0e, CL},
+	{0xff0f, 0xff19, ID},
+	{0xff1a, 0xff1b, NS},
+	{0xff1c, 0xff1e, ID},
+	{0xff1f, 0xff1f, EX},
+	{0xff20, 0xff3a, ID},
+	{0xff3b, 0xff3b, OP},
+	{0xff3c, 0xff3c, ID},
+	{0xff3d, 0xff3d, CL},
+	{0xff3e, 0xff5a, ID},
+	{0xff5b, 0xff5b, OP},
+	{0xff5c, 0xff5c, ID},
+	{0xff5d, 0xff5d, CL},
+	{0xff5e, 0xff5e, ID},
+	{0xff5f, 0xff5f, OP},
+	{0xff60, 0xff61, CL},
+	{0xff62, 0xff62, OP},
+	{0xff63, 0xff64, CL},
+	{0xff65, 0xff65, NS},
+	{0xff66, 0xff66, ID},
+	{0xff67, 0xff70, CJ},
+	{0xff71, 0xff9d, ID},
+	{0xff9e, 0xff9f, NS},
+	{0xffa0, 0xffbe, ID},
+	{0xffc2, 0xffc7, ID},
+	{0xffca, 0xffcf, ID},
+	{0xffd2, 0xffd7, ID},
+	{0xffda, 0xffdc, ID},
+	{0xffe0, 0xffe0, PO},
+	{0xffe1, 0xffe1, PR},
+	{0xffe2, 0xffe4, ID},
+	{0xffe5, 0xffe6, PR},
+	{0xffe8, 0xffee, AL},
+	{0xfff9, 0xfffb, CM},
+	{0xfffc, 0xfffc, CB},
+	{0xfffd, 0xfffd, AI},
+	{0x10000, 0x1000b, AL},
+	{0x1000d, 0x10026, AL},
+	{0x10028, 0x1003a, AL},
+	{0x1003c, 0x1003d, AL},
+	{0x1003f, 0x1004d, AL},
+	{0x10050, 0x1005d, AL},
+	{0x10080, 0x100fa, AL},
+	{0x10100, 0x10102, BA},
+	{0x10107, 0x10133, AL},
+	{0x10137, 0x1018e, AL},
+	{0x10190, 0x1019c, AL},
+	{0x101a0, 0x101a0, AL},
+	{0x101d0, 0x101fc, AL},
+	{0x101fd, 0x101fd, CM},
+	{0x10280, 0x1029c, AL},
+	{0x102a0, 0x102d0, AL},
+	{0x102e0, 0x102e0, CM},
+	{0x102e1, 0x102fb, AL},
+	{0x10300, 0x10323, AL},
+	{0x1032d, 0x1034a, AL},
+	{0x10350, 0x10375, AL},
+	{0x10376, 0x1037a, CM},
+	{0x10380, 0x1039d, AL},
+	{0x1039f, 0x1039f, BA},
+	{0x103a0, 0x103c3, AL},
+	{0x103c8, 0x103cf, AL},
+	{0x103d0, 0x103d0, BA},
+	{0x103d1, 0x103d5, AL},
+	{0x10400, 0x1049d, AL},
+	{0x104a0, 0x104a9, NU},
+	{0x104b0, 0x104d3, AL},
+	{0x104d8, 0x104fb, AL},
+	{0x10500, 0x10527, AL},
+	{0x10530, 0x10563, AL},
+	{0x1056f, 0x1057a, AL},
+	{0x1057c, 0x1058a, AL},
+	{0x1058c, 0x10592, AL},
+	{0x10594, 0x10595, AL},
+	{0x10597, 0x105a1, AL},
+	{0x105a3, 0x105b1, AL},
+	{0x105b3, 0x105b9, AL},
+	{0x105bb, 0x105bc, AL},
+	{0x10600, 0x10736, AL},
+	{0x10740, 0x10755, AL},
+	{0x10760, 0x10767, AL},
+	{0x10780, 0x10785, AL},
+	{0x10787, 0x107b0, AL},
+	{0x107b2, 0x107ba, AL},
+	{0x10800, 0x10805, AL},
+	{0x10808, 0x10808, AL},
+	{0x1080a, 0x10835, AL},
+	{0x10837, 0x10838, AL},
+	{0x1083c, 0x1083c, AL},
+	{0x1083f, 0x10855, AL},
+	{0x10857, 0x10857, BA},
+	{0x10858, 0x1089e, AL},
+	{0x108a7, 0x108af, AL},
+	{0x108e0, 0x108f2, AL},
+	{0x108f4, 0x108f5, AL},
+	{0x108fb, 0x1091b, AL},
+	{0x1091f, 0x1091f, BA},
+	{0x10920, 0x10939, AL},
+	{0x1093f, 0x1093f, AL},
+	{0x10980, 0x109b7, AL},
+	{0x109bc, 0x109cf, AL},
+	{0x109d2, 0x10a00, AL},
+	{0x10a01, 0x10a03, CM},
+	{0x10a05, 0x10a06, CM},
+	{0x10a0c, 0x10a0f, CM},
+	{0x10a10, 0x10a13, AL},
+	{0x10a15, 0x10a17, AL},
+	{0x10a19, 0x10a35, AL},
+	{0x10a38, 0x10a3a, CM},
+	{0x10a3f, 0x10a3f, CM},
+	{0x10a40, 0x10a48, AL},
+	{0x10a50, 0x10a57, BA},
+	{0x10a58, 0x10a58, AL},
+	{0x10a60, 0x10a9f, AL},
+	{0x10ac0, 0x10ae4, AL},
+	{0x10ae5, 0x10ae6, CM},
+	{0x10aeb, 0x10aef, AL},
+	{0x10af0, 0x10af5, BA},
+	{0x10af6, 0x10af6, IN},
+	{0x10b00, 0x10b35, AL},
+	{0x10b39, 0x10b3f, BA},
+	{0x10b40, 0x10b55, AL},
+	{0x10b58, 0x10b72, AL},
+	{0x10b78, 0x10b91, AL},
+	{0x10b99, 0x10b9c, AL},
+	{0x10ba9, 0x10baf, AL},
+	{0x10c00, 0x10c48, AL},
+	{0x10c80, 0x10cb2, AL},
+	{0x10cc0, 0x10cf2, AL},
+	{0x10cfa, 0x10d23, AL},
+	{0x10d24, 0x10d27, CM},
+	{0x10d30, 0x10d39, NU},
+	{0x10e60, 0x10e7e, AL},
+	{0x10e80, 0x10ea9, AL},
+	{0x10eab, 0x10eac, CM},
+	{0x10ead, 0x10ead, BA},
+	{0x10eb0, 0x10eb1, AL},
+	{0x10f00, 0x10f27, AL},
+	{0x10f30, 0x10f45, AL},
+	{0x10f46, 0x10f50, CM},
+	{0x10f51, 0x10f59, AL},
+	{0x10f70, 0x10f81, AL},
+	{0x10f82, 0x10f85, CM},
+	{0x10f86, 0x10f89, AL},
+	{0x10fb0, 0x10fcb, AL},
+	{0x10fe0, 0x10ff6, AL},
+	{0x11000, 0x11002, CM},
+	{0x11003, 0x11037, AL},
+	{0x11038, 0x11046, CM},
+	{0x11047, 0x11048, BA},
+	{0x11049, 0x1104d, AL},
+	{0x11052, 0x11065, AL},
+	{0x11066, 0x1106f, NU},
+	{0x11070, 0x11070, CM},
+	{0x11071, 0x11072, AL},
+	{0x11073, 0x11074, CM},
+	{0x11075, 0x11075, AL},
+	{0x1107f, 0x11082, CM},
+	{0x11083, 0x110af, AL},
+	{0x110b0, 0x110ba, CM},
+	{0x110bb, 0x110bd, AL},
+	{0x110be, 0x110c1, BA},
+	{0x110c2, 0x110c2, CM},
+	{0x110cd, 0x110cd, AL},
+	{0x110d0, 0x110e8, AL},
+	{0x110f0, 0x110f9, NU},
+	{0x11100, 0x11102, CM},
+	{0x11103, 0x11126, AL},
+	{0x11127, 0x11134, CM},
+	{0x11136, 0x1113f, NU},
+	{0x11140, 0x11143, BA},
+	{0x11144, 0x11144, AL},
+	{0x11145, 0x11146, CM},
+	{0x11147, 0x11147, AL},
+	{0x11150, 0x11172, AL},
+	{0x11173, 0x11173, CM},
+	{0x11174, 0x11174, AL},
+	{0x11175, 0x11175, BB},
+	{0x11176, 0x11176, AL},
+	{0x11180, 0x11182, CM},
+	{0x11183, 0x111b2, AL},
+	{0x111b3, 0x111c0, CM},
+	{0x111c1, 0x111c4, AL},
+	{0x111c5, 0x111c6, BA},
+	{0x111c7, 0x111c7, AL},
+	{0x111c8, 0x111c8, BA},
+	{0x111c9, 0x111cc, CM},
+	{0x111cd, 0x111cd, AL},
+	{0x111ce, 0x111cf, CM},
+	{0x111d0, 0x111d9, NU},
+	{0x111da, 0x111da, AL},
+	{0x111db, 0x111db, BB},
+	{0x111dc, 0x111dc, AL},
+	{0x111dd, 0x111df, BA},
+	{0x111e1, 0x111f4, AL},
+	{0x11200, 0x11211, AL},
+	{0x11213, 0x1122b, AL},
+	{0x1122c, 0x11237, CM},
+	{0x11238, 0x11239, BA},
+	{0x1123a, 0x1123a, AL},
+	{0x1123b, 0x1123c, BA},
+	{0x1123d, 0x1123d, AL},
+	{0x1123e, 0x1123e, CM},
+	{0x11280, 0x11286, AL},
+	{0x11288, 0x11288, AL},
+	{0x1128a, 0x1128d, AL},
+	{0x1128f, 0x1129d, AL},
+	{0x1129f, 0x112a8, AL},
+	{0x112a9, 0x112a9, BA},
+	{0x112b0, 0x112de, AL},
+	{0x112df, 0x112ea, CM},
+	{0x112f0, 0x112f9, NU},
+	{0x11300, 0x11303, CM},
+	{0x11305, 0x1130c, AL},
+	{0x1130f, 0x11310, AL},
+	{0x11313, 0x11328, AL},
+	{0x1132a, 0x11330, AL},
+	{0x11332, 0x11333, AL},
+	{0x11335, 0x11339, AL},
+	{0x1133b, 0x1133c, CM},
+	{0x1133d, 0x1133d, AL},
+	{0x1133e, 0x11344, CM},
+	{0x11347, 0x11348, CM},
+	{0x1134b, 0x1134d, CM},
+	{0x11350, 0x11350, AL},
+	{0x11357, 0x11357, CM},
+	{0x1135d, 0x11361, AL},
+	{0x11362, 0x11363, CM},
+	{0x11366, 0x1136c, CM},
+	{0x11370, 0x11374, CM},
+	{0x11400, 0x11434, AL},
+	{0x11435, 0x11446, CM},
+	{0x11447, 0x1144a, AL},
+	{0x1144b, 0x1144e, BA},
+	{0x1144f, 0x1144f, AL},
+	{0x11450, 0x11459, NU},
+	{0x1145a, 0x1145b, BA},
+	{0x1145d, 0x1145d, AL},
+	{0x1145e, 0x1145e, CM},
+	{0x1145f, 0x11461, AL},
+	{0x11480, 0x114af, AL},
+	{0x114b0, 0x114c3, CM},
+	{0x114c4, 0x114c7, AL},
+	{0x114d0, 0x114d9, NU},
+	{0x11580, 0x115ae, AL},
+	{0x115af, 0x115b5, CM},
+	{0x115b8, 0x115c0, CM},
+	{0x115c1, 0x115c1, BB},
+	{0x115c2, 0x115c3, BA},
+	{0x115c4, 0x115c5, EX},
+	{0x115c6, 0x115c8, AL},
+	{0x115c9, 0x115d7, BA},
+	{0x115d8, 0x115db, AL},
+	{0x115dc, 0x115dd, CM},
+	{0x11600, 0x1162f, AL},
+	{0x11630, 0x11640, CM},
+	{0x11641, 0x11642, BA},
+	{0x11643, 0x11644, AL},
+	{0x11650, 0x11659, NU},
+	{0x11660, 0x1166c, BB},
+	{0x11680, 0x116aa, AL},
+	{0x116ab, 0x116b7, CM},
+	{0x116b8, 0x116b9, AL},
+	{0x116c0, 0x116c9, NU},
+	{0x11700, 0x1171a, SA},
+	{0x1171d, 0x1172b, SA},
+	{0x11730, 0x11739, NU},
+	{0x1173a, 0x1173b, SA},
+	{0x1173c, 0x1173e, BA},
+	{0x1173f, 0x11746, SA},
+	{0x11800, 0x1182b, AL},
+	{0x1182c, 0x1183a, CM},
+	{0x1183b, 0x1183b, AL},
+	{0x118a0, 0x118df, AL},
+	{0x118e0, 0x118e9, NU},
+	{0x118ea, 0x118f2, AL},
+	{0x118ff, 0x11906, AL},
+	{0x11909, 0x11909, AL},
+	{0x1190c, 0x11913, AL},
+	{0x11915, 0x11916, AL},
+	{0x11918, 0x1192f, AL},
+	{0x11930, 0x11935, CM},
+	{0x11937, 0x11938, CM},
+	{0x1193b, 0x1193e, CM},
+	{0x1193f, 0x1193f, AL},
+	{0x11940, 0x11940, CM},
+	{0x11941, 0x11941, AL},
+	{0x11942, 0x11943, CM},
+	{0x11944, 0x11946, BA},
+	{0x11950, 0x11959, NU},
+	{0x119a0, 0x119a7, AL},
+	{0x119aa, 0x119d0, AL},
+	{0x119d1, 0x119d7, CM},
+	{0x119da, 0x119e0, CM},
+	{0x119e1, 0x119e1, AL},
+	{0x119e2, 0x119e2, BB},
+	{0x119e3, 0x119e3, AL},
+	{0x119e4, 0x119e4, CM},
+	{0x11a00, 0x11a00, AL},
+	{0x11a01, 0x11a0a, CM},
+	{0x11a0b, 0x11a32, AL},
+	{0x11a33, 0x11a39, CM},
+	{0x11a3a, 0x11a3a, AL},
+	{0x11a3b, 0x11a3e, CM},
+	{0x11a3f, 0x11a3f, BB},
+	{0x11a40, 0x11a40, AL},
+	{0x11a41, 0x11a44, BA},
+	{0x11a45, 0x11a45, BB},
+	{0x11a46, 0x11a46, AL},
+	{0x11a47, 0x11a47, CM},
+	{0x11a50, 0x11a50, AL},
+	{0x11a51, 0x11a5b, CM},
+	{0x11a5c, 0x11a89, AL},
+	{0x11a8a, 0x11a99, CM},
+	{0x11a9a, 0x11a9c, BA},
+	{0x11a9d, 0x11a9d, AL},
+	{0x11a9e, 0x11aa0, BB},
+	{0x11aa1, 0x11aa2, BA},
+	{0x11ab0, 0x11af8, AL},
+	{0x11c00, 0x11c08, AL},
+	{0x11c0a, 0x11c2e, AL},
+	{0x11c2f, 0x11c36, CM},
+	{0x11c38, 0x11c3f, CM},
+	{0x11c40, 0x11c40, AL},
+	{0x11c41, 0x11c45, BA},
+	{0x11c50, 0x11c59, NU},
+	{0x11c5a, 0x11c6c, AL},
+	{0x11c70, 0x11c70, BB},
+	{0x11c71, 0x11c71, EX},
+	{0x11c72, 0x11c8f, AL},
+	{0x11c92, 0x11ca7, CM},
+	{0x11ca9, 0x11cb6, CM},
+	{0x11d00, 0x11d06, AL},
+	{0x11d08, 0x11d09, AL},
+	{0x11d0b, 0x11d30, AL},
+	{0x11d31, 0x11d36, CM},
+	{0x11d3a, 0x11d3a, CM},
+	{0x11d3c, 0x11d3d, CM},
+	{0x11d3f, 0x11d45, CM},
+	{0x11d46, 0x11d46, AL},
+	{0x11d47, 0x11d47, CM},
+	{0x11d50, 0x11d59, NU},
+	{0x11d60, 0x11d65, AL},
+	{0x11d67, 0x11d68, AL},
+	{0x11d6a, 0x11d89, AL},
+	{0x11d8a, 0x11d8e, CM},
+	{0x11d90, 0x11d91, CM},
+	{0x11d93, 0x11d97, CM},
+	{0x11d98, 0x11d98, AL},
+	{0x11da0, 0x11da9, NU},
+	{0x11ee0, 0x11ef2, AL},
+	{0x11ef3, 0x11ef6, CM},
+	{0x11ef7, 0x11ef8, AL},
+	{0x11fb0, 0x11fb0, AL},
+	{0x11fc0, 0x11fdc, AL},
+	{0x11fdd, 0x11fe0, PO},
+	{0x11fe1, 0x11ff1, AL},
+	{0x11fff, 0x11fff, BA},
+	{0x12000, 0x12399, AL},
+	{0x12400, 0x1246e, AL},
+	{0x12470, 0x12474, BA},
+	{0x12480, 0x12543, AL},
+	{0x12f90, 0x12ff2, AL},
+	{0x13000, 0x13257, AL},
+	{0x13258, 0x1325a, OP},
+	{0x1325b, 0x1325d, CL},
+	{0x1325e, 0x13281, AL},
+	{0x13282, 0x13282, CL},
+	{0x13283, 0x13285, AL},
+	{0x13286, 0x13286, OP},
+	{0x13287, 0x13287, CL},
+	{0x13288, 0x13288, OP},
+	{0x13289, 0x13289, CL},
+	{0x1328a, 0x13378, AL},
+	{0x13379, 0x13379, OP},
+	{0x1337a, 0x1337b, CL},
+	{0x1337c, 0x1342e, AL},
+	{0x13430, 0x13436, GL},
+	{0x13437, 0x13437, OP},
+	{0x13438, 0x13438, CL},
+	{0x14400, 0x145cd, AL},
+	{0x145ce, 0x145ce, OP},
+	{0x145cf, 0x145cf, CL},
+	{0x145d0, 0x14646, AL},
+	{0x16800, 0x16a38, AL},
+	{0x16a40, 0x16a5e, AL},
+	{0x16a60, 0x16a69, NU},
+	{0x16a6e, 0x16a6f, BA},
+	{0x16a70, 0x16abe, AL},
+	{0x16ac0, 0x16ac9, NU},
+	{0x16ad0, 0x16aed, AL},
+	{0x16af0, 0x16af4, CM},
+	{0x16af5, 0x16af5, BA},
+	{0x16b00, 0x16b2f, AL},
+	{0x16b30, 0x16b36, CM},
+	{0x16b37, 0x16b39, BA},
+	{0x16b3a, 0x16b43, AL},
+	{0x16b44, 0x16b44, BA},
+	{0x16b45, 0x16b45, AL},
+	{0x16b50, 0x16b59, NU},
+	{0x16b5b, 0x16b61, AL},
+	{0x16b63, 0x16b77, AL},
+	{0x16b7d, 0x16b8f, AL},
+	{0x16e40, 0x16e96, AL},
+	{0x16e97, 0x16e98, BA},
+	{0x16e99, 0x16e9a, AL},
+	{0x16f00, 0x16f4a, AL},
+	{0x16f4f, 0x16f4f, CM},
+	{0x16f50, 0x16f50, AL},
+	{0x16f51, 0x16f87, CM},
+	{0x16f8f, 0x16f92, CM},
+	{0x16f93, 0x16f9f, AL},
+	{0x16fe0, 0x16fe3, NS},
+	{0x16fe4, 0x16fe4, GL},
+	{0x16ff0, 0x16ff1, CM},
+	{0x17000, 0x187f7, ID},
+	{0x18800, 0x18aff, ID},
+	{0x18b00, 0x18cd5, AL},
+	{0x18d00, 0x18d08, ID},
+	{0x1aff0, 0x1aff3, AL},
+	{0x1aff5, 0x1affb, AL},
+	{0x1affd, 0x1affe, AL},
+	{0x1b000, 0x1b122, ID},
+	{0x1b150, 0x1b152, CJ},
+	{0x1b164, 0x1b167, CJ},
+	{0x1b170, 0x1b2fb, ID},
+	{0x1bc00, 0x1bc6a, AL},
+	{0x1bc70, 0x1bc7c, AL},
+	{0x1bc80, 0x1bc88, AL},
+	{0x1bc90, 0x1bc99, AL},
+	{0x1bc9c, 0x1bc9c, AL},
+	{0x1bc9d, 0x1bc9e, CM},
+	{0x1bc9f, 0x1bc9f, BA},
+	{0x1bca0, 0x1bca3, CM},
+	{0x1cf00, 0x1cf2d, CM},
+	{0x1cf30, 0x1cf46, CM},
+	{0x1cf50, 0x1cfc3, AL},
+	{0x1d000, 0x1d0f5, AL},
+	{0x1d100, 0x1d126, AL},
+	{0x1d129, 0x1d164, AL},
+	{0x1d165, 0x1d169, CM},
+	{0x1d16a, 0x1d16c, AL},
+	{0x1d16d, 0x1d182, CM},
+	{0x1d183, 0x1d184, AL},
+	{0x1d185, 0x1d18b, CM},
+	{0x1d18c, 0x1d1a9, AL},
+	{0x1d1aa, 0x1d1ad, CM},
+	{0x1d1ae, 0x1d1ea, AL},
+	{0x1d200, 0x1d241, AL},
+	{0x1d242, 0x1d244, CM},
+	{0x1d245, 0x1d245, AL},
+	{0x1d2e0, 0x1d2f3, AL},
+	{0x1d300, 0x1d356, AL},
+	{0x1d360, 0x1d378, AL},
+	{0x1d400, 0x1d454, AL},
+	{0x1d456, 0x1d49c, AL},
+	{0x1d49e, 0x1d49f, AL},
+	{0x1d4a2, 0x1d4a2, AL},
+	{0x1d4a5, 0x1d4a6, AL},
+	{0x1d4a9, 0x1d4ac, AL},
+	{0x1d4ae, 0x1d4b9, AL},
+	{0x1d4bb, 0x1d4bb, AL},
+	{0x1d4bd, 0x1d4c3, AL},
+	{0x1d4c5, 0x1d505, AL},
+	{0x1d507, 0x1d50a, AL},
+	{0x1d50d, 0x1d514, AL},
+	{0x1d516, 0x1d51c, AL},
+	{0x1d51e, 0x1d539, AL},
+	{0x1d53b, 0x1d53e, AL},
+	{0x1d540, 0x1d544, AL},
+	{0x1d546, 0x1d546, AL},
+	{0x1d54a, 0x1d550, AL},
+	{0x1d552, 0x1d6a5, AL},
+	{0x1d6a8, 0x1d7cb, AL},
+	{0x1d7ce, 0x1d7ff, NU},
+	{0x1d800, 0x1d9ff, AL},
+	{0x1da00, 0x1da36, CM},
+	{0x1da37, 0x1da3a, AL},
+	{0x1da3b, 0x1da6c, CM},
+	{0x1da6d, 0x1da74, AL},
+	{0x1da75, 0x1da75, CM},
+	{0x1da76, 0x1da83, AL},
+	{0x1da84, 0x1da84, CM},
+	{0x1da85, 0x1da86, AL},
+	{0x1da87, 0x1da8a, BA},
+	{0x1da8b, 0x1da8b, AL},
+	{0x1da9b, 0x1da9f, CM},
+	{0x1daa1, 0x1daaf, CM},
+	{0x1df00, 0x1df1e, AL},
+	{0x1e000, 0x1e006, CM},
+	{0x1e008, 0x1e018, CM},
+	{0x1e01b, 0x1e021, CM},
+	{0x1e023, 0x1e024, CM},
+	{0x1e026, 0x1e02a, CM},
+	{0x1e100, 0x1e12c, AL},
+	{0x1e130, 0x1e136, CM},
+	{0x1e137, 0x1e13d, AL},
+	{0x1e140, 0x1e149, NU},
+	{0x1e14e, 0x1e14f, AL},
+	{0x1e290, 0x1e2ad, AL},
+	{0x1e2ae, 0x1e2ae, CM},
+	{0x1e2c0, 0x1e2eb, AL},
+	{0x1e2ec, 0x1e2ef, CM},
+	{0x1e2f0, 0x1e2f9, NU},
+	{0x1e2ff, 0x1e2ff, PR},
+	{0x1e7e0, 0x1e7e6, AL},
+	{0x1e7e8, 0x1e7eb, AL},
+	{0x1e7ed, 0x1e7ee, AL},
+	{0x1e7f0, 0x1e7fe, AL},
+	{0x1e800, 0x1e8c4, AL},
+	{0x1e8c7, 0x1e8cf, AL},
+	{0x1e8d0, 0x1e8d6, CM},
+	{0x1e900, 0x1e943, AL},
+	{0x1e944, 0x1e94a, CM},
+	{0x1e94b, 0x1e94b, AL},
+	{0x1e950, 0x1e959, NU},
+	{0x1e95e, 0x1e95f, OP},
+	{0x1ec71, 0x1ecab, AL},
+	{0x1ecac, 0x1ecac, PO},
+	{0x1ecad, 0x1ecaf, AL},
+	{0x1ecb0, 0x1ecb0, PO},
+	{0x1ecb1, 0x1ecb4, AL},
+	{0x1ed01, 0x1ed3d, AL},
+	{0x1ee00, 0x1ee03, AL},
+	{0x1ee05, 0x1ee1f, AL},
+	{0x1ee21, 0x1ee22, AL},
+	{0x1ee24, 0x1ee24, AL},
+	{0x1ee27, 0x1ee27, AL},
+	{0x1ee29, 0x1ee32, AL},
+	{0x1ee34, 0x1ee37, AL},
+	{0x1ee39, 0x1ee39, AL},
+	{0x1ee3b, 0x1ee3b, AL},
+	{0x1ee42, 0x1ee42, AL},
+	{0x1ee47, 0x1ee47, AL},
+	{0x1ee49, 0x1ee49, AL},
+	{0x1ee4b, 0x1ee4b, AL},
+	{0x1ee4d, 0x1ee4f, AL},
+	{0x1ee51, 0x1ee52, AL},
+	{0x1ee54, 0x1ee54, AL},
+	{0x1ee57, 0x1ee57, AL},
+	{0x1ee59, 0x1ee59, AL},
+	{0x1ee5b, 0x1ee5b, AL},
+	{0x1ee5d, 0x1ee5d, AL},
+	{0x1ee5f, 0x1ee5f, AL},
+	{0x1ee61, 0x1ee62, AL},
+	{0x1ee64, 0x1ee64, AL},
+	{0x1ee67, 0x1ee6a, AL},
+	{0x1ee6c, 0x1ee72, AL},
+	{0x1ee74, 0x1ee77, AL},
+	{0x1ee79, 0x1ee7c, AL},
+	{0x1ee7e, 0x1ee7e, AL},
+	{0x1ee80, 0x1ee89, AL},
+	{0x1ee8b, 0x1ee9b, AL},
+	{0x1eea1, 0x1eea3, AL},
+	{0x1eea5, 0x1eea9, AL},
+	{0x1eeab, 0x1eebb, AL},
+	{0x1eef0, 0x1eef1, AL},
+	{0x1f000, 0x1f0ff, ID},
+	{0x1f100, 0x1f10c, AI},
+	{0x1f10d, 0x1f10f, ID},
+	{0x1f110, 0x1f12d, AI},
+	{0x1f12e, 0x1f12f, AL},
+	{0x1f130, 0x1f169, AI},
+	{0x1f16a, 0x1f16c, AL},
+	{0x1f16d, 0x1f16f, ID},
+	{0x1f170, 0x1f1ac, AI},
+	{0x1f1ad, 0x1f1e5, ID},
+	{0x1f1e6, 0x1f1ff, RI},
+	{0x1f200, 0x1f384, ID},
+	{0x1f385, 0x1f385, EB},
+	{0x1f386, 0x1f39b, ID},
+	{0x1f39c, 0x1f39d, AL},
+	{0x1f39e, 0x1f3b4, ID},
+	{0x1f3b5, 0x1f3b6, AL},
+	{0x1f3b7, 0x1f3bb, ID},
+	{0x1f3bc, 0x1f3bc, AL},
+	{0x1f3bd, 0x1f3c1, ID},
+	{0x1f3c2, 0x1f3c4, EB},
+	{0x1f3c5, 0x1f3c6, ID},
+	{0x1f3c7, 0x1f3c7, EB},
+	{0x1f3c8, 0x1f3c9, ID},
+	{0x1f3ca, 0x1f3cc, EB},
+	{0x1f3cd, 0x1f3fa, ID},
+	{0x1f3fb, 0x1f3ff, EM},
+	{0x1f400, 0x1f441, ID},
+	{0x1f442, 0x1f443, EB},
+	{0x1f444, 0x1f445, ID},
+	{0x1f446, 0x1f450, EB},
+	{0x1f451, 0x1f465, ID},
+	{0x1f466, 0x1f478, EB},
+	{0x1f479, 0x1f47b, ID},
+	{0x1f47c, 0x1f47c, EB},
+	{0x1f47d, 0x1f480, ID},
+	{0x1f481, 0x1f483, EB},
+	{0x1f484, 0x1f484, ID},
+	{0x1f485, 0x1f487, EB},
+	{0x1f488, 0x1f48e, ID},
+	{0x1f48f, 0x1f48f, EB},
+	{0x1f490, 0x1f490, ID},
+	{0x1f491, 0x1f491, EB},
+	{0x1f492, 0x1f49f, ID},
+	{0x1f4a0, 0x1f4a0, AL},
+	{0x1f4a1, 0x1f4a1, ID},
+	{0x1f4a2, 0x1f4a2, AL},
+	{0x1f4a3, 0x1f4a3, ID},
+	{0x1f4a4, 0x1f4a4, AL},
+	{0x1f4a5, 0x1f4a9, ID},
+	{0x1f4aa, 0x1f4aa, EB},
+	{0x1f4ab, 0x1f4ae, ID},
+	{0x1f4af, 0x1f4af, AL},
+	{0x1f4b0, 0x1f4b0, ID},
+	{0x1f4b1, 0x1f4b2, AL},
+	{0x1f4b3, 0x1f4ff, ID},
+	{0x1f500, 0x1f506, AL},
+	{0x1f507, 0x1f516, ID},
+	{0x1f517, 0x1f524, AL},
+	{0x1f525, 0x1f531, ID},
+	{0x1f532, 0x1f549, AL},
+	{0x1f54a, 0x1f573, ID},
+	{0x1f574, 0x1f575, EB},
+	{0x1f576, 0x1f579, ID},
+	{0x1f57a, 0x1f57a, EB},
+	{0x1f57b, 0x1f58f, ID},
+	{0x1f590, 0x1f590, EB},
+	{0x1f591, 0x1f594, ID},
+	{0x1f595, 0x1f596, EB},
+	{0x1f597, 0x1f5d3, ID},
+	{0x1f5d4, 0x1f5db, AL},
+	{0x1f5dc, 0x1f5f3, ID},
+	{0x1f5f4, 0x1f5f9, AL},
+	{0x1f5fa, 0x1f644, ID},
+	{0x1f645, 0x1f647, EB},
+	{0x1f648, 0x1f64a, ID},
+	{0x1f64b, 0x1f64f, EB},
+	{0x1f650, 0x1f675, AL},
+	{0x1f676, 0x1f678, QU},
+	{0x1f679, 0x1f67b, NS},
+	{0x1f67c, 0x1f67f, AL},
+	{0x1f680, 0x1f6a2, ID},
+	{0x1f6a3, 0x1f6a3, EB},
+	{0x1f6a4, 0x1f6b3, ID},
+	{0x1f6b4, 0x1f6b6, EB},
+	{0x1f6b7, 0x1f6bf, ID},
+	{0x1f6c0, 0x1f6c0, EB},
+	{0x1f6c1, 0x1f6cb, ID},
+	{0x1f6cc, 0x1f6cc, EB},
+	{0x1f6cd, 0x1f6ff, ID},
+	{0x1f700, 0x1f773, AL},
+	{0x1f774, 0x1f77f, ID},
+	{0x1f780, 0x1f7d4, AL},
+	{0x1f7d5, 0x1f7ff, ID},
+	{0x1f800, 0x1f80b, AL},
+	{0x1f80c, 0x1f80f, ID},
+	{0x1f810, 0x1f847, AL},
+	{0x1f848, 0x1f84f, ID},
+	{0x1f850, 0x1f859, AL},
+	{0x1f85a, 0x1f85f, ID},
+	{0x1f860, 0x1f887, AL},
+	{0x1f888, 0x1f88f, ID},
+	{0x1f890, 0x1f8ad, AL},
+	{0x1f8ae, 0x1f8ff, ID},
+	{0x1f900, 0x1f90b, AL},
+	{0x1f90c, 0x1f90c, EB},
+	{0x1f90d, 0x1f90e, ID},
+	{0x1f90f, 0x1f90f, EB},
+	{0x1f910, 0x1f917, ID},
+	{0x1f918, 0x1f91f, EB},
+	{0x1f920, 0x1f925, ID},
+	{0x1f926, 0x1f926, EB},
+	{0x1f927, 0x1f92f, ID},
+	{0x1f930, 0x1f939, EB},
+	{0x1f93a, 0x1f93b, ID},
+	{0x1f93c, 0x1f93e, EB},
+	{0x1f93f, 0x1f976, ID},
+	{0x1f977, 0x1f977, EB},
+	{0x1f978, 0x1f9b4, ID},
+	{0x1f9b5, 0x1f9b6, EB},
+	{0x1f9b7, 0x1f9b7, ID},
+	{0x1f9b8, 0x1f9b9, EB},
+	{0x1f9ba, 0x1f9ba, ID},
+	{0x1f9bb, 0x1f9bb, EB},
+	{0x1f9bc, 0x1f9cc, ID},
+	{0x1f9cd, 0x1f9cf, EB},
+	{0x1f9d0, 0x1f9d0, ID},
+	{0x1f9d1, 0x1f9dd, EB},
+	{0x1f9de, 0x1f9ff, ID},
+	{0x1fa00, 0x1fa53, AL},
+	{0x1fa54, 0x1fac2, ID},
+	{0x1fac3, 0x1fac5, EB},
+	{0x1fac6, 0x1faef, ID},
+	{0x1faf0, 0x1faf6, EB},
+	{0x1faf7, 0x1faff, ID},
+	{0x1fb00, 0x1fb92, AL},
+	{0x1fb94, 0x1fbca, AL},
+	{0x1fbf0, 0x1fbf9, NU},
+	{0x1fc00, 0x1fffd, ID},
+	{0x20000, 0x2fffd, ID},
+	{0x30000, 0x3fffd, ID},
+	{0xe0001, 0xe0001, CM},
+	{0xe0020, 0xe007f, CM},
+	{0xe0100, 0xe01ef, CM},
+}
+
+var eastAsianParenRanges = []runeRange{
+	{0x2329, 0x2329},
+	{0x3008, 0x3008},
+	{0x300a, 0x300a},
+	{0x300c, 0x300c},
+	{0x300e, 0x300e},
+	{0x3010, 0x3010},
+	{0x3014, 0x3014},
+	{0x3016, 0x3016},
+	{0x3018, 0x3018},
+	{0x301a, 0x301a},
+	{0x301d, 0x301d},
+	{0xfe17, 0xfe17},
+	{0xfe35, 0xfe35},
+	{0xfe37, 0xfe37},
+	{0xfe39, 0xfe39},
+	{0xfe3b, 0xfe3b},
+	{0xfe3d, 0xfe3d},
+	{0xfe3f, 0xfe3f},
+	{0xfe41, 0xfe41},
+	{0xfe43, 0xfe43},
+	{0xfe47, 0xfe47},
+	{0xfe59, 0xfe59},
+	{0xfe5b, 0xfe5b},
+	{0xfe5d, 0xfe5d},
+	{0xff08, 0xff08},
+	{0xff3b, 0xff3b},
+	{0xff5b, 0xff5b},
+	{0xff5f, 0xff5f},
+	{0xff62, 0xff62},
+}
+
+var reservedPictographicRanges = []runeRange{
+	{0x1f02c, 0x1f02f},
+	{0x1f094, 0x1f09f},
+	{0x1f0af, 0x1f0b0},
+	{0x1f0c0, 0x1f0c0},
+	{0x1f0d0, 0x1f0d0},
+	{0x1f0f6, 0x1f0ff},
+	{0x1f1ae, 0x1f1e5},
+	{0x1f203, 0x1f20f},
+	{0x1f23c, 0x1f23f},
+	{0x1f249, 0x1f24f},
+	{0x1f252, 0x1f25f},
+	{0x1f266, 0x1f2ff},
+	{0x1f6d8, 0x1f6dc},
+	{0x1f6ed, 0x1f6ef},
+	{0x1f6fd, 0x1f6ff},
+	{0x1f774, 0x1f77f},
+	{0x1f7d9, 0x1f7df},
+	{0x1f7ec, 0x1f7ef},
+	{0x1f7f1, 0x1f7ff},
+	{0x1f80c, 0x1f80f},
+	{0x1f848, 0x1f84f},
+	{0x1f85a, 0x1f85f},
+	{0x1f888, 0x1f88f},
+	{0x1f8ae, 0x1f8af},
+	{0x1f8b2, 0x1f8ff},
+	{0x1fa54, 0x1fa5f},
+	{0x1fa6e, 0x1fa6f},
+	{0x1fa75, 0x1fa77},
+	{0x1fa7d, 0x1fa7f},
+	{0x1fa87, 0x1fa8f},
+	{0x1faad, 0x1faaf},
+	{0x1fabb, 0x1fabf},
+	{0x1fac6, 0x1facf},
+	{0x1fada, 0x1fadf},
+	{0x1fae8, 0x1faef},
+	{0x1faf7, 0x1faff},
+	{0x1fc00, 0x1fffd},
+}
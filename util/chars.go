@@ -61,7 +61,7 @@ func IsControlChar(c rune) bool {
 //
 // [ASCII whitespace]: https://infra.spec.whatwg.org/#ascii-whitespace
 func IsAsciiWhitespace(c rune) bool {
-	whitespaceCodes := []rune{0x0009, 0x000a, 0x000c, 0x000d}
+	whitespaceCodes := []rune{0x0009, 0x000a, 0x000c, 0x000d, 0x0020}
 	return slices.Contains(whitespaceCodes, c)
 }
 
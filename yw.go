@@ -24,6 +24,8 @@ import (
 	"github.com/inseo-oh/yw/layout/builder"
 	"github.com/inseo-oh/yw/namespaces"
 	"github.com/inseo-oh/yw/platform"
+	"github.com/inseo-oh/yw/platform/fontdb"
+	"github.com/inseo-oh/yw/util"
 )
 
 func loadUserAgentCss() *cssom.Stylesheet {
@@ -61,13 +63,23 @@ type Browser struct {
 	DumpDom    bool // Dump DOM tree?
 	DumpLayout bool // Dump layout tree?
 	DumpPaint  bool // Dump paint tree?
+
+	// ScrollOffsets holds scroll positions of scroll containers, keyed by ID
+	// of their elements. These are applied after building the layout tree,
+	// and clamped to the scrollable area of each container.
+	ScrollOffsets map[string]image.Point
+
+	// FontDirs holds directories to search for fonts, in addition to fonts
+	// bundled in res/font.
+	FontDirs []string
+
+	// FontDB is the database fonts are selected from. If it's nil, it's
+	// created from FontDirs on the first layout.
+	FontDB *fontdb.Database
 }
 
 // Run loads the document from urlStr URL, and renders resulting document to viewportImg.
 func (b *Browser) Run(urlStr string, fontProvider platform.FontProvider, viewportImg *image.RGBA) {
-	log.Println("= Loading user agent CSS ====================================")
-	uaStylesheet := loadUserAgentCss()
-
 	// Fetch the document ------------------------------------------------------
 	log.Println("= Fetching document =========================================")
 	log.Printf("Document URL: %s", urlStr)
@@ -84,12 +96,20 @@ func (b *Browser) Run(urlStr string, fontProvider platform.FontProvider, viewpor
 	if err != nil {
 		log.Fatal(err)
 	}
+	b.Render(string(htmlBytes), *urlObj, fontProvider, viewportImg)
+}
+
+// Layout builds layout tree of the HTML document html, whose URL is docURL,
+// for the viewport of given size, and returns the initial containing block.
+func (b *Browser) Layout(html string, docURL url.URL, fontProvider platform.FontProvider, viewportWidth, viewportHeight int) layout.Box {
+	log.Println("= Loading user agent CSS ====================================")
+	uaStylesheet := loadUserAgentCss()
+
 	// Parse the HTML ----------------------------------------------------------
 	log.Println("= Parsing document ==========================================")
-	html := string(htmlBytes)
 	par := htmlparser.NewParser(html)
 	par.Document = dom.NewDocument()
-	par.Document.SetBaseURL(*urlObj)
+	par.Document.SetBaseURL(docURL)
 	doc := par.Run()
 	log.Println("= Document parsed ===========================================")
 	if b.DumpDom {
@@ -100,17 +120,48 @@ func (b *Browser) Run(urlStr string, fontProvider platform.FontProvider, viewpor
 	log.Println("= Applying style rules ======================================")
 	cascade.ApplyStyleRules(uaStylesheet, doc)
 
-	// Do something with it ----------------------------------------------------
+	// Build layout tree -------------------------------------------------------
 	log.Println("= Building layout tree ======================================")
+	htmlElem := doc.FilterElementChildrenByLocalName(dom.NamePair{Namespace: namespaces.Html, LocalName: "html"})[0]
+	if b.FontDB == nil {
+		log.Println("= Scanning fonts ============================================")
+		b.FontDB = fontdb.New()
+		for _, dir := range append([]string{"res/font"}, b.FontDirs...) {
+			b.FontDB.AddDir(dir)
+		}
+		log.Printf("Found %d font faces", len(b.FontDB.Faces()))
+	}
+	icb := builder.BuildLayout(htmlElem, float64(viewportWidth), float64(viewportHeight), fontProvider, b.FontDB)
+	if len(b.ScrollOffsets) != 0 {
+		b.applyScrollOffsets(icb)
+	}
+	return icb
+}
+
+// applyScrollOffsets scrolls bx and its descendants, using b.ScrollOffsets.
+func (b *Browser) applyScrollOffsets(bx layout.Box) {
+	if elem := bx.BoxElement(); !util.IsNil(elem) && bx.IsScrollContainer() {
+		if id, ok := elem.AttrWithoutNamespace("id"); ok {
+			if offset, ok := b.ScrollOffsets[id]; ok {
+				bx.ScrollTo(layout.PhysicalPos(offset.X), layout.PhysicalPos(offset.Y))
+			}
+		}
+	}
+	for _, child := range bx.ChildBoxes() {
+		b.applyScrollOffsets(child)
+	}
+}
+
+// Render renders the HTML document html, whose URL is docURL, to viewportImg.
+func (b *Browser) Render(html string, docURL url.URL, fontProvider platform.FontProvider, viewportImg *image.RGBA) {
 	viewportSize := viewportImg.Rect.Size()
+	icb := b.Layout(html, docURL, fontProvider, viewportSize.X, viewportSize.Y)
+
 	for y := range viewportSize.Y {
 		for x := range viewportSize.X {
 			viewportImg.Set(x, y, color.White)
 		}
 	}
-
-	htmlElem := doc.FilterElementChildrenByLocalName(dom.NamePair{Namespace: namespaces.Html, LocalName: "html"})[0]
-	icb := builder.BuildLayout(htmlElem, float64(viewportSize.X), float64(viewportSize.Y), fontProvider)
 	if b.DumpLayout {
 		layout.PrintTree(icb, 0)
 	}
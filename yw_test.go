@@ -0,0 +1,1255 @@
+// This file is part of YW project. Copyright 2025 Oh Inseo (YJK)
+// SPDX-License-Identifier: BSD-3-Clause
+// See LICENSE for details, and LICENSE_WHATWG_SPECS for WHATWG license information.
+
+package yw
+
+import (
+	"cmp"
+	"errors"
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"log"
+	"maps"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/inseo-oh/yw/css/fonts"
+	"github.com/inseo-oh/yw/dom"
+	"github.com/inseo-oh/yw/gfx"
+	"github.com/inseo-oh/yw/layout"
+	"github.com/inseo-oh/yw/platform"
+	"github.com/inseo-oh/yw/platform/fontdb"
+	"github.com/inseo-oh/yw/platform/linux"
+	"github.com/inseo-oh/yw/util"
+)
+
+var updateGoldens = flag.Bool("update", false, "update golden images of rendering tests")
+
+// Demo pages under res/demo/layout that have golden images next to them.
+// Since text is not rendered by the null font provider, these should not
+// depend on text to render anything meaningful.
+var goldenDemos = []string{
+	"background1",
+	"border1",
+	"border2",
+	"border3",
+	"flex1",
+	"grid1",
+	"image1",
+	"overflow1",
+	"position1",
+	"sizing1",
+	"table1",
+	"visibility1",
+}
+
+const (
+	goldenViewportWidth  = 640
+	goldenViewportHeight = 480
+)
+
+func TestRenderGoldens(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	for _, name := range goldenDemos {
+		t.Run(name, func(t *testing.T) {
+			htmlPath := "res/demo/layout/" + name + ".html"
+			pngPath := "res/demo/layout/" + name + ".png"
+			html, err := os.ReadFile(htmlPath)
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", htmlPath, err)
+			}
+			got := image.NewRGBA(image.Rect(0, 0, goldenViewportWidth, goldenViewportHeight))
+			br := Browser{}
+			br.Render(string(html), fileURLOf(t, htmlPath), linux.NewNullFontProvider(), got)
+
+			if *updateGoldens {
+				f, err := os.Create(pngPath)
+				if err != nil {
+					t.Fatalf("failed to create %s: %v", pngPath, err)
+				}
+				defer f.Close()
+				if err := png.Encode(f, got); err != nil {
+					t.Fatalf("failed to write %s: %v", pngPath, err)
+				}
+				return
+			}
+			f, err := os.Open(pngPath)
+			if err != nil {
+				t.Fatalf("failed to open %s (run with -update to create it): %v", pngPath, err)
+			}
+			defer f.Close()
+			goldenImg, err := png.Decode(f)
+			if err != nil {
+				t.Fatalf("failed to decode %s: %v", pngPath, err)
+			}
+			expected := image.NewRGBA(goldenImg.Bounds())
+			draw.Draw(expected, expected.Bounds(), goldenImg, goldenImg.Bounds().Min, draw.Src)
+			if expected.Bounds() != got.Bounds() {
+				t.Fatalf("expected image size %v, got %v", expected.Bounds(), got.Bounds())
+			}
+			diffCount := 0
+			var firstDiff image.Point
+			for y := range got.Bounds().Dy() {
+				for x := range got.Bounds().Dx() {
+					if expected.RGBAAt(x, y) != got.RGBAAt(x, y) {
+						if diffCount == 0 {
+							firstDiff = image.Pt(x, y)
+						}
+						diffCount++
+					}
+				}
+			}
+			if diffCount != 0 {
+				t.Errorf("%d pixels differ from %s (first one at %v: expected %v, got %v)",
+					diffCount, pngPath, firstDiff, expected.RGBAAt(firstDiff.X, firstDiff.Y), got.RGBAAt(firstDiff.X, firstDiff.Y))
+			}
+		})
+	}
+}
+
+// fileURLOf returns file URL of path, so that subresources are resolved
+// relative to it.
+func fileURLOf(t *testing.T, path string) url.URL {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		t.Fatalf("failed to get absolute path of %s: %v", path, err)
+	}
+	return url.URL{Scheme: "file", Path: absPath}
+}
+
+// findBoxByElementID returns the first box generated by the element with given
+// ID, or nil if there's no such box.
+func findBoxByElementID(bx layout.Box, id string) layout.Box {
+	if elem := bx.BoxElement(); !util.IsNil(elem) {
+		if v, ok := elem.AttrWithoutNamespace("id"); ok && v == id {
+			return bx
+		}
+	}
+	for _, child := range bx.ChildBoxes() {
+		if res := findBoxByElementID(child, id); res != nil {
+			return res
+		}
+	}
+	return nil
+}
+
+// layoutDemo lays out the demo page res/demo/layout/<name>.html, and returns
+// the initial containing block. Logs are discarded until the test finishes.
+func layoutDemo(t *testing.T, name string, fontProvider platform.FontProvider) layout.Box {
+	t.Helper()
+	log.SetOutput(io.Discard)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	htmlPath := "res/demo/layout/" + name + ".html"
+	html, err := os.ReadFile(htmlPath)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", htmlPath, err)
+	}
+	br := Browser{}
+	return br.Layout(string(html), fileURLOf(t, htmlPath), fontProvider, goldenViewportWidth, goldenViewportHeight)
+}
+
+// assertBorderBoxes checks border boxes of block containers generated by
+// elements with given IDs, in the physical coordinates.
+func assertBorderBoxes(t *testing.T, root layout.Box, expected map[string]layout.PhysicalRect) {
+	t.Helper()
+	for _, id := range slices.Sorted(maps.Keys(expected)) {
+		bx := findBoxByElementID(root, id)
+		if bx == nil {
+			t.Errorf("#%s: box not found", id)
+			continue
+		}
+		bcon, ok := bx.(*layout.BlockContainerBox)
+		if !ok {
+			t.Errorf("#%s: expected block container, got %T", id, bx)
+			continue
+		}
+		if got := bcon.BoxBorderRect().ToPhysicalRect(); got != expected[id] {
+			t.Errorf("#%s: expected border box %v, got %v", id, expected[id], got)
+		}
+	}
+}
+
+func TestMarginCollapsing(t *testing.T) {
+	// Expected vertical positions of border boxes. Note that the null font
+	// provider makes line boxes zero in height, but they still separate margins.
+	type expectedBox struct {
+		id            string
+		logicalY      layout.LogicalPos
+		logicalHeight layout.LogicalPos
+	}
+	tests := []struct {
+		name  string
+		boxes []expectedBox
+	}{
+		{"margin1", []expectedBox{
+			// Line boxes between them separate margins
+			{"mtop1", 10, 0},
+			{"mtop2", 30, 0},
+			{"mbot1", 30, 0},
+			{"mbot2", 40, 0},
+		}},
+		{"margin2", []expectedBox{
+			{"div1", 10, 120},
+			{"div2", 20, 100},
+			{"div3", 30, 80},
+			{"div4", 40, 60},
+			{"div5", 50, 40},
+			{"div6", 60, 20},
+			{"div7", 70, 0},
+		}},
+		{"margin3", []expectedBox{
+			// Vertical margins of inline boxes don't affect line boxes.
+			{"div1", 0, 0},
+			{"div2", 0, 0},
+			{"div3", 0, 140},
+			{"div4", 140, 140},
+		}},
+		{"margin4", []expectedBox{
+			// Siblings
+			{"siblings", 0, 80},
+			{"sib1", 0, 20},
+			{"sib2", 50, 20},
+			{"sib3", 60, 20},
+			// Parent and first child
+			{"parent-first", 80, 40},
+			{"pf-outer", 100, 20},
+			{"pf-inner", 100, 20},
+			// Parent and last child
+			{"parent-last", 120, 70},
+			{"pl-outer", 120, 20},
+			{"pl-inner", 120, 20},
+			{"pl-next", 170, 20},
+			// Empty box
+			{"empty", 190, 60},
+			{"e-before", 190, 20},
+			{"e-empty", 230, 0},
+			{"e-after", 230, 20},
+			// Positive and negative margins
+			{"negative", 250, 100},
+			{"n1", 250, 20},
+			{"n2", 290, 20},
+			{"n3", 330, 20},
+			// BFC root
+			{"bfc-root", 360, 40},
+			{"bfc-child", 380, 20},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			icb := layoutDemo(t, tt.name, linux.NewNullFontProvider())
+			for _, expected := range tt.boxes {
+				bx := findBoxByElementID(icb, expected.id)
+				if bx == nil {
+					t.Errorf("#%s: box not found", expected.id)
+					continue
+				}
+				bcon, ok := bx.(*layout.BlockContainerBox)
+				if !ok {
+					t.Errorf("#%s: expected block container, got %T", expected.id, bx)
+					continue
+				}
+				rect := bcon.BoxBorderRect()
+				if rect.LogicalY != expected.logicalY || rect.LogicalHeight != expected.logicalHeight {
+					t.Errorf("#%s: expected y=%g height=%g, got y=%g height=%g",
+						expected.id, expected.logicalY, expected.logicalHeight, rect.LogicalY, rect.LogicalHeight)
+				}
+			}
+		})
+	}
+}
+
+func TestPositionedLayout(t *testing.T) {
+	icb := layoutDemo(t, "position1", linux.NewNullFontProvider())
+	assertBorderBoxes(t, icb, map[string]layout.PhysicalRect{
+		// Relatively positioned boxes don't affect boxes that follow.
+		"rel":      {Left: 20, Top: 10, Width: 100, Height: 20},
+		"rel-next": {Left: 0, Top: 20, Width: 640, Height: 20},
+		// Absolutely positioned boxes are placed against the padding box of #container.
+		"container":       {Left: 50, Top: 40, Width: 330, Height: 180},
+		"abs-tl":          {Left: 75, Top: 55, Width: 50, Height: 50},
+		"abs-br":          {Left: 325, Top: 165, Width: 40, Height: 40},
+		"abs-stretch":     {Left: 155, Top: 45, Width: 70, Height: 170},
+		"abs-static":      {Left: 65, Top: 55, Width: 30, Height: 10},
+		"abs-center":      {Left: 185, Top: 115, Width: 60, Height: 30},
+		"abs-end":         {Left: 335, Top: 45, Width: 40, Height: 10},
+		"after-container": {Left: 0, Top: 220, Width: 640, Height: 20},
+		// Fixed boxes are placed against the viewport.
+		"fixed": {Left: 620, Top: 0, Width: 20, Height: 20},
+		// Sticky boxes don't leave their containing block.
+		"sticky": {Left: 0, Top: 340, Width: 640, Height: 20},
+	})
+}
+
+func TestFlexLayout(t *testing.T) {
+	icb := layoutDemo(t, "flex1", linux.NewNullFontProvider())
+	assertBorderBoxes(t, icb, map[string]layout.PhysicalRect{
+		// Free space is distributed according to flex-grow, and items are stretched.
+		"grow1":      {Left: 0, Top: 0, Width: 100, Height: 50},
+		"grow2":      {Left: 100, Top: 0, Width: 200, Height: 50},
+		"inflexible": {Left: 300, Top: 0, Width: 100, Height: 50},
+		// Negative free space is distributed according to flex-shrink * flex base size.
+		"shrink1":  {Left: 0, Top: 50, Width: 175, Height: 20},
+		"shrink3":  {Left: 175, Top: 50, Width: 125, Height: 20},
+		"justify1": {Left: 0, Top: 80, Width: 50, Height: 10},
+		"justify2": {Left: 125, Top: 80, Width: 50, Height: 10},
+		"justify3": {Left: 250, Top: 80, Width: 50, Height: 10},
+		// Items that don't fit go to the next line.
+		"wrap":        {Left: 0, Top: 100, Width: 200, Height: 45},
+		"wrap1":       {Left: 0, Top: 100, Width: 90, Height: 20},
+		"wrap2":       {Left: 100, Top: 100, Width: 90, Height: 20},
+		"wrap3":       {Left: 0, Top: 125, Width: 90, Height: 20},
+		"col-stretch": {Left: 0, Top: 175, Width: 100, Height: 20},
+		"col-end":     {Left: 70, Top: 195, Width: 30, Height: 20},
+		"rev1":        {Left: 150, Top: 245, Width: 50, Height: 10},
+		"rev2":        {Left: 100, Top: 245, Width: 50, Height: 10},
+		"after":       {Left: 0, Top: 255, Width: 640, Height: 10},
+	})
+}
+
+func TestGridLayout(t *testing.T) {
+	icb := layoutDemo(t, "grid1", linux.NewNullFontProvider())
+	assertBorderBoxes(t, icb, map[string]layout.PhysicalRect{
+		// Flexible tracks share the space left by fixed tracks and gaps.
+		"t1":     {Left: 0, Top: 0, Width: 100, Height: 20},
+		"t2":     {Left: 110, Top: 0, Width: 100, Height: 20},
+		"t3":     {Left: 220, Top: 0, Width: 200, Height: 20},
+		"t4":     {Left: 0, Top: 25, Width: 100, Height: 20},
+		"tracks": {Left: 0, Top: 0, Width: 420, Height: 45},
+		// Items with definite positions are placed first, and others fill the remaining cells.
+		"p-span":  {Left: 0, Top: 45, Width: 100, Height: 30},
+		"p-fixed": {Left: 100, Top: 75, Width: 50, Height: 30},
+		"p-auto1": {Left: 100, Top: 45, Width: 50, Height: 30},
+		"p-auto2": {Left: 0, Top: 75, Width: 50, Height: 30},
+		"af1":     {Left: 0, Top: 105, Width: 100, Height: 10},
+		"af3":     {Left: 240, Top: 105, Width: 100, Height: 10},
+		"af4":     {Left: 0, Top: 115, Width: 100, Height: 10},
+		"al1":     {Left: 0, Top: 145, Width: 100, Height: 10},
+		"al2":     {Left: 100, Top: 165, Width: 100, Height: 10},
+		"after":   {Left: 0, Top: 175, Width: 640, Height: 10},
+	})
+}
+
+func TestTableLayout(t *testing.T) {
+	icb := layoutDemo(t, "table1", linux.NewNullFontProvider())
+	// For tables, border boxes are of table wrapper boxes.
+	assertBorderBoxes(t, icb, map[string]layout.PhysicalRect{
+		// Columns are as wide as the widest cell, and the spanning cell widens both of them.
+		"a1":   {Left: 10, Top: 10, Width: 95, Height: 20},
+		"a2":   {Left: 115, Top: 10, Width: 95, Height: 20},
+		"a3":   {Left: 10, Top: 40, Width: 200, Height: 10},
+		"auto": {Left: 0, Top: 0, Width: 220, Height: 60},
+		// Widths come from the column element and the first row, and the last column takes the rest.
+		"f1":    {Left: 5, Top: 65, Width: 100, Height: 50},
+		"f2":    {Left: 105, Top: 65, Width: 50, Height: 25},
+		"f3":    {Left: 155, Top: 65, Width: 140, Height: 25},
+		"f4":    {Left: 105, Top: 90, Width: 50, Height: 25},
+		"f5":    {Left: 155, Top: 90, Width: 140, Height: 25},
+		"fixed": {Left: 0, Top: 60, Width: 300, Height: 60},
+		// Wider borders win, and the caption goes above the table.
+		"cap":      {Left: 0, Top: 120, Width: 90, Height: 15},
+		"c1":       {Left: 0, Top: 135, Width: 44, Height: 28},
+		"c2":       {Left: 44, Top: 135, Width: 46, Height: 28},
+		"collapse": {Left: 0, Top: 120, Width: 90, Height: 43},
+		"after":    {Left: 0, Top: 163, Width: 640, Height: 10},
+	})
+}
+
+// generatedTextOf returns text of the pseudo-element named name, originating
+// from elem, inside of bx. ok is false if there's no such pseudo-element.
+func generatedTextOf(bx layout.Box, elem dom.Element, name string) (text string, ok bool) {
+	if pseudoElem := bx.BoxElement(); !util.IsNil(pseudoElem) && pseudoElem.LocalName() == "::"+name && pseudoElem.Parent() == elem {
+		sb := strings.Builder{}
+		var visit func(bx layout.Box)
+		visit = func(bx layout.Box) {
+			for _, txt := range bx.ChildTexts() {
+				sb.WriteString(txt.Text)
+			}
+			for _, child := range bx.ChildBoxes() {
+				visit(child)
+			}
+		}
+		visit(bx)
+		return sb.String(), true
+	}
+	for _, child := range bx.ChildBoxes() {
+		if text, ok := generatedTextOf(child, elem, name); ok {
+			return text, true
+		}
+	}
+	return "", false
+}
+
+func TestQuotes(t *testing.T) {
+	icb := layoutDemo(t, "quotes1", linux.NewNullFontProvider())
+	for _, tt := range []struct {
+		id   string
+		text string
+	}{
+		{"o1", "“"},
+		// Quotes inside flex and grid items are nested only once, even if
+		// they are laid out again.
+		{"flex1", "‘"},
+		{"flex2", "’"},
+		{"flex3", "‘"},
+		{"grid1", "‘"},
+		{"grid2", "’"},
+		{"c1", "’"},
+		{"c2", "”"},
+		// close-quote at depth 0 generates nothing.
+		{"c3", ""},
+	} {
+		bx := findBoxByElementID(icb, tt.id)
+		if bx == nil {
+			t.Errorf("#%s: box not found", tt.id)
+			continue
+		}
+		if text, _ := generatedTextOf(bx, bx.BoxElement(), "before"); text != tt.text {
+			t.Errorf("#%s: expected ::before text %q, got %q", tt.id, tt.text, text)
+		}
+	}
+}
+
+func TestListMarkers(t *testing.T) {
+	tests := []struct {
+		id         string
+		pseudoElem string
+		text       string // Empty if the pseudo-element shouldn't exist. Trailing spaces aren't part of text boxes.
+	}{
+		{"u1", "marker", "•"},
+		{"u2", "marker", "•"},
+		{"u3", "marker", "◦"},
+		// value attribute sets the counter, and following items continue from there.
+		{"o1", "marker", "1."},
+		{"o2", "marker", "10."},
+		{"o3", "marker", "11."},
+		// Reversed lists count down to 1, unless start is given.
+		{"r1", "marker", "3."},
+		{"r2", "marker", "2."},
+		{"r3", "marker", "1."},
+		{"rs1", "marker", "10."},
+		{"rs2", "marker", "9."},
+		{"roman1", "marker", "IV."},
+		{"roman2", "marker", "V."},
+		{"hangul1", "marker", "일십일,"},
+		{"string1", "marker", "->"},
+		{"none1", "marker", ""},
+		{"s1", "before", "A)"},
+		{"s2", "before", "B)"},
+		{"s3", "before", "Z)"},
+	}
+	icb := layoutDemo(t, "list1", linux.NewNullFontProvider())
+	for _, tt := range tests {
+		bx := findBoxByElementID(icb, tt.id)
+		if bx == nil {
+			t.Errorf("#%s: box not found", tt.id)
+			continue
+		}
+		text, ok := generatedTextOf(bx, bx.BoxElement(), tt.pseudoElem)
+		if tt.text == "" {
+			if ok {
+				t.Errorf("#%s: expected no ::%s, got %q", tt.id, tt.pseudoElem, text)
+			}
+			continue
+		}
+		if !ok {
+			t.Errorf("#%s: ::%s not found", tt.id, tt.pseudoElem)
+		} else if text != tt.text {
+			t.Errorf("#%s: expected ::%s text %q, got %q", tt.id, tt.pseudoElem, tt.text, text)
+		}
+	}
+
+	// Outside markers are placed before the border box of the list item.
+	li := findBoxByElementID(icb, "u1").(*layout.BlockContainerBox)
+	for _, child := range li.ChildBoxes() {
+		bcon, ok := child.(*layout.BlockContainerBox)
+		if !ok || !bcon.IsOutsideMarker {
+			continue
+		}
+		markerRect := bcon.BoxMarginRect().ToPhysicalRect()
+		if got, expected := markerRect.Left+markerRect.Width, li.BoxBorderRect().ToPhysicalRect().Left; got != expected {
+			t.Errorf("#u1: expected marker to end at %v, got %v", expected, got)
+		}
+		return
+	}
+	t.Errorf("#u1: outside marker box not found")
+}
+
+// fixedWidthFontProvider provides fonts where every character is 10px wide and
+// 10px tall, so that tests can depend on positions of text.
+type fixedWidthFontProvider struct{}
+
+func (prv fixedWidthFontProvider) OpenFont(path string, index int) (gfx.Font, error) {
+	return fixedWidthFont{}, nil
+}
+
+type fixedWidthFont struct{}
+
+func (fnt fixedWidthFont) SetTextSize(size int) {}
+func (fnt fixedWidthFont) Metrics() gfx.FontMetrics {
+	return gfx.FontMetrics{Ascender: 8, Descender: 2, LineHeight: 10}
+}
+func (fnt fixedWidthFont) DrawText(text string, dest *image.RGBA, offsetX, offsetY int, textColor color.Color) image.Rectangle {
+	return image.Rect(offsetX, offsetY, offsetX+10*utf8.RuneCountInString(text), offsetY+10)
+}
+func (fnt fixedWidthFont) HasGlyph(r rune) bool {
+	return true
+}
+
+// textsOf returns texts inside bx, in tree order.
+func textsOf(bx layout.Box) []*layout.Text {
+	res := slices.Clone(bx.ChildTexts())
+	for _, child := range bx.ChildBoxes() {
+		res = append(res, textsOf(child)...)
+	}
+	return res
+}
+
+// textLinesOf returns text fragments inside bx, grouped into lines along the
+// block axis.
+func textLinesOf(bx layout.Box) [][]string {
+	texts := textsOf(bx)
+	slices.SortStableFunc(texts, func(a, b *layout.Text) int {
+		if a.Rect.LogicalY != b.Rect.LogicalY {
+			return cmp.Compare(a.Rect.LogicalY, b.Rect.LogicalY)
+		}
+		return cmp.Compare(a.Rect.LogicalX, b.Rect.LogicalX)
+	})
+	lines := [][]string{}
+	for i, txt := range texts {
+		if i == 0 || texts[i-1].Rect.LogicalY != txt.Rect.LogicalY {
+			lines = append(lines, []string{})
+		}
+		lines[len(lines)-1] = append(lines[len(lines)-1], txt.Text)
+	}
+	return lines
+}
+
+// assertTextLines checks lines of texts inside elements with given IDs.
+// See textLinesOf.
+func assertTextLines(t *testing.T, root layout.Box, expected map[string][][]string) {
+	t.Helper()
+	for _, id := range slices.Sorted(maps.Keys(expected)) {
+		bx := findBoxByElementID(root, id)
+		if bx == nil {
+			t.Errorf("#%s: box not found", id)
+			continue
+		}
+		if got := textLinesOf(bx); !reflect.DeepEqual(got, expected[id]) {
+			t.Errorf("#%s: expected lines %q, got %q", id, expected[id], got)
+		}
+	}
+}
+
+// textRect is text and its physical rect, relative to the margin box of some
+// box.
+type textRect struct {
+	text string
+	rect layout.PhysicalRect
+}
+
+// textRectsCase is expected height of the element with the ID, and texts
+// inside of it.
+type textRectsCase struct {
+	id        string
+	height    layout.PhysicalPos
+	textRects []textRect
+}
+
+// assertTextRects checks boxes and texts against cases. Texts are compared
+// from top to bottom, and then from left to right.
+func assertTextRects(t *testing.T, root layout.Box, cases []textRectsCase) {
+	t.Helper()
+	for _, tt := range cases {
+		bx := findBoxByElementID(root, tt.id)
+		if bx == nil {
+			t.Errorf("#%s: box not found", tt.id)
+			continue
+		}
+		marginRect := bx.BoxMarginRect().ToPhysicalRect()
+		if marginRect.Height != tt.height {
+			t.Errorf("#%s: expected height %v, got %v", tt.id, tt.height, marginRect.Height)
+		}
+		got := []textRect{}
+		for _, txt := range textsOf(bx) {
+			rect := txt.Rect.ToPhysicalRect()
+			rect.Left -= marginRect.Left
+			rect.Top -= marginRect.Top
+			got = append(got, textRect{txt.Text, rect})
+		}
+		slices.SortFunc(got, func(a, b textRect) int {
+			return cmp.Or(cmp.Compare(a.rect.Top, b.rect.Top), cmp.Compare(a.rect.Left, b.rect.Left))
+		})
+		if !reflect.DeepEqual(got, tt.textRects) {
+			t.Errorf("#%s: expected texts %v, got %v", tt.id, tt.textRects, got)
+		}
+	}
+}
+
+func TestLineBreaking(t *testing.T) {
+	// Every character is 10px wide, and boxes are 100px wide.
+	icb := layoutDemo(t, "linebreak1", fixedWidthFontProvider{})
+	assertTextLines(t, icb, map[string][][]string{
+		"words": {{"Hello"}, {"world foo"}},
+		// UTF-8 sequences are never split.
+		"hangul":   {{"가나다라마바사아자차"}, {"카타"}},
+		"keep-all": {{"가나다"}, {"라마바사아자차카타파"}},
+		// Words overflow, unless they can be broken.
+		"long-word":           {{"abcdefghijklmnop"}, {"qr"}},
+		"anywhere":            {{"abcdefghij"}, {"klmnop qr"}},
+		"break-all":           {{"ab abcdefg"}, {"hijkl"}},
+		"line-break-anywhere": {{"ab, cdefgh"}, {"ijkl"}},
+		// There are no break opportunities between text in different elements,
+		// unless there's a space.
+		"elements":    {{"foo", "bar"}, {"bazqux", "quuxx"}},
+		"punctuation": {{"abc def"}, {"(gh) ij."}},
+	})
+}
+
+func TestBidi(t *testing.T) {
+	// Every character is 10px wide, and boxes are 200px wide.
+	icb := layoutDemo(t, "bidi1", fixedWidthFontProvider{})
+	assertTextLines(t, icb, map[string][][]string{
+		"ltr":     {{"abc ", "והד גבא", " xyz"}},
+		"rtl":     {{"גבא ", "abc"}},
+		"css-rtl": {{"גבא ", "abc"}},
+		// dir=auto takes the direction of the first strong character.
+		"auto":     {{"abc", " גבא"}},
+		"auto-ltr": {{"abc ", "גבא"}},
+		"bdo":      {{"abc", "fed"}},
+		"override": {{"cba"}},
+		"numbers":  {{"123", " גבא"}},
+	})
+	// Inline boxes are moved along with their contents.
+	inlineBoxes := map[string][2]layout.PhysicalPos{"ltr-span": {170, 30}, "rtl-span": {0, 30}}
+	for _, id := range slices.Sorted(maps.Keys(inlineBoxes)) {
+		expected := inlineBoxes[id]
+		bx := findBoxByElementID(icb, id)
+		if bx == nil {
+			t.Errorf("#%s: box not found", id)
+			continue
+		}
+		if rect := bx.BoxMarginRect().ToPhysicalRect(); rect.Left != expected[0] || rect.Width != expected[1] {
+			t.Errorf("#%s: expected left %v and width %v, got %v and %v", id, expected[0], expected[1], rect.Left, rect.Width)
+		}
+	}
+}
+
+func TestWritingModes(t *testing.T) {
+	// Every character is 10px wide and tall, and boxes are 100x50px.
+	icb := layoutDemo(t, "writingmode1", fixedWidthFontProvider{})
+	column := func(left, height layout.PhysicalPos) layout.PhysicalRect {
+		return layout.PhysicalRect{Left: left, Top: 0, Width: 10, Height: height}
+	}
+	assertTextRects(t, icb, []textRectsCase{
+		// Lines are placed from right to left.
+		{"rl", 50, []textRect{{"바사아자차", column(80, 50)}, {"가나다라마", column(90, 50)}}},
+		// Lines are placed from left to right.
+		{"lr", 50, []textRect{{"가나다라마", column(0, 50)}, {"바사아자차", column(10, 50)}}},
+		// Auto height is the height of contents.
+		{"auto-height", 30, []textRect{{"가나다", column(90, 30)}}},
+		{"upright", 50, []textRect{{"abc", column(90, 30)}}},
+		{"after", 50, []textRect{{"abc", layout.PhysicalRect{Left: 0, Top: 0, Width: 30, Height: 10}}}},
+	})
+	for _, id := range []string{"rl", "lr", "auto-height", "upright", "after"} {
+		if bx := findBoxByElementID(icb, id); bx != nil && bx.BoxMarginRect().ToPhysicalRect().Width != 100 {
+			t.Errorf("#%s: expected width 100, got %v", id, bx.BoxMarginRect().ToPhysicalRect().Width)
+		}
+	}
+}
+
+// textLine returns rect of a text that is 10px tall.
+func textLine(left, top, width layout.PhysicalPos) layout.PhysicalRect {
+	return layout.PhysicalRect{Left: left, Top: top, Width: width, Height: 10}
+}
+
+func TestInlineLayout(t *testing.T) {
+	// Every character is 10px wide, with 8px ascent and 2px descent, and boxes
+	// are 100px wide.
+	icb := layoutDemo(t, "inline1", fixedWidthFontProvider{})
+	assertTextRects(t, icb, []textRectsCase{
+		{"left", 10, []textRect{{"ab cd", textLine(0, 0, 50)}}},
+		{"right", 10, []textRect{{"ab cd", textLine(50, 0, 50)}}},
+		{"center", 10, []textRect{{"ab cd", textLine(25, 0, 50)}}},
+		{"end", 10, []textRect{{"ab cd", textLine(50, 0, 50)}}},
+		// Spacing is added between words, except on the last line.
+		{"justify", 20, []textRect{{"aaa", textLine(0, 0, 30)}, {"bb", textLine(45, 0, 20)}, {"cc", textLine(80, 0, 20)}, {"dddd", textLine(0, 10, 40)}}},
+		{"indent", 20, []textRect{{"ab cd ef", textLine(20, 0, 80)}, {"gh ij", textLine(0, 10, 50)}}},
+		{"hanging", 20, []textRect{{"ab cd ef", textLine(0, 0, 80)}, {"gh ij", textLine(20, 10, 50)}}},
+		// Half-leading is added above and below the text.
+		{"line-height", 40, []textRect{{"ab cd ef", textLine(0, 5, 80)}, {"gh ij", textLine(0, 25, 50)}}},
+		{"raise", 14, []textRect{{"cd", textLine(20, 0, 20)}, {"ab", textLine(0, 4, 20)}}},
+		// sub lowers the baseline by 1/5 of the parent's font size.
+		{"sub", 13, []textRect{{"ab", textLine(0, 0, 20)}, {"cd", textLine(20, 3, 20)}}},
+		{"top", 30, []textRect{{"cd", textLine(20, 0, 20)}, {"ab", textLine(0, 10, 20)}}},
+		{"bottom", 30, []textRect{{"ab", textLine(0, 10, 20)}, {"cd", textLine(20, 20, 20)}}},
+		// Bottom of the empty inline-block sits on the baseline.
+		{"atomic", 32, []textRect{{"ab", textLine(0, 22, 20)}}},
+	})
+}
+
+func TestWhiteSpace(t *testing.T) {
+	// Every character is 10px wide and 10px tall, and boxes are 100px wide.
+	icb := layoutDemo(t, "whitespace1", fixedWidthFontProvider{})
+	assertTextRects(t, icb, []textRectsCase{
+		// Newline at the end doesn't make an empty line.
+		{"pre", 20, []textRect{{"  ab  cd", textLine(0, 0, 80)}, {"ef", textLine(0, 10, 20)}}},
+		{"blank", 30, []textRect{{"a", textLine(0, 0, 10)}, {"b", textLine(0, 20, 10)}}},
+		// Preserved spaces at the end of the line hang.
+		{"pre-wrap", 20, []textRect{{"ab   cdef", textLine(0, 0, 90)}, {"ghijk", textLine(0, 10, 50)}}},
+		{"pre-line", 20, []textRect{{"ab cd", textLine(0, 0, 50)}, {"ef", textLine(0, 10, 20)}}},
+		{"nowrap", 10, []textRect{{"ab cd ef gh ij kl", textLine(0, 0, 170)}}},
+		// Tabs are expanded to the next tab stop(8 spaces by default).
+		{"tab", 10, []textRect{{"a       b", textLine(0, 0, 90)}}},
+		{"tab-size", 10, []textRect{{"ab  c", textLine(0, 0, 50)}}},
+	})
+}
+
+func TestOverflow(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	htmlPath := "res/demo/layout/overflow1.html"
+	html, err := os.ReadFile(htmlPath)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", htmlPath, err)
+	}
+	docURL := fileURLOf(t, htmlPath)
+
+	// Every character is 10px wide, and the box is 100px wide.
+	br := Browser{}
+	icb := br.Layout(string(html), docURL, fixedWidthFontProvider{}, goldenViewportWidth, goldenViewportHeight)
+	if bx := findBoxByElementID(icb, "ellipsis"); bx == nil {
+		t.Errorf("#ellipsis: box not found")
+	} else if got, expected := textLinesOf(bx), [][]string{{"abcdefghi…"}}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("#ellipsis: expected texts %v, got %v", expected, got)
+	}
+
+	// Scroll position is clamped to the scrollable overflow area, which is
+	// 300x200 inside of the 110x60 padding box.
+	br = Browser{ScrollOffsets: map[string]image.Point{"scroll": {500, 20}, "visible": {10, 10}}}
+	icb = br.Layout(string(html), docURL, linux.NewNullFontProvider(), goldenViewportWidth, goldenViewportHeight)
+	scroll := findBoxByElementID(icb, "scroll")
+	if scroll == nil {
+		t.Fatalf("#scroll: box not found")
+	}
+	if !scroll.IsScrollContainer() {
+		t.Errorf("#scroll: expected scroll container")
+	}
+	if bx := findBoxByElementID(icb, "visible"); bx == nil || bx.IsScrollContainer() {
+		t.Errorf("#visible: expected a box that is not a scroll container")
+	}
+
+	// The marker at (200, 20) of the contents is scrolled by (195, 20), so
+	// it's at (10, 5) of the padding box, which has 5px of padding.
+	img := image.NewRGBA(image.Rect(0, 0, goldenViewportWidth, goldenViewportHeight))
+	br.Render(string(html), docURL, linux.NewNullFontProvider(), img)
+	paddingRect := scroll.BoxPaddingRect().ToPhysicalRect()
+	left, top := int(paddingRect.Left), int(paddingRect.Top)
+	blue := color.RGBA{0, 0, 255, 255}
+	green := color.RGBA{0, 128, 0, 255}
+	for _, tt := range []struct {
+		x, y     int
+		expected color.RGBA
+	}{
+		{left + 9, top + 5, green},
+		{left + 10, top + 5, blue},
+		{left + 29, top + 24, blue},
+		{left + 30, top + 24, green},
+		{left + 10, top + 25, green},
+	} {
+		if got := img.RGBAAt(tt.x, tt.y); got != tt.expected {
+			t.Errorf("expected %v at (%d, %d), got %v", tt.expected, tt.x, tt.y, got)
+		}
+	}
+}
+
+func TestVisibility(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	htmlPath := "res/demo/layout/visibility1.html"
+	html, err := os.ReadFile(htmlPath)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", htmlPath, err)
+	}
+	docURL := fileURLOf(t, htmlPath)
+
+	br := Browser{}
+	icb := br.Layout(string(html), docURL, fixedWidthFontProvider{}, goldenViewportWidth, goldenViewportHeight)
+	texts := map[string]*layout.Text{}
+	var visit func(bx layout.Box)
+	visit = func(bx layout.Box) {
+		for _, txt := range bx.ChildTexts() {
+			texts[txt.Text] = txt
+		}
+		for _, child := range bx.ChildBoxes() {
+			visit(child)
+		}
+	}
+	visit(icb)
+	for text, expected := range map[string]bool{"Hidden": true, "Shown": false, "Collapsed": true, "Transparent": false} {
+		if txt, ok := texts[text]; !ok {
+			t.Errorf("%q: text not found", text)
+		} else if got := txt.IsHidden; got != expected {
+			t.Errorf("%q: expected IsHidden %v, got %v", text, expected, got)
+		}
+	}
+
+	// Children of display: contents elements are laid out as if they were
+	// children of the parent.
+	if bx := findBoxByElementID(icb, "contents"); bx != nil {
+		t.Errorf("#contents: expected no box, got %v", bx)
+	}
+	if bx := findBoxByElementID(icb, "parent"); bx == nil {
+		t.Errorf("#parent: box not found")
+	} else if got, expected := textLinesOf(bx), [][]string{{"Foo", "Bar"}, {"Baz", " Qux"}}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("#parent: expected texts %v, got %v", expected, got)
+	}
+	// Texts still inherit style of the display: contents element.
+	if txt, ok := texts["Bar"]; ok && txt.Color != (color.RGBA{0, 0, 255, 255}) {
+		t.Errorf("%q: expected blue text, got %v", txt.Text, txt.Color)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, goldenViewportWidth, goldenViewportHeight))
+	br.Render(string(html), docURL, linux.NewNullFontProvider(), img)
+	icb = br.Layout(string(html), docURL, linux.NewNullFontProvider(), goldenViewportWidth, goldenViewportHeight)
+	pointIn := func(id string, x, y int) (int, int) {
+		bx := findBoxByElementID(icb, id)
+		if bx == nil {
+			t.Fatalf("#%s: box not found", id)
+		}
+		r := bx.BoxBorderRect().ToPhysicalRect()
+		return int(r.Left) + x, int(r.Top) + y
+	}
+	white := color.RGBA{255, 255, 255, 255}
+	blue := color.RGBA{0, 0, 255, 255}
+	for _, tt := range []struct {
+		id       string
+		x, y     int
+		expected color.RGBA
+	}{
+		// Neither borders nor backgrounds of hidden boxes are painted.
+		{"hidden", 2, 2, white},
+		{"hidden", 80, 40, white},
+		{"shown", 2, 2, blue},
+		{"collapse", 2, 2, white},
+		{"transparent", 2, 2, white},
+		{"transparent", 50, 25, white},
+	} {
+		x, y := pointIn(tt.id, tt.x, tt.y)
+		if got := img.RGBAAt(x, y); got != tt.expected {
+			t.Errorf("#%s: expected %v at (%d, %d), got %v", tt.id, tt.expected, x, y, got)
+		}
+	}
+
+	// The group is composited at once, so overlapping parts of children are
+	// not blended with each other.
+	overlapX, overlapY := pointIn("opacity", 10, 20)
+	innerX, innerY := pointIn("opacity", 10, 10)
+	if got, expected := img.RGBAAt(overlapX, overlapY), img.RGBAAt(innerX, innerY); got != expected {
+		t.Errorf("#opacity: expected %v at overlapping area, got %v", expected, got)
+	}
+	if got := img.RGBAAt(innerX, innerY); got == blue || got == white {
+		t.Errorf("#opacity: expected translucent blue, got %v", got)
+	}
+}
+
+func TestImages(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	htmlPath := "res/demo/layout/image1.html"
+	html, err := os.ReadFile(htmlPath)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", htmlPath, err)
+	}
+	br := Browser{}
+	icb := br.Layout(string(html), fileURLOf(t, htmlPath), fixedWidthFontProvider{}, goldenViewportWidth, goldenViewportHeight)
+	for _, tt := range []struct {
+		id            string
+		width, height layout.PhysicalPos
+	}{
+		{"png", 20, 20},
+		// Missing dimension is computed from the aspect ratio.
+		{"width-attr", 40, 40},
+		{"height-attr", 60, 30},
+		{"css-size", 80, 20},
+		{"bordered", 20, 20},
+		{"max-width", 10, 5},
+		// Intrinsic sizes are divided by the pixel density.
+		{"density", 20, 20},
+		{"density-2x", 20, 20},
+		// 40w image is selected for 40px source size.
+		{"width-descriptor", 40, 40},
+		{"block", 30, 15},
+	} {
+		bx := findBoxByElementID(icb, tt.id)
+		if bx == nil {
+			t.Errorf("#%s: box not found", tt.id)
+			continue
+		}
+		rect := bx.BoxContentRect().ToPhysicalRect()
+		if rect.Width != tt.width || rect.Height != tt.height {
+			t.Errorf("#%s: expected size %vx%v, got %vx%v", tt.id, tt.width, tt.height, rect.Width, rect.Height)
+		}
+	}
+	if bx := findBoxByElementID(icb, "block"); bx != nil {
+		if parentWidth := bx.BoxParent().BoxContentRect().LogicalWidth; bx.BoxMarginRect().LogicalWidth == parentWidth {
+			t.Errorf("#block: expected shrink-to-fit width, got %v", parentWidth)
+		}
+	}
+
+	// Broken images are rendered as their alt text.
+	if bx := findBoxByElementID(icb, "broken"); bx == nil {
+		t.Errorf("#broken: box not found")
+	} else if got, expected := textLinesOf(bx), [][]string{{"Before", "Missing image", " after"}}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("#broken: expected texts %v, got %v", expected, got)
+	}
+}
+
+func TestBackgrounds(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	htmlPath := "res/demo/layout/background1.html"
+	html, err := os.ReadFile(htmlPath)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", htmlPath, err)
+	}
+	docURL := fileURLOf(t, htmlPath)
+
+	br := Browser{}
+	img := image.NewRGBA(image.Rect(0, 0, goldenViewportWidth, goldenViewportHeight))
+	br.Render(string(html), docURL, linux.NewNullFontProvider(), img)
+	icb := br.Layout(string(html), docURL, linux.NewNullFontProvider(), goldenViewportWidth, goldenViewportHeight)
+	pointIn := func(id string, x, y int) (int, int) {
+		bx := findBoxByElementID(icb, id)
+		if bx == nil {
+			t.Fatalf("#%s: box not found", id)
+		}
+		r := bx.BoxBorderRect().ToPhysicalRect()
+		return int(r.Left) + x, int(r.Top) + y
+	}
+	white := color.RGBA{255, 255, 255, 255}
+	red := color.RGBA{255, 0, 0, 255}
+	blue := color.RGBA{0, 0, 255, 255}
+	lime := color.RGBA{0, 255, 0, 255}
+	yellow := color.RGBA{255, 255, 0, 255}
+	silver := color.RGBA{192, 192, 192, 255}
+	gray := color.RGBA{128, 128, 128, 255}
+	for _, tt := range []struct {
+		id       string
+		x, y     int
+		expected color.RGBA
+	}{
+		{"stops", 25, 30, red},
+		{"stops", 75, 30, blue},
+		// Conic gradients start from the angle given by "from".
+		{"conic-hard", 75, 45, red},
+		{"conic-hard", 25, 15, blue},
+		{"ellipse", 95, 30, white},
+		// Background color is visible where the image is not repeated.
+		{"no-repeat", 2, 2, yellow},
+		{"position", 2, 2, silver},
+		{"contain", 50, 2, gray},
+		// Upper layers are painted over lower ones.
+		{"layers", 25, 30, red},
+		{"layers", 75, 30, blue},
+		// Background color is clipped to the padding box, so it's not visible
+		// behind the translucent border.
+		{"clip", 15, 15, lime},
+	} {
+		x, y := pointIn(tt.id, tt.x, tt.y)
+		if got := img.RGBAAt(x, y); got != tt.expected {
+			t.Errorf("#%s: expected %v at (%d, %d), got %v", tt.id, tt.expected, x, y, got)
+		}
+	}
+	if x, y := pointIn("clip", 5, 5); img.RGBAAt(x, y).G > 200 {
+		t.Errorf("#clip: expected border without background behind it, got %v", img.RGBAAt(x, y))
+	}
+
+	// Images with the same URL are fetched and decoded only once.
+	backgroundImageOf := func(id string) image.Image {
+		bcon, ok := findBoxByElementID(icb, id).(*layout.BlockContainerBox)
+		if !ok || len(bcon.Background.Images) == 0 {
+			t.Fatalf("#%s: expected block container with background image", id)
+		}
+		return bcon.Background.Images[0]
+	}
+	if repeat, noRepeat := backgroundImageOf("repeat"), backgroundImageOf("no-repeat"); repeat == nil || repeat != noRepeat {
+		t.Errorf("expected #repeat and #no-repeat to share the same image, got %p and %p", repeat, noRepeat)
+	}
+}
+
+func TestBorderRadiusAndShadows(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	htmlPath := "res/demo/layout/border3.html"
+	html, err := os.ReadFile(htmlPath)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", htmlPath, err)
+	}
+	docURL := fileURLOf(t, htmlPath)
+
+	br := Browser{}
+	img := image.NewRGBA(image.Rect(0, 0, goldenViewportWidth, goldenViewportHeight))
+	br.Render(string(html), docURL, linux.NewNullFontProvider(), img)
+	icb := br.Layout(string(html), docURL, linux.NewNullFontProvider(), goldenViewportWidth, goldenViewportHeight)
+	pointIn := func(id string, x, y int) (int, int) {
+		bx := findBoxByElementID(icb, id)
+		if bx == nil {
+			t.Fatalf("#%s: box not found", id)
+		}
+		r := bx.BoxBorderRect().ToPhysicalRect()
+		return int(r.Left) + x, int(r.Top) + y
+	}
+	white := color.RGBA{255, 255, 255, 255}
+	black := color.RGBA{0, 0, 0, 255}
+	red := color.RGBA{255, 0, 0, 255}
+	blue := color.RGBA{0, 0, 255, 255}
+	yellow := color.RGBA{255, 255, 0, 255}
+	for _, tt := range []struct {
+		id       string
+		x, y     int
+		expected color.RGBA
+	}{
+		// Backgrounds are clipped to rounded corners.
+		{"radius", 1, 1, white},
+		{"radius", 50, 30, blue},
+		{"corners", 1, 1, blue},
+		{"corners", 2, 58, white},
+		{"bordered", 1, 1, white},
+		{"bordered", 4, 30, red},
+		{"bordered", 50, 30, yellow},
+		// Contents are clipped to the curve of the padding box.
+		{"overflow", 1, 1, white},
+		{"overflow", 50, 30, red},
+		// Outer shadows are only painted outside of the border box.
+		{"shadow", 50, 30, white},
+		{"shadow", 102, 30, black},
+		{"shadow", 50, 62, black},
+		{"shadow", 2, 62, white},
+		// Inner shadows are painted above the background.
+		{"inset", 2, 30, red},
+		{"inset", 50, 30, yellow},
+	} {
+		x, y := pointIn(tt.id, tt.x, tt.y)
+		if got := img.RGBAAt(x, y); got != tt.expected {
+			t.Errorf("#%s: expected %v at (%d, %d), got %v", tt.id, tt.expected, x, y, got)
+		}
+	}
+
+	// Curves are anti-aliased, so pixels along them are partially painted.
+	if x, y := pointIn("radius", 14, 0); img.RGBAAt(x, y) == white || img.RGBAAt(x, y) == blue {
+		t.Errorf("#radius: expected partially painted pixel at (%d, %d), got %v", x, y, img.RGBAAt(x, y))
+	}
+	// Blurred shadows fade out outside of the box.
+	if x, y := pointIn("blur", -4, 30); img.RGBAAt(x, y) == white || img.RGBAAt(x, y) == blue {
+		t.Errorf("#blur: expected blurred shadow at (%d, %d), got %v", x, y, img.RGBAAt(x, y))
+	}
+}
+
+// faceFontProvider provides fixed width fonts that remember which face they
+// were opened for. "broken" faces can't be opened. If opened isn't nil, it
+// counts how many times each face was opened.
+type faceFontProvider struct {
+	opened map[string]int
+}
+
+func (prv faceFontProvider) OpenFont(path string, index int) (gfx.Font, error) {
+	if prv.opened != nil {
+		prv.opened[path]++
+	}
+	if path == "broken" {
+		return nil, errors.New("broken font")
+	}
+	return faceFont{path: path}, nil
+}
+
+type faceFont struct {
+	fixedWidthFont
+	path string
+}
+
+// HasGlyph reports that "cjk" faces only have Hangul and spaces, "kana" faces
+// only have Katakana, and others only have characters before Hangul Jamo
+// block.
+func (fnt faceFont) HasGlyph(r rune) bool {
+	switch fnt.path {
+	case "cjk":
+		return unicode.In(r, unicode.Hangul) || r == ' '
+	case "kana":
+		return unicode.In(r, unicode.Katakana)
+	}
+	return r < 0x1100
+}
+
+func TestFontMatching(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	db := fontdb.New()
+	db.Generics = map[fonts.FamilyType][]string{
+		fonts.SansSerif: {"Test Sans"},
+		fonts.Serif:     {"Test Serif"},
+		fonts.Monospace: {"Test Mono"},
+	}
+	for _, face := range []fontdb.Face{
+		{Path: "sans", Family: "Test Sans", Weight: 400, Style: fonts.NormalStyle, Stretch: fonts.NormalStretch},
+		{Path: "sans-bold", Family: "Test Sans", Weight: 700, Style: fonts.NormalStyle, Stretch: fonts.NormalStretch},
+		{Path: "sans-italic", Family: "Test Sans", Weight: 400, Style: fonts.Italic, Stretch: fonts.NormalStretch},
+		{Path: "sans-condensed", Family: "Test Sans", Weight: 400, Style: fonts.NormalStyle, Stretch: fonts.Condensed},
+		{Path: "serif", Family: "Test Serif", Weight: 400, Style: fonts.NormalStyle, Stretch: fonts.NormalStretch},
+		{Path: "serif-bold", Family: "Test Serif", Weight: 700, Style: fonts.NormalStyle, Stretch: fonts.NormalStretch},
+		{Path: "serif-black", Family: "Test Serif", Weight: 900, Style: fonts.NormalStyle, Stretch: fonts.NormalStretch},
+		{Path: "mono", Family: "Test Mono", Weight: 400, Style: fonts.NormalStyle, Stretch: fonts.NormalStretch},
+		{Path: "broken", Family: "Test Broken", Weight: 400, Style: fonts.NormalStyle, Stretch: fonts.NormalStretch},
+	} {
+		db.AddFace(face)
+	}
+
+	htmlPath := "res/demo/layout/font1.html"
+	html, err := os.ReadFile(htmlPath)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", htmlPath, err)
+	}
+	br := Browser{FontDB: db}
+	icb := br.Layout(string(html), fileURLOf(t, htmlPath), faceFontProvider{}, goldenViewportWidth, goldenViewportHeight)
+	for _, tt := range []struct {
+		id   string
+		face string
+	}{
+		{"plain", "sans"},
+		{"bold", "sans-bold"},
+		{"italic", "sans-italic"},
+		{"code", "mono"},
+		{"serif", "serif"},
+		{"serif-bold", "serif-bold"},
+		// bolder inside of bold is resolved against the parent's weight.
+		{"bolder", "serif-black"},
+		{"named", "serif"},
+		// Unavailable families fall back to the default family.
+		{"unknown", "sans"},
+		{"light", "sans"},
+		// Heavier weights are preferred for weights above 500.
+		{"semibold", "sans-bold"},
+		// Italic faces are used for oblique if there's no oblique face.
+		{"oblique", "sans-italic"},
+		{"condensed", "sans-condensed"},
+		// Faces that can't be opened are skipped.
+		{"broken", "serif"},
+	} {
+		bx := findBoxByElementID(icb, tt.id)
+		if bx == nil {
+			t.Errorf("#%s: box not found", tt.id)
+			continue
+		}
+		texts := bx.ChildTexts()
+		if len(texts) == 0 {
+			t.Errorf("#%s: text not found", tt.id)
+			continue
+		}
+		if got := texts[0].Font.(faceFont).path; got != tt.face {
+			t.Errorf("#%s: expected face %q, got %q", tt.id, tt.face, got)
+		}
+	}
+}
+
+func TestFontFallback(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	db := fontdb.New()
+	db.Generics = map[fonts.FamilyType][]string{
+		fonts.SansSerif: {"Test Sans"},
+	}
+	hangul := fontdb.NewCoverage([2]rune{' ', ' '}, [2]rune{0x1100, 0x11ff}, [2]rune{0x3130, 0x318f}, [2]rune{0xac00, 0xd7a3})
+	faces := []fontdb.Face{
+		{Path: "sans", Family: "Test Sans", Weight: 400, Style: fonts.NormalStyle, Stretch: fonts.NormalStretch},
+		{Path: "cjk", Family: "Test CJK", Weight: 400, Style: fonts.NormalStyle, Stretch: fonts.NormalStretch, Coverage: hangul},
+	}
+	// Faces without any characters shouldn't be opened at all.
+	for i := range 40 {
+		faces = append(faces, fontdb.Face{
+			Path: fmt.Sprintf("empty-%d", i), Family: fmt.Sprintf("Test Empty %d", i),
+			Weight: 400, Style: fonts.NormalStyle, Stretch: fonts.NormalStretch, Coverage: fontdb.NewCoverage(),
+		})
+	}
+	// Faces far down the chain are still used, if others don't have the
+	// character.
+	faces = append(faces, fontdb.Face{
+		Path: "kana", Family: "Test Kana", Weight: 400, Style: fonts.NormalStyle, Stretch: fonts.NormalStretch,
+		Coverage: fontdb.NewCoverage([2]rune{0x30a0, 0x30ff}),
+	})
+	for _, face := range faces {
+		db.AddFace(face)
+	}
+
+	htmlPath := "res/demo/layout/font2.html"
+	html, err := os.ReadFile(htmlPath)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", htmlPath, err)
+	}
+	br := Browser{FontDB: db}
+	prv := faceFontProvider{opened: map[string]int{}}
+	icb := br.Layout(string(html), fileURLOf(t, htmlPath), prv, goldenViewportWidth, goldenViewportHeight)
+	type run struct {
+		text, face string
+		left       float64
+	}
+	for _, tt := range []struct {
+		id       string
+		expected []run
+	}{
+		{"latin", []run{{"Latin only", "sans", 0}}},
+		{"mixed", []run{{"abc", "sans", 0}, {"가나다", "cjk", 30}, {"def", "sans", 60}}},
+		// Combining marks stay with the preceding character.
+		{"mark", []run{{"가\u0301", "cjk", 0}, {"a", "sans", 20}}},
+		// Spaces stay with the current font if it has them.
+		{"space", []run{{"가 나", "cjk", 0}}},
+		// Characters missing from the first family use the next one.
+		{"cjk-first", []run{{"한글 ", "cjk", 0}, {"and Latin", "sans", 30}}},
+		{"kana", []run{{"アイ", "kana", 0}}},
+		// Characters missing from all fonts in the chain use the primary font.
+		{"missing", []run{{"∀∃", "sans", 0}}},
+	} {
+		bx := findBoxByElementID(icb, tt.id)
+		if bx == nil {
+			t.Errorf("#%s: box not found", tt.id)
+			continue
+		}
+		got := []run{}
+		for _, txt := range bx.ChildTexts() {
+			left := float64(txt.Rect.ToPhysicalRect().Left - bx.BoxContentRect().ToPhysicalRect().Left)
+			got = append(got, run{txt.Text, txt.Font.(faceFont).path, left})
+		}
+		if !slices.Equal(got, tt.expected) {
+			t.Errorf("#%s: expected %v, got %v", tt.id, tt.expected, got)
+		}
+	}
+	for path, count := range prv.opened {
+		if count != 1 {
+			t.Errorf("expected %q to be opened once, opened %d times", path, count)
+		}
+		if path != "sans" && path != "cjk" && path != "kana" {
+			t.Errorf("expected %q not to be opened", path)
+		}
+	}
+}